@@ -5,14 +5,19 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/robalyx/rotector/internal/common/progress"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/worker/ai"
+	"github.com/robalyx/rotector/internal/worker/core"
 	"github.com/robalyx/rotector/internal/worker/maintenance"
 	"github.com/robalyx/rotector/internal/worker/queue"
+	"github.com/robalyx/rotector/internal/worker/retention"
 	"github.com/robalyx/rotector/internal/worker/stats"
 	"github.com/urfave/cli/v3"
 	"go.uber.org/zap"
@@ -21,22 +26,61 @@ import (
 const (
 	// WorkerLogDir specifies where worker log files are stored.
 	WorkerLogDir = "logs/worker_logs"
+	// PurgeReportDir specifies where dry run purge reports are stored.
+	PurgeReportDir = "logs/purge_reports"
 
 	// AIWorker processes user content through AI analysis.
 	AIWorker           = "ai"
 	AIWorkerTypeFriend = "friend"
 	AIWorkerTypeMember = "member"
+	AIPreviewCommand   = "preview"
 
-	// MaintenanceWorker maintains tracking and old data.
+	// MaintenanceWorker checks for bans and maintains tracking and thumbnails.
 	MaintenanceWorker = "maintenance"
 
+	// RetentionCommand applies the retention policies once, outside the stats worker.
+	RetentionCommand = "retention"
+
 	// StatsWorker handles statistics aggregation and storage.
 	StatsWorker = "stats"
 
 	// QueueWorker manages the processing queue for user checks.
 	QueueWorker = "queue"
+
+	// AllWorkers runs one of each worker inside a single process.
+	AllWorkers = "all"
+
+	// MinConnsPerCombinedWorker is the number of database connections each
+	// sub-worker is expected to need when running in a combined process.
+	MinConnsPerCombinedWorker = 2
+
+	// ShutdownTimeout is how long workers are given to finish their current
+	// batch after a shutdown signal before the process exits anyway.
+	ShutdownTimeout = 2 * time.Minute
 )
 
+// worker runs batches until the context is cancelled, finishing the batch in
+// progress before returning.
+type worker interface {
+	Start(ctx context.Context)
+}
+
+// combinedWorker describes a sub-worker started by the combined process.
+type combinedWorker struct {
+	label      string
+	workerType string
+	subType    string
+}
+
+// combinedWorkers lists the sub-workers started by the combined process.
+var combinedWorkers = []combinedWorker{ //nolint:gochecknoglobals
+	{label: "AI Friend", workerType: AIWorker, subType: AIWorkerTypeFriend},
+	{label: "AI Member", workerType: AIWorker, subType: AIWorkerTypeMember},
+	{label: "Maintenance", workerType: MaintenanceWorker},
+	{label: "Stats", workerType: StatsWorker},
+	{label: "Queue", workerType: QueueWorker},
+}
+
 func main() {
 	if err := run(); err != nil {
 		log.Printf("Error: %v", err)
@@ -77,16 +121,54 @@ func run() error {
 							return nil
 						},
 					},
+					{
+						Name:  AIPreviewCommand,
+						Usage: "Preview the AI prompt for a stored user without saving results",
+						Flags: []cli.Flag{
+							&cli.UintFlag{
+								Name:     "user-id",
+								Usage:    "ID of the stored user to preview",
+								Required: true,
+							},
+							&cli.BoolFlag{
+								Name:  "call",
+								Usage: "Also call the model and show the parsed result",
+							},
+						},
+						Action: func(ctx context.Context, c *cli.Command) error {
+							return runPreview(ctx, c.Uint("user-id"), c.Bool("call"))
+						},
+					},
 				},
 			},
 			{
 				Name:  MaintenanceWorker,
 				Usage: "Start maintenance workers",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "Run a single pass reporting what would be purged without modifying the database",
+					},
+					&cli.BoolFlag{
+						Name:  "report",
+						Usage: "With --dry-run, also write the IDs that would be purged to " + PurgeReportDir,
+					},
+				},
 				Action: func(ctx context.Context, c *cli.Command) error {
+					if c.Bool("dry-run") {
+						return runDryRun(ctx, c.Bool("report"))
+					}
 					runWorkers(ctx, MaintenanceWorker, "", c.Int("workers"))
 					return nil
 				},
 			},
+			{
+				Name:  RetentionCommand,
+				Usage: "Apply the retention policies once and exit",
+				Action: func(ctx context.Context, _ *cli.Command) error {
+					return runRetention(ctx)
+				},
+			},
 			{
 				Name:  StatsWorker,
 				Usage: "Start statistics worker",
@@ -103,13 +185,22 @@ func run() error {
 					return nil
 				},
 			},
+			{
+				Name:  AllWorkers,
+				Usage: "Start one of each worker in a single process",
+				Action: func(ctx context.Context, _ *cli.Command) error {
+					runAllWorkers(ctx)
+					return nil
+				},
+			},
 		},
 	}
 
 	return app.Run(context.Background(), os.Args)
 }
 
-// runWorkers starts multiple instances of a worker type.
+// runWorkers starts multiple instances of a worker type. On SIGINT or SIGTERM,
+// each worker finishes its current batch and stops before the process exits.
 func runWorkers(ctx context.Context, workerType, subType string, count int64) {
 	app, err := setup.InitializeApp(ctx, WorkerLogDir)
 	if err != nil {
@@ -117,6 +208,10 @@ func runWorkers(ctx context.Context, workerType, subType string, count int64) {
 	}
 	defer app.Cleanup(ctx)
 
+	// Stop workers after their current batch once a shutdown signal is received
+	workerCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	// Initialize progress bars
 	bars := make([]*progress.Bar, count)
 	for i := range count {
@@ -129,6 +224,7 @@ func runWorkers(ctx context.Context, workerType, subType string, count int64) {
 
 	// Start workers
 	var wg sync.WaitGroup
+	var stoppedCleanly atomic.Int64
 	for i := range count {
 		wg.Add(1)
 		go func(workerID int64) {
@@ -141,60 +237,245 @@ func runWorkers(ctx context.Context, workerType, subType string, count int64) {
 			// Get progress bar for this worker
 			bar := bars[workerID]
 
-			var w interface{ Start() }
-			switch {
-			case workerType == AIWorker && subType == AIWorkerTypeMember:
-				w = ai.NewGroupWorker(app, bar, workerLogger)
-			case workerType == AIWorker && subType == AIWorkerTypeFriend:
-				w = ai.NewFriendWorker(app, bar, workerLogger)
-			case workerType == MaintenanceWorker:
-				w = maintenance.New(app, bar, workerLogger)
-			case workerType == StatsWorker:
-				w = stats.New(app, bar, workerLogger)
-			case workerType == QueueWorker:
-				w = queue.New(app, bar, workerLogger)
-			default:
-				log.Fatalf("Invalid worker type: %s %s", workerType, subType)
+			w := newWorker(app, workerType, subType, bar, workerLogger)
+			if runWorker(workerCtx, w, workerLogger) {
+				stoppedCleanly.Add(1)
 			}
-
-			runWorker(ctx, w, workerLogger)
 		}(i)
 	}
 
 	log.Printf("Started %d %s %s workers", count, workerType, subType)
-	wg.Wait()
+	waitForWorkers(workerCtx, stop, &wg)
 	renderer.Stop()
-	log.Println("All workers have finished. Exiting.")
+
+	clean := stoppedCleanly.Load()
+	log.Printf("All workers have finished: %d shut down cleanly, %d interrupted mid-batch. Exiting.", clean, count-clean)
 }
 
-// runWorker runs a single worker in a loop with error recovery.
-func runWorker(ctx context.Context, w interface{ Start() }, logger *zap.Logger) {
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info("Context cancelled, stopping worker")
-			return
-		default:
-			func() {
-				defer func() {
-					if r := recover(); r != nil {
-						logger.Error("Worker execution failed",
-							zap.String("worker_type", fmt.Sprintf("%T", w)),
-							zap.Any("panic", r),
-						)
-						logger.Info("Restarting worker in 5 seconds...")
-						time.Sleep(5 * time.Second)
-					}
-				}()
+// runAllWorkers starts one of each worker in a single process sharing the same
+// application resources. Each sub-worker keeps its own log file and progress bar,
+// and a panic in one sub-worker only restarts that sub-worker. On SIGINT or SIGTERM,
+// each sub-worker finishes its current batch and the shared resources are closed
+// before exiting.
+func runAllWorkers(ctx context.Context) {
+	app, err := setup.InitializeApp(ctx, WorkerLogDir)
+	if err != nil {
+		log.Fatalf("Failed to initialize application: %v", err)
+	}
+	defer app.Cleanup(ctx)
 
-				logger.Info("Starting worker")
-				w.Start()
+	// Warn if the shared database pool is too small for all sub-workers
+	checkPoolSize(app, len(combinedWorkers))
+
+	// Stop sub-workers after their current batch once a shutdown signal is received
+	workerCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Initialize progress bars
+	bars := make([]*progress.Bar, len(combinedWorkers))
+	for i, cw := range combinedWorkers {
+		bars[i] = progress.NewBar(100, 25, cw.label)
+	}
+
+	// Create and start the renderer
+	renderer := progress.NewRenderer(bars)
+	go renderer.Render()
+
+	// Start sub-workers
+	var wg sync.WaitGroup
+	var stoppedCleanly atomic.Int64
+	for i, cw := range combinedWorkers {
+		wg.Add(1)
+		go func(i int, cw combinedWorker) {
+			defer wg.Done()
+
+			workerLogger := app.LogManager.GetWorkerLogger(
+				fmt.Sprintf("%s_%s_worker_%d", cw.workerType, cw.subType, 0),
+			)
+
+			w := newWorker(app, cw.workerType, cw.subType, bars[i], workerLogger)
+			if runWorker(workerCtx, w, workerLogger) {
+				stoppedCleanly.Add(1)
+			}
+		}(i, cw)
+	}
+
+	log.Printf("Started %d workers in a single process", len(combinedWorkers))
+	waitForWorkers(workerCtx, stop, &wg)
+	renderer.Stop()
+
+	clean := int(stoppedCleanly.Load())
+	log.Printf("All workers have finished: %d shut down cleanly, %d interrupted mid-batch. "+
+		"Closing shared resources and exiting.", clean, len(combinedWorkers)-clean)
+}
+
+// waitForWorkers blocks until all workers have stopped. Once the shutdown signal
+// is received, workers get ShutdownTimeout to finish their current batch, and a
+// second signal restores the default behaviour of exiting immediately.
+func waitForWorkers(ctx context.Context, stop context.CancelFunc, wg *sync.WaitGroup) {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-ctx.Done():
+	}
+
+	stop()
+	log.Printf("Shutdown signal received. Waiting up to %s for workers to finish their current batch...", ShutdownTimeout)
+
+	select {
+	case <-done:
+	case <-time.After(ShutdownTimeout):
+		log.Println("Timed out waiting for workers to finish.")
+	}
+}
+
+// newWorker creates a worker of the given type.
+func newWorker(
+	app *setup.App, workerType, subType string, bar *progress.Bar, logger *zap.Logger,
+) worker {
+	switch {
+	case workerType == AIWorker && subType == AIWorkerTypeMember:
+		return ai.NewGroupWorker(app, bar, logger)
+	case workerType == AIWorker && subType == AIWorkerTypeFriend:
+		return ai.NewFriendWorker(app, bar, logger)
+	case workerType == MaintenanceWorker:
+		return maintenance.New(app, bar, logger)
+	case workerType == StatsWorker:
+		return stats.New(app, bar, logger)
+	case workerType == QueueWorker:
+		return queue.New(app, bar, logger)
+	default:
+		log.Fatalf("Invalid worker type: %s %s", workerType, subType)
+		return nil
+	}
+}
+
+// checkPoolSize logs a warning if the configured database pool is too small
+// for the given number of workers sharing it.
+func checkPoolSize(app *setup.App, workerCount int) {
+	required := workerCount * MinConnsPerCombinedWorker
+	maxOpenConns := app.Config.Common.PostgreSQL.MaxOpenConns
+	if maxOpenConns < required {
+		app.Logger.Warn("Database pool may be too small for combined workers",
+			zap.Int("max_open_conns", maxOpenConns),
+			zap.Int("recommended", required),
+			zap.Int("workers", workerCount))
+		log.Printf("Warning: max_open_conns is %d but %d is recommended for %d combined workers",
+			maxOpenConns, required, workerCount)
+	}
+}
+
+// runPreview prints the AI prompt for a stored user.
+func runPreview(ctx context.Context, userID uint64, callModel bool) error {
+	app, err := setup.InitializeApp(ctx, WorkerLogDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize application: %w", err)
+	}
+	defer app.Cleanup(ctx)
+
+	logger := app.LogManager.GetWorkerLogger("ai_preview")
+	return ai.PreviewUserPrompt(ctx, app, logger, userID, callModel, os.Stdout)
+}
+
+// runDryRun runs a single maintenance pass that only reports what would be purged.
+func runDryRun(ctx context.Context, writeReport bool) error {
+	app, err := setup.InitializeApp(ctx, WorkerLogDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize application: %w", err)
+	}
+	defer app.Cleanup(ctx)
+
+	logger := app.LogManager.GetWorkerLogger("maintenance_dryrun")
+	bar := progress.NewBar(100, 25, "Dry Run")
+
+	report, err := maintenance.New(app, bar, logger).DryRun(ctx)
+	if err != nil {
+		return fmt.Errorf("dry run failed: %w", err)
+	}
+	report.LogSummary(logger)
+
+	log.Printf("Dry run: checked %d users, %d banned and %d old cleared users would be purged",
+		report.CheckedUsers, len(report.BannedUserIDs), len(report.ClearedUserIDs))
+
+	if writeReport {
+		path, err := report.WriteFile(PurgeReportDir)
+		if err != nil {
+			return err
+		}
+		log.Printf("Report written to %s", path)
+	}
+
+	return nil
+}
+
+// runRetention runs a single retention pass, purging each class of data older
+// than its configured retention period.
+func runRetention(ctx context.Context) error {
+	app, err := setup.InitializeApp(ctx, WorkerLogDir)
+	if err != nil {
+		return fmt.Errorf("failed to initialize application: %w", err)
+	}
+	defer app.Cleanup(ctx)
+
+	logger := app.LogManager.GetWorkerLogger("retention")
+
+	result, err := retention.NewRunner(app.DB, app.Config.Worker.Retention, logger).Run(ctx)
+	if result != nil {
+		log.Printf("Retention: removed %d cleared users, %d cleared groups, %d banned users, "+
+			"%d deleted users, %d activity log chunks, %d hourly stats and %d resolved appeals",
+			result.ClearedUsers, result.ClearedGroups, result.BannedUsers, result.DeletedUsers,
+			result.ActivityChunks, result.HourlyStats, result.ResolvedAppeals)
+	}
+	if err != nil {
+		return fmt.Errorf("retention failed: %w", err)
+	}
+
+	return nil
+}
+
+// runWorker runs a single worker in a loop with error recovery until the context
+// is cancelled. Returns true if the worker finished its last batch and stopped
+// cleanly, or false if its last run was cut short by a panic.
+func runWorker(ctx context.Context, w worker, logger *zap.Logger) bool {
+	for ctx.Err() == nil {
+		panicked := func() (panicked bool) {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Error("Worker execution failed",
+						zap.String("worker_type", fmt.Sprintf("%T", w)),
+						zap.Any("panic", r),
+					)
+					panicked = true
+				}
 			}()
 
+			logger.Info("Starting worker")
+			w.Start(ctx)
+			return false
+		}()
+
+		// Workers return once the context is cancelled
+		if ctx.Err() != nil {
+			logger.Info("Context cancelled, worker stopped", zap.Bool("clean", !panicked))
+			return !panicked
+		}
+
+		if panicked {
+			logger.Info("Restarting worker in 5 seconds...")
+		} else {
 			logger.Warn("Worker stopped unexpectedly",
 				zap.String("worker_type", fmt.Sprintf("%T", w)),
 			)
-			time.Sleep(5 * time.Second)
 		}
+		core.SleepContext(ctx, 5*time.Second)
 	}
+
+	logger.Info("Context cancelled, stopping worker")
+	return true
 }
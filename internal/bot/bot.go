@@ -19,6 +19,7 @@ import (
 
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/presence"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
 	"github.com/robalyx/rotector/internal/bot/menu/admin"
@@ -46,6 +47,7 @@ type Bot struct {
 	logger            *zap.Logger
 	sessionManager    *session.Manager
 	paginationManager *pagination.Manager
+	presenceUpdater   *presence.Updater
 	dashboardLayout   interfaces.DashboardLayout
 	banLayout         interfaces.BanLayout
 }
@@ -53,11 +55,12 @@ type Bot struct {
 // New initializes a Bot instance by creating all required managers and layouts.
 func New(app *setup.App) (*Bot, error) {
 	// Initialize session manager for persistent storage
-	sessionManager, err := session.NewManager(app.DB, app.RedisManager, app.Logger)
+	sessionManager, err := session.NewManager(app.DB, app.RedisManager, app.Config.Bot.ReadOnly, app.Logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create session manager: %w", err)
 	}
 	paginationManager := pagination.NewManager(sessionManager, app.Logger)
+	utils.SetComponentLogger(app.Logger)
 
 	// Create bot instance
 	b := &Bot{
@@ -96,6 +99,8 @@ func New(app *setup.App) (*Bot, error) {
 			OnApplicationCommandInteraction: b.handleApplicationCommandInteraction,
 			OnComponentInteraction:          b.handleComponentInteraction,
 			OnModalSubmit:                   b.handleModalSubmit,
+			OnReady:                         b.handleReady,
+			OnResumed:                       b.handleResumed,
 		}),
 	)
 	if err != nil {
@@ -103,13 +108,20 @@ func New(app *setup.App) (*Bot, error) {
 	}
 	b.client = client
 
+	// Show live queue stats as the bot's activity if enabled
+	if app.Config.Bot.Presence.Enabled {
+		b.presenceUpdater = presence.New(app.DB, client, app.Config.Bot.Presence, app.Logger)
+	}
+
 	// Initialize layouts after bot instance is created
 	settingLayout := setting.New(app, sessionManager, paginationManager)
 	logLayout := log.New(app, sessionManager, paginationManager)
 	chatLayout := chat.New(app, sessionManager, paginationManager)
 	captchaLayout := captcha.New(app, sessionManager, paginationManager)
 	userReviewLayout := userReview.New(app, sessionManager, paginationManager, settingLayout, logLayout, chatLayout, captchaLayout)
-	groupReviewLayout := groupReview.New(app, sessionManager, paginationManager, settingLayout, logLayout, chatLayout, captchaLayout)
+	groupReviewLayout := groupReview.New(
+		app, sessionManager, paginationManager, settingLayout, logLayout, chatLayout, captchaLayout, userReviewLayout,
+	)
 	queueLayout := queue.New(app, sessionManager, paginationManager, userReviewLayout)
 	appealLayout := appeal.New(app, sessionManager, paginationManager, userReviewLayout)
 	adminLayout := admin.New(app, sessionManager, paginationManager, settingLayout)
@@ -137,17 +149,48 @@ func New(app *setup.App) (*Bot, error) {
 }
 
 // Start registers global commands with Discord and opens the gateway connection.
-// It first ensures the dashboard command is registered globally before starting
-// the bot's gateway connection to receive events.
+// It first ensures the dashboard and review commands are registered globally before
+// starting the bot's gateway connection to receive events.
 func (b *Bot) Start() error {
 	b.logger.Info("Registering commands")
 
-	// Register the dashboard command globally for all guilds
+	// Register the commands globally for all guilds
+	minID := 1
 	_, err := b.client.Rest().SetGlobalCommands(b.client.ApplicationID(), []discord.ApplicationCommandCreate{
 		discord.SlashCommandCreate{
 			Name:        constants.DashboardCommandName,
 			Description: "View the dashboard",
 		},
+		discord.SlashCommandCreate{
+			Name:        constants.ReviewCommandName,
+			Description: "Open a user or group for review by ID",
+			Options: []discord.ApplicationCommandOption{
+				discord.ApplicationCommandOptionSubCommand{
+					Name:        constants.ReviewUserSubcommand,
+					Description: "Open a user for review",
+					Options: []discord.ApplicationCommandOption{
+						discord.ApplicationCommandOptionInt{
+							Name:        constants.ReviewIDOptionName,
+							Description: "Roblox user ID",
+							Required:    true,
+							MinValue:    &minID,
+						},
+					},
+				},
+				discord.ApplicationCommandOptionSubCommand{
+					Name:        constants.ReviewGroupSubcommand,
+					Description: "Open a group for review",
+					Options: []discord.ApplicationCommandOption{
+						discord.ApplicationCommandOptionInt{
+							Name:        constants.ReviewIDOptionName,
+							Description: "Roblox group ID",
+							Required:    true,
+							MinValue:    &minID,
+						},
+					},
+				},
+			},
+		},
 	})
 	if err != nil {
 		return fmt.Errorf("failed to register commands: %w", err)
@@ -159,6 +202,11 @@ func (b *Bot) Start() error {
 		return fmt.Errorf("failed to open gateway: %w", err)
 	}
 
+	// Start updating the bot's activity
+	if b.presenceUpdater != nil {
+		b.presenceUpdater.Start()
+	}
+
 	b.logger.Info("Started bot")
 	return nil
 }
@@ -167,7 +215,27 @@ func (b *Bot) Start() error {
 // This ensures all pending events are processed before shutdown.
 func (b *Bot) Close() {
 	b.logger.Info("Closing bot")
+	if b.presenceUpdater != nil {
+		b.presenceUpdater.Stop()
+	}
 	b.client.Close(context.Background())
+
+	// Write the activity logs of the last interactions before the loggers are synced
+	b.db.Activity().Flush()
+}
+
+// handleReady re-applies the bot's activity when a shard starts a new session.
+func (b *Bot) handleReady(event *events.Ready) {
+	if b.presenceUpdater != nil {
+		b.presenceUpdater.OnReady(event)
+	}
+}
+
+// handleResumed re-applies the bot's activity when a shard resumes its session.
+func (b *Bot) handleResumed(event *events.Resumed) {
+	if b.presenceUpdater != nil {
+		b.presenceUpdater.OnResumed(event)
+	}
 }
 
 // handleApplicationCommandInteraction processes slash commands by first deferring the response,
@@ -180,12 +248,19 @@ func (b *Bot) handleApplicationCommandInteraction(event *events.ApplicationComma
 			return
 		}
 
-		// Only handle dashboard command - respond with error for unknown commands
-		if event.SlashCommandInteractionData().CommandName() != constants.DashboardCommandName {
+		// Only handle known commands - respond with error for unknown commands
+		command := event.SlashCommandInteractionData().CommandName()
+		if command != constants.DashboardCommandName && command != constants.ReviewCommandName {
 			b.paginationManager.RespondWithError(event, "This command is not available.")
 			return
 		}
 
+		// Reject commands from servers that are not allowlisted
+		if ok, message := b.checkGuildAllowed(event); !ok {
+			b.paginationManager.RespondWithMessage(event, message)
+			return
+		}
+
 		start := time.Now()
 		defer func() {
 			if r := recover(); r != nil {
@@ -213,6 +288,13 @@ func (b *Bot) handleApplicationCommandInteraction(event *events.ApplicationComma
 			return
 		}
 
+		// Open the requested user or group directly
+		if command == constants.ReviewCommandName {
+			b.handleReviewCommand(event, s)
+			s.Touch(context.Background())
+			return
+		}
+
 		// Check if the session has a valid current page
 		page := b.paginationManager.GetPage(s.GetString(constants.SessionKeyCurrentPage))
 		if page == nil {
@@ -222,12 +304,35 @@ func (b *Bot) handleApplicationCommandInteraction(event *events.ApplicationComma
 			return
 		}
 
+		// Return to the dashboard if the stored review target can no longer be reviewed
+		if s.TargetRemoved() {
+			b.dashboardLayout.Show(event, s, constants.TargetRemovedMessage)
+			s.Touch(context.Background())
+			return
+		}
+
 		// Navigate to stored page
 		b.paginationManager.NavigateTo(event, s, page, "")
 		s.Touch(context.Background())
 	}()
 }
 
+// handleReviewCommand opens the user or group with the ID given to the review command.
+func (b *Bot) handleReviewCommand(event *events.ApplicationCommandInteractionCreate, s *session.Session) {
+	data := event.SlashCommandInteractionData()
+	id, ok := data.OptInt(constants.ReviewIDOptionName)
+	if !ok || id <= 0 {
+		b.paginationManager.RespondWithError(event, "Please provide a valid ID.")
+		return
+	}
+
+	if data.SubCommandName != nil && *data.SubCommandName == constants.ReviewGroupSubcommand {
+		b.dashboardLayout.ShowGroupLookup(event, s, uint64(id))
+		return
+	}
+	b.dashboardLayout.ShowUserLookup(event, s, uint64(id))
+}
+
 // handleComponentInteraction processes button clicks and select menu choices.
 // It first updates the message to show "Processing..." and removes interactive components
 // to prevent double-clicks, then processes the interaction in a goroutine.
@@ -250,6 +355,17 @@ func (b *Bot) handleComponentInteraction(event *events.ComponentInteractionCreat
 				zap.Duration("duration", duration))
 		}()
 
+		// Reject interactions from servers that are not allowlisted
+		if ok, message := b.checkGuildAllowed(event); !ok {
+			if err := event.CreateMessage(discord.NewMessageCreateBuilder().
+				SetContent(message).
+				SetEphemeral(true).
+				Build()); err != nil {
+				b.logger.Error("Failed to send guild rejection message", zap.Error(err))
+			}
+			return
+		}
+
 		// Validate session but return early if session creation failed or session expired
 		s, ok := b.validateAndGetSession(event, event.User().ID)
 		if !ok {
@@ -301,6 +417,13 @@ func (b *Bot) handleComponentInteraction(event *events.ComponentInteractionCreat
 			return
 		}
 
+		// Return to the dashboard if the stored review target can no longer be reviewed
+		if s.TargetRemoved() {
+			b.dashboardLayout.Show(event, s, constants.TargetRemovedMessage)
+			s.Touch(context.Background())
+			return
+		}
+
 		// Verify interaction is for latest message
 		sessionMessageID := s.GetUint64(constants.SessionKeyMessageID)
 		if sessionMessageID != uint64(event.Message.ID) {
@@ -322,6 +445,17 @@ func (b *Bot) handleComponentInteraction(event *events.ComponentInteractionCreat
 // then processes the submission in a goroutine.
 func (b *Bot) handleModalSubmit(event *events.ModalSubmitInteractionCreate) {
 	go func() {
+		// Reject submissions from servers that are not allowlisted
+		if ok, message := b.checkGuildAllowed(event); !ok {
+			if err := event.CreateMessage(discord.NewMessageCreateBuilder().
+				SetContent(message).
+				SetEphemeral(true).
+				Build()); err != nil {
+				b.logger.Error("Failed to send guild rejection message", zap.Error(err))
+			}
+			return
+		}
+
 		// Update message to prevent double-submissions
 		updateBuilder := discord.NewMessageUpdateBuilder().
 			SetContent(utils.GetTimestampedSubtext("Processing...")).
@@ -374,12 +508,39 @@ func (b *Bot) handleModalSubmit(event *events.ModalSubmitInteractionCreate) {
 			return
 		}
 
+		// Return to the dashboard if the stored review target can no longer be reviewed
+		if s.TargetRemoved() {
+			b.dashboardLayout.Show(event, s, constants.TargetRemovedMessage)
+			s.Touch(context.Background())
+			return
+		}
+
 		// Handle submission and update session
 		b.paginationManager.HandleInteraction(event, s)
 		s.Touch(context.Background())
 	}()
 }
 
+// checkGuildAllowed checks if the event comes from an allowlisted guild, or from a direct
+// message while those are allowed. Returns false and the rejection message if it does not.
+func (b *Bot) checkGuildAllowed(event interfaces.CommonEvent) (bool, string) {
+	botSettings, err := b.db.Settings().GetBotSettings(context.Background())
+	if err != nil {
+		b.logger.Error("Failed to get bot settings", zap.Error(err))
+		return false, "Failed to verify access status. Please try again later."
+	}
+
+	guildID := event.GuildID()
+	if botSettings.IsGuildAllowed(guildID) {
+		return true, ""
+	}
+
+	if guildID == nil {
+		return false, "This bot is not available in direct messages."
+	}
+	return false, "This bot is not available in this server."
+}
+
 // checkBanStatus checks if a user is banned and shows the ban menu if they are.
 // Returns true if the user is banned and should not proceed.
 func (b *Bot) checkBanStatus(event interfaces.CommonEvent, s *session.Session, userID snowflake.ID, closeSession bool) bool {
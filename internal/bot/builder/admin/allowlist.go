@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+// AllowlistBuilder creates the visual layout for managing allowlisted groups.
+type AllowlistBuilder struct {
+	groups []*types.AllowlistedGroup
+}
+
+// NewAllowlistBuilder creates a new group allowlist builder.
+func NewAllowlistBuilder(s *session.Session) *AllowlistBuilder {
+	var groups []*types.AllowlistedGroup
+	s.GetInterface(constants.SessionKeyAllowlistedGroups, &groups)
+
+	return &AllowlistBuilder{
+		groups: groups,
+	}
+}
+
+// Build creates a Discord message listing the allowlisted groups with
+// controls for adding and removing them.
+func (b *AllowlistBuilder) Build() *discord.MessageUpdateBuilder {
+	embed := discord.NewEmbedBuilder().
+		SetTitle("Group Allowlist").
+		SetDescription(fmt.Sprintf(
+			"Roblox groups that are never flagged and do not count towards flagging their members (%d total).",
+			len(b.groups),
+		)).
+		SetColor(constants.DefaultEmbedColor)
+
+	// Add fields for each group up to the embed limit
+	for i, group := range b.groups {
+		if i >= constants.MaxAllowlistedGroupsShown {
+			break
+		}
+
+		reason := group.Reason
+		if reason == "" {
+			reason = "No reason provided"
+		}
+
+		embed.AddField(
+			strconv.FormatUint(group.ID, 10),
+			fmt.Sprintf("%s\nAdded by <@%d> <t:%d:R>", utils.FormatString(utils.TruncateString(reason, 100)), group.AddedBy, group.AddedAt.Unix()),
+			false,
+		)
+	}
+
+	if len(b.groups) == 0 {
+		embed.AddField("No allowlisted groups", "Use the buttons below to allowlist a group.", false)
+	} else if len(b.groups) > constants.MaxAllowlistedGroupsShown {
+		embed.SetFooter(fmt.Sprintf("Showing %d of %d groups", constants.MaxAllowlistedGroupsShown, len(b.groups)), "")
+	}
+
+	return discord.NewMessageUpdateBuilder().
+		SetEmbeds(embed.Build()).
+		AddActionRow(
+			discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
+			discord.NewPrimaryButton("Add Group", constants.AddAllowlistButtonCustomID),
+			discord.NewDangerButton("Remove Group", constants.RemoveAllowlistButtonCustomID).
+				WithDisabled(len(b.groups) == 0),
+		)
+}
@@ -38,6 +38,11 @@ func (b *ConfirmBuilder) Build() *discord.MessageUpdateBuilder {
 		description = "Are you sure you want to delete roblox user `" + b.id + "` from the database?"
 		embed.AddField("Reason", b.reason, false)
 
+	case constants.RestoreUserAction:
+		title = "Confirm Roblox User Restore"
+		description = "Are you sure you want to restore deleted roblox user `" + b.id + "` to the flagged users?"
+		embed.AddField("Reason", b.reason, false)
+
 	case constants.DeleteGroupAction:
 		title = "Confirm Roblox Group Deletion"
 		description = "Are you sure you want to delete roblox group `" + b.id + "` from the database?"
@@ -7,11 +7,15 @@ import (
 )
 
 // Builder creates the visual layout for the admin menu.
-type Builder struct{}
+type Builder struct {
+	readOnly bool
+}
 
 // NewBuilder creates a new admin menu builder.
-func NewBuilder(_ *session.Session) *Builder {
-	return &Builder{}
+func NewBuilder(s *session.Session) *Builder {
+	return &Builder{
+		readOnly: s.GetBool(constants.SessionKeyReadOnly),
+	}
 }
 
 // Build creates a Discord message with admin options.
@@ -21,6 +25,19 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 		discord.NewStringSelectMenuOption("Bot Settings", constants.BotSettingsButtonCustomID).
 			WithEmoji(discord.ComponentEmoji{Name: "⚙️"}).
 			WithDescription("Configure bot-wide settings"),
+		discord.NewStringSelectMenuOption("Protected Accounts", constants.ProtectedButtonCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "🛡️"}).
+			WithDescription("Manage Roblox accounts that are never flagged"),
+		discord.NewStringSelectMenuOption("Group Allowlist", constants.AllowlistButtonCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "🏳️"}).
+			WithDescription("Manage Roblox groups that are never flagged"),
+		discord.NewStringSelectMenuOption("Trending Terms", constants.TrendsButtonCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "📈"}).
+			WithDescription("View terms rising in recently flagged content"),
+		discord.NewStringSelectMenuOption("Refresh Leaderboard Views", constants.RefreshViewsCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "🔄"}).
+			WithDescription("Rebuild the leaderboard and reviewer stats now"),
+		b.buildReadOnlyOption(),
 		discord.NewStringSelectMenuOption("Ban Discord User", constants.BanUserButtonCustomID).
 			WithEmoji(discord.ComponentEmoji{Name: "🔨"}).
 			WithDescription("Ban a Discord user from the system"),
@@ -30,6 +47,9 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 		discord.NewStringSelectMenuOption("Delete Roblox User", constants.DeleteUserButtonCustomID).
 			WithEmoji(discord.ComponentEmoji{Name: "🗑️"}).
 			WithDescription("Delete a Roblox user from the database"),
+		discord.NewStringSelectMenuOption("Restore Roblox User", constants.RestoreUserButtonCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "♻️"}).
+			WithDescription("Restore a deleted Roblox user to the review queue"),
 		discord.NewStringSelectMenuOption("Delete Roblox Group", constants.DeleteGroupButtonCustomID).
 			WithEmoji(discord.ComponentEmoji{Name: "🗑️"}).
 			WithDescription("Delete a Roblox group from the database"),
@@ -38,9 +58,13 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 	// Create embed
 	embed := discord.NewEmbedBuilder().
 		SetTitle("Admin Menu").
-		SetDescription("⚠️ **Warning**: These actions are permanent and cannot be undone.").
+		SetDescription("⚠️ **Warning**: These actions take effect immediately. Deleted users can be restored until they are purged.").
 		SetColor(constants.DefaultEmbedColor)
 
+	if b.readOnly {
+		embed.AddField("Read-only Mode", "🚧 Active. All changes are refused until it is turned off.", false)
+	}
+
 	return discord.NewMessageUpdateBuilder().
 		SetEmbeds(embed.Build()).
 		AddActionRow(
@@ -50,3 +74,15 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 			discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
 		)
 }
+
+// buildReadOnlyOption creates the option for toggling read-only mode.
+func (b *Builder) buildReadOnlyOption() discord.StringSelectMenuOption {
+	if b.readOnly {
+		return discord.NewStringSelectMenuOption("Disable Read-only Mode", constants.ToggleReadOnlyCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "🔓"}).
+			WithDescription("Allow changes again after maintenance")
+	}
+	return discord.NewStringSelectMenuOption("Enable Read-only Mode", constants.ToggleReadOnlyCustomID).
+		WithEmoji(discord.ComponentEmoji{Name: "🚧"}).
+		WithDescription("Refuse all changes during database maintenance")
+}
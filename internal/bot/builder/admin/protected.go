@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+// ProtectedBuilder creates the visual layout for managing protected accounts.
+type ProtectedBuilder struct {
+	accounts []*types.ProtectedAccount
+}
+
+// NewProtectedBuilder creates a new protected accounts builder.
+func NewProtectedBuilder(s *session.Session) *ProtectedBuilder {
+	var accounts []*types.ProtectedAccount
+	s.GetInterface(constants.SessionKeyProtectedAccounts, &accounts)
+
+	return &ProtectedBuilder{
+		accounts: accounts,
+	}
+}
+
+// Build creates a Discord message listing the protected accounts with
+// controls for adding and removing them.
+func (b *ProtectedBuilder) Build() *discord.MessageUpdateBuilder {
+	embed := discord.NewEmbedBuilder().
+		SetTitle("Protected Accounts").
+		SetDescription(fmt.Sprintf(
+			"Roblox accounts that are never flagged by the checkers or confirmed in reviews (%d total).",
+			len(b.accounts),
+		)).
+		SetColor(constants.DefaultEmbedColor)
+
+	// Add fields for each account up to the embed limit
+	for i, account := range b.accounts {
+		if i >= constants.MaxProtectedAccountsShown {
+			break
+		}
+
+		note := account.Note
+		if note == "" {
+			note = "No note provided"
+		}
+
+		embed.AddField(
+			strconv.FormatUint(account.ID, 10),
+			fmt.Sprintf("%s\nAdded by <@%d> <t:%d:R>", utils.FormatString(utils.TruncateString(note, 100)), account.AddedBy, account.AddedAt.Unix()),
+			false,
+		)
+	}
+
+	if len(b.accounts) == 0 {
+		embed.AddField("No protected accounts", "Use the buttons below to protect an account.", false)
+	} else if len(b.accounts) > constants.MaxProtectedAccountsShown {
+		embed.SetFooter(fmt.Sprintf("Showing %d of %d accounts", constants.MaxProtectedAccountsShown, len(b.accounts)), "")
+	}
+
+	return discord.NewMessageUpdateBuilder().
+		SetEmbeds(embed.Build()).
+		AddActionRow(
+			discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
+			discord.NewPrimaryButton("Add Account", constants.AddProtectedButtonCustomID),
+			discord.NewDangerButton("Remove Account", constants.RemoveProtectedButtonCustomID).
+				WithDisabled(len(b.accounts) == 0),
+		)
+}
@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+// TrendsBuilder creates the visual layout for the trending terms page.
+type TrendsBuilder struct {
+	terms      []*types.TrendingTerm
+	page       int
+	totalPages int
+}
+
+// NewTrendsBuilder creates a new trending terms builder.
+func NewTrendsBuilder(s *session.Session) *TrendsBuilder {
+	var terms []*types.TrendingTerm
+	s.GetInterface(constants.SessionKeyTrendingTerms, &terms)
+
+	return &TrendsBuilder{
+		terms:      terms,
+		page:       s.GetInt(constants.SessionKeyPaginationPage),
+		totalPages: s.GetInt(constants.SessionKeyTotalPages),
+	}
+}
+
+// Build creates a Discord message listing the rising terms for the current page.
+func (b *TrendsBuilder) Build() *discord.MessageUpdateBuilder {
+	embed := discord.NewEmbedBuilder().
+		SetTitle("Trending Terms").
+		SetDescription("Terms appearing more often in content flagged this week compared to the week before.").
+		SetColor(constants.DefaultEmbedColor)
+
+	// Add fields for terms on the current page
+	start := b.page * constants.TrendingTermsPerPage
+	end := min(start+constants.TrendingTermsPerPage, len(b.terms))
+	for i := start; i < end; i++ {
+		term := b.terms[i]
+		embed.AddField(
+			fmt.Sprintf("%d. %s", i+1, term.Term),
+			fmt.Sprintf("This week: %d\nChange: %s", term.CurrentCount, formatChange(term)),
+			true,
+		)
+	}
+
+	if len(b.terms) == 0 {
+		embed.AddField("No trending terms", "Terms are extracted hourly by the stats worker.", false)
+	} else {
+		embed.SetFooter(fmt.Sprintf("Page %d/%d • Updated %s",
+			b.page+1, b.totalPages+1, b.terms[0].UpdatedAt.Format("2006-01-02 15:04 MST")), "")
+	}
+
+	return discord.NewMessageUpdateBuilder().
+		SetEmbeds(embed.Build()).
+		AddActionRow(
+			discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
+			discord.NewSecondaryButton("⏮️", string(utils.ViewerFirstPage)).WithDisabled(b.page == 0),
+			discord.NewSecondaryButton("◀️", string(utils.ViewerPrevPage)).WithDisabled(b.page == 0),
+			discord.NewSecondaryButton("▶️", string(utils.ViewerNextPage)).WithDisabled(b.page == b.totalPages),
+			discord.NewSecondaryButton("⏭️", string(utils.ViewerLastPage)).WithDisabled(b.page == b.totalPages),
+		)
+}
+
+// formatChange formats the change from the previous period, marking terms
+// that did not appear at all in the previous period as new.
+func formatChange(term *types.TrendingTerm) string {
+	if term.PreviousCount == 0 {
+		return "🆕 new"
+	}
+	return "+" + strconv.FormatFloat(term.Change, 'f', 0, 64) + "%"
+}
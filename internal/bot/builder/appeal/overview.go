@@ -3,6 +3,7 @@ package appeal
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/robalyx/rotector/internal/bot/constants"
@@ -21,6 +22,7 @@ type OverviewBuilder struct {
 	hasNextPage  bool
 	hasPrevPage  bool
 	isReviewer   bool
+	staleDays    uint64
 }
 
 // NewOverviewBuilder creates a new overview builder.
@@ -40,6 +42,7 @@ func NewOverviewBuilder(s *session.Session) *OverviewBuilder {
 		hasNextPage:  s.GetBool(constants.SessionKeyHasNextPage),
 		hasPrevPage:  s.GetBool(constants.SessionKeyHasPrevPage),
 		isReviewer:   botSettings.IsReviewer(s.UserID()),
+		staleDays:    botSettings.AppealStaleDays,
 	}
 }
 
@@ -101,6 +104,14 @@ func (b *OverviewBuilder) formatAppealField(appeal *types.Appeal) (string, strin
 	claimedInfo := ""
 	if appeal.ClaimedBy != 0 {
 		claimedInfo = fmt.Sprintf("\nClaimed by: <@%d>", appeal.ClaimedBy)
+
+		// Warn reviewers about their claims that are about to be released
+		if b.sortBy == enum.AppealSortByClaimed && b.staleDays > 0 {
+			releaseAt := appeal.ClaimActivity.Add(time.Duration(b.staleDays) * 24 * time.Hour)
+			if time.Until(releaseAt) < constants.AppealStaleWarningWindow {
+				claimedInfo += fmt.Sprintf("\n⚠️ Claim released <t:%d:R> without a reply", releaseAt.Unix())
+			}
+		}
 	}
 
 	// Format timestamps
@@ -109,6 +120,9 @@ func (b *OverviewBuilder) formatAppealField(appeal *types.Appeal) (string, strin
 	lastActivity := fmt.Sprintf("<t:%d:R>", appeal.LastActivity.Unix())
 
 	fieldName := fmt.Sprintf("%s Appeal `#%d`", statusEmoji, appeal.ID)
+	if b.isReviewer && appeal.HasDuplicates {
+		fieldName += " 🔁"
+	}
 	fieldValue := fmt.Sprintf(
 		"User: [%s](https://www.roblox.com/users/%d/profile)\n"+
 			"Requester: <@%d>%s\n"+
@@ -146,14 +160,20 @@ func (b *OverviewBuilder) buildComponents() []discord.ContainerComponent {
 				statusEmoji = "❌"
 			}
 
+			// Mark appeals that were also submitted from other accounts
+			label := fmt.Sprintf("%s Appeal #%d", statusEmoji, appeal.ID)
+			if b.isReviewer && appeal.HasDuplicates {
+				label += " 🔁"
+			}
+
 			// Create option for each appeal
 			option := discord.NewStringSelectMenuOption(
-				fmt.Sprintf("%s Appeal #%d", statusEmoji, appeal.ID),
+				utils.SanitizeOptionLabel(label),
 				strconv.FormatInt(appeal.ID, 10),
-			).WithDescription(
+			).WithDescription(utils.SanitizeOptionDescription(
 				"View appeal for User ID: " +
 					utils.CensorString(strconv.FormatUint(appeal.UserID, 10), b.settings.StreamerMode),
-			)
+			))
 
 			options = append(options, option)
 		}
@@ -190,6 +210,9 @@ func (b *OverviewBuilder) buildComponents() []discord.ContainerComponent {
 				discord.NewStringSelectMenuOption("Newest First", enum.AppealSortByNewest.String()).
 					WithDescription("Show newest appeals first").
 					WithDefault(b.sortBy == enum.AppealSortByNewest),
+				discord.NewStringSelectMenuOption("Unclaimed", enum.AppealSortByUnclaimed.String()).
+					WithDescription("Show unclaimed appeals by recent activity").
+					WithDefault(b.sortBy == enum.AppealSortByUnclaimed),
 			),
 		))
 	}
@@ -1,13 +1,17 @@
 package appeal
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 )
@@ -16,16 +20,20 @@ import (
 type TicketBuilder struct {
 	appeal      *types.Appeal
 	messages    []*types.AppealMessage
+	thumbnail   string
 	settings    *types.UserSetting
 	botSettings *types.BotSetting
 	page        int
 	totalPages  int
 	isReviewer  bool
 	userID      uint64
+	export      *bytes.Buffer
+	appeals     store.AppealStore
+	attachments map[int64]*types.AppealAttachment
 }
 
 // NewTicketBuilder creates a new ticket builder.
-func NewTicketBuilder(s *session.Session) *TicketBuilder {
+func NewTicketBuilder(s *session.Session, appeals store.AppealStore) *TicketBuilder {
 	var appeal *types.Appeal
 	s.GetInterface(constants.SessionKeyAppeal, &appeal)
 	var messages []*types.AppealMessage
@@ -38,12 +46,15 @@ func NewTicketBuilder(s *session.Session) *TicketBuilder {
 	return &TicketBuilder{
 		appeal:      appeal,
 		messages:    messages,
+		thumbnail:   s.GetString(constants.SessionKeyAppealThumbnail),
 		settings:    settings,
 		botSettings: botSettings,
 		page:        s.GetInt(constants.SessionKeyPaginationPage),
 		totalPages:  s.GetInt(constants.SessionKeyTotalPages),
 		isReviewer:  botSettings.IsReviewer(s.UserID()),
 		userID:      s.UserID(),
+		export:      s.GetBuffer(constants.SessionKeyAppealExport),
+		appeals:     appeals,
 	}
 }
 
@@ -53,11 +64,27 @@ func (b *TicketBuilder) Build() *discord.MessageUpdateBuilder {
 	headerEmbed := b.buildHeaderEmbed()
 
 	// Create conversation embed
-	conversationEmbed := b.buildConversationEmbed()
+	pageMessages := b.pageMessages()
+	b.attachments = b.getAttachments(pageMessages)
+	conversationEmbed := b.buildConversationEmbed(pageMessages)
 
-	// Build message with components
-	builder := discord.NewMessageUpdateBuilder().
-		SetEmbeds(headerEmbed.Build(), conversationEmbed.Build())
+	// Build message with the user's thumbnail or placeholder
+	builder := discord.NewMessageUpdateBuilder()
+	utils.SetThumbnail(headerEmbed, builder, b.thumbnail)
+	builder.SetEmbeds(headerEmbed.Build(), conversationEmbed.Build())
+
+	// Show the images attached to the messages on this page
+	for _, msg := range pageMessages {
+		if attachment, ok := b.attachments[msg.ID]; ok {
+			builder.AddFile(attachment.UploadName(), "", bytes.NewReader(attachment.Data))
+			builder.AddEmbeds(b.buildAttachmentEmbed(attachment).Build())
+		}
+	}
+
+	// Attach the exported user record if one was requested
+	if b.export != nil {
+		builder.AddFile(fmt.Sprintf("user_%d_export.json", b.appeal.UserID), "application/json", b.export)
+	}
 
 	// Add navigation buttons
 	components := []discord.ContainerComponent{
@@ -82,11 +109,13 @@ func (b *TicketBuilder) Build() *discord.MessageUpdateBuilder {
 			actionButtons = append(actionButtons,
 				discord.NewPrimaryButton("Lookup User", constants.AppealLookupUserButtonCustomID),
 				discord.NewSuccessButton("Accept", constants.AcceptAppealButtonCustomID),
+				discord.NewSecondaryButton("Accept and return to queue", constants.ReturnAppealButtonCustomID),
 				discord.NewDangerButton("Reject", constants.RejectAppealButtonCustomID),
 			)
 		} else {
-			// Add close button for regular users
+			// Add attachment and close buttons for regular users
 			actionButtons = append(actionButtons,
+				discord.NewPrimaryButton("Respond with Attachment", constants.AppealAttachButtonCustomID),
 				discord.NewDangerButton("Close Ticket", constants.AppealCloseButtonCustomID),
 			)
 		}
@@ -94,6 +123,27 @@ func (b *TicketBuilder) Build() *discord.MessageUpdateBuilder {
 		components = append(components, discord.NewActionRow(actionButtons...))
 	}
 
+	// Add attachment, claim and export buttons for reviewers
+	if b.isReviewer {
+		reviewerButtons := []discord.InteractiveComponent{}
+		if b.appeal.Status == enum.AppealStatusPending {
+			reviewerButtons = append(reviewerButtons,
+				discord.NewPrimaryButton("Respond with Attachment", constants.AppealAttachButtonCustomID))
+			if b.appeal.ClaimedBy == b.userID {
+				reviewerButtons = append(reviewerButtons,
+					discord.NewSecondaryButton("Unclaim", constants.AppealUnclaimButtonCustomID))
+			} else {
+				reviewerButtons = append(reviewerButtons,
+					discord.NewSuccessButton("Claim", constants.AppealClaimButtonCustomID).
+						WithDisabled(b.appeal.ClaimedBy != 0))
+			}
+		}
+		reviewerButtons = append(reviewerButtons,
+			discord.NewSecondaryButton("Export Record", constants.AppealExportButtonCustomID))
+
+		components = append(components, discord.NewActionRow(reviewerButtons...))
+	}
+
 	builder.AddContainerComponents(components...)
 	return builder
 }
@@ -125,8 +175,8 @@ func (b *TicketBuilder) buildHeaderEmbed() *discord.EmbedBuilder {
 		AddField("Last Viewed", fmt.Sprintf("<t:%d:R>", b.appeal.LastViewed.Unix()), true).
 		AddField("Last Activity", fmt.Sprintf("<t:%d:R>", b.appeal.LastActivity.Unix()), true)
 
-	if b.appeal.ClaimedBy != 0 {
-		embed.AddField("Claimed By", fmt.Sprintf("<@%d>", b.appeal.ClaimedBy), true)
+	if b.appeal.Status == enum.AppealStatusPending {
+		embed.SetDescription(b.buildClaimStatus())
 	}
 
 	if b.appeal.ReviewerID != 0 {
@@ -143,23 +193,99 @@ func (b *TicketBuilder) buildHeaderEmbed() *discord.EmbedBuilder {
 	return embed
 }
 
-// buildConversationEmbed creates the embed showing the message history.
-func (b *TicketBuilder) buildConversationEmbed() *discord.EmbedBuilder {
-	embed := discord.NewEmbedBuilder().
-		SetColor(utils.GetMessageEmbedColor(b.settings.StreamerMode))
+// buildClaimStatus describes who is handling a pending appeal and, for reviewers,
+// when an inactive claim will be released.
+func (b *TicketBuilder) buildClaimStatus() string {
+	if b.appeal.ClaimedBy == 0 {
+		if b.isReviewer {
+			return "🔓 **Unclaimed** - claim this appeal before responding to let other reviewers know you are handling it"
+		}
+		return "🔓 Waiting for a moderator"
+	}
+
+	claimer := fmt.Sprintf("<@%d>", b.appeal.ClaimedBy)
+	if b.appeal.ClaimedBy == b.userID {
+		claimer = "you"
+	}
+	status := fmt.Sprintf("🔒 **Claimed by %s** <t:%d:R>", claimer, b.appeal.ClaimedAt.Unix())
+
+	// Show when the claim expires without a reply
+	if b.isReviewer && b.botSettings.AppealStaleDays > 0 && !b.appeal.ClaimActivity.IsZero() {
+		releaseAt := b.appeal.ClaimActivity.Add(time.Duration(b.botSettings.AppealStaleDays) * 24 * time.Hour)
+		status += fmt.Sprintf("\nReleased <t:%d:R> if there are no replies", releaseAt.Unix())
+	}
+
+	return status
+}
+
+// pageMessages returns the messages shown on the current page.
+func (b *TicketBuilder) pageMessages() []*types.AppealMessage {
+	start := min(b.page*constants.AppealMessagesPerPage, len(b.messages))
+	end := min(start+constants.AppealMessagesPerPage, len(b.messages))
+	return b.messages[start:end]
+}
 
-	// Calculate page boundaries
-	start := b.page * constants.AppealMessagesPerPage
-	end := start + constants.AppealMessagesPerPage
-	if end > len(b.messages) {
-		end = len(b.messages)
+// getAttachments loads the images attached to the given messages, leaving out images
+// that would take the page over the upload limit. Images that fail to load are also
+// left out rather than failing the whole ticket.
+func (b *TicketBuilder) getAttachments(messages []*types.AppealMessage) map[int64]*types.AppealAttachment {
+	var messageIDs []int64
+	for _, msg := range messages {
+		if msg.HasAttachment {
+			messageIDs = append(messageIDs, msg.ID)
+		}
+	}
+	if len(messageIDs) == 0 || b.appeals == nil {
+		return nil
+	}
+
+	attachments, err := b.appeals.GetAppealAttachments(context.Background(), messageIDs)
+	if err != nil {
+		return nil
+	}
+
+	total := 0
+	for _, msg := range messages {
+		attachment, ok := attachments[msg.ID]
+		if !ok {
+			continue
+		}
+		if total+len(attachment.Data) > constants.AppealAttachmentUploadLimit {
+			delete(attachments, msg.ID)
+			continue
+		}
+		total += len(attachment.Data)
+	}
+	return attachments
+}
+
+// attachmentName returns the filename shown for an image. Original filenames often
+// contain usernames, so streamer mode shows the neutral upload name instead.
+func (b *TicketBuilder) attachmentName(attachment *types.AppealAttachment) string {
+	if b.settings.StreamerMode {
+		return attachment.UploadName()
 	}
+	return attachment.Filename
+}
+
+// buildAttachmentEmbed creates the embed showing an image attached to a message.
+func (b *TicketBuilder) buildAttachmentEmbed(attachment *types.AppealAttachment) *discord.EmbedBuilder {
+	return discord.NewEmbedBuilder().
+		SetDescription(fmt.Sprintf("📎 `%s` - <t:%d:R>", b.attachmentName(attachment), attachment.CreatedAt.Unix())).
+		SetImage("attachment://" + attachment.UploadName()).
+		SetColor(utils.GetMessageEmbedColor(b.settings.StreamerMode))
+}
+
+// buildConversationEmbed creates the embed showing the messages on the current page.
+func (b *TicketBuilder) buildConversationEmbed(messages []*types.AppealMessage) *discord.EmbedBuilder {
+	embed := discord.NewEmbedBuilder().
+		SetColor(utils.GetMessageEmbedColor(b.settings.StreamerMode))
 
 	// Add messages
 	if len(b.messages) == 0 {
 		embed.SetDescription("No messages yet.")
 	} else {
-		for _, msg := range b.messages[start:end] {
+		for _, msg := range messages {
 			// Format role
 			var roleName string
 			switch msg.Role {
@@ -167,6 +293,8 @@ func (b *TicketBuilder) buildConversationEmbed() *discord.EmbedBuilder {
 				roleName = "Moderator"
 			case enum.MessageRoleUser:
 				roleName = "User"
+			case enum.MessageRoleSystem:
+				roleName = "System"
 			}
 
 			// Format field title with role and time
@@ -181,6 +309,14 @@ func (b *TicketBuilder) buildConversationEmbed() *discord.EmbedBuilder {
 
 			// Format field value with message and user mention
 			fieldValue := fmt.Sprintf("<@%d>\n%s", msg.UserID, censoredContent)
+			if msg.Role == enum.MessageRoleSystem {
+				fieldValue = censoredContent
+			}
+
+			// Note the attached image, which is shown below the conversation
+			if msg.HasAttachment {
+				fieldValue += "\n" + b.attachmentNote(msg)
+			}
 
 			embed.AddField(fieldName, fieldValue, false)
 		}
@@ -191,3 +327,12 @@ func (b *TicketBuilder) buildConversationEmbed() *discord.EmbedBuilder {
 
 	return embed
 }
+
+// attachmentNote describes the image attached to a message in the conversation.
+func (b *TicketBuilder) attachmentNote(msg *types.AppealMessage) string {
+	attachment, ok := b.attachments[msg.ID]
+	if !ok {
+		return "📎 *Image could not be shown on this page*"
+	}
+	return fmt.Sprintf("📎 `%s`", b.attachmentName(attachment))
+}
@@ -5,7 +5,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/snowflake/v2"
@@ -27,11 +30,16 @@ type Builder struct {
 	userID           uint64
 	userCounts       *types.UserCounts
 	groupCounts      *types.GroupCounts
+	reasonCounts     []*types.ReasonCategoryCount
 	userStatsBuffer  *bytes.Buffer
 	groupStatsBuffer *bytes.Buffer
 	activeUsers      []snowflake.ID
 	workerStatuses   []core.Status
 	voteStats        *types.VoteAccuracy
+	aiCostToday      float64
+	heldUsers        int
+	heldGroups       int
+	isReadOnly       bool
 	titleCaser       cases.Caser
 }
 
@@ -43,6 +51,8 @@ func NewBuilder(s *session.Session, redisClient rueidis.Client) *Builder {
 	s.GetInterface(constants.SessionKeyUserCounts, &userCounts)
 	var groupCounts *types.GroupCounts
 	s.GetInterface(constants.SessionKeyGroupCounts, &groupCounts)
+	var reasonCounts []*types.ReasonCategoryCount
+	s.GetInterface(constants.SessionKeyReasonCounts, &reasonCounts)
 	var activeUsers []snowflake.ID
 	s.GetInterface(constants.SessionKeyActiveUsers, &activeUsers)
 	var workerStatuses []core.Status
@@ -58,11 +68,16 @@ func NewBuilder(s *session.Session, redisClient rueidis.Client) *Builder {
 		userID:           s.UserID(),
 		userCounts:       userCounts,
 		groupCounts:      groupCounts,
+		reasonCounts:     reasonCounts,
 		userStatsBuffer:  userStatsBuffer,
 		groupStatsBuffer: groupStatsBuffer,
 		activeUsers:      activeUsers,
 		workerStatuses:   workerStatuses,
 		voteStats:        voteStats,
+		aiCostToday:      s.GetFloat64(constants.SessionKeyAICostToday),
+		heldUsers:        s.GetInt(constants.SessionKeyHeldUsers),
+		heldGroups:       s.GetInt(constants.SessionKeyHeldGroups),
+		isReadOnly:       s.GetBool(constants.SessionKeyReadOnly),
 		titleCaser:       cases.Title(language.English),
 	}
 }
@@ -119,6 +134,9 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 			discord.NewStringSelectMenuOption("AI Chat Assistant", constants.ChatAssistantButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "🤖"}).
 				WithDescription("Chat with AI about moderation topics"),
+			discord.NewStringSelectMenuOption("Search Groups", constants.SearchGroupButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "🔎"}).
+				WithDescription("Search groups by name, description or reason"),
 			discord.NewStringSelectMenuOption("Activity Log Browser", constants.ActivityBrowserButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "📜"}).
 				WithDescription("Search and filter activity logs"),
@@ -151,11 +169,23 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 	}
 
 	// Create embeds
-	embeds := []discord.Embed{
+	embeds := []discord.Embed{}
+
+	// Add maintenance banner first so it is noticed
+	if b.isReadOnly {
+		embeds = append(embeds, b.buildMaintenanceEmbed())
+	}
+
+	embeds = append(embeds,
 		b.buildWelcomeEmbed(),
 		b.buildVoteStatsEmbed(),
 		b.buildUserGraphEmbed(),
 		b.buildGroupGraphEmbed(),
+	)
+
+	// Add worker overview for reviewers
+	if b.botSettings.IsReviewer(b.userID) && len(b.workerStatuses) > 0 {
+		embeds = append(embeds, b.buildWorkersEmbed())
 	}
 
 	// Add announcement embed if type is not none
@@ -187,6 +217,14 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 	return builder
 }
 
+// buildMaintenanceEmbed creates the banner shown while read-only mode is active.
+func (b *Builder) buildMaintenanceEmbed() discord.Embed {
+	return discord.NewEmbedBuilder().
+		SetDescription(constants.ReadOnlyBanner).
+		SetColor(constants.MaintenanceEmbedColor).
+		Build()
+}
+
 // buildWelcomeEmbed creates the main welcome embed.
 func (b *Builder) buildWelcomeEmbed() discord.Embed {
 	embed := discord.NewEmbedBuilder().
@@ -217,6 +255,10 @@ func (b *Builder) buildWelcomeEmbed() discord.Embed {
 		embed.AddField("Active Reviewers", fieldValue, false)
 	}
 
+	// Add the number of items currently open in a review
+	embed.AddField("Currently Being Reviewed",
+		fmt.Sprintf("`%d` users • `%d` groups", b.heldUsers, b.heldGroups), false)
+
 	return embed.Build()
 }
 
@@ -230,6 +272,15 @@ func (b *Builder) buildUserGraphEmbed() discord.Embed {
 		AddField("Banned Users", strconv.Itoa(b.userCounts.Banned), true).
 		SetColor(constants.DefaultEmbedColor)
 
+	// Add breakdown of why flagged and confirmed users were flagged
+	if len(b.reasonCounts) > 0 {
+		lines := make([]string, 0, len(b.reasonCounts))
+		for _, count := range b.reasonCounts {
+			lines = append(lines, fmt.Sprintf("%s: %d", getReasonCategoryLabel(count.ReasonCategory), count.Count))
+		}
+		embed.AddField("Flag Reasons", strings.Join(lines, "\n"), false)
+	}
+
 	// Attach user statistics chart if available
 	if b.userStatsBuffer != nil {
 		embed.SetImage("attachment://user_stats_chart.png")
@@ -238,6 +289,23 @@ func (b *Builder) buildUserGraphEmbed() discord.Embed {
 	return embed.Build()
 }
 
+// getReasonCategoryLabel returns the display label of a reason category.
+func getReasonCategoryLabel(category enum.ReasonCategory) string {
+	switch category {
+	case enum.ReasonCategoryFriend:
+		return "👫 Friend network"
+	case enum.ReasonCategoryGroup:
+		return "🌐 Group-based"
+	case enum.ReasonCategoryContent:
+		return "🤖 Content-based"
+	case enum.ReasonCategoryMultiple:
+		return "🔀 Multiple"
+	case enum.ReasonCategoryOther:
+		return "❔ Other"
+	}
+	return category.String()
+}
+
 // buildGroupGraphEmbed creates the embed containing group statistics graph and current counts.
 func (b *Builder) buildGroupGraphEmbed() discord.Embed {
 	embed := discord.NewEmbedBuilder().
@@ -256,6 +324,59 @@ func (b *Builder) buildGroupGraphEmbed() discord.Embed {
 	return embed.Build()
 }
 
+// buildWorkersEmbed creates the embed summarizing worker heartbeats by type.
+// Workers that have not reported within the stale threshold are shown in red.
+func (b *Builder) buildWorkersEmbed() discord.Embed {
+	type workerGroup struct {
+		online    int
+		stale     int
+		processed int
+	}
+
+	// Group workers by type and subtype
+	groups := make(map[string]*workerGroup)
+	for _, status := range b.workerStatuses {
+		name := b.titleCaser.String(strings.TrimSpace(status.WorkerType + " " + status.SubType))
+		group, ok := groups[name]
+		if !ok {
+			group = &workerGroup{}
+			groups[name] = group
+		}
+
+		if time.Since(status.LastSeen) > constants.DashboardWorkerStaleThreshold {
+			group.stale++
+			continue
+		}
+		group.online++
+		group.processed += status.Processed
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	embed := discord.NewEmbedBuilder().
+		SetTitle("Workers").
+		SetColor(constants.DefaultEmbedColor)
+
+	for _, name := range names {
+		group := groups[name]
+		value := fmt.Sprintf("🟢 %d online", group.online)
+		if group.stale > 0 {
+			value += fmt.Sprintf("\n🔴 %d stale", group.stale)
+			embed.SetColor(constants.ErrorEmbedColor)
+		}
+		value += fmt.Sprintf("\n%d processed", group.processed)
+		embed.AddField(name, value, true)
+	}
+
+	embed.AddField("AI Spend Today", fmt.Sprintf("$%.2f (estimated)", b.aiCostToday), true)
+
+	return embed.Build()
+}
+
 // buildAnnouncementEmbed creates the announcement embed.
 func (b *Builder) buildAnnouncementEmbed() discord.Embed {
 	var color int
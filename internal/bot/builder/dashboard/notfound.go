@@ -0,0 +1,59 @@
+package dashboard
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+// UserNotFoundBuilder creates the visual layout shown when a looked up user is not in the database.
+type UserNotFoundBuilder struct {
+	settings    *types.UserSetting
+	botSettings *types.BotSetting
+	userID      uint64
+	targetID    uint64
+}
+
+// NewUserNotFoundBuilder creates a new user not found builder.
+func NewUserNotFoundBuilder(s *session.Session) *UserNotFoundBuilder {
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+
+	return &UserNotFoundBuilder{
+		settings:    settings,
+		botSettings: botSettings,
+		userID:      s.UserID(),
+		targetID:    s.GetUint64(constants.SessionKeyLookupUserID),
+	}
+}
+
+// Build creates a Discord message explaining that the user was not found.
+// Reviewers are offered a button to queue the user for scanning.
+func (b *UserNotFoundBuilder) Build() *discord.MessageUpdateBuilder {
+	description := fmt.Sprintf("User `%s` is not in our database.",
+		utils.CensorString(strconv.FormatUint(b.targetID, 10), b.settings.StreamerMode))
+
+	buttons := []discord.InteractiveComponent{
+		discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
+	}
+	if b.botSettings.IsReviewer(b.userID) {
+		description += "\nYou can queue them to be scanned and flagged if needed."
+		buttons = append(buttons, discord.NewPrimaryButton("Queue for Scanning", constants.QueueLookupUserButtonCustomID))
+	}
+
+	embed := discord.NewEmbedBuilder().
+		SetTitle("User Not Found").
+		SetDescription(description).
+		SetColor(utils.GetMessageEmbedColor(b.settings.StreamerMode))
+
+	return discord.NewMessageUpdateBuilder().
+		SetEmbeds(embed.Build()).
+		AddContainerComponents(discord.NewActionRow(buttons...))
+}
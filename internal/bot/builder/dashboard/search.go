@@ -0,0 +1,146 @@
+package dashboard
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+)
+
+// GroupSearchBuilder creates the visual layout for group search results.
+type GroupSearchBuilder struct {
+	settings    *types.UserSetting
+	query       string
+	statuses    []enum.GroupType
+	results     []*types.GroupSearchResult
+	hasNextPage bool
+	hasPrevPage bool
+}
+
+// NewGroupSearchBuilder creates a new group search builder.
+func NewGroupSearchBuilder(s *session.Session) *GroupSearchBuilder {
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var statuses []enum.GroupType
+	s.GetInterface(constants.SessionKeyGroupSearchStatuses, &statuses)
+	var results []*types.GroupSearchResult
+	s.GetInterface(constants.SessionKeyGroupSearchResults, &results)
+
+	return &GroupSearchBuilder{
+		settings:    settings,
+		query:       s.GetString(constants.SessionKeyGroupSearchQuery),
+		statuses:    statuses,
+		results:     results,
+		hasNextPage: s.GetBool(constants.SessionKeyHasNextPage),
+		hasPrevPage: s.GetBool(constants.SessionKeyHasPrevPage),
+	}
+}
+
+// Build creates a Discord message showing the search results.
+func (b *GroupSearchBuilder) Build() *discord.MessageUpdateBuilder {
+	embed := discord.NewEmbedBuilder().
+		SetTitle("Group Search Results").
+		SetDescription(fmt.Sprintf("Query: `%s`", utils.NormalizeString(b.query))).
+		SetColor(utils.GetMessageEmbedColor(b.settings.StreamerMode))
+
+	if len(b.results) == 0 {
+		embed.AddField("No results", "No groups matched your search.", false)
+	}
+
+	// Add fields for each result
+	for _, result := range b.results {
+		name := fmt.Sprintf("%s %s", getStatusBadge(result.Status), utils.CensorString(result.Name, b.settings.StreamerMode))
+		value := fmt.Sprintf("ID: `%s`\nConfidence: `%.2f` | Flagged Members: `%d`\nReason: %s",
+			utils.CensorString(strconv.FormatUint(result.ID, 10), b.settings.StreamerMode),
+			result.Confidence,
+			result.FlaggedMembers,
+			utils.TruncateString(utils.NormalizeString(result.Reason), 200),
+		)
+		embed.AddField(utils.TruncateString(name, 256), value, false)
+	}
+
+	return discord.NewMessageUpdateBuilder().
+		SetEmbeds(embed.Build()).
+		AddContainerComponents(b.buildComponents()...)
+}
+
+// buildComponents creates the interactive components for the search results.
+func (b *GroupSearchBuilder) buildComponents() []discord.ContainerComponent {
+	var components []discord.ContainerComponent
+
+	// Add result selection menu
+	if len(b.results) > 0 {
+		options := make([]discord.StringSelectMenuOption, 0, len(b.results))
+		for _, result := range b.results {
+			idStr := strconv.FormatUint(result.ID, 10)
+			options = append(options, discord.NewStringSelectMenuOption(
+				utils.SanitizeOptionLabel(utils.CensorString(result.Name, b.settings.StreamerMode)),
+				idStr,
+			).WithDescription(fmt.Sprintf("%s group %s", result.Status.String(), utils.CensorString(idStr, b.settings.StreamerMode))))
+		}
+
+		components = append(components, discord.NewActionRow(
+			discord.NewStringSelectMenu(constants.GroupSearchResultSelectID, "Select a group to review", options...),
+		))
+	}
+
+	// Add status filter menu
+	components = append(components, discord.NewActionRow(
+		discord.NewStringSelectMenu(constants.GroupSearchStatusSelectID, "Filter by status", b.buildStatusOptions()...),
+	))
+
+	// Add navigation buttons
+	components = append(components, discord.NewActionRow(
+		discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
+		discord.NewSecondaryButton("⏮️", string(utils.ViewerFirstPage)).WithDisabled(!b.hasPrevPage),
+		discord.NewSecondaryButton("◀️", string(utils.ViewerPrevPage)).WithDisabled(!b.hasPrevPage),
+		discord.NewSecondaryButton("▶️", string(utils.ViewerNextPage)).WithDisabled(!b.hasNextPage),
+		discord.NewPrimaryButton("🔍", constants.SearchGroupButtonCustomID),
+	))
+
+	return components
+}
+
+// buildStatusOptions creates the options for the status filter menu.
+func (b *GroupSearchBuilder) buildStatusOptions() []discord.StringSelectMenuOption {
+	isAll := len(b.statuses) != 1
+	options := []discord.StringSelectMenuOption{
+		discord.NewStringSelectMenuOption("All Statuses", constants.GroupSearchAllStatusesOption).
+			WithDefault(isAll),
+	}
+
+	for _, status := range []enum.GroupType{
+		enum.GroupTypeFlagged,
+		enum.GroupTypeConfirmed,
+		enum.GroupTypeCleared,
+		enum.GroupTypeLocked,
+	} {
+		options = append(options, discord.NewStringSelectMenuOption(status.String(), status.String()).
+			WithEmoji(discord.ComponentEmoji{Name: getStatusBadge(status)}).
+			WithDefault(!isAll && b.statuses[0] == status))
+	}
+
+	return options
+}
+
+// getStatusBadge returns the emoji badge for a group status.
+func getStatusBadge(status enum.GroupType) string {
+	switch status {
+	case enum.GroupTypeConfirmed:
+		return "⚠️"
+	case enum.GroupTypeFlagged:
+		return "⏳"
+	case enum.GroupTypeCleared:
+		return "✅"
+	case enum.GroupTypeLocked:
+		return "🔒"
+	case enum.GroupTypeUnflagged:
+		return "🔄"
+	}
+	return ""
+}
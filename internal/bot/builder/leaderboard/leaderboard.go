@@ -1,6 +1,7 @@
 package leaderboard
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -15,22 +16,31 @@ import (
 // Builder creates the visual layout for viewing the voting leaderboard.
 type Builder struct {
 	settings    *types.UserSetting
+	isReviewer  bool
+	isAdmin     bool
 	stats       []types.VoteAccuracy
 	usernames   map[uint64]string
+	anonymous   map[uint64]bool
+	userID      uint64
 	hasNextPage bool
 	hasPrevPage bool
 	lastRefresh time.Time
 	nextRefresh time.Time
+	export      *bytes.Buffer
 }
 
 // NewBuilder creates a new leaderboard builder.
 func NewBuilder(s *session.Session) *Builder {
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
 	var stats []types.VoteAccuracy
 	s.GetInterface(constants.SessionKeyLeaderboardStats, &stats)
 	var usernames map[uint64]string
 	s.GetInterface(constants.SessionKeyLeaderboardUsernames, &usernames)
+	var anonymous map[uint64]bool
+	s.GetInterface(constants.SessionKeyLeaderboardAnonymous, &anonymous)
 	var lastRefresh time.Time
 	s.GetInterface(constants.SessionKeyLeaderboardLastRefresh, &lastRefresh)
 	var nextRefresh time.Time
@@ -38,12 +48,17 @@ func NewBuilder(s *session.Session) *Builder {
 
 	return &Builder{
 		settings:    settings,
+		isReviewer:  botSettings.IsReviewer(s.UserID()),
+		isAdmin:     botSettings.IsAdmin(s.UserID()),
 		stats:       stats,
 		usernames:   usernames,
+		anonymous:   anonymous,
+		userID:      s.UserID(),
 		hasNextPage: s.GetBool(constants.SessionKeyHasNextPage),
 		hasPrevPage: s.GetBool(constants.SessionKeyHasPrevPage),
 		lastRefresh: lastRefresh,
 		nextRefresh: nextRefresh,
+		export:      s.GetBuffer(constants.SessionKeyReviewerStatsExport),
 	}
 }
 
@@ -64,10 +79,7 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 
 	if len(b.stats) > 0 {
 		for _, stat := range b.stats {
-			username := b.usernames[stat.DiscordUserID]
-			if username == "" {
-				username = fmt.Sprintf("Unknown (%d)", stat.DiscordUserID)
-			}
+			username := b.getDisplayName(stat)
 
 			// Get rank display with medal if applicable
 			rankDisplay := getRankDisplay(stat.Rank)
@@ -92,23 +104,41 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 	// Create components
 	components := b.buildComponents()
 
-	return discord.NewMessageUpdateBuilder().
+	builder := discord.NewMessageUpdateBuilder().
 		SetEmbeds(embed.Build()).
 		AddContainerComponents(components...)
+
+	// Attach the exported reviewer stats if requested
+	if b.export != nil {
+		builder.AddFile(fmt.Sprintf("reviewer_stats_%s.csv", b.settings.LeaderboardPeriod.String()), "text/csv", b.export)
+	}
+
+	return builder
 }
 
 // buildComponents creates all interactive components for the leaderboard viewer.
 func (b *Builder) buildComponents() []discord.ContainerComponent {
+	// Add review times button for reviewers and export button for reviewers and admins
+	actionButtons := []discord.InteractiveComponent{
+		discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
+	}
+	if b.isReviewer {
+		actionButtons = append(actionButtons,
+			discord.NewSecondaryButton("⏱️ Review Times", constants.ReviewTimesButtonCustomID))
+	}
+	if b.isReviewer || b.isAdmin {
+		actionButtons = append(actionButtons,
+			discord.NewSecondaryButton("📄 Export CSV", constants.ExportReviewerStatsButtonCustomID))
+	}
+
 	return []discord.ContainerComponent{
 		// Time period selection menu
 		discord.NewActionRow(
 			discord.NewStringSelectMenu(constants.LeaderboardPeriodSelectMenuCustomID, "Select Time Period",
-				b.buildPeriodOptions()...),
-		),
-		// Refresh button
-		discord.NewActionRow(
-			discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
+				buildPeriodOptions(b.settings.LeaderboardPeriod)...),
 		),
+		// Action buttons
+		discord.NewActionRow(actionButtons...),
 		// Navigation buttons
 		discord.NewActionRow(
 			discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
@@ -121,23 +151,40 @@ func (b *Builder) buildComponents() []discord.ContainerComponent {
 }
 
 // buildPeriodOptions creates the options for the time period selection menu.
-func (b *Builder) buildPeriodOptions() []discord.StringSelectMenuOption {
+func buildPeriodOptions(selected enum.LeaderboardPeriod) []discord.StringSelectMenuOption {
 	return []discord.StringSelectMenuOption{
 		discord.NewStringSelectMenuOption("Daily", enum.LeaderboardPeriodDaily.String()).
-			WithDefault(b.settings.LeaderboardPeriod == enum.LeaderboardPeriodDaily),
+			WithDefault(selected == enum.LeaderboardPeriodDaily),
 		discord.NewStringSelectMenuOption("Weekly", enum.LeaderboardPeriodWeekly.String()).
-			WithDefault(b.settings.LeaderboardPeriod == enum.LeaderboardPeriodWeekly),
+			WithDefault(selected == enum.LeaderboardPeriodWeekly),
 		discord.NewStringSelectMenuOption("Bi-Weekly", enum.LeaderboardPeriodBiWeekly.String()).
-			WithDefault(b.settings.LeaderboardPeriod == enum.LeaderboardPeriodBiWeekly),
+			WithDefault(selected == enum.LeaderboardPeriodBiWeekly),
 		discord.NewStringSelectMenuOption("Monthly", enum.LeaderboardPeriodMonthly.String()).
-			WithDefault(b.settings.LeaderboardPeriod == enum.LeaderboardPeriodMonthly),
+			WithDefault(selected == enum.LeaderboardPeriodMonthly),
 		discord.NewStringSelectMenuOption("Bi-Annually", enum.LeaderboardPeriodBiAnnually.String()).
-			WithDefault(b.settings.LeaderboardPeriod == enum.LeaderboardPeriodBiAnnually),
+			WithDefault(selected == enum.LeaderboardPeriodBiAnnually),
 		discord.NewStringSelectMenuOption("Annually", enum.LeaderboardPeriodAnnually.String()).
-			WithDefault(b.settings.LeaderboardPeriod == enum.LeaderboardPeriodAnnually),
+			WithDefault(selected == enum.LeaderboardPeriodAnnually),
 		discord.NewStringSelectMenuOption("All Time", enum.LeaderboardPeriodAllTime.String()).
-			WithDefault(b.settings.LeaderboardPeriod == enum.LeaderboardPeriodAllTime),
+			WithDefault(selected == enum.LeaderboardPeriodAllTime),
+	}
+}
+
+// getDisplayName returns the name shown for a leaderboard entry. Users who chose
+// to be anonymous are shown by their rank, and see a marker on their own entry.
+func (b *Builder) getDisplayName(stat types.VoteAccuracy) string {
+	if b.anonymous[stat.DiscordUserID] {
+		name := fmt.Sprintf("Anonymous #%d", stat.Rank)
+		if stat.DiscordUserID == b.userID {
+			name += " (you)"
+		}
+		return name
+	}
+
+	if username := b.usernames[stat.DiscordUserID]; username != "" {
+		return username
 	}
+	return fmt.Sprintf("Unknown (%d)", stat.DiscordUserID)
 }
 
 // getRankDisplay returns a formatted rank with medal emoji for top 3.
@@ -0,0 +1,44 @@
+package leaderboard
+
+import (
+	"testing"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestBuildMasksAnonymousUsers(t *testing.T) {
+	s := session.NewSession(nil, nil, "session:1", make(map[string]interface{}), zap.NewNop(), 30)
+	s.Set(constants.SessionKeyUserSettings, &types.UserSetting{})
+	s.Set(constants.SessionKeyBotSettings, &types.BotSetting{})
+	s.Set(constants.SessionKeyLeaderboardStats, []types.VoteAccuracy{
+		{DiscordUserID: 10, Rank: 1, CorrectVotes: 9, TotalVotes: 10, Accuracy: 0.9},
+		{DiscordUserID: 20, Rank: 2, CorrectVotes: 8, TotalVotes: 10, Accuracy: 0.8},
+		{DiscordUserID: 30, Rank: 3, CorrectVotes: 7, TotalVotes: 10, Accuracy: 0.7},
+		{DiscordUserID: 40, Rank: 4, CorrectVotes: 6, TotalVotes: 10, Accuracy: 0.6},
+	})
+	s.Set(constants.SessionKeyLeaderboardUsernames, map[uint64]string{10: "visible", 20: "hidden"})
+	s.Set(constants.SessionKeyLeaderboardAnonymous, map[uint64]bool{20: true, 30: true})
+
+	message := NewBuilder(s).Build().Build()
+	require.NotNil(t, message.Embeds)
+	embeds := *message.Embeds
+	require.Len(t, embeds, 1)
+
+	names := make([]string, 0, len(embeds[0].Fields))
+	for _, field := range embeds[0].Fields {
+		names = append(names, field.Name)
+	}
+
+	// Anonymous users keep their rank but never show their name, even if it was resolved
+	assert.Equal(t, []string{
+		"🥇 visible",
+		"🥈 Anonymous #2",
+		"🥉 Anonymous #3 (you)",
+		"#4 Unknown (40)",
+	}, names)
+}
@@ -0,0 +1,209 @@
+package leaderboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+)
+
+// ReviewTimesBuilder creates the visual layout for viewing reviewer decision times.
+type ReviewTimesBuilder struct {
+	settings    *types.UserSetting
+	durations   *types.DecisionDurations
+	appealTimes *types.AppealResponseTimeStats
+	regressions *types.AppealRegressionStats
+	precision   []*types.CheckerPrecision
+}
+
+// NewReviewTimesBuilder creates a new review times builder.
+func NewReviewTimesBuilder(s *session.Session) *ReviewTimesBuilder {
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var durations *types.DecisionDurations
+	s.GetInterface(constants.SessionKeyDecisionDurations, &durations)
+	var appealTimes *types.AppealResponseTimeStats
+	s.GetInterface(constants.SessionKeyAppealResponseTimes, &appealTimes)
+	var regressions *types.AppealRegressionStats
+	s.GetInterface(constants.SessionKeyAppealRegressions, &regressions)
+	var precision []*types.CheckerPrecision
+	s.GetInterface(constants.SessionKeyCheckerPrecision, &precision)
+
+	return &ReviewTimesBuilder{
+		settings:    settings,
+		durations:   durations,
+		appealTimes: appealTimes,
+		regressions: regressions,
+		precision:   precision,
+	}
+}
+
+// Build creates a Discord message showing decision times by reason category and confidence.
+func (b *ReviewTimesBuilder) Build() *discord.MessageUpdateBuilder {
+	embed := discord.NewEmbedBuilder().
+		SetTitle("⏱️ Review Times").
+		SetDescription(fmt.Sprintf(
+			"Time spent per decision for %s period. Decisions longer than %s are excluded.",
+			b.settings.LeaderboardPeriod.String(),
+			types.MaxDecisionDuration.String(),
+		)).
+		SetColor(utils.GetMessageEmbedColor(b.settings.StreamerMode))
+
+	if b.durations != nil {
+		embed.AddField("By Reason Category", buildDurationTable("Category", b.durations.ByCategory), false)
+		embed.AddField("By Confidence", buildDurationTable("Confidence", b.durations.ByConfidence), false)
+	}
+
+	if b.appealTimes != nil {
+		embed.AddField("Appeal Response Times", buildAppealTimesTable(b.appealTimes), false)
+	}
+
+	if b.regressions != nil {
+		embed.AddField("Re-flag Rate After Accepted Appeals", formatRegressionStats(b.regressions), false)
+	}
+
+	embed.AddField("Confirm Rate by Flag Source", formatCheckerPrecision(b.precision), false)
+
+	return discord.NewMessageUpdateBuilder().
+		SetEmbeds(embed.Build()).
+		AddContainerComponents(
+			discord.NewActionRow(
+				discord.NewStringSelectMenu(constants.LeaderboardPeriodSelectMenuCustomID, "Select Time Period",
+					buildPeriodOptions(b.settings.LeaderboardPeriod)...),
+			),
+			discord.NewActionRow(
+				discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
+				discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
+			),
+		)
+}
+
+// buildDurationTable formats duration statistics as a table in a code block.
+func buildDurationTable(header string, stats []*types.DecisionDurationStat) string {
+	if len(stats) == 0 {
+		return "No decisions recorded for this time period"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```\n")
+	sb.WriteString(fmt.Sprintf("%-25s %6s %8s %8s\n", header, "Count", "Median", "P90"))
+	for _, stat := range stats {
+		sb.WriteString(fmt.Sprintf("%-25s %6d %8s %8s\n",
+			utils.TruncateString(stat.Label, 25),
+			stat.Count,
+			formatSeconds(stat.MedianSeconds),
+			formatSeconds(stat.P90Seconds),
+		))
+	}
+	sb.WriteString("```")
+
+	return sb.String()
+}
+
+// buildAppealTimesTable formats appeal response time statistics as a table in a code block.
+// Appeals closed automatically by the system are not included.
+func buildAppealTimesTable(stats *types.AppealResponseTimeStats) string {
+	if stats.FirstResponse.Count == 0 && stats.Resolution.Count == 0 {
+		return "No appeals responded to for this time period"
+	}
+
+	rows := []struct {
+		label string
+		stat  types.AppealTimingStat
+	}{
+		{label: "First Response", stat: stats.FirstResponse},
+		{label: "Resolution", stat: stats.Resolution},
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```\n")
+	sb.WriteString(fmt.Sprintf("%-15s %6s %9s %9s %9s\n", "Metric", "Count", "Average", "Median", "P95"))
+	for _, row := range rows {
+		sb.WriteString(fmt.Sprintf("%-15s %6d %9s %9s %9s\n",
+			row.label,
+			row.stat.Count,
+			formatLongSeconds(row.stat.AvgSeconds),
+			formatLongSeconds(row.stat.MedianSeconds),
+			formatLongSeconds(row.stat.P95Seconds),
+		))
+	}
+	sb.WriteString("```")
+
+	return sb.String()
+}
+
+// formatRegressionStats formats how many accepted appeals were followed by the
+// user being flagged again.
+func formatRegressionStats(stats *types.AppealRegressionStats) string {
+	if stats.AcceptedCount == 0 {
+		return "No appeals accepted for this time period"
+	}
+
+	text := fmt.Sprintf("%.1f%% (%d of %d accepted appeals)",
+		stats.ReflagRate()*100, stats.RegressedCount, stats.AcceptedCount)
+	if stats.RegressedCount > 0 {
+		text += fmt.Sprintf(", flagged again after %.1f days on average", stats.AvgDaysToReflag)
+	}
+	return text
+}
+
+// formatCheckerPrecision formats the share of each checker's flags that reviewers
+// confirmed, such as "AI flags: 78% confirmed (39 of 50)".
+func formatCheckerPrecision(precision []*types.CheckerPrecision) string {
+	if len(precision) == 0 {
+		return "No users confirmed or cleared for this time period"
+	}
+
+	lines := make([]string, 0, len(precision))
+	for _, p := range precision {
+		lines = append(lines, fmt.Sprintf("%s: %.0f%% confirmed (%d of %d)",
+			flagSourceLabel(p.FlagSource), p.ConfirmRate()*100, p.Confirmed, p.Confirmed+p.Cleared))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// flagSourceLabel returns the display name of the checker that flagged a user.
+func flagSourceLabel(source enum.FlagSource) string {
+	switch source {
+	case enum.FlagSourceAI:
+		return "AI flags"
+	case enum.FlagSourceFriend:
+		return "Friend-network flags"
+	case enum.FlagSourceGroup:
+		return "Group flags"
+	case enum.FlagSourceManualRecheck:
+		return "Manual recheck flags"
+	case enum.FlagSourceUnknown:
+		return "Unknown source"
+	default:
+		return source.String()
+	}
+}
+
+// formatLongSeconds formats a number of seconds as days and hours, hours and
+// minutes, or minutes depending on its size.
+func formatLongSeconds(seconds float64) string {
+	minutes := int(seconds/60 + 0.5)
+	switch {
+	case minutes >= 24*60:
+		return fmt.Sprintf("%dd%02dh", minutes/(24*60), minutes%(24*60)/60)
+	case minutes >= 60:
+		return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// formatSeconds formats a number of seconds as minutes and seconds.
+func formatSeconds(seconds float64) string {
+	total := int(seconds + 0.5)
+	if total < 60 {
+		return fmt.Sprintf("%ds", total)
+	}
+	return fmt.Sprintf("%dm%02ds", total/60, total%60)
+}
@@ -3,7 +3,6 @@ package log
 import (
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
@@ -17,6 +16,7 @@ import (
 // Builder creates the visual layout for viewing activity logs.
 type Builder struct {
 	settings           *types.UserSetting
+	isReviewer         bool
 	logs               []*types.ActivityLog
 	discordID          uint64
 	userID             uint64
@@ -33,6 +33,8 @@ type Builder struct {
 func NewBuilder(s *session.Session) *Builder {
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
 	var logs []*types.ActivityLog
 	s.GetInterface(constants.SessionKeyLogs, &logs)
 	var activityTypeFilter enum.ActivityType
@@ -40,6 +42,7 @@ func NewBuilder(s *session.Session) *Builder {
 
 	return &Builder{
 		settings:           settings,
+		isReviewer:         botSettings.IsReviewer(s.UserID()),
 		logs:               logs,
 		discordID:          s.GetUint64(constants.SessionKeyDiscordIDFilter),
 		userID:             s.GetUint64(constants.SessionKeyUserIDFilter),
@@ -83,12 +86,7 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 	// Add log entries with details
 	if len(b.logs) > 0 {
 		for _, log := range b.logs {
-			details := ""
-			for key, value := range log.Details {
-				newKey := strings.ToUpper(key[:1]) + key[1:]
-				newValue := utils.NormalizeString(fmt.Sprintf("%v", value))
-				details += fmt.Sprintf("\n%s: `%v`", newKey, newValue)
-			}
+			details := utils.FormatLogDetails(log.Details)
 
 			description := fmt.Sprintf("Activity: `%s`", log.ActivityType.String())
 
@@ -131,7 +129,7 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 
 // buildComponents creates all interactive components for the log viewer.
 func (b *Builder) buildComponents() []discord.ContainerComponent {
-	return []discord.ContainerComponent{
+	components := []discord.ContainerComponent{
 		// Query condition selection menu
 		discord.NewActionRow(
 			discord.NewStringSelectMenu(constants.ActionSelectMenuCustomID, "Set Filter Condition",
@@ -145,6 +143,8 @@ func (b *Builder) buildComponents() []discord.ContainerComponent {
 					WithDescription(strconv.FormatUint(b.reviewerID, 10)),
 				discord.NewStringSelectMenuOption("Filter by Date Range", constants.LogsQueryDateRangeOption).
 					WithDescription(fmt.Sprintf("%s to %s", b.startDate.Format("2006-01-02"), b.endDate.Format("2006-01-02"))),
+				discord.NewStringSelectMenuOption("Reviewer Summary", constants.LogsReviewerSummaryOption).
+					WithDescription("Show a reviewer's activity totals for a day"),
 			),
 		),
 		// Activity type filter menu
@@ -166,6 +166,22 @@ func (b *Builder) buildComponents() []discord.ContainerComponent {
 			discord.NewSecondaryButton("⏭️", string(utils.ViewerLastPage)).WithDisabled(true), // This is disabled on purpose
 		),
 	}
+
+	// Add export menu for reviewers
+	if b.isReviewer {
+		components = append(components, discord.NewActionRow(
+			discord.NewStringSelectMenu(constants.LogsExportSelectMenuCustomID, "Export Matching Logs",
+				discord.NewStringSelectMenuOption("Export as CSV", constants.LogsExportCSVOption).
+					WithEmoji(discord.ComponentEmoji{Name: "📄"}).
+					WithDescription("Details are included as a JSON column"),
+				discord.NewStringSelectMenuOption("Export as JSON lines", constants.LogsExportJSONLinesOption).
+					WithEmoji(discord.ComponentEmoji{Name: "🧾"}).
+					WithDescription("One JSON object per log"),
+			),
+		))
+	}
+
+	return components
 }
 
 // buildActivityTypeOptions creates the options for the activity type filter menu.
@@ -0,0 +1,68 @@
+package log
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+// SummaryBuilder creates the visual layout for a reviewer's daily activity summary.
+type SummaryBuilder struct {
+	settings   *types.UserSetting
+	counts     []*types.ActivityTypeCount
+	reviewerID uint64
+	date       time.Time
+}
+
+// NewSummaryBuilder creates a new reviewer summary builder.
+func NewSummaryBuilder(s *session.Session) *SummaryBuilder {
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var counts []*types.ActivityTypeCount
+	s.GetInterface(constants.SessionKeyReviewerSummary, &counts)
+
+	return &SummaryBuilder{
+		settings:   settings,
+		counts:     counts,
+		reviewerID: s.GetUint64(constants.SessionKeyReviewerSummaryReviewer),
+		date:       s.GetTime(constants.SessionKeyReviewerSummaryDate),
+	}
+}
+
+// Build creates a Discord message showing the reviewer's activity counts by type.
+func (b *SummaryBuilder) Build() *discord.MessageUpdateBuilder {
+	embed := discord.NewEmbedBuilder().
+		SetTitle("Reviewer Summary").
+		AddField("Reviewer", fmt.Sprintf("<@%d>", b.reviewerID), true).
+		AddField("Date (UTC)", fmt.Sprintf("`%s`", b.date.Format("2006-01-02")), true).
+		SetColor(utils.GetMessageEmbedColor(b.settings.StreamerMode))
+
+	if len(b.counts) > 0 {
+		total := 0
+		for _, count := range b.counts {
+			total += count.Count
+		}
+		embed.AddField("Total", strconv.Itoa(total), true)
+
+		for _, count := range b.counts {
+			embed.AddField(count.ActivityType.String(), strconv.Itoa(count.Count), true)
+		}
+	} else {
+		embed.AddField("No Activity", "This reviewer has no logged activity on this date", false)
+	}
+
+	return discord.NewMessageUpdateBuilder().
+		SetEmbeds(embed.Build()).
+		AddContainerComponents(
+			discord.NewActionRow(
+				discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
+				discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
+			),
+		)
+}
@@ -2,39 +2,41 @@ package queue
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/common/queue"
 )
 
 // Builder creates the visual layout for managing queue operations.
-// It shows current queue lengths and provides options for adding users
+// It shows how many items wait in each priority queue along with how fast
+// they are being processed, and provides options for adding users
 // to different priority queues.
 type Builder struct {
-	highPriorityCount   int
-	normalPriorityCount int
-	lowPriorityCount    int
+	stats []*queue.PriorityStats
 }
 
 // NewBuilder creates a new queue embed.
-func NewBuilder(highCount, normalCount, lowCount int) *Builder {
+func NewBuilder(stats []*queue.PriorityStats) *Builder {
 	return &Builder{
-		highPriorityCount:   highCount,
-		normalPriorityCount: normalCount,
-		lowPriorityCount:    lowCount,
+		stats: stats,
 	}
 }
 
 // Build creates a Discord message showing the priority queues.
 func (b *Builder) Build() *discord.MessageUpdateBuilder {
-	// Create embed showing queue lengths
-	embed := discord.NewEmbedBuilder().
+	// Create embed showing queue stats
+	embedBuilder := discord.NewEmbedBuilder().
 		SetTitle("User Queue Manager").
-		AddField("High Priority Queue", fmt.Sprintf("%d items", b.highPriorityCount), true).
-		AddField("Normal Priority Queue", fmt.Sprintf("%d items", b.normalPriorityCount), true).
-		AddField("Low Priority Queue", fmt.Sprintf("%d items", b.lowPriorityCount), true).
-		SetColor(constants.DefaultEmbedColor).
-		Build()
+		SetFooter("Wait times and rates cover items processed in the last hour", "").
+		SetColor(constants.DefaultEmbedColor)
+
+	for _, stats := range b.stats {
+		embedBuilder.AddField(priorityNames[stats.Priority]+" Priority Queue", formatStats(stats), true)
+	}
+
+	embed := embedBuilder.Build()
 
 	// Add queue management components
 	components := []discord.ContainerComponent{
@@ -63,3 +65,33 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 		SetEmbeds(embed).
 		AddContainerComponents(components...)
 }
+
+// priorityNames maps priority levels to their display names.
+var priorityNames = map[string]string{
+	queue.HighPriority:   "High",
+	queue.NormalPriority: "Normal",
+	queue.LowPriority:    "Low",
+}
+
+// formatStats formats the stats of a priority queue as its waiting count,
+// average wait and hourly throughput.
+func formatStats(stats *queue.PriorityStats) string {
+	wait := "avg wait unknown"
+	if stats.KnownWaits > 0 {
+		wait = "avg wait " + formatWait(stats.AvgWait)
+	}
+	return fmt.Sprintf("%d waiting (%s, %d/hr)", stats.Waiting, wait, stats.ProcessedLastHour)
+}
+
+// formatWait formats a wait as seconds, minutes, or hours and minutes depending on its size.
+func formatWait(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Round(time.Second).Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Round(time.Minute).Minutes()))
+	default:
+		minutes := int(d.Round(time.Minute).Minutes())
+		return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+	}
+}
@@ -18,10 +18,13 @@ import (
 // MembersBuilder creates the visual layout for viewing a group's flagged members.
 type MembersBuilder struct {
 	settings    *types.UserSetting
+	botSettings *types.BotSetting
+	userID      uint64
 	group       *types.ReviewGroup
 	pageMembers []uint64
 	members     map[uint64]*types.ReviewUser
 	presences   map[uint64]*apiTypes.UserPresenceResponse
+	selected    map[uint64]bool
 	start       int
 	page        int
 	total       int
@@ -33,6 +36,8 @@ type MembersBuilder struct {
 func NewMembersBuilder(s *session.Session) *MembersBuilder {
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
 	var group *types.ReviewGroup
 	s.GetInterface(constants.SessionKeyGroupTarget, &group)
 	var pageMembers []uint64
@@ -40,14 +45,24 @@ func NewMembersBuilder(s *session.Session) *MembersBuilder {
 	var members map[uint64]*types.ReviewUser
 	s.GetInterface(constants.SessionKeyGroupMembers, &members)
 	var presences map[uint64]*apiTypes.UserPresenceResponse
-	s.GetInterface(constants.SessionKeyPresences, &presences)
+	s.GetInterface(constants.SessionKeyGroupMemberPresences, &presences)
+	var selectedIDs []uint64
+	s.GetInterface(constants.SessionKeyGroupSelectedMembers, &selectedIDs)
+
+	selected := make(map[uint64]bool, len(selectedIDs))
+	for _, id := range selectedIDs {
+		selected[id] = true
+	}
 
 	return &MembersBuilder{
 		settings:    settings,
+		botSettings: botSettings,
+		userID:      s.UserID(),
 		group:       group,
 		pageMembers: pageMembers,
 		members:     members,
 		presences:   presences,
+		selected:    selected,
 		start:       s.GetInt(constants.SessionKeyStart),
 		page:        s.GetInt(constants.SessionKeyPaginationPage),
 		total:       s.GetInt(constants.SessionKeyTotalItems),
@@ -61,9 +76,8 @@ func (b *MembersBuilder) Build() *discord.MessageUpdateBuilder {
 	totalPages := (b.total + constants.MembersPerPage - 1) / constants.MembersPerPage
 	censor := b.settings.StreamerMode || b.settings.ReviewMode == enum.ReviewModeTraining
 
-	// Create file attachment for the member avatars grid
+	// Name the file attachment for the member avatars grid
 	fileName := fmt.Sprintf("members_%d_%d.png", b.group.ID, b.page)
-	file := discord.NewFile(fileName, "", b.imageBuffer)
 
 	// Build base embed with group info
 	embed := discord.NewEmbedBuilder().
@@ -83,9 +97,17 @@ func (b *MembersBuilder) Build() *discord.MessageUpdateBuilder {
 		embed.AddField(fieldName, fieldValue, true)
 	}
 
-	builder := discord.NewMessageUpdateBuilder().
-		SetEmbeds(embed.Build()).
-		SetFiles(file)
+	builder := discord.NewMessageUpdateBuilder()
+
+	// The grid is not persisted with the session, so a page reloaded in a later
+	// interaction keeps the attachment the message already has
+	if b.imageBuffer != nil {
+		builder.AddFiles(discord.NewFile(fileName, "", b.imageBuffer))
+	}
+
+	// Set group thumbnail or attach placeholder
+	utils.SetThumbnail(embed, builder, b.group.ThumbnailURL)
+	builder.SetEmbeds(embed.Build())
 
 	// Only add navigation components if not streaming
 	if !b.isStreaming {
@@ -98,11 +120,58 @@ func (b *MembersBuilder) Build() *discord.MessageUpdateBuilder {
 				discord.NewSecondaryButton("⏭️", string(utils.ViewerLastPage)).WithDisabled(b.page == totalPages-1),
 			),
 		}...)
+
+		// Add bulk actions for reviewers in standard mode
+		if b.settings.ReviewMode != enum.ReviewModeTraining && b.botSettings.IsReviewer(b.userID) {
+			builder.AddContainerComponents(b.buildBulkComponents()...)
+		}
 	}
 
 	return builder
 }
 
+// buildBulkComponents creates the member selection menu and the buttons for
+// confirming or clearing the selected members at once.
+func (b *MembersBuilder) buildBulkComponents() []discord.ContainerComponent {
+	options := b.buildMemberOptions()
+	if len(options) == 0 {
+		return nil
+	}
+
+	return []discord.ContainerComponent{
+		discord.NewActionRow(
+			discord.NewStringSelectMenu(constants.MembersSelectMenuCustomID, "Select members", options...).
+				WithMinValues(0).
+				WithMaxValues(len(options)),
+		),
+		discord.NewActionRow(
+			discord.NewDangerButton(fmt.Sprintf("Confirm Selected (%d)", len(b.selected)), constants.MembersConfirmButtonCustomID).
+				WithDisabled(len(b.selected) == 0),
+			discord.NewSuccessButton(fmt.Sprintf("Clear Selected (%d)", len(b.selected)), constants.MembersClearButtonCustomID).
+				WithDisabled(len(b.selected) == 0),
+		),
+	}
+}
+
+// buildMemberOptions creates a select option for each flagged member on the current page.
+func (b *MembersBuilder) buildMemberOptions() []discord.StringSelectMenuOption {
+	options := make([]discord.StringSelectMenuOption, 0, len(b.pageMembers))
+	for i, memberID := range b.pageMembers {
+		member, ok := b.members[memberID]
+		if !ok || member.Status == enum.UserTypeUnflagged {
+			continue
+		}
+
+		label := fmt.Sprintf("Member %d: %s", b.start+i+1, utils.CensorString(member.Name, b.settings.StreamerMode))
+		options = append(options,
+			discord.NewStringSelectMenuOption(utils.SanitizeOptionLabel(label), strconv.FormatUint(memberID, 10)).
+				WithDescription(member.Status.String()).
+				WithDefault(b.selected[memberID]),
+		)
+	}
+	return options
+}
+
 // getMemberFieldName creates the field name for a member entry.
 func (b *MembersBuilder) getMemberFieldName(index int, memberID uint64) string {
 	fieldName := fmt.Sprintf("Member %d", b.start+index+1)
@@ -9,30 +9,38 @@ import (
 
 	"github.com/disgoorg/disgo/discord"
 	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
-	"github.com/robalyx/rotector/assets"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/utils"
-	"github.com/robalyx/rotector/internal/common/client/fetcher"
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/robalyx/rotector/internal/common/translator"
 )
 
 // ReviewBuilder creates the visual layout for reviewing a group.
 type ReviewBuilder struct {
-	db          *database.Client
-	settings    *types.UserSetting
-	botSettings *types.BotSetting
-	userID      uint64
-	group       *types.ReviewGroup
-	groupInfo   *apiTypes.GroupResponse
-	memberIDs   []uint64
-	isTraining  bool
+	db           *database.Client
+	translations *translator.Cache
+	description  *translator.Translation
+	shout        *translator.Translation
+	settings     *types.UserSetting
+	botSettings  *types.BotSetting
+	userID       uint64
+	group        *types.ReviewGroup
+	groupInfo    *apiTypes.GroupResponse
+	memberIDs    []uint64
+	counts       *types.GroupMemberCounts
+	ownerGroups  []*types.OwnedGroup
+	queueCount   int
+	ownerOffer   string
+	groupsOffer  int
+	isTraining   bool
+	isReadOnly   bool
 }
 
 // NewReviewBuilder creates a new review builder.
-func NewReviewBuilder(s *session.Session, db *database.Client) *ReviewBuilder {
+func NewReviewBuilder(s *session.Session, translations *translator.Cache, db *database.Client) *ReviewBuilder {
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
 	var botSettings *types.BotSetting
@@ -43,16 +51,35 @@ func NewReviewBuilder(s *session.Session, db *database.Client) *ReviewBuilder {
 	s.GetInterface(constants.SessionKeyGroupInfo, &groupInfo)
 	var memberIDs []uint64
 	s.GetInterface(constants.SessionKeyGroupMemberIDs, &memberIDs)
+	var counts *types.GroupMemberCounts
+	s.GetInterface(constants.SessionKeyGroupMemberCounts, &counts)
+	var ownerGroups []*types.OwnedGroup
+	s.GetInterface(constants.SessionKeyOwnerGroups, &ownerGroups)
+	var groupsOffer []uint64
+	s.GetInterface(constants.SessionKeyOwnerGroupsOffer, &groupsOffer)
+	var description *translator.Translation
+	s.GetInterface(constants.SessionKeyTranslatedGroupDesc, &description)
+	var shout *translator.Translation
+	s.GetInterface(constants.SessionKeyTranslatedGroupShout, &shout)
 
 	return &ReviewBuilder{
-		db:          db,
-		settings:    settings,
-		botSettings: botSettings,
-		userID:      s.UserID(),
-		group:       group,
-		groupInfo:   groupInfo,
-		memberIDs:   memberIDs,
-		isTraining:  settings.ReviewMode == enum.ReviewModeTraining,
+		db:           db,
+		translations: translations,
+		description:  description,
+		shout:        shout,
+		settings:     settings,
+		botSettings:  botSettings,
+		userID:       s.UserID(),
+		group:        group,
+		groupInfo:    groupInfo,
+		memberIDs:    memberIDs,
+		counts:       counts,
+		ownerGroups:  ownerGroups,
+		queueCount:   s.GetInt(constants.SessionKeyConfirmedGroupMemberCount),
+		ownerOffer:   s.GetString(constants.SessionKeyOwnerQueueOfferName),
+		groupsOffer:  len(groupsOffer),
+		isTraining:   settings.ReviewMode == enum.ReviewModeTraining,
+		isReadOnly:   s.GetBool(constants.SessionKeyReadOnly),
 	}
 }
 
@@ -68,18 +95,8 @@ func (b *ReviewBuilder) Build() *discord.MessageUpdateBuilder {
 	// Create components
 	components := b.buildComponents()
 
-	// Create builder and handle thumbnail
-	if b.group.ThumbnailURL != "" && b.group.ThumbnailURL != fetcher.ThumbnailPlaceholder {
-		reviewEmbed.SetThumbnail(b.group.ThumbnailURL)
-	} else {
-		// Load and attach placeholder image
-		placeholderImage, err := assets.Images.Open("images/content_deleted.png")
-		if err == nil {
-			builder.SetFiles(discord.NewFile("content_deleted.png", "", placeholderImage))
-			_ = placeholderImage.Close()
-		}
-		reviewEmbed.SetThumbnail("attachment://content_deleted.png")
-	}
+	// Set thumbnail or attach placeholder
+	utils.SetThumbnail(reviewEmbed, builder, b.group.ThumbnailURL)
 
 	return builder.
 		AddEmbeds(modeEmbed.Build(), reviewEmbed.Build()).
@@ -109,6 +126,11 @@ func (b *ReviewBuilder) buildModeEmbed() *discord.EmbedBuilder {
 		description = "Error encountered. Please check your settings."
 	}
 
+	// Warn that actions are disabled during maintenance
+	if b.isReadOnly {
+		description += "\n" + constants.ReadOnlyBanner
+	}
+
 	return discord.NewEmbedBuilder().
 		SetTitle(mode).
 		SetDescription(description).
@@ -143,6 +165,10 @@ func (b *ReviewBuilder) buildReviewEmbed() *discord.EmbedBuilder {
 	confidence := fmt.Sprintf("%.2f", b.group.Confidence)
 	memberCount := strconv.FormatUint(b.groupInfo.MemberCount, 10)
 	flaggedMembers := strconv.Itoa(len(b.memberIDs))
+	if b.counts != nil {
+		flaggedMembers = fmt.Sprintf("%d tracked (%d confirmed, %d flagged)",
+			b.counts.Tracked, b.counts.Confirmed, b.counts.Flagged)
+	}
 
 	// Censor reason if needed
 	reason := utils.CensorStringsInText(
@@ -164,6 +190,7 @@ func (b *ReviewBuilder) buildReviewEmbed() *discord.EmbedBuilder {
 			AddField("Last Updated", lastUpdated, true).
 			AddField("Reason", reason, false).
 			AddField("Shout", b.getShout(), false).
+			AddField("Recent Shouts", b.getRecentShouts(), false).
 			AddField("Description", b.getDescription(), false)
 	} else {
 		// Standard mode - show all information with links
@@ -184,8 +211,11 @@ func (b *ReviewBuilder) buildReviewEmbed() *discord.EmbedBuilder {
 			AddField("Last Updated", lastUpdated, true).
 			AddField("Reason", reason, false).
 			AddField("Shout", b.getShout(), false).
+			AddField("Recent Shouts", b.getRecentShouts(), false).
 			AddField("Description", b.getDescription(), false).
-			AddField("Review History", b.getReviewHistory(), false)
+			AddField("Owner's Other Groups", b.getOwnerGroups(), false).
+			AddField("Review History", b.getReviewHistory(), false).
+			AddField("Notes", b.getNotes(), false)
 	}
 
 	// Add status-specific timestamps
@@ -237,12 +267,21 @@ func (b *ReviewBuilder) buildActionOptions() []discord.StringSelectMenuOption {
 			discord.NewStringSelectMenuOption("Ask AI about group", constants.OpenAIChatButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "🤖"}).
 				WithDescription("Ask the AI questions about this group"),
+			discord.NewStringSelectMenuOption("Review owner", constants.GroupReviewOwnerButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "👤"}).
+				WithDescription("Open the review page for the group's owner"),
 			discord.NewStringSelectMenuOption("View group logs", constants.GroupViewLogsButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "📋"}).
 				WithDescription("View activity logs for this group"),
 			discord.NewStringSelectMenuOption("Confirm with reason", constants.GroupConfirmWithReasonButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "🚫"}).
 				WithDescription("Confirm the group with a custom reason"),
+			discord.NewStringSelectMenuOption("Add note", constants.AddNoteButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "📝"}).
+				WithDescription("Leave a note on this group for other reviewers"),
+			discord.NewStringSelectMenuOption("Delete note", constants.DeleteNoteButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "🗑️"}).
+				WithDescription("Delete one of your notes on this group"),
 			discord.NewStringSelectMenuOption("Change Review Mode", constants.ReviewModeOption).
 				WithEmoji(discord.ComponentEmoji{Name: "🎓"}).
 				WithDescription("Switch between training and standard modes"),
@@ -260,6 +299,19 @@ func (b *ReviewBuilder) buildActionOptions() []discord.StringSelectMenuOption {
 	return options
 }
 
+// buildReasonPresetOptions creates the options for the reviewer's saved reason presets.
+func (b *ReviewBuilder) buildReasonPresetOptions() []discord.StringSelectMenuOption {
+	options := make([]discord.StringSelectMenuOption, 0, len(b.settings.ReasonPresets))
+	for i, preset := range b.settings.ReasonPresets {
+		options = append(options,
+			discord.NewStringSelectMenuOption(utils.SanitizeOptionLabel(preset.Name), strconv.Itoa(i)+constants.ModalOpenSuffix).
+				WithEmoji(discord.ComponentEmoji{Name: "📝"}).
+				WithDescription(utils.SanitizeOptionDescription(preset.Template)),
+		)
+	}
+	return options
+}
+
 // buildComponents creates all interactive components for the review menu.
 func (b *ReviewBuilder) buildComponents() []discord.ContainerComponent {
 	components := []discord.ContainerComponent{}
@@ -278,6 +330,42 @@ func (b *ReviewBuilder) buildComponents() []discord.ContainerComponent {
 		),
 	)
 
+	// Add reason presets menu for reviewers with saved presets
+	if b.botSettings.IsReviewer(b.userID) && len(b.settings.ReasonPresets) > 0 {
+		components = append(components,
+			discord.NewActionRow(
+				discord.NewStringSelectMenu(constants.ReasonPresetSelectMenuCustomID, "Confirm with preset", b.buildReasonPresetOptions()...),
+			),
+		)
+	}
+
+	// Add follow-up buttons in a single row so the message stays within Discord's row limit
+	if b.botSettings.IsReviewer(b.userID) {
+		var offers []discord.InteractiveComponent
+		if b.queueCount > 0 {
+			offers = append(offers, discord.NewPrimaryButton(
+				fmt.Sprintf("Queue %d tracked members for recheck?", b.queueCount),
+				constants.GroupQueueMembersButtonCustomID,
+			))
+		}
+		if b.ownerOffer != "" {
+			offers = append(offers, discord.NewPrimaryButton(
+				utils.SanitizeButtonLabel(fmt.Sprintf("Queue owner %s for check?",
+					utils.CensorString(b.ownerOffer, b.settings.StreamerMode))),
+				constants.GroupQueueOwnerButtonCustomID,
+			))
+		}
+		if b.groupsOffer > 0 {
+			offers = append(offers, discord.NewPrimaryButton(
+				fmt.Sprintf("Flag %d other groups by the same owner for scanning?", b.groupsOffer),
+				constants.GroupFlagOwnerGroupsButtonCustomID,
+			))
+		}
+		if len(offers) > 0 {
+			components = append(components, discord.NewActionRow(offers...))
+		}
+	}
+
 	// Add navigation/action buttons
 	components = append(components, discord.NewActionRow(
 		discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
@@ -298,6 +386,13 @@ func (b *ReviewBuilder) getDescription() string {
 		return constants.NotApplicable
 	}
 
+	// Use the translation prepared when the group was loaded
+	var prefix string
+	if translated, ok := b.getTranslation(b.description, description); ok && translated != description {
+		description = translated
+		prefix = "(translated)\n"
+	}
+
 	// Prepare description
 	description = utils.TruncateString(description, 400)
 	description = utils.FormatString(description)
@@ -309,7 +404,50 @@ func (b *ReviewBuilder) getDescription() string {
 		strconv.FormatUint(b.group.Owner.UserID, 10),
 	)
 
-	return description
+	return prefix + description
+}
+
+// getOwnerGroups returns the field listing the other groups owned by the group's owner.
+func (b *ReviewBuilder) getOwnerGroups() string {
+	if b.ownerGroups == nil {
+		return constants.NotApplicable
+	}
+
+	lines := make([]string, 0, constants.MaxOwnerGroupsShown+1)
+	others := 0
+	for _, group := range b.ownerGroups {
+		if group.ID == b.group.ID {
+			continue
+		}
+		others++
+		if len(lines) >= constants.MaxOwnerGroupsShown {
+			continue
+		}
+
+		line := fmt.Sprintf("- [%s](https://www.roblox.com/groups/%d)",
+			utils.CensorString(group.Name, b.settings.StreamerMode), group.ID)
+		switch group.Status {
+		case enum.GroupTypeConfirmed:
+			line += " ⚠️ Confirmed"
+		case enum.GroupTypeFlagged:
+			line += " ⏳ Flagged"
+		case enum.GroupTypeCleared:
+			line += " ✅ Cleared"
+		case enum.GroupTypeLocked:
+			line += " 🔒 Locked"
+		case enum.GroupTypeUnflagged:
+		}
+		lines = append(lines, line)
+	}
+
+	if others == 0 {
+		return "None"
+	}
+	if others > len(lines) {
+		lines = append(lines, fmt.Sprintf("... and %d more", others-len(lines)))
+	}
+
+	return strings.Join(lines, "\n")
 }
 
 // getShout returns the shout field for the embed.
@@ -319,11 +457,58 @@ func (b *ReviewBuilder) getShout() string {
 		return constants.NotApplicable
 	}
 
+	// Use the translation prepared when the group was loaded
+	shout := b.group.Shout.Body
+	var prefix string
+	if translated, ok := b.getTranslation(b.shout, shout); ok && translated != shout {
+		shout = translated
+		prefix = "(translated)\n"
+	}
+
 	// Prepare shout
-	shout := utils.TruncateString(b.group.Shout.Body, 400)
+	shout = utils.TruncateString(shout, 400)
 	shout = utils.FormatString(shout)
 
-	return shout
+	return prefix + shout
+}
+
+// getTranslation returns the translation of a group's text from the session or
+// the translation cache. It never calls the translation API so that building the
+// embed does not wait on the network.
+func (b *ReviewBuilder) getTranslation(translation *translator.Translation, text string) (string, bool) {
+	if translation != nil && translation.Source == text {
+		return translation.Text, true
+	}
+
+	input, ok := translator.PrepareInput(text)
+	if !ok {
+		return "", false
+	}
+	return b.translations.Lookup(input, "auto", "en")
+}
+
+// getRecentShouts returns the recent shouts field for the embed.
+func (b *ReviewBuilder) getRecentShouts() string {
+	shouts, err := b.db.Shouts().GetShoutHistory(context.Background(), b.group.ID)
+	if err != nil {
+		return "Failed to fetch shout history"
+	}
+
+	if len(shouts) == 0 {
+		return constants.NotApplicable
+	}
+
+	lines := make([]string, 0, constants.ReviewShoutsLimit+1)
+	for i, shout := range shouts {
+		if i >= constants.ReviewShoutsLimit {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(shouts)-constants.ReviewShoutsLimit))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("- <t:%d:R> `%s`",
+			shout.PostedAt.Unix(), utils.NormalizeString(utils.TruncateString(shout.Content, 200))))
+	}
+
+	return strings.Join(lines, "\n")
 }
 
 // getReviewHistory returns the review history field for the embed.
@@ -361,6 +546,18 @@ func (b *ReviewBuilder) getReviewHistory() string {
 	return strings.Join(history, "\n")
 }
 
+// getNotes returns the latest reviewer notes field for the embed.
+func (b *ReviewBuilder) getNotes() string {
+	notes, err := b.db.Notes().GetNotes(
+		context.Background(), enum.NoteTargetTypeGroup, b.group.ID, constants.ReviewNotesLimit+1,
+	)
+	if err != nil {
+		return "Failed to fetch notes"
+	}
+
+	return utils.FormatReviewNotes(notes)
+}
+
 // getConfirmButtonLabel returns the appropriate label for the confirm button based on review mode.
 func (b *ReviewBuilder) getConfirmButtonLabel() string {
 	if b.settings.ReviewMode == enum.ReviewModeTraining {
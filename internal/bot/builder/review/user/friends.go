@@ -61,9 +61,8 @@ func (b *FriendsBuilder) Build() *discord.MessageUpdateBuilder {
 	totalPages := (b.total + constants.FriendsPerPage - 1) / constants.FriendsPerPage
 	censor := b.settings.StreamerMode || b.settings.ReviewMode == enum.ReviewModeTraining
 
-	// Create file attachment for the friend avatars grid
+	// Name the file attachment for the friend avatars grid
 	fileName := fmt.Sprintf("friends_%d_%d.png", b.user.ID, b.page)
-	file := discord.NewFile(fileName, "", b.imageBuffer)
 
 	// Build base embed with user info
 	embed := discord.NewEmbedBuilder().
@@ -84,8 +83,13 @@ func (b *FriendsBuilder) Build() *discord.MessageUpdateBuilder {
 	}
 
 	builder := discord.NewMessageUpdateBuilder().
-		SetEmbeds(embed.Build()).
-		SetFiles(file)
+		SetEmbeds(embed.Build())
+
+	// The grid is not persisted with the session, so a page reloaded in a later
+	// interaction keeps the attachment the message already has
+	if b.imageBuffer != nil {
+		builder.AddFiles(discord.NewFile(fileName, "", b.imageBuffer))
+	}
 
 	// Only add navigation components if not streaming
 	if !b.isStreaming {
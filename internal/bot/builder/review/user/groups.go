@@ -57,9 +57,8 @@ func (b *GroupsBuilder) Build() *discord.MessageUpdateBuilder {
 	totalPages := (b.total + constants.GroupsPerPage - 1) / constants.GroupsPerPage
 	censor := b.settings.StreamerMode || b.settings.ReviewMode == enum.ReviewModeTraining
 
-	// Create file attachment for the group thumbnails grid
+	// Name the file attachment for the group thumbnails grid
 	fileName := fmt.Sprintf("groups_%d_%d.png", b.user.ID, b.page)
-	file := discord.NewFile(fileName, "", b.imageBuffer)
 
 	// Build base embed with user info
 	embed := discord.NewEmbedBuilder().
@@ -80,8 +79,13 @@ func (b *GroupsBuilder) Build() *discord.MessageUpdateBuilder {
 	}
 
 	builder := discord.NewMessageUpdateBuilder().
-		SetEmbeds(embed.Build()).
-		SetFiles(file)
+		SetEmbeds(embed.Build())
+
+	// The grid is not persisted with the session, so a page reloaded in a later
+	// interaction keeps the attachment the message already has
+	if b.imageBuffer != nil {
+		builder.AddFiles(discord.NewFile(fileName, "", b.imageBuffer))
+	}
 
 	// Only add navigation components if not streaming
 	if !b.isStreaming {
@@ -8,11 +8,9 @@ import (
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
-	"github.com/robalyx/rotector/assets"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/utils"
-	"github.com/robalyx/rotector/internal/common/client/fetcher"
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
@@ -26,24 +24,35 @@ type ReviewBuilder struct {
 	botSettings    *types.BotSetting
 	userID         uint64
 	user           *types.ReviewUser
-	translator     *translator.Translator
+	translations   *translator.Cache
+	translation    *translator.Translation
 	flaggedFriends map[uint64]*types.ReviewUser
+	networkCluster *types.NetworkClusterInfo
 	flaggedGroups  map[uint64]*types.ReviewGroup
+	allowlisted    map[uint64]bool
 	isTraining     bool
+	isReadOnly     bool
+	voteBreakdown  *types.VoteBreakdown
 }
 
 // NewReviewBuilder creates a new review builder.
-func NewReviewBuilder(s *session.Session, translator *translator.Translator, db *database.Client) *ReviewBuilder {
+func NewReviewBuilder(s *session.Session, translations *translator.Cache, db *database.Client) *ReviewBuilder {
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
 	var botSettings *types.BotSetting
 	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
 	var user *types.ReviewUser
 	s.GetInterface(constants.SessionKeyTarget, &user)
+	var translation *translator.Translation
+	s.GetInterface(constants.SessionKeyTranslatedDescription, &translation)
 	var flaggedFriends map[uint64]*types.ReviewUser
 	s.GetInterface(constants.SessionKeyFlaggedFriends, &flaggedFriends)
+	var networkCluster *types.NetworkClusterInfo
+	s.GetInterface(constants.SessionKeyNetworkCluster, &networkCluster)
 	var flaggedGroups map[uint64]*types.ReviewGroup
 	s.GetInterface(constants.SessionKeyFlaggedGroups, &flaggedGroups)
+	var allowlisted map[uint64]bool
+	s.GetInterface(constants.SessionKeyAllowlistedGroupIDs, &allowlisted)
 
 	return &ReviewBuilder{
 		db:             db,
@@ -51,10 +60,14 @@ func NewReviewBuilder(s *session.Session, translator *translator.Translator, db
 		botSettings:    botSettings,
 		userID:         s.UserID(),
 		user:           user,
-		translator:     translator,
+		translations:   translations,
+		translation:    translation,
 		flaggedFriends: flaggedFriends,
+		networkCluster: networkCluster,
 		flaggedGroups:  flaggedGroups,
+		allowlisted:    allowlisted,
 		isTraining:     settings.ReviewMode == enum.ReviewModeTraining,
+		isReadOnly:     s.GetBool(constants.SessionKeyReadOnly),
 	}
 }
 
@@ -63,6 +76,11 @@ func NewReviewBuilder(s *session.Session, translator *translator.Translator, db
 func (b *ReviewBuilder) Build() *discord.MessageUpdateBuilder {
 	builder := discord.NewMessageUpdateBuilder()
 
+	// Get the vote breakdown shown in training mode
+	if b.isTraining {
+		b.voteBreakdown = b.getVoteBreakdown()
+	}
+
 	// Create embeds
 	modeEmbed := b.buildModeEmbed()
 	reviewEmbed := b.buildReviewBuilder()
@@ -70,18 +88,8 @@ func (b *ReviewBuilder) Build() *discord.MessageUpdateBuilder {
 	// Create components
 	components := b.buildComponents()
 
-	// Create builder and handle thumbnail
-	if b.user.ThumbnailURL != "" && b.user.ThumbnailURL != fetcher.ThumbnailPlaceholder {
-		reviewEmbed.SetThumbnail(b.user.ThumbnailURL)
-	} else {
-		// Load and attach placeholder image
-		placeholderImage, err := assets.Images.Open("images/content_deleted.png")
-		if err == nil {
-			builder.SetFiles(discord.NewFile("content_deleted.png", "", placeholderImage))
-			_ = placeholderImage.Close()
-		}
-		reviewEmbed.SetThumbnail("attachment://content_deleted.png")
-	}
+	// Set thumbnail or attach placeholder
+	utils.SetThumbnail(reviewEmbed, builder, b.user.ThumbnailURL)
 
 	return builder.
 		AddEmbeds(modeEmbed.Build(), reviewEmbed.Build()).
@@ -111,6 +119,11 @@ func (b *ReviewBuilder) buildModeEmbed() *discord.EmbedBuilder {
 		description += "Error encountered. Please check your settings."
 	}
 
+	// Warn that actions are disabled during maintenance
+	if b.isReadOnly {
+		description += "\n" + constants.ReadOnlyBanner
+	}
+
 	return discord.NewEmbedBuilder().
 		SetTitle(mode).
 		SetDescription(description).
@@ -121,10 +134,7 @@ func (b *ReviewBuilder) buildModeEmbed() *discord.EmbedBuilder {
 func (b *ReviewBuilder) buildReviewBuilder() *discord.EmbedBuilder {
 	embed := discord.NewEmbedBuilder().
 		SetColor(utils.GetMessageEmbedColor(b.isTraining || b.settings.StreamerMode)).
-		SetTitle(fmt.Sprintf("⚠️ %d Reports • 🛡️ %d Safe",
-			b.user.Reputation.Downvotes,
-			b.user.Reputation.Upvotes,
-		))
+		SetTitle(b.getHeader())
 
 	// Add status indicator based on user status
 	var status string
@@ -147,14 +157,7 @@ func (b *ReviewBuilder) buildReviewBuilder() *discord.EmbedBuilder {
 	followerCount := utils.FormatNumber(b.user.FollowerCount)
 	followingCount := utils.FormatNumber(b.user.FollowingCount)
 
-	// Censor reason if needed
-	reason := utils.CensorStringsInText(
-		b.user.Reason,
-		b.isTraining || b.settings.StreamerMode,
-		strconv.FormatUint(b.user.ID, 10),
-		b.user.Name,
-		b.user.DisplayName,
-	)
+	reason := b.getReason()
 
 	if b.settings.ReviewMode == enum.ReviewModeTraining {
 		// Training mode - show limited information without links
@@ -191,8 +194,17 @@ func (b *ReviewBuilder) buildReviewBuilder() *discord.EmbedBuilder {
 			AddField("Game Visits", b.getTotalVisits(), true).
 			AddField("Confidence", confidence, true).
 			AddField("Created At", createdAt, true).
-			AddField("Last Updated", lastUpdated, true).
-			AddField("Reason", reason, false).
+			AddField("Last Updated", lastUpdated, true)
+
+		if len(b.user.PreviousNames) != 0 {
+			embed.AddField("Previously Known As", b.getPreviousNames(), false)
+		}
+
+		if secondOpinion := b.getSecondOpinion(); secondOpinion != "" {
+			embed.AddField("🤔 Second Opinion", secondOpinion, false)
+		}
+
+		embed.AddField("Reason", reason, false).
 			AddField("Description", b.getDescription(), false).
 			AddField(b.getFriendsField(), b.getFriends(), false).
 			AddField(b.getGroupsField(), b.getGroups(), false).
@@ -202,7 +214,8 @@ func (b *ReviewBuilder) buildReviewBuilder() *discord.EmbedBuilder {
 		if len(b.user.FlaggedContent) != 0 {
 			embed.AddField("Flagged Content", b.getFlaggedContent(), false)
 		}
-		embed.AddField("Review History", b.getReviewHistory(), false)
+		embed.AddField("Review History", b.getReviewHistory(), false).
+			AddField("Notes", b.getNotes(), false)
 	}
 
 	// Add status-specific timestamps
@@ -216,6 +229,16 @@ func (b *ReviewBuilder) buildReviewBuilder() *discord.EmbedBuilder {
 		embed.AddField("Purged At", fmt.Sprintf("<t:%d:R>", b.user.PurgedAt.Unix()), true)
 	}
 
+	// Note that pinned users are kept past the cleared user purge
+	if b.user.IsPinned {
+		embed.AddField("📌 Pinned", "This cleared user is kept as a reference case and will not be purged.", false)
+	}
+
+	// Explain why protected users cannot be confirmed
+	if b.user.IsProtected {
+		embed.AddField("🛡️ Protected Account", "This account is on the protected list and cannot be confirmed.", false)
+	}
+
 	// Add UUID and status to footer
 	embed.SetFooter(fmt.Sprintf("%s • UUID: %s", status, b.user.UUID.String()), "")
 
@@ -264,29 +287,109 @@ func (b *ReviewBuilder) buildActionOptions() []discord.StringSelectMenuOption {
 			discord.NewStringSelectMenuOption("View user logs", constants.ViewUserLogsButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "📋"}).
 				WithDescription("View activity logs for this user"),
+			discord.NewStringSelectMenuOption("Search by name", constants.SearchUserNameButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "🔎"}).
+				WithDescription("Find users by part of their username or display name"),
 			discord.NewStringSelectMenuOption("Recheck user", constants.RecheckButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "🔄"}).
 				WithDescription("Add user to high priority queue for recheck"),
+			discord.NewStringSelectMenuOption("Refresh counts", constants.RefreshCountsButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "📈"}).
+				WithDescription("Update follower counts and games without a rescan"),
 			discord.NewStringSelectMenuOption("Confirm with reason", constants.ConfirmWithReasonButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "🚫"}).
 				WithDescription("Confirm the user with a custom reason"),
+			discord.NewStringSelectMenuOption("Add note", constants.AddNoteButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "📝"}).
+				WithDescription("Leave a note on this user for other reviewers"),
+			discord.NewStringSelectMenuOption("Delete note", constants.DeleteNoteButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "🗑️"}).
+				WithDescription("Delete one of your notes on this user"),
+			discord.NewStringSelectMenuOption("Request second opinion", constants.RequestSecondOpinionButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "🤔"}).
+				WithDescription("Hand this user to another reviewer to decide"),
 			discord.NewStringSelectMenuOption("Change Review Mode", constants.ReviewModeOption).
 				WithEmoji(discord.ComponentEmoji{Name: "🎓"}).
 				WithDescription("Switch between training and standard modes"),
 		}
 		options = append(options, reviewerOptions...)
+
+		// Cleared users can be pinned to keep them from being purged
+		if b.user.Status == enum.UserTypeCleared {
+			if b.user.IsPinned {
+				options = append(options,
+					discord.NewStringSelectMenuOption("Unpin cleared user", constants.PinClearedUserButtonCustomID).
+						WithEmoji(discord.ComponentEmoji{Name: "📌"}).
+						WithDescription("Allow this user to be purged with other cleared users"))
+			} else {
+				options = append(options,
+					discord.NewStringSelectMenuOption("Pin cleared user", constants.PinClearedUserButtonCustomID).
+						WithEmoji(discord.ComponentEmoji{Name: "📌"}).
+						WithDescription("Keep this user as a reference case instead of purging"))
+			}
+			options = append(options,
+				discord.NewStringSelectMenuOption("Re-flag user", constants.ReflagUserButtonCustomID).
+					WithEmoji(discord.ComponentEmoji{Name: "🚩"}).
+					WithDescription("Send this cleared user back to the review queue"))
+		}
 	}
 
 	// Add last default options
 	options = append(options,
+		discord.NewStringSelectMenuOption("Skip with note", constants.SkipWithNoteButtonCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "⏭️"}).
+			WithDescription("Skip this user and leave a note for the next reviewer"),
 		discord.NewStringSelectMenuOption("Change Review Target", constants.ReviewTargetModeOption).
 			WithEmoji(discord.ComponentEmoji{Name: "🎯"}).
 			WithDescription("Change what type of users to review"),
+		discord.NewStringSelectMenuOption("Set Confidence Threshold", constants.ReviewConfidenceThresholdOption).
+			WithEmoji(discord.ComponentEmoji{Name: "📊"}).
+			WithDescription("Only review users at or above a confidence level"),
 	)
 
 	return options
 }
 
+// buildReasonPresetOptions creates the options for the reviewer's saved reason presets.
+func (b *ReviewBuilder) buildReasonPresetOptions() []discord.StringSelectMenuOption {
+	options := make([]discord.StringSelectMenuOption, 0, len(b.settings.ReasonPresets))
+	for i, preset := range b.settings.ReasonPresets {
+		options = append(options,
+			discord.NewStringSelectMenuOption(utils.SanitizeOptionLabel(preset.Name), strconv.Itoa(i)+constants.ModalOpenSuffix).
+				WithEmoji(discord.ComponentEmoji{Name: "📝"}).
+				WithDescription(utils.SanitizeOptionDescription(preset.Template)),
+		)
+	}
+	return options
+}
+
+// buildDecisiveContentOptions creates the options for marking flagged content items
+// as decisive, with currently marked items selected by default.
+func (b *ReviewBuilder) buildDecisiveContentOptions() []discord.StringSelectMenuOption {
+	decisive := make(map[string]struct{}, len(b.user.DecisiveContent))
+	for _, item := range b.user.DecisiveContent {
+		decisive[item] = struct{}{}
+	}
+
+	options := make([]discord.StringSelectMenuOption, 0, constants.MaxDecisiveContentOptions)
+	for i, item := range b.user.FlaggedContent {
+		if i >= constants.MaxDecisiveContentOptions {
+			break
+		}
+
+		_, isDecisive := decisive[item]
+		label, inOutfit := types.SplitFlaggedContent(item)
+		if inOutfit {
+			label = "👕 " + label
+		}
+		options = append(options,
+			discord.NewStringSelectMenuOption(utils.SanitizeOptionLabel(label), strconv.Itoa(i)).
+				WithDefault(isDecisive),
+		)
+	}
+	return options
+}
+
 // buildComponents creates all interactive components for the review menu.
 func (b *ReviewBuilder) buildComponents() []discord.ContainerComponent {
 	components := []discord.ContainerComponent{}
@@ -305,17 +408,66 @@ func (b *ReviewBuilder) buildComponents() []discord.ContainerComponent {
 		),
 	)
 
-	// Add navigation/action buttons
+	// Add reason presets menu for reviewers with saved presets
+	if b.botSettings.IsReviewer(b.userID) && len(b.settings.ReasonPresets) > 0 {
+		components = append(components,
+			discord.NewActionRow(
+				discord.NewStringSelectMenu(constants.ReasonPresetSelectMenuCustomID, "Confirm with preset", b.buildReasonPresetOptions()...),
+			),
+		)
+	}
+
+	// Add decisive content marking for reviewers confirming in standard mode
+	if !b.isTraining && b.botSettings.IsReviewer(b.userID) && len(b.user.FlaggedContent) > 0 {
+		options := b.buildDecisiveContentOptions()
+		components = append(components,
+			discord.NewActionRow(
+				discord.NewStringSelectMenu(constants.DecisiveContentSelectMenuCustomID,
+					"Mark decisive content (optional)", options...).
+					WithMinValues(0).
+					WithMaxValues(len(options)),
+			),
+		)
+	}
+
+	// Add navigation/action buttons, disabling votes the user has already cast
+	hasVoted := b.voteBreakdown != nil && b.voteBreakdown.HasVoted
 	components = append(components, discord.NewActionRow(
 		discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
-		discord.NewDangerButton(b.getConfirmButtonLabel(), constants.ConfirmButtonCustomID),
-		discord.NewSuccessButton(b.getClearButtonLabel(), constants.ClearButtonCustomID),
+		discord.NewDangerButton(b.getConfirmButtonLabel(), constants.ConfirmButtonCustomID).WithDisabled(hasVoted),
+		discord.NewSuccessButton(b.getClearButtonLabel(), constants.ClearButtonCustomID).WithDisabled(hasVoted),
 		discord.NewSecondaryButton("Skip", constants.SkipButtonCustomID),
 	))
 
 	return components
 }
 
+// getVoteBreakdown returns the votes cast on the user, or nil if they could not be fetched.
+func (b *ReviewBuilder) getVoteBreakdown() *types.VoteBreakdown {
+	breakdown, err := b.db.Votes().GetVoteBreakdown(context.Background(), b.user.ID, b.userID, enum.VoteTypeUser)
+	if err != nil {
+		return nil
+	}
+	return breakdown
+}
+
+// getHeader returns the title of the review embed. Training mode shows the
+// vote agreement when available, otherwise the raw reputation counts are shown.
+func (b *ReviewBuilder) getHeader() string {
+	if b.voteBreakdown == nil {
+		return fmt.Sprintf("⚠️ %d Reports • 🛡️ %d Safe",
+			b.user.Reputation.Downvotes,
+			b.user.Reputation.Upvotes,
+		)
+	}
+
+	header := utils.FormatVoteBreakdown(b.voteBreakdown)
+	if b.voteBreakdown.HasVoted {
+		header += " • ✅ You voted"
+	}
+	return header
+}
+
 // getConfirmButtonLabel returns the appropriate label for the confirm button based on review mode.
 func (b *ReviewBuilder) getConfirmButtonLabel() string {
 	if b.settings.ReviewMode == enum.ReviewModeTraining {
@@ -346,6 +498,33 @@ func (b *ReviewBuilder) getTotalVisits() string {
 	return utils.FormatNumber(totalVisits)
 }
 
+// getReason returns the reason for flagging with each source on its own bullet.
+func (b *ReviewBuilder) getReason() string {
+	sections := types.SplitReason(b.user.Reason)
+	if len(sections) == 0 {
+		return constants.NotApplicable
+	}
+
+	var reason strings.Builder
+	for i, section := range sections {
+		if i > 0 {
+			reason.WriteString("\n")
+		}
+		reason.WriteString("- " + section)
+	}
+
+	// Censor reason if needed
+	censored := utils.CensorStringsInText(
+		reason.String(),
+		b.isTraining || b.settings.StreamerMode,
+		strconv.FormatUint(b.user.ID, 10),
+		b.user.Name,
+		b.user.DisplayName,
+	)
+
+	return utils.TruncateString(censored, 1024)
+}
+
 // getDescription returns the description field for the embed.
 func (b *ReviewBuilder) getDescription() string {
 	description := b.user.Description
@@ -355,6 +534,13 @@ func (b *ReviewBuilder) getDescription() string {
 		return constants.NotApplicable
 	}
 
+	// Use the translation prepared when the user was loaded
+	var prefix string
+	if translated, ok := b.getTranslatedDescription(); ok && translated != description {
+		description = translated
+		prefix = "(translated)\n"
+	}
+
 	// Prepare description
 	description = utils.TruncateString(description, 400)
 	description = utils.FormatString(description)
@@ -366,26 +552,49 @@ func (b *ReviewBuilder) getDescription() string {
 		b.user.DisplayName,
 	)
 
-	// Translate the description
-	translatedDescription, err := b.translator.Translate(context.Background(), description, "auto", "en")
-	if err == nil && translatedDescription != description {
-		return "(translated)\n" + translatedDescription
-	}
+	return prefix + description
+}
 
-	return description
+// getTranslatedDescription returns the translation of the user's description
+// from the session or the translation cache. It never calls the translation API
+// so that building the embed does not wait on the network.
+func (b *ReviewBuilder) getTranslatedDescription() (string, bool) {
+	if b.translation != nil && b.translation.Source == b.user.Description {
+		return b.translation.Text, true
+	}
+	input, ok := translator.PrepareInput(b.user.Description)
+	if !ok {
+		return "", false
+	}
+	return b.translations.Lookup(input, "auto", "en")
 }
 
 // getFlaggedContent returns the flagged content field for the embed.
 func (b *ReviewBuilder) getFlaggedContent() string {
+	items, decisiveCount := b.user.SortedFlaggedContent()
+
 	content := make([]string, 0, 5)
-	for i, item := range b.user.FlaggedContent {
+	for i, item := range items {
 		if i >= 5 {
 			content = append(content, "... and more")
 			break
 		}
-		newItem := utils.TruncateString(item, 100)
+		text, inOutfit := types.SplitFlaggedContent(item)
+		newItem := utils.TruncateString(text, 100)
 		newItem = utils.NormalizeString(newItem)
-		content = append(content, fmt.Sprintf("- `%s`", newItem))
+
+		// Mark items that were found in an outfit name
+		prefix := ""
+		if inOutfit {
+			prefix = "👕 "
+		}
+
+		// Highlight items marked as decisive by the confirming reviewer
+		if i < decisiveCount {
+			content = append(content, fmt.Sprintf("- %s**`%s`** (decisive)", prefix, newItem))
+		} else {
+			content = append(content, fmt.Sprintf("- %s`%s`", prefix, newItem))
+		}
 	}
 
 	return strings.Join(content, "\n")
@@ -416,8 +625,22 @@ func (b *ReviewBuilder) getReviewHistory() string {
 
 	history := make([]string, 0, len(logs))
 	for _, log := range logs {
-		history = append(history, fmt.Sprintf("- <@%d> (%s) - <t:%d:R>",
-			log.ReviewerID, log.ActivityType.String(), log.ActivityTimestamp.Unix()))
+		entry := fmt.Sprintf("- <@%d> (%s) - <t:%d:R>",
+			log.ReviewerID, log.ActivityType.String(), log.ActivityTimestamp.Unix())
+
+		// Show skip notes so the next reviewer has the context
+		if note, ok := log.Details["note"].(string); ok && note != "" && !b.isTraining {
+			entry += "\n  - Note: " + utils.FormatHistoryNote(note)
+		}
+
+		// Show why a cleared user was sent back to review
+		if log.ActivityType == enum.ActivityTypeUserReflagged && !b.isTraining {
+			if reason, ok := log.Details["reason"].(string); ok && reason != "" {
+				entry += "\n  - Reason: " + utils.FormatHistoryNote(reason)
+			}
+		}
+
+		history = append(history, entry)
 	}
 
 	if nextCursor != nil {
@@ -427,6 +650,45 @@ func (b *ReviewBuilder) getReviewHistory() string {
 	return strings.Join(history, "\n")
 }
 
+// getNotes returns the latest reviewer notes field for the embed.
+func (b *ReviewBuilder) getNotes() string {
+	notes, err := b.db.Notes().GetNotes(
+		context.Background(), enum.NoteTargetTypeUser, b.user.ID, constants.ReviewNotesLimit+1,
+	)
+	if err != nil {
+		return "Failed to fetch notes"
+	}
+
+	return utils.FormatReviewNotes(notes)
+}
+
+// getSecondOpinion returns who asked for a second opinion on the user and why.
+// Returns an empty string if no second opinion was requested.
+func (b *ReviewBuilder) getSecondOpinion() string {
+	opinion, err := b.db.SecondOpinions().GetSecondOpinion(context.Background(), b.user.ID)
+	if err != nil {
+		return "Failed to fetch second opinion request"
+	}
+	if opinion == nil {
+		return ""
+	}
+
+	requested := fmt.Sprintf("Requested by <@%d> - <t:%d:R>", opinion.RequestedBy, opinion.RequestedAt.Unix())
+	if opinion.Note == "" {
+		return requested
+	}
+	return requested + "\n" + utils.FormatHistoryNote(opinion.Note)
+}
+
+// getPreviousNames returns the earlier usernames of the user, most recent first.
+func (b *ReviewBuilder) getPreviousNames() string {
+	names := make([]string, 0, len(b.user.PreviousNames))
+	for i := len(b.user.PreviousNames) - 1; i >= 0; i-- {
+		names = append(names, utils.CensorString(b.user.PreviousNames[i], b.settings.StreamerMode))
+	}
+	return strings.Join(names, ", ")
+}
+
 // getFriends returns the friends field for the embed.
 func (b *ReviewBuilder) getFriends() string {
 	friends := make([]string, 0, constants.ReviewFriendsLimit)
@@ -470,15 +732,27 @@ func (b *ReviewBuilder) getGroups() string {
 		}
 
 		name := utils.CensorString(group.Group.Name, b.isTraining || b.settings.StreamerMode)
+		var entry string
 		if b.isTraining {
-			groups = append(groups, name)
+			entry = name
 		} else {
-			groups = append(groups, fmt.Sprintf(
+			entry = fmt.Sprintf(
 				"[%s](https://www.roblox.com/groups/%d)",
 				name,
 				group.Group.ID,
-			))
+			)
 		}
+
+		// Mark groups owned by the user
+		if types.IsGroupOwner(b.user.ID, group) {
+			entry += " 👑"
+		}
+
+		// Mark groups that are excluded from flagging
+		if b.allowlisted[group.Group.ID] {
+			entry += " 🏳️"
+		}
+		groups = append(groups, entry)
 	}
 
 	if len(groups) == 0 {
@@ -580,15 +854,22 @@ func (b *ReviewBuilder) getFriendsField() string {
 		parts = append(parts, fmt.Sprintf("%d ✅", c))
 	}
 
+	field := "Friends"
 	if len(parts) > 0 {
-		return "Friends (" + strings.Join(parts, ", ") + ")"
+		field += " (" + strings.Join(parts, ", ") + ")"
 	}
-	return "Friends"
+
+	// Show how many of the confirmed and flagged friends are connected
+	if b.networkCluster != nil && b.networkCluster.FriendCount > 1 {
+		field += fmt.Sprintf(" | Network: %d flagged friends, %d mutually connected",
+			b.networkCluster.FriendCount, b.networkCluster.ConnectedCount)
+	}
+	return field
 }
 
 // getGroupsField returns the groups field name for the embed.
 func (b *ReviewBuilder) getGroupsField() string {
-	if len(b.flaggedGroups) == 0 {
+	if len(b.flaggedGroups) == 0 && len(b.allowlisted) == 0 {
 		return "Groups"
 	}
 
@@ -612,6 +893,9 @@ func (b *ReviewBuilder) getGroupsField() string {
 	if c := counts[enum.GroupTypeLocked]; c > 0 {
 		parts = append(parts, fmt.Sprintf("%d 🔒", c))
 	}
+	if c := len(b.allowlisted); c > 0 {
+		parts = append(parts, fmt.Sprintf("%d 🏳️", c))
+	}
 
 	if len(parts) > 0 {
 		return "Groups (" + strings.Join(parts, ", ") + ")"
@@ -0,0 +1,96 @@
+package user
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+)
+
+// NameSearchBuilder creates the visual layout for the results of a user name search.
+type NameSearchBuilder struct {
+	settings *types.UserSetting
+	query    string
+	results  []*types.ReviewUser
+}
+
+// NewNameSearchBuilder creates a new name search builder.
+func NewNameSearchBuilder(s *session.Session) *NameSearchBuilder {
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var results []*types.ReviewUser
+	s.GetInterface(constants.SessionKeyUserNameSearchResults, &results)
+
+	return &NameSearchBuilder{
+		settings: settings,
+		query:    s.GetString(constants.SessionKeyUserNameSearchQuery),
+		results:  results,
+	}
+}
+
+// Build creates a Discord message listing the users matching the search.
+func (b *NameSearchBuilder) Build() *discord.MessageUpdateBuilder {
+	embed := discord.NewEmbedBuilder().
+		SetTitle("User Search Results").
+		SetDescription(fmt.Sprintf("Query: `%s`\nShowing the %d closest matches.",
+			utils.NormalizeString(b.query), len(b.results))).
+		SetColor(utils.GetMessageEmbedColor(b.settings.StreamerMode))
+
+	options := make([]discord.StringSelectMenuOption, 0, len(b.results))
+	for _, user := range b.results {
+		idStr := strconv.FormatUint(user.ID, 10)
+		name := utils.CensorString(user.Name, b.settings.StreamerMode)
+		displayName := utils.CensorString(user.DisplayName, b.settings.StreamerMode)
+
+		// Add a field for each result
+		fieldName := fmt.Sprintf("%s %s", getUserStatusBadge(user.Status), name)
+		fieldValue := fmt.Sprintf("Display Name: `%s`\nID: `%s` | Status: `%s` | Confidence: `%.2f`",
+			displayName,
+			utils.CensorString(idStr, b.settings.StreamerMode),
+			user.Status.String(),
+			user.Confidence,
+		)
+		embed.AddField(utils.TruncateString(fieldName, 256), fieldValue, false)
+
+		options = append(options,
+			discord.NewStringSelectMenuOption(utils.SanitizeOptionLabel(name), idStr).
+				WithEmoji(discord.ComponentEmoji{Name: getUserStatusBadge(user.Status)}).
+				WithDescription(utils.SanitizeOptionDescription(fmt.Sprintf("%s user - %s", user.Status.String(), displayName))))
+	}
+
+	components := []discord.ContainerComponent{
+		discord.NewActionRow(
+			discord.NewStringSelectMenu(constants.UserNameSearchResultSelectID, "Select a user to review", options...),
+		),
+		discord.NewActionRow(
+			discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
+			discord.NewPrimaryButton("🔍", constants.SearchUserNameButtonCustomID),
+		),
+	}
+
+	return discord.NewMessageUpdateBuilder().
+		SetEmbeds(embed.Build()).
+		AddContainerComponents(components...)
+}
+
+// getUserStatusBadge returns the emoji badge for a user status.
+func getUserStatusBadge(status enum.UserType) string {
+	switch status {
+	case enum.UserTypeConfirmed:
+		return "⚠️"
+	case enum.UserTypeFlagged:
+		return "⏳"
+	case enum.UserTypeCleared:
+		return "✅"
+	case enum.UserTypeBanned:
+		return "🔨"
+	case enum.UserTypeUnflagged:
+		return "🔄"
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+package setting
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+// ReasonPresetsBuilder creates the visual layout for managing confirm reason presets.
+type ReasonPresetsBuilder struct {
+	settings *types.UserSetting
+}
+
+// NewReasonPresetsBuilder creates a new reason presets builder.
+func NewReasonPresetsBuilder(s *session.Session) *ReasonPresetsBuilder {
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	return &ReasonPresetsBuilder{
+		settings: settings,
+	}
+}
+
+// Build creates a Discord message listing the saved presets with
+// controls for adding and deleting them.
+func (b *ReasonPresetsBuilder) Build() *discord.MessageUpdateBuilder {
+	embed := discord.NewEmbedBuilder().
+		SetTitle("Reason Presets").
+		SetDescription(fmt.Sprintf(
+			"Saved confirm reasons available in the review menus (%d/%d).\n"+
+				"Templates support the `{group}` and `{confidence}` placeholders.",
+			len(b.settings.ReasonPresets), constants.MaxReasonPresets,
+		)).
+		SetColor(constants.DefaultEmbedColor)
+
+	// Add fields for each preset
+	for _, preset := range b.settings.ReasonPresets {
+		embed.AddField(preset.Name, utils.FormatString(preset.Template), false)
+	}
+
+	if len(b.settings.ReasonPresets) == 0 {
+		embed.AddField("No presets", "Use the button below to add your first preset.", false)
+	}
+
+	return discord.NewMessageUpdateBuilder().
+		SetEmbeds(embed.Build()).
+		AddContainerComponents(b.buildComponents()...)
+}
+
+// buildComponents creates the interactive components for the presets menu.
+func (b *ReasonPresetsBuilder) buildComponents() []discord.ContainerComponent {
+	var components []discord.ContainerComponent
+
+	// Add delete menu if there are presets
+	if len(b.settings.ReasonPresets) > 0 {
+		options := make([]discord.StringSelectMenuOption, 0, len(b.settings.ReasonPresets))
+		for i, preset := range b.settings.ReasonPresets {
+			options = append(options, discord.NewStringSelectMenuOption(utils.SanitizeOptionLabel(preset.Name), strconv.Itoa(i)).
+				WithDescription(utils.SanitizeOptionDescription(preset.Template)))
+		}
+
+		components = append(components, discord.NewActionRow(
+			discord.NewStringSelectMenu(constants.DeleteReasonPresetSelectID, "Select a preset to delete", options...),
+		))
+	}
+
+	// Add navigation buttons
+	addButton := discord.NewPrimaryButton("Add Preset", constants.AddReasonPresetButtonID)
+	if len(b.settings.ReasonPresets) >= constants.MaxReasonPresets {
+		addButton = addButton.AsDisabled()
+	}
+
+	components = append(components, discord.NewActionRow(
+		discord.NewSecondaryButton("◀️", constants.BackButtonCustomID),
+		addButton,
+	))
+
+	return components
+}
@@ -24,6 +24,7 @@ var (
 	ErrAnnouncementTooLong   = errors.New("announcement message cannot exceed 512 characters")
 	ErrDescriptionTooLong    = errors.New("description cannot exceed 512 characters")
 	ErrNotReviewer           = errors.New("you are not an official reviewer")
+	ErrInvalidConfidence     = errors.New("confidence must be between 0 and 1")
 )
 
 // Validator is a function that validates setting input.
@@ -101,6 +102,18 @@ func validateNumber(value string, _ uint64) error {
 	return nil
 }
 
+// validateConfidence checks if a string is a valid confidence value between 0 and 1.
+func validateConfidence(value string, _ uint64) error {
+	confidence, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("value must be a valid number: %w", err)
+	}
+	if confidence < 0 || confidence > 1 {
+		return ErrInvalidConfidence
+	}
+	return nil
+}
+
 // NewRegistry creates and initializes the setting registry.
 func NewRegistry() *Registry {
 	r := &Registry{
@@ -119,13 +132,21 @@ func (r *Registry) registerUserSettings() {
 	r.UserSettings[constants.StreamerModeOption] = r.createStreamerModeSetting()
 	r.UserSettings[constants.ReviewModeOption] = r.createReviewModeSetting()
 	r.UserSettings[constants.ReviewTargetModeOption] = r.createReviewTargetModeSetting()
+	r.UserSettings[constants.ReviewConfidenceThresholdOption] = r.createReviewConfidenceThresholdSetting()
+	r.UserSettings[constants.LeaderboardAnonymousOption] = r.createLeaderboardAnonymousSetting()
 }
 
 // registerBotSettings adds all bot-wide settings to the registry.
 func (r *Registry) registerBotSettings() {
 	r.BotSettings[constants.ReviewerIDsOption] = r.createReviewerIDsSetting()
 	r.BotSettings[constants.AdminIDsOption] = r.createAdminIDsSetting()
+	r.BotSettings[constants.AllowedGuildIDsOption] = r.createAllowedGuildIDsSetting()
+	r.BotSettings[constants.AllowDMsOption] = r.createAllowDMsSetting()
 	r.BotSettings[constants.SessionLimitOption] = r.createSessionLimitSetting()
+	r.BotSettings[constants.AppealStaleDaysOption] = r.createAppealStaleDaysSetting()
+	r.BotSettings[constants.AppealCooldownDaysOption] = r.createAppealCooldownDaysSetting()
+	r.BotSettings[constants.NotificationChannelOption] = r.createNotificationChannelSetting()
+	r.BotSettings[constants.MaxPinnedUsersOption] = r.createMaxPinnedUsersSetting()
 	r.BotSettings[constants.WelcomeMessageOption] = r.createWelcomeMessageSetting()
 	r.BotSettings[constants.AnnouncementTypeOption] = r.createAnnouncementTypeSetting()
 	r.BotSettings[constants.AnnouncementMessageOption] = r.createAnnouncementMessageSetting()
@@ -223,6 +244,98 @@ func (r *Registry) createSessionLimitSetting() Setting {
 	}
 }
 
+// createAppealStaleDaysSetting creates the appeal stale days setting.
+func (r *Registry) createAppealStaleDaysSetting() Setting {
+	return Setting{
+		Key:          constants.AppealStaleDaysOption,
+		Name:         "Appeal Stale Days",
+		Description:  "Days without a reply before claimed appeals are released (0 to disable)",
+		Type:         enum.SettingTypeNumber,
+		DefaultValue: uint64(7),
+		Validators:   []Validator{validateNumber},
+		ValueGetter: func(_ *types.UserSetting, bs *types.BotSetting) string {
+			return strconv.FormatUint(bs.AppealStaleDays, 10)
+		},
+		ValueUpdater: func(value string, _ *types.UserSetting, bs *types.BotSetting, _ *session.Session) error {
+			days, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			bs.AppealStaleDays = days
+			return nil
+		},
+	}
+}
+
+// createAppealCooldownDaysSetting creates the appeal cooldown days setting.
+func (r *Registry) createAppealCooldownDaysSetting() Setting {
+	return Setting{
+		Key:          constants.AppealCooldownDaysOption,
+		Name:         "Appeal Cooldown Days",
+		Description:  "Days after a rejected appeal before the same user ID can be appealed again (0 to disable)",
+		Type:         enum.SettingTypeNumber,
+		DefaultValue: uint64(7),
+		Validators:   []Validator{validateNumber},
+		ValueGetter: func(_ *types.UserSetting, bs *types.BotSetting) string {
+			return strconv.FormatUint(bs.AppealCooldownDays, 10)
+		},
+		ValueUpdater: func(value string, _ *types.UserSetting, bs *types.BotSetting, _ *session.Session) error {
+			days, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			bs.AppealCooldownDays = days
+			return nil
+		},
+	}
+}
+
+// createNotificationChannelSetting creates the notification channel setting.
+func (r *Registry) createNotificationChannelSetting() Setting {
+	return Setting{
+		Key:          constants.NotificationChannelOption,
+		Name:         "Notification Channel",
+		Description:  "Channel ID for the weekly cleared user expiry digest (0 to disable)",
+		Type:         enum.SettingTypeNumber,
+		DefaultValue: uint64(0),
+		Validators:   []Validator{validateNumber},
+		ValueGetter: func(_ *types.UserSetting, bs *types.BotSetting) string {
+			return strconv.FormatUint(bs.NotificationChannelID, 10)
+		},
+		ValueUpdater: func(value string, _ *types.UserSetting, bs *types.BotSetting, _ *session.Session) error {
+			channelID, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			bs.NotificationChannelID = channelID
+			return nil
+		},
+	}
+}
+
+// createMaxPinnedUsersSetting creates the max pinned users setting.
+func (r *Registry) createMaxPinnedUsersSetting() Setting {
+	return Setting{
+		Key:          constants.MaxPinnedUsersOption,
+		Name:         "Max Pinned Users",
+		Description:  "Maximum number of cleared users that can be pinned to skip the purge",
+		Type:         enum.SettingTypeNumber,
+		DefaultValue: uint64(25),
+		Validators:   []Validator{validateNumber},
+		ValueGetter: func(_ *types.UserSetting, bs *types.BotSetting) string {
+			return strconv.FormatUint(bs.MaxPinnedUsers, 10)
+		},
+		ValueUpdater: func(value string, _ *types.UserSetting, bs *types.BotSetting, _ *session.Session) error {
+			limit, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			bs.MaxPinnedUsers = limit
+			return nil
+		},
+	}
+}
+
 // createReviewerIDsSetting creates the reviewer IDs setting.
 func (r *Registry) createReviewerIDsSetting() Setting {
 	return Setting{
@@ -305,6 +418,67 @@ func (r *Registry) createAdminIDsSetting() Setting {
 	}
 }
 
+// createAllowedGuildIDsSetting creates the allowed guild IDs setting.
+func (r *Registry) createAllowedGuildIDsSetting() Setting {
+	return Setting{
+		Key:          constants.AllowedGuildIDsOption,
+		Name:         "Allowed Server IDs",
+		Description:  "Set which servers can use the bot",
+		Type:         enum.SettingTypeID,
+		DefaultValue: []uint64{},
+		Validators:   []Validator{validateDiscordID},
+		ValueGetter: func(_ *types.UserSetting, bs *types.BotSetting) string {
+			if len(bs.AllowedGuildIDs) == 0 {
+				return "All servers allowed"
+			}
+			// Show only first 10 IDs
+			displayIDs := utils.FormatIDs(bs.AllowedGuildIDs)
+			if len(bs.AllowedGuildIDs) > 10 {
+				displayIDs += fmt.Sprintf("\n...and %d more", len(bs.AllowedGuildIDs)-10)
+			}
+			return displayIDs
+		},
+		ValueUpdater: func(value string, _ *types.UserSetting, bs *types.BotSetting, _ *session.Session) error {
+			id, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			exists := false
+			for i, guildID := range bs.AllowedGuildIDs {
+				if guildID == id {
+					bs.AllowedGuildIDs = append(bs.AllowedGuildIDs[:i], bs.AllowedGuildIDs[i+1:]...)
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				bs.AllowedGuildIDs = append(bs.AllowedGuildIDs, id)
+			}
+			return nil
+		},
+	}
+}
+
+// createAllowDMsSetting creates the allow direct messages setting.
+func (r *Registry) createAllowDMsSetting() Setting {
+	return Setting{
+		Key:          constants.AllowDMsOption,
+		Name:         "Allow Direct Messages",
+		Description:  "Toggle whether the bot can be used in direct messages",
+		Type:         enum.SettingTypeBool,
+		DefaultValue: true,
+		Validators:   []Validator{validateBool},
+		ValueGetter: func(_ *types.UserSetting, bs *types.BotSetting) string {
+			return strconv.FormatBool(bs.AllowDMs)
+		},
+		ValueUpdater: func(value string, _ *types.UserSetting, bs *types.BotSetting, _ *session.Session) error {
+			boolVal, _ := strconv.ParseBool(value)
+			bs.AllowDMs = boolVal
+			return nil
+		},
+	}
+}
+
 // createReviewTargetModeSetting creates the review target mode setting.
 func (r *Registry) createReviewTargetModeSetting() Setting {
 	return Setting{
@@ -361,6 +535,49 @@ func (r *Registry) createReviewTargetModeSetting() Setting {
 	}
 }
 
+// createReviewConfidenceThresholdSetting creates the review confidence threshold setting.
+func (r *Registry) createReviewConfidenceThresholdSetting() Setting {
+	return Setting{
+		Key:          constants.ReviewConfidenceThresholdOption,
+		Name:         "Review Confidence Threshold",
+		Description:  "Only review users with at least this confidence (0 to disable)",
+		Type:         enum.SettingTypeNumber,
+		DefaultValue: float64(0),
+		Validators:   []Validator{validateConfidence},
+		ValueGetter: func(us *types.UserSetting, _ *types.BotSetting) string {
+			return strconv.FormatFloat(us.ReviewConfidenceThreshold, 'f', 2, 64)
+		},
+		ValueUpdater: func(value string, us *types.UserSetting, _ *types.BotSetting, _ *session.Session) error {
+			threshold, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return err
+			}
+			us.ReviewConfidenceThreshold = threshold
+			return nil
+		},
+	}
+}
+
+// createLeaderboardAnonymousSetting creates the leaderboard anonymity setting.
+func (r *Registry) createLeaderboardAnonymousSetting() Setting {
+	return Setting{
+		Key:          constants.LeaderboardAnonymousOption,
+		Name:         "Anonymous on Leaderboard",
+		Description:  "Hide your name on the voting leaderboard",
+		Type:         enum.SettingTypeBool,
+		DefaultValue: false,
+		Validators:   []Validator{validateBool},
+		ValueGetter: func(us *types.UserSetting, _ *types.BotSetting) string {
+			return strconv.FormatBool(us.LeaderboardAnonymous)
+		},
+		ValueUpdater: func(value string, us *types.UserSetting, _ *types.BotSetting, _ *session.Session) error {
+			boolVal, _ := strconv.ParseBool(value)
+			us.LeaderboardAnonymous = boolVal
+			return nil
+		},
+	}
+}
+
 // createWelcomeMessageSetting creates the welcome message setting.
 func (r *Registry) createWelcomeMessageSetting() Setting {
 	return Setting{
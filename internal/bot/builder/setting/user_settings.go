@@ -1,6 +1,7 @@
 package setting
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/disgoorg/disgo/discord"
@@ -53,6 +54,15 @@ func (b *UserSettingsBuilder) Build() *discord.MessageUpdateBuilder {
 		options = append(options, option)
 	}
 
+	// Add reason presets option for reviewers
+	isReviewer := b.botSettings.IsReviewer(uint64(b.settings.UserID))
+	if isReviewer {
+		options = append(options, discord.NewStringSelectMenuOption(
+			"Manage Reason Presets",
+			constants.ReasonPresetsOption,
+		).WithDescription("Save reusable reasons for confirming with reason"))
+	}
+
 	// Create embed with current settings values
 	embed := discord.NewEmbedBuilder().
 		SetTitle("User Settings")
@@ -64,6 +74,11 @@ func (b *UserSettingsBuilder) Build() *discord.MessageUpdateBuilder {
 		embed.AddField(setting.Name, value, true)
 	}
 
+	if isReviewer {
+		embed.AddField("Reason Presets",
+			fmt.Sprintf("%d/%d saved", len(b.settings.ReasonPresets), constants.MaxReasonPresets), true)
+	}
+
 	embed.SetColor(constants.DefaultEmbedColor)
 
 	// Add interactive components for changing settings
@@ -66,8 +66,8 @@ func (b *Builder) buildWorkerStatusEmbed() discord.Embed {
 			for _, w := range workers {
 				shortID := w.WorkerID[:8]
 				emoji := b.getStatusEmoji(w)
-				statusLines = append(statusLines, fmt.Sprintf("%s `%s` %s (%d%%)",
-					emoji, shortID, w.CurrentTask, w.Progress))
+				statusLines = append(statusLines, fmt.Sprintf("%s `%s` %s (%d%%, %d processed)",
+					emoji, shortID, w.CurrentTask, w.Progress, w.Processed))
 			}
 
 			// Add field for this worker type
@@ -4,7 +4,11 @@ import "time"
 
 // Commands.
 const (
-	DashboardCommandName = "dashboard"
+	DashboardCommandName  = "dashboard"
+	ReviewCommandName     = "review"
+	ReviewUserSubcommand  = "user"
+	ReviewGroupSubcommand = "group"
+	ReviewIDOptionName    = "id"
 )
 
 // Common.
@@ -17,6 +21,25 @@ const (
 	DefaultEmbedColor        = 0x312D2B
 	ErrorEmbedColor          = 0xE74C3C
 	StreamerModeEmbedColor   = 0x3E3769
+	MaintenanceEmbedColor    = 0xF1C40F
+)
+
+// Read-only Mode.
+const (
+	ReadOnlyMessage = "Maintenance in progress. Changes are disabled until maintenance is complete."
+	ReadOnlyBanner  = "🚧 **Maintenance in progress.** The bot is in read-only mode, so you can browse but not make changes."
+)
+
+// TargetRemovedMessage is shown when a session is resumed on a review target that
+// can no longer be reviewed.
+const TargetRemovedMessage = "The item you were reviewing is no longer available."
+
+// Component limits enforced by Discord.
+const (
+	MaxSelectOptionLabelLength       = 100
+	MaxSelectOptionDescriptionLength = 100
+	MaxButtonLabelLength             = 80
+	EmptyComponentLabel              = "Unnamed"
 )
 
 // Dashboard Menu.
@@ -33,11 +56,32 @@ const (
 	WorkerStatusButtonCustomID     = "worker_status"
 	LookupUserButtonCustomID       = "lookup_user" + ModalOpenSuffix
 	LookupGroupButtonCustomID      = "lookup_group" + ModalOpenSuffix
+	SearchGroupButtonCustomID      = "search_group" + ModalOpenSuffix
 
 	LookupUserModalCustomID  = "lookup_user_modal"
 	LookupUserInputCustomID  = "lookup_user_input"
 	LookupGroupModalCustomID = "lookup_group_modal"
 	LookupGroupInputCustomID = "lookup_group_input"
+	SearchGroupModalCustomID = "search_group_modal"
+	SearchGroupInputCustomID = "search_group_input"
+
+	// DashboardWorkerStaleThreshold is how long since its last heartbeat before the
+	// dashboard shows a worker as stale.
+	DashboardWorkerStaleThreshold = 2 * time.Minute
+)
+
+// User Not Found Menu.
+const (
+	QueueLookupUserButtonCustomID = "queue_lookup_user"
+	LookupQueueReason             = "Requested from the review command"
+)
+
+// Group Search Menu.
+const (
+	GroupSearchPerPage           = 10
+	GroupSearchResultSelectID    = "group_search_result"
+	GroupSearchStatusSelectID    = "group_search_status"
+	GroupSearchAllStatusesOption = "all"
 )
 
 // Common Review Menu.
@@ -51,6 +95,22 @@ const (
 	ConfirmReasonInputCustomID     = "confirm_reason"
 	RecheckReasonModalCustomID     = "recheck_reason_modal"
 	RecheckReasonInputCustomID     = "recheck_reason"
+	SkipNoteModalCustomID          = "skip_note_modal"
+	SkipNoteInputCustomID          = "skip_note"
+	ReflagReasonModalCustomID      = "reflag_reason_modal"
+	ReflagReasonInputCustomID      = "reflag_reason"
+	AddNoteModalCustomID           = "add_note_modal"
+	NoteInputCustomID              = "note"
+	DeleteNoteModalCustomID        = "delete_note_modal"
+	NoteIDInputCustomID            = "note_id"
+	SecondOpinionModalCustomID     = "second_opinion_modal"
+	SecondOpinionNoteInputCustomID = "second_opinion_note"
+	ReasonPresetSelectMenuCustomID = "reason_preset"
+
+	AddNoteButtonCustomID    = "add_note" + ModalOpenSuffix
+	DeleteNoteButtonCustomID = "delete_note" + ModalOpenSuffix
+
+	RequestSecondOpinionButtonCustomID = "request_second_opinion" + ModalOpenSuffix
 
 	ConfirmButtonCustomID = "confirm"
 	ClearButtonCustomID   = "clear"
@@ -66,7 +126,25 @@ const (
 	OpenOutfitsMenuButtonCustomID   = "open_outfits_menu"
 	OpenFriendsMenuButtonCustomID   = "open_friends_menu"
 	OpenGroupsMenuButtonCustomID    = "open_groups_menu"
+	PinClearedUserButtonCustomID    = "pin_cleared_user"
+	RefreshCountsButtonCustomID     = "refresh_counts"
+	ReflagUserButtonCustomID        = "reflag_user" + ModalOpenSuffix
+	SkipWithNoteButtonCustomID      = "skip_with_note" + ModalOpenSuffix
+	SearchUserNameButtonCustomID    = "search_user_name" + ModalOpenSuffix
 	AbortButtonCustomID             = "abort"
+
+	SearchUserNameModalCustomID  = "search_user_name_modal"
+	SearchUserNameInputCustomID  = "search_user_name_input"
+	UserNameSearchResultSelectID = "user_name_search_result"
+
+	// UserNameSearchLimit is the maximum number of matches listed when searching users by name.
+	UserNameSearchLimit = 25
+
+	// MaxSkipNoteLength caps the length of the note a reviewer can leave when skipping.
+	MaxSkipNoteLength = 200
+
+	DecisiveContentSelectMenuCustomID = "decisive_content"
+	MaxDecisiveContentOptions         = 25
 )
 
 // User Review Menu - Friends Viewer.
@@ -96,6 +174,23 @@ const (
 	GroupRecheckButtonCustomID           = "group_recheck" + ModalOpenSuffix
 	GroupViewMembersButtonCustomID       = "group_view_members"
 	GroupViewLogsButtonCustomID          = "group_view_logs"
+	GroupQueueMembersButtonCustomID      = "group_queue_members"
+	GroupReviewOwnerButtonCustomID       = "group_review_owner"
+	GroupQueueOwnerButtonCustomID        = "group_queue_owner"
+	GroupFlagOwnerGroupsButtonCustomID   = "group_flag_owner_groups"
+
+	// MaxGroupMembersQueued caps how many tracked members of a confirmed
+	// group can be queued for recheck at once.
+	MaxGroupMembersQueued = 500
+
+	// MaxOwnerGroupsShown caps how many other groups of the owner are listed on the review page.
+	MaxOwnerGroupsShown = 5
+	// MaxOwnerGroupsFlagged caps how many other groups of a confirmed group's owner
+	// can be flagged for scanning at once.
+	MaxOwnerGroupsFlagged = 50
+	// OwnerGroupConfidence is the confidence given to groups flagged for sharing
+	// an owner with a confirmed group.
+	OwnerGroupConfidence = 0.5
 )
 
 // Group Review Menu - Members Viewer.
@@ -103,6 +198,10 @@ const (
 	MembersPerPage     = 12
 	MembersGridColumns = 3
 	MembersGridRows    = 4
+
+	MembersSelectMenuCustomID    = "members_select"
+	MembersConfirmButtonCustomID = "members_confirm"
+	MembersClearButtonCustomID   = "members_clear"
 )
 
 // Chat Menu.
@@ -132,6 +231,22 @@ const (
 	ChatModelOption          = "chat_model"
 	ReviewModeOption         = "review_mode"
 	ReviewTargetModeOption   = "review_target_mode"
+	ReasonPresetsOption      = "reason_presets"
+
+	ReviewConfidenceThresholdOption = "review_confidence_threshold"
+	LeaderboardAnonymousOption      = "leaderboard_anonymous"
+)
+
+// Reason Presets Menu.
+const (
+	MaxReasonPresets            = 10
+	MaxReasonPresetNameLength   = 50
+	MaxReasonPresetLength       = 512
+	AddReasonPresetButtonID     = "add_reason_preset" + ModalOpenSuffix
+	DeleteReasonPresetSelectID  = "delete_reason_preset"
+	ReasonPresetModalCustomID   = "reason_preset_modal"
+	ReasonPresetNameInputID     = "reason_preset_name"
+	ReasonPresetTemplateInputID = "reason_preset_template"
 )
 
 // Bot Settings.
@@ -140,7 +255,13 @@ const (
 	BotSettingSelectID        = "bot_setting_select"
 	ReviewerIDsOption         = "reviewer_ids"
 	AdminIDsOption            = "admin_ids"
+	AllowedGuildIDsOption     = "allowed_guild_ids"
+	AllowDMsOption            = "allow_dms"
 	SessionLimitOption        = "session_limit"
+	AppealStaleDaysOption     = "appeal_stale_days"
+	AppealCooldownDaysOption  = "appeal_cooldown_days"
+	NotificationChannelOption = "notification_channel"
+	MaxPinnedUsersOption      = "max_pinned_users"
 	WelcomeMessageOption      = "welcome_message"
 	AnnouncementTypeOption    = "announcement_type"
 	AnnouncementMessageOption = "announcement_message"
@@ -157,7 +278,27 @@ const (
 	LogsQueryReviewerIDOption           = "query_reviewer_id" + ModalOpenSuffix
 	LogsQueryDateRangeOption            = "query_date_range" + ModalOpenSuffix
 	LogsQueryActivityTypeFilterCustomID = "activity_type_filter"
+	LogsReviewerSummaryOption           = "reviewer_summary" + ModalOpenSuffix
+	LogsSummaryReviewerInputCustomID    = "summary_reviewer_input"
+	LogsSummaryDateInputCustomID        = "summary_date_input"
 	ClearFiltersButtonCustomID          = "clear_filters"
+	LogsExportSelectMenuCustomID        = "logs_export"
+	LogsExportCSVOption                 = "export_csv"
+	LogsExportJSONLinesOption           = "export_jsonl"
+
+	// LogsExportPageSize is how many logs are fetched at a time while exporting.
+	LogsExportPageSize = 1000
+	// LogsExportMaxRows caps the number of logs in a single export.
+	LogsExportMaxRows = 50000
+	// LogsExportMaxBytes is the largest log export attached to a message, which is
+	// Discord's attachment size limit.
+	LogsExportMaxBytes = 8 * 1024 * 1024
+
+	// MaxLogDetailValueLength caps each rendered detail value so long reasons stay readable.
+	MaxLogDetailValueLength = 120
+	// MaxLogDetailsLength caps the rendered details of a single log entry so a full
+	// page of entries stays within Discord's embed size limit.
+	MaxLogDetailsLength = 350
 )
 
 // Queue Menu.
@@ -175,15 +316,22 @@ const (
 	AppealModalCustomID       = "appeal_modal"
 	AppealUserInputCustomID   = "appeal_user_input"
 	AppealReasonInputCustomID = "appeal_reason_input"
+	AppealImageInputCustomID  = "appeal_image_input"
 
 	AppealLookupUserButtonCustomID = "appeal_lookup_user"
 	AcceptAppealButtonCustomID     = "accept_appeal" + ModalOpenSuffix
+	ReturnAppealButtonCustomID     = "return_appeal" + ModalOpenSuffix
 	RejectAppealButtonCustomID     = "reject_appeal" + ModalOpenSuffix
 	AppealCloseButtonCustomID      = "appeal_close"
+	AppealExportButtonCustomID     = "appeal_export"
+	AppealClaimButtonCustomID      = "appeal_claim"
+	AppealUnclaimButtonCustomID    = "appeal_unclaim"
 
 	AcceptAppealModalCustomID  = "accept_appeal_modal"
+	ReturnAppealModalCustomID  = "return_appeal_modal"
 	RejectAppealModalCustomID  = "reject_appeal_modal"
 	AppealRespondModalCustomID = "appeal_respond_modal"
+	AppealAttachModalCustomID  = "appeal_attach_modal"
 
 	AppealsPerPage              = 5
 	AppealMessagesPerPage       = 5
@@ -192,6 +340,22 @@ const (
 	AppealSortSelectID          = "appeal_sort"
 	AppealCreateButtonCustomID  = "appeal_create" + ModalOpenSuffix
 	AppealRespondButtonCustomID = "appeal_respond" + ModalOpenSuffix
+	AppealAttachButtonCustomID  = "appeal_attach" + ModalOpenSuffix
+
+	// AppealStaleWarningWindow is how long before a stale claim is released
+	// that reviewers are warned about it.
+	AppealStaleWarningWindow = 24 * time.Hour
+
+	// AppealAttachmentUploadLimit is the most image data uploaded with a single ticket
+	// page, keeping the page below Discord's upload limit for servers without boosts.
+	AppealAttachmentUploadLimit = 8 * 1024 * 1024
+
+	// AppealExportLogLimit is the maximum number of activity logs included in a record export.
+	AppealExportLogLimit = 500
+
+	// AppealDuplicateWindow is how long a rejected appeal still counts as a
+	// duplicate of a new appeal from another requester for the same user.
+	AppealDuplicateWindow = 30 * 24 * time.Hour
 
 	VerifyDescriptionButtonID = "verify_description"
 )
@@ -212,11 +376,18 @@ const (
 	BanUserButtonCustomID     = "ban_user" + ModalOpenSuffix
 	UnbanUserButtonCustomID   = "unban_user" + ModalOpenSuffix
 	DeleteUserButtonCustomID  = "delete_user" + ModalOpenSuffix
+	RestoreUserButtonCustomID = "restore_user" + ModalOpenSuffix
 	DeleteGroupButtonCustomID = "delete_group" + ModalOpenSuffix
+	ProtectedButtonCustomID   = "protected_accounts"
+	AllowlistButtonCustomID   = "group_allowlist"
+	ToggleReadOnlyCustomID    = "toggle_read_only"
+	TrendsButtonCustomID      = "trending_terms"
+	RefreshViewsCustomID      = "refresh_views"
 
 	BanUserModalCustomID     = "ban_user_modal"
 	UnbanUserModalCustomID   = "unban_user_modal"
 	DeleteUserModalCustomID  = "delete_user_modal"
+	RestoreUserModalCustomID = "restore_user_modal"
 	DeleteGroupModalCustomID = "delete_group_modal"
 
 	BanUserInputCustomID     = "ban_user_input"
@@ -224,6 +395,7 @@ const (
 	BanDurationInputCustomID = "ban_duration_input"
 	UnbanUserInputCustomID   = "unban_user_input"
 	DeleteUserInputCustomID  = "delete_user_input"
+	RestoreUserInputCustomID = "restore_user_input"
 	DeleteGroupInputCustomID = "delete_group_input"
 	AdminReasonInputCustomID = "admin_reason_input"
 
@@ -232,25 +404,55 @@ const (
 	BanUserAction     = "ban_user"
 	UnbanUserAction   = "unban_user"
 	DeleteUserAction  = "delete_user"
+	RestoreUserAction = "restore_user"
 	DeleteGroupAction = "delete_group"
+
+	MaxProtectedAccountsShown     = 25
+	AddProtectedButtonCustomID    = "add_protected" + ModalOpenSuffix
+	RemoveProtectedButtonCustomID = "remove_protected" + ModalOpenSuffix
+	AddProtectedModalCustomID     = "add_protected_modal"
+	RemoveProtectedModalCustomID  = "remove_protected_modal"
+	ProtectedUserInputCustomID    = "protected_user_input"
+	ProtectedNoteInputCustomID    = "protected_note_input"
+
+	MaxAllowlistedGroupsShown     = 25
+	AddAllowlistButtonCustomID    = "add_allowlist" + ModalOpenSuffix
+	RemoveAllowlistButtonCustomID = "remove_allowlist" + ModalOpenSuffix
+	AddAllowlistModalCustomID     = "add_allowlist_modal"
+	RemoveAllowlistModalCustomID  = "remove_allowlist_modal"
+	AllowlistGroupInputCustomID   = "allowlist_group_input"
+	AllowlistReasonInputCustomID  = "allowlist_reason_input"
+
+	TrendingTermsPerPage = 10
 )
 
 // Leaderboard Menu
 const (
 	LeaderboardEntriesPerPage           = 10
 	LeaderboardPeriodSelectMenuCustomID = "leaderboard_period"
+	ReviewTimesButtonCustomID           = "review_times"
+	ExportReviewerStatsButtonCustomID   = "export_reviewer_stats"
+
+	// LeaderboardUsernameCacheTTL is how long resolved Discord usernames are
+	// reused before they are fetched again.
+	LeaderboardUsernameCacheTTL = 15 * time.Minute
+
+	// ReviewerStatsExportMaxBytes is the largest reviewer stats CSV attached to a
+	// message, which is Discord's attachment size limit.
+	ReviewerStatsExportMaxBytes = 8 * 1024 * 1024
 )
 
 // Session keys.
 const (
 	SessionKeyMessageID     = "messageID"
 	SessionKeyCurrentPage   = "currentPage"
-	SessionKeyPreviousPages = "previousPages"
+	SessionKeyPreviousPages = "breadcrumbs"
 	SessionKeyImageBuffer   = "imageBuffer"
 
-	SessionKeyIsRefreshed = "isRefreshed"
-	SessionKeyUserCounts  = "userCounts"
-	SessionKeyGroupCounts = "groupCounts"
+	SessionKeyIsRefreshed  = "isRefreshed"
+	SessionKeyUserCounts   = "userCounts"
+	SessionKeyGroupCounts  = "groupCounts"
+	SessionKeyReasonCounts = "reasonCounts"
 
 	SessionKeyPaginationPage = "paginationPage"
 	SessionKeyStart          = "start"
@@ -266,24 +468,32 @@ const (
 	SessionKeyActiveUsers    = "activeUsers"
 	SessionKeyWorkerStatuses = "workerStatuses"
 	SessionKeyVoteStats      = "voteStats"
-
-	SessionKeySettingName  = "settingName"
-	SessionKeySettingType  = "settingType"
-	SessionKeySetting      = "setting"
-	SessionKeyUserSettings = "userSettings"
-	SessionKeyBotSettings  = "botSettings"
-	SessionKeyCurrentValue = "currentValue"
-	SessionKeyCustomID     = "customID"
-	SessionKeyOptions      = "options"
-	SessionKeyRoles        = "roles"
+	SessionKeyAICostToday    = "aiCostToday"
+	SessionKeyHeldUsers      = "heldUsers"
+	SessionKeyHeldGroups     = "heldGroups"
+
+	SessionKeySettingName   = "settingName"
+	SessionKeySettingType   = "settingType"
+	SessionKeySetting       = "setting"
+	SessionKeyUserSettings  = "userSettings"
+	SessionKeyBotSettings   = "botSettings"
+	SessionKeyReadOnly      = "readOnly"
+	SessionKeyTrendingTerms = "trendingTerms"
+	SessionKeyCurrentValue  = "currentValue"
+	SessionKeyCustomID      = "customID"
+	SessionKeyOptions       = "options"
+	SessionKeyRoles         = "roles"
 
 	SessionKeyFriends        = "friends"
 	SessionKeyPresences      = "presences"
 	SessionKeyFlaggedFriends = "flaggedFriends"
+	SessionKeyNetworkCluster = "networkCluster"
 
 	SessionKeyGroups        = "groups"
 	SessionKeyFlaggedGroups = "flaggedGroups"
 
+	SessionKeyAllowlistedGroupIDs = "allowlistedGroupIDs"
+
 	SessionKeyOutfits = "outfits"
 
 	SessionKeyChatHistory = "chatHistory"
@@ -301,28 +511,67 @@ const (
 	SessionKeyDateRangeStartFilter = "dateRangeStartFilter"
 	SessionKeyDateRangeEndFilter   = "dateRangeEndFilter"
 
+	SessionKeyReviewerSummary         = "reviewerSummary"
+	SessionKeyReviewerSummaryReviewer = "reviewerSummaryReviewer"
+	SessionKeyReviewerSummaryDate     = "reviewerSummaryDate"
+
 	SessionKeyQueueUser        = "queueUser"
+	SessionKeyLookupUserID     = "lookupUserID"
 	SessionKeyQueueStatus      = "queueStatus"
 	SessionKeyQueuePriority    = "queuePriority"
 	SessionKeyQueuePosition    = "queuePosition"
 	SessionKeyQueueHighCount   = "queueHighCount"
 	SessionKeyQueueNormalCount = "queueNormalCount"
 	SessionKeyQueueLowCount    = "queueLowCount"
-
-	SessionKeyTarget = "target"
-
-	SessionKeyGroupTarget      = "groupTarget"
-	SessionKeyGroupMemberIDs   = "groupMemberIDs"
-	SessionKeyGroupMembers     = "groupMembers"
-	SessionKeyGroupPageMembers = "groupPageMembers"
-	SessionKeyGroupInfo        = "groupInfo"
+	SessionKeyQueueStats       = "queueStats"
+
+	SessionKeyTarget           = "target"
+	SessionKeyDecisionTally    = "decisionTally"
+	SessionKeyReviewQueue      = "reviewQueue"
+	SessionKeyThresholdRelaxed = "thresholdRelaxed"
+	SessionKeyUserReviewLock   = "userReviewLock"
+
+	SessionKeyTranslatedDescription = "translatedDescription"
+	SessionKeyTranslatedGroupDesc   = "translatedGroupDescription"
+	SessionKeyTranslatedGroupShout  = "translatedGroupShout"
+
+	SessionKeyGroupTarget          = "groupTarget"
+	SessionKeyGroupReviewLock      = "groupReviewLock"
+	SessionKeyGroupMemberIDs       = "groupMemberIDs"
+	SessionKeyGroupMembers         = "groupMembers"
+	SessionKeyGroupPageMembers     = "groupPageMembers"
+	SessionKeyGroupMemberPresences = "groupMemberPresences"
+	SessionKeyGroupSelectedMembers = "groupSelectedMembers"
+	SessionKeyGroupInfo            = "groupInfo"
+	SessionKeyGroupMemberCounts    = "groupMemberCounts"
+
+	SessionKeyConfirmedGroupID          = "confirmedGroupID"
+	SessionKeyConfirmedGroupMemberCount = "confirmedGroupMemberCount"
+	SessionKeyOwnerQueueOfferID         = "ownerQueueOfferID"
+	SessionKeyOwnerQueueOfferName       = "ownerQueueOfferName"
+	SessionKeyOwnerGroupsOwnerID        = "ownerGroupsOwnerID"
+	SessionKeyOwnerGroups               = "ownerGroups"
+	SessionKeyOwnerGroupsOfferSource    = "ownerGroupsOfferSource"
+	SessionKeyOwnerGroupsOffer          = "ownerGroupsOffer"
+
+	SessionKeyGroupSearchQuery       = "groupSearchQuery"
+	SessionKeyGroupSearchStatuses    = "groupSearchStatuses"
+	SessionKeyGroupSearchResults     = "groupSearchResults"
+	SessionKeyGroupSearchCursor      = "groupSearchCursor"
+	SessionKeyGroupSearchNextCursor  = "groupSearchNextCursor"
+	SessionKeyGroupSearchPrevCursors = "groupSearchPrevCursors"
+
+	SessionKeyUserNameSearchQuery   = "userNameSearchQuery"
+	SessionKeyUserNameSearchResults = "userNameSearchResults"
 
 	SessionKeyAppeal            = "appeal"
 	SessionKeyAppeals           = "appeals"
 	SessionKeyAppealMessages    = "appealMessages"
+	SessionKeyAppealThumbnail   = "appealThumbnail"
 	SessionKeyAppealCursor      = "appealCursor"
 	SessionKeyAppealNextCursor  = "appealNextCursor"
 	SessionKeyAppealPrevCursors = "appealPrevCursors"
+	SessionKeyAppealExport      = "appealExport"
 
 	SessionKeyVerifyUserID = "verifyUserID"
 	SessionKeyVerifyReason = "verifyReason"
@@ -339,19 +588,48 @@ const (
 	SessionKeyBanExpiry = "banExpiry"
 	SessionKeyBanInfo   = "banInfo"
 
+	SessionKeyProtectedAccounts = "protectedAccounts"
+	SessionKeyAllowlistedGroups = "allowlistedGroups"
+
 	SessionKeyLeaderboardStats       = "leaderboardStats"
 	SessionKeyLeaderboardUsernames   = "leaderboardUsernames"
+	SessionKeyLeaderboardAnonymous   = "leaderboardAnonymous"
 	SessionKeyLeaderboardCursor      = "leaderboardCursor"
 	SessionKeyLeaderboardNextCursor  = "leaderboardNextCursor"
 	SessionKeyLeaderboardPrevCursors = "leaderboardPrevCursors"
 	SessionKeyLeaderboardLastRefresh = "leaderboardLastRefresh"
 	SessionKeyLeaderboardNextRefresh = "leaderboardNextRefresh"
+	SessionKeyReviewerStatsExport    = "reviewerStatsExport"
+
+	SessionKeyDecisionDurations   = "decisionDurations"
+	SessionKeyAppealResponseTimes = "appealResponseTimes"
+	SessionKeyAppealRegressions   = "appealRegressions"
+	SessionKeyCheckerPrecision    = "checkerPrecision"
 )
 
 const (
+	// ReviewPrefetchSize is how many users are fetched at once and queued in the
+	// session so reviewers can move between users without waiting on the database.
+	ReviewPrefetchSize = 5
+
+	// ReviewPrefetchMaxAge is how long prefetched users are served from the session
+	// queue. It matches how long the database holds them for the reviewer who
+	// fetched them, after which the queue is refilled.
+	ReviewPrefetchMaxAge = 10 * time.Minute
+
 	// ReviewHistoryLimit caps the number of review history entries shown.
 	ReviewHistoryLimit = 5
 
+	// ReviewNotesLimit caps the number of reviewer notes shown in the review embeds.
+	ReviewNotesLimit = 3
+
+	// ReviewShoutsLimit caps the number of recent shouts shown in the group review embed.
+	ReviewShoutsLimit = 3
+
+	// UserCountsRefreshCooldown is how long after a refresh of a user before
+	// reviewers can refresh their follow counts and games again.
+	UserCountsRefreshCooldown = 10 * time.Minute
+
 	// ReviewFriendsLimit caps the number of friends shown in the main review embed
 	// to prevent the embed from becoming too long.
 	ReviewFriendsLimit = 10
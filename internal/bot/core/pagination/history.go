@@ -0,0 +1,60 @@
+package pagination
+
+import (
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+)
+
+// MaxHistoryDepth is the number of previous pages kept in the navigation history.
+// The oldest pages are dropped once it is exceeded.
+const MaxHistoryDepth = 10
+
+// pageStateKeys are the session keys shared by every paginated page. They are saved
+// with a page when navigating away from it since the next page overwrites them.
+var pageStateKeys = []string{
+	constants.SessionKeyPaginationPage,
+	constants.SessionKeyStart,
+	constants.SessionKeyTotalItems,
+	constants.SessionKeyTotalPages,
+	constants.SessionKeyHasNextPage,
+	constants.SessionKeyHasPrevPage,
+}
+
+// pushBreadcrumb returns the history after navigating from the current page to the
+// next page. If the next page is already in the history, the history is cut back to
+// the point before it instead of pushing the current page.
+func pushBreadcrumb(history []session.Breadcrumb, current session.Breadcrumb, next string) []session.Breadcrumb {
+	for i, breadcrumb := range history {
+		if breadcrumb.Page == next {
+			return history[:i]
+		}
+	}
+
+	history = append(history, current)
+	if len(history) > MaxHistoryDepth {
+		history = history[len(history)-MaxHistoryDepth:]
+	}
+	return history
+}
+
+// savePageState returns the pagination state of the current page.
+func savePageState(s *session.Session) map[string]interface{} {
+	state := make(map[string]interface{})
+	for _, key := range pageStateKeys {
+		if value := s.Get(key); value != nil {
+			state[key] = value
+		}
+	}
+	return state
+}
+
+// restorePageState replaces the pagination state in the session with the saved state.
+func restorePageState(s *session.Session, state map[string]interface{}) {
+	for _, key := range pageStateKeys {
+		if value, ok := state[key]; ok {
+			s.Set(key, value)
+		} else {
+			s.Delete(key)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package pagination
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// pageNames returns the page names in the history.
+func pageNames(history []session.Breadcrumb) []string {
+	names := make([]string, 0, len(history))
+	for _, breadcrumb := range history {
+		names = append(names, breadcrumb.Page)
+	}
+	return names
+}
+
+func TestPushBreadcrumb(t *testing.T) {
+	// Opening a review from an appeal ticket, then the friends viewer
+	var history []session.Breadcrumb
+	history = pushBreadcrumb(history, session.Breadcrumb{Page: "Dashboard"}, "Appeal Overview")
+	history = pushBreadcrumb(history, session.Breadcrumb{Page: "Appeal Overview"}, "Appeal Ticket")
+	history = pushBreadcrumb(history, session.Breadcrumb{Page: "Appeal Ticket"}, "Review Menu")
+	history = pushBreadcrumb(history, session.Breadcrumb{Page: "Review Menu"}, "Friends Menu")
+	assert.Equal(t, []string{"Dashboard", "Appeal Overview", "Appeal Ticket", "Review Menu"}, pageNames(history))
+
+	// Going back pops one page at a time until the ticket
+	history = pushBreadcrumb(history, session.Breadcrumb{Page: "Friends Menu"}, "Review Menu")
+	assert.Equal(t, []string{"Dashboard", "Appeal Overview", "Appeal Ticket"}, pageNames(history))
+	history = pushBreadcrumb(history, session.Breadcrumb{Page: "Review Menu"}, "Appeal Ticket")
+	assert.Equal(t, []string{"Dashboard", "Appeal Overview"}, pageNames(history))
+}
+
+func TestPushBreadcrumbCollapsesCycles(t *testing.T) {
+	history := []session.Breadcrumb{{Page: "Dashboard"}}
+	for range 5 {
+		history = pushBreadcrumb(history, session.Breadcrumb{Page: "Review Menu"}, "Log Menu")
+		history = pushBreadcrumb(history, session.Breadcrumb{Page: "Log Menu"}, "Review Menu")
+	}
+
+	assert.Equal(t, []string{"Dashboard"}, pageNames(history))
+}
+
+func TestPushBreadcrumbCapsDepth(t *testing.T) {
+	var history []session.Breadcrumb
+	for i := range MaxHistoryDepth + 5 {
+		history = pushBreadcrumb(history, session.Breadcrumb{Page: fmt.Sprintf("Page %d", i)}, fmt.Sprintf("Page %d", i+1))
+	}
+
+	assert.Len(t, history, MaxHistoryDepth)
+	assert.Equal(t, "Page 5", history[0].Page)
+	assert.Equal(t, fmt.Sprintf("Page %d", MaxHistoryDepth+4), history[len(history)-1].Page)
+}
+
+func TestPageState(t *testing.T) {
+	s := session.NewSession(nil, nil, "session:1", make(map[string]interface{}), zap.NewNop(), 1)
+
+	// Leave the appeal ticket on its third page
+	s.Set(constants.SessionKeyPaginationPage, 2)
+	s.Set(constants.SessionKeyHasNextPage, true)
+	state := savePageState(s)
+
+	// The friends viewer overwrites the shared keys
+	s.Set(constants.SessionKeyPaginationPage, 0)
+	s.Set(constants.SessionKeyTotalItems, 40)
+
+	restorePageState(s, state)
+	assert.Equal(t, 2, s.GetInt(constants.SessionKeyPaginationPage))
+	assert.True(t, s.GetBool(constants.SessionKeyHasNextPage))
+	assert.Nil(t, s.Get(constants.SessionKeyTotalItems))
+}
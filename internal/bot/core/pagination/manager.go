@@ -85,8 +85,13 @@ func (m *Manager) HandleInteraction(event interfaces.CommonEvent, s *session.Ses
 		switch data := e.Data.(type) {
 		case discord.StringSelectMenuInteractionData:
 			if page.SelectHandlerFunc != nil {
-				page.SelectHandlerFunc(e, s, data.CustomID(), data.Values[0])
-				m.logger.Debug("Select interaction", zap.String("customID", data.CustomID()), zap.String("option", data.Values[0]))
+				// Multi-select menus may be submitted with nothing selected
+				var option string
+				if len(data.Values) > 0 {
+					option = data.Values[0]
+				}
+				page.SelectHandlerFunc(e, s, data.CustomID(), option)
+				m.logger.Debug("Select interaction", zap.String("customID", data.CustomID()), zap.String("option", option))
 			} else {
 				m.logger.Error("No select handler found for customID", zap.String("customID", data.CustomID()))
 			}
@@ -133,49 +138,48 @@ func (m *Manager) NavigateTo(event interfaces.CommonEvent, s *session.Session, p
 		zap.Uint64("message_id", uint64(message.ID)))
 }
 
-// UpdatePage updates the session with a new page.
+// UpdatePage updates the session with a new page. The page being left is pushed onto
+// the history together with its pagination state. If the new page is already in the
+// history, the history is cut back to it so circular navigation does not grow it.
 func (m *Manager) UpdatePage(s *session.Session, newPage *Page) {
 	currentPage := s.GetString(constants.SessionKeyCurrentPage)
 	if currentPage != "" && currentPage != newPage.Name {
-		// Get existing page history
-		var previousPages []string
+		var previousPages []session.Breadcrumb
 		s.GetInterface(constants.SessionKeyPreviousPages, &previousPages)
 
-		// Check if new page exists in history
-		for i, page := range previousPages {
-			if page == newPage.Name {
-				// Found the page in history, revert back to its state
-				previousPages = previousPages[:i]
-				s.Set(constants.SessionKeyPreviousPages, previousPages)
-				s.Set(constants.SessionKeyCurrentPage, newPage.Name)
-				return
-			}
-		}
-
-		// Page not in history, append current page
-		previousPages = append(previousPages, currentPage)
-		s.Set(constants.SessionKeyPreviousPages, previousPages)
+		current := session.Breadcrumb{Page: currentPage, State: savePageState(s)}
+		s.Set(constants.SessionKeyPreviousPages, pushBreadcrumb(previousPages, current, newPage.Name))
 	}
 
 	s.Set(constants.SessionKeyCurrentPage, newPage.Name)
 }
 
-// NavigateBack navigates back to the previous page in the history.
+// NavigateBack navigates back to the previous page in the history, restoring the
+// pagination state it was left in.
 func (m *Manager) NavigateBack(event interfaces.CommonEvent, s *session.Session, content string) {
-	var previousPages []string
+	var previousPages []session.Breadcrumb
 	s.GetInterface(constants.SessionKeyPreviousPages, &previousPages)
 
-	if len(previousPages) > 0 {
+	for len(previousPages) > 0 {
 		// Get the last page from history
 		lastIdx := len(previousPages) - 1
-		previousPage := previousPages[lastIdx]
+		previous := previousPages[lastIdx]
+
+		// Skip pages that are no longer registered
+		page := m.GetPage(previous.Page)
+		if page == nil {
+			previousPages = previousPages[:lastIdx]
+			s.Set(constants.SessionKeyPreviousPages, previousPages)
+			continue
+		}
 
-		// Navigate to the previous page
-		page := m.GetPage(previousPage)
+		// Navigate to the previous page as it was left
+		restorePageState(s, previous.State)
 		m.NavigateTo(event, s, page, content)
-	} else {
-		m.Refresh(event, s, content)
+		return
 	}
+
+	m.Refresh(event, s, content)
 }
 
 // Refresh reloads the current page in the session.
@@ -185,6 +189,17 @@ func (m *Manager) Refresh(event interfaces.CommonEvent, s *session.Session, cont
 	m.NavigateTo(event, s, page, content)
 }
 
+// RejectIfReadOnly refreshes the current page with a maintenance notice if the bot is
+// in read-only mode. Mutating handlers call this first and return early if it returns true.
+func (m *Manager) RejectIfReadOnly(event interfaces.CommonEvent, s *session.Session) bool {
+	if !s.GetBool(constants.SessionKeyReadOnly) {
+		return false
+	}
+
+	m.Refresh(event, s, constants.ReadOnlyMessage)
+	return true
+}
+
 // RespondWithError clears all message components and embeds, replacing them with
 // a timestamped error message. This is used when an unrecoverable error occurs
 // during interaction handling.
@@ -3,19 +3,22 @@ package pagination
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"image"
 	"image/draw"
 	"image/png"
+	"io"
+	"net/http"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/disgoorg/disgo/events"
 	"github.com/jaxron/axonet/pkg/client"
-	"github.com/robalyx/rotector/assets"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
 	"go.uber.org/zap"
 	"golang.org/x/image/webp"
 )
@@ -32,30 +35,37 @@ type StreamRequest struct {
 	OnSuccess func(buf *bytes.Buffer)
 }
 
-// DownloadResult represents the result of an image download.
+// imageDownloadTimeout is how long a single image may take to download before it
+// is replaced by the placeholder.
+const imageDownloadTimeout = 4 * time.Second
+
+// ErrImageUnavailable is returned when an image could not be downloaded or decoded.
+var ErrImageUnavailable = errors.New("image unavailable")
+
+// imageFetcher downloads the raw bytes of an image.
+type imageFetcher func(ctx context.Context, url string) ([]byte, error)
+
+// DownloadResult represents the result of an image download. The image is nil for
+// missing thumbnails and failed downloads, which are drawn with the placeholder.
 type DownloadResult struct {
 	index int
-	data  []byte
+	img   image.Image
+	err   error
 }
 
 // ImageStreamer handles progressive loading and merging of images.
 type ImageStreamer struct {
 	paginationManager *Manager
 	logger            *zap.Logger
-	client            *client.Client
+	fetch             imageFetcher
+	timeout           time.Duration
 	placeholderImg    image.Image
 }
 
 // NewImageStreamer creates a new ImageStreamer instance.
 func NewImageStreamer(paginationManager *Manager, logger *zap.Logger, client *client.Client) *ImageStreamer {
-	// Load placeholder image for missing or failed thumbnails
-	imageFile, err := assets.Images.Open("images/content_deleted.png")
-	if err != nil {
-		logger.Fatal("Failed to load placeholder image", zap.Error(err))
-	}
-	defer func() { _ = imageFile.Close() }()
-
-	placeholderImg, _, err := image.Decode(imageFile)
+	// Decode placeholder image for missing or failed thumbnails
+	placeholderImg, _, err := image.Decode(utils.PlaceholderImage())
 	if err != nil {
 		logger.Fatal("Failed to decode placeholder image", zap.Error(err))
 	}
@@ -63,8 +73,20 @@ func NewImageStreamer(paginationManager *Manager, logger *zap.Logger, client *cl
 	return &ImageStreamer{
 		paginationManager: paginationManager,
 		logger:            logger,
-		client:            client,
-		placeholderImg:    placeholderImg,
+		fetch: func(ctx context.Context, url string) ([]byte, error) {
+			resp, err := client.NewRequest().URL(url).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				return nil, fmt.Errorf("%w: status %d", ErrImageUnavailable, resp.StatusCode)
+			}
+			return io.ReadAll(resp.Body)
+		},
+		timeout:        imageDownloadTimeout,
+		placeholderImg: placeholderImg,
 	}
 }
 
@@ -85,57 +107,24 @@ func (is *ImageStreamer) Stream(req StreamRequest) {
 	// Track downloaded images and failed downloads
 	var (
 		mu               sync.RWMutex
-		downloadedImages = make([][]byte, len(urls))
+		downloadedImages = make([]image.Image, len(urls))
 		failedDownloads  atomic.Int32
-		resultChan       = make(chan DownloadResult, len(urls))
 		doneChan         = make(chan struct{})
 	)
 
-	// Create request context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 6*time.Second)
-	defer cancel()
-
-	// Start downloading images concurrently
-	for i, url := range urls {
-		go func(index int, url string) {
-			// Skip if the URL is empty
-			if url == "" {
-				resultChan <- DownloadResult{index: index}
-				return
-			}
-
-			// Download image
-			resp, err := is.client.NewRequest().URL(url).Do(ctx)
-			if err != nil {
-				is.logger.Warn("Failed to download image", zap.Error(err), zap.String("url", url))
-				failedDownloads.Add(1)
-				resultChan <- DownloadResult{index: index}
-				return
-			}
-			defer resp.Body.Close()
-
-			// Read image data into buffer
-			buf := new(bytes.Buffer)
-			if _, err := buf.ReadFrom(resp.Body); err != nil {
-				is.logger.Warn("Failed to read image", zap.Error(err), zap.String("url", url))
-				failedDownloads.Add(1)
-				resultChan <- DownloadResult{index: index}
-				return
-			}
-
-			resultChan <- DownloadResult{index: index, data: buf.Bytes()}
-		}(i, url)
-	}
-
 	// Collect downloaded images as they complete
+	results := is.downloadImages(urls)
 	go func() {
 		for range urls {
-			result := <-resultChan
-			if result.data != nil {
-				mu.Lock()
-				downloadedImages[result.index] = result.data
-				mu.Unlock()
+			result := <-results
+			if result.err != nil {
+				failedDownloads.Add(1)
+				continue
 			}
+
+			mu.Lock()
+			downloadedImages[result.index] = result.img
+			mu.Unlock()
 		}
 		close(doneChan)
 	}()
@@ -146,35 +135,77 @@ func (is *ImageStreamer) Stream(req StreamRequest) {
 
 	for {
 		select {
-		case <-ctx.Done():
-			// Clean up streaming state before returning
-			req.Session.Set(constants.SessionKeyIsStreaming, false)
-			is.createAndDisplayGrid(req, downloadedImages, &mu, "Images took too long to load")
-			return
 		case <-doneChan:
 			// Final update when all images are downloaded
-			message := "Images loaded"
-			if failed := failedDownloads.Load(); failed > 0 {
-				message = fmt.Sprintf("Images loaded (%d failed)", failed)
-			}
-
 			req.Session.Set(constants.SessionKeyIsStreaming, false)
-			is.createAndDisplayGrid(req, downloadedImages, &mu, message)
+			is.createAndDisplayGrid(req, downloadedImages, &mu,
+				imageStatusMessage("Images loaded", int(failedDownloads.Load())))
 			return
 		case <-ticker.C:
 			// Periodic update while images are still downloading
-			message := "Loading images..."
-			if failed := failedDownloads.Load(); failed > 0 {
-				message = fmt.Sprintf("Loading images... (%d failed)", failed)
+			is.createAndDisplayGrid(req, downloadedImages, &mu,
+				imageStatusMessage("Loading images...", int(failedDownloads.Load())))
+		}
+	}
+}
+
+// downloadImages downloads the images concurrently and sends one result per URL as
+// each download completes. Every download has its own timeout, so a slow or broken
+// image only costs its own tile.
+func (is *ImageStreamer) downloadImages(urls []string) <-chan DownloadResult {
+	results := make(chan DownloadResult, len(urls))
+
+	for i, url := range urls {
+		go func(index int, url string) {
+			// Skip if the thumbnail is missing
+			if utils.IsMissingThumbnail(url) {
+				results <- DownloadResult{index: index}
+				return
 			}
 
-			is.createAndDisplayGrid(req, downloadedImages, &mu, message)
-		}
+			img, err := is.downloadImage(url)
+			if err != nil {
+				is.logger.Warn("Failed to download image", zap.Error(err), zap.String("url", url))
+			}
+			results <- DownloadResult{index: index, img: img, err: err}
+		}(i, url)
+	}
+
+	return results
+}
+
+// downloadImage downloads and decodes a single image within the download timeout.
+func (is *ImageStreamer) downloadImage(url string) (image.Image, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), is.timeout)
+	defer cancel()
+
+	data, err := is.fetch(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImageUnavailable, err)
+	}
+
+	img, err := webp.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImageUnavailable, err)
+	}
+
+	return img, nil
+}
+
+// imageStatusMessage appends the number of unavailable thumbnails to a status message.
+func imageStatusMessage(message string, failed int) string {
+	switch {
+	case failed == 1:
+		return message + " (1 thumbnail unavailable)"
+	case failed > 1:
+		return fmt.Sprintf("%s (%d thumbnails unavailable)", message, failed)
+	default:
+		return message
 	}
 }
 
 // createAndDisplayGrid creates and displays the image grid.
-func (is *ImageStreamer) createAndDisplayGrid(req StreamRequest, images [][]byte, mu *sync.RWMutex, message string) {
+func (is *ImageStreamer) createAndDisplayGrid(req StreamRequest, images []image.Image, mu *sync.RWMutex, message string) {
 	mu.Lock()
 	defer mu.Unlock()
 
@@ -183,7 +214,7 @@ func (is *ImageStreamer) createAndDisplayGrid(req StreamRequest, images [][]byte
 	}
 
 	// Create image grid from downloaded images
-	buf, err := is.mergeImageBytes(images, req.Columns, req.Rows, req.MaxItems)
+	buf, err := mergeImages(images, is.placeholderImg, req.Columns, req.Rows, req.MaxItems)
 	if err != nil {
 		is.logger.Error("Failed to merge images", zap.Error(err))
 		return
@@ -198,8 +229,9 @@ func (is *ImageStreamer) createAndDisplayGrid(req StreamRequest, images [][]byte
 	is.paginationManager.NavigateTo(req.Event, req.Session, req.Page, message)
 }
 
-// mergeImageBytes combines multiple images into a single grid layout.
-func (is *ImageStreamer) mergeImageBytes(images [][]byte, columns, rows, maxItems int) (*bytes.Buffer, error) {
+// mergeImages combines multiple images into a single grid layout. Missing images
+// are drawn with the placeholder.
+func mergeImages(images []image.Image, placeholder image.Image, columns, rows, maxItems int) (*bytes.Buffer, error) {
 	// Define dimensions for the grid and individual images
 	imgWidth := 150
 	imgHeight := 150
@@ -210,15 +242,13 @@ func (is *ImageStreamer) mergeImageBytes(images [][]byte, columns, rows, maxItem
 	dst := image.NewRGBA(image.Rect(0, 0, gridWidth, gridHeight))
 
 	// Place each image in its grid position
-	for i, imgBytes := range images {
+	for i, img := range images {
 		if i >= maxItems {
 			continue
 		}
 
-		// Decode source image
-		img, err := webp.Decode(bytes.NewReader(imgBytes))
-		if err != nil {
-			img = is.placeholderImg
+		if img == nil {
+			img = placeholder
 		}
 
 		// Calculate position in grid
@@ -0,0 +1,164 @@
+package pagination
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// tinyWebP is a 1x1 lossless WebP image.
+const tinyWebP = "UklGRhoAAABXRUJQVlA4TA0AAAAvAAAAEAcQERGIiP4HAA=="
+
+var errNotFound = errors.New("not found")
+
+// newTestStreamer creates an ImageStreamer that downloads images with fetch.
+func newTestStreamer(fetch imageFetcher, timeout time.Duration) *ImageStreamer {
+	return &ImageStreamer{
+		logger:         zap.NewNop(),
+		fetch:          fetch,
+		timeout:        timeout,
+		placeholderImg: image.NewUniform(color.White),
+	}
+}
+
+// collectResults waits for one result per URL and returns them in URL order.
+func collectResults(t *testing.T, results <-chan DownloadResult, count int) []DownloadResult {
+	t.Helper()
+
+	ordered := make([]DownloadResult, count)
+	for range count {
+		select {
+		case result := <-results:
+			ordered[result.index] = result
+		case <-time.After(time.Second):
+			require.FailNow(t, "timed out waiting for download results")
+		}
+	}
+	return ordered
+}
+
+func TestDownloadImages(t *testing.T) {
+	validImage, err := base64.StdEncoding.DecodeString(tinyWebP)
+	require.NoError(t, err)
+
+	t.Run("failed images do not affect the others", func(t *testing.T) {
+		streamer := newTestStreamer(func(_ context.Context, url string) ([]byte, error) {
+			switch url {
+			case "expired":
+				return nil, errNotFound
+			case "corrupt":
+				return []byte("not an image"), nil
+			default:
+				return validImage, nil
+			}
+		}, time.Second)
+
+		urls := []string{"a", "expired", "b", "corrupt", ""}
+		results := collectResults(t, streamer.downloadImages(urls), len(urls))
+
+		require.NoError(t, results[0].err)
+		assert.NotNil(t, results[0].img)
+		require.ErrorIs(t, results[1].err, ErrImageUnavailable)
+		require.ErrorIs(t, results[1].err, errNotFound)
+		assert.Nil(t, results[1].img)
+		require.NoError(t, results[2].err)
+		assert.NotNil(t, results[2].img)
+		require.ErrorIs(t, results[3].err, ErrImageUnavailable)
+
+		// Missing thumbnails are not counted as failures
+		require.NoError(t, results[4].err)
+		assert.Nil(t, results[4].img)
+	})
+
+	t.Run("every image fails", func(t *testing.T) {
+		streamer := newTestStreamer(func(_ context.Context, _ string) ([]byte, error) {
+			return nil, errNotFound
+		}, time.Second)
+
+		urls := []string{"a", "b", "c"}
+		for _, result := range collectResults(t, streamer.downloadImages(urls), len(urls)) {
+			require.ErrorIs(t, result.err, ErrImageUnavailable)
+			assert.Nil(t, result.img)
+		}
+	})
+
+	t.Run("slow images time out on their own", func(t *testing.T) {
+		streamer := newTestStreamer(func(ctx context.Context, url string) ([]byte, error) {
+			if url == "slow" {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			return validImage, nil
+		}, 50*time.Millisecond)
+
+		urls := []string{"slow", "fast"}
+		results := collectResults(t, streamer.downloadImages(urls), len(urls))
+
+		require.ErrorIs(t, results[0].err, context.DeadlineExceeded)
+		require.NoError(t, results[1].err)
+		assert.NotNil(t, results[1].img)
+	})
+}
+
+func TestNewImageStreamerFetch(t *testing.T) {
+	validImage, err := base64.StdEncoding.DecodeString(tinyWebP)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/expired.webp" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(validImage)
+	}))
+	t.Cleanup(server.Close)
+
+	streamer := NewImageStreamer(nil, zap.NewNop(), client.NewClient())
+
+	urls := []string{server.URL + "/thumbnail.webp", server.URL + "/expired.webp"}
+	results := collectResults(t, streamer.downloadImages(urls), len(urls))
+
+	require.NoError(t, results[0].err)
+	assert.NotNil(t, results[0].img)
+
+	// An expired thumbnail is reported as unavailable instead of decoding the error page
+	require.ErrorIs(t, results[1].err, ErrImageUnavailable)
+	assert.ErrorContains(t, results[1].err, "status 404")
+	assert.Nil(t, results[1].img)
+}
+
+func TestMergeImages(t *testing.T) {
+	tile := image.NewUniform(color.Black)
+	placeholder := image.NewUniform(color.White)
+
+	buf, err := mergeImages([]image.Image{tile, nil, tile}, placeholder, 2, 2, 4)
+	require.NoError(t, err)
+
+	grid, err := png.Decode(buf)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 300, 300), grid.Bounds())
+
+	// Failed tiles are drawn with the placeholder and the rest are kept
+	gray := func(x, y int) uint8 { return color.GrayModel.Convert(grid.At(x, y)).(color.Gray).Y }
+	assert.Equal(t, uint8(0), gray(75, 75))
+	assert.Equal(t, uint8(255), gray(225, 75))
+	assert.Equal(t, uint8(0), gray(75, 225))
+}
+
+func TestImageStatusMessage(t *testing.T) {
+	assert.Equal(t, "Images loaded", imageStatusMessage("Images loaded", 0))
+	assert.Equal(t, "Images loaded (1 thumbnail unavailable)", imageStatusMessage("Images loaded", 1))
+	assert.Equal(t, "Images loaded (2 thumbnails unavailable)", imageStatusMessage("Images loaded", 2))
+}
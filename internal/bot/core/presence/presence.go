@@ -0,0 +1,184 @@
+package presence
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/robalyx/rotector/internal/common/setup/config"
+	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultInterval is used when no valid update interval is configured.
+	DefaultInterval = 5 * time.Minute
+	// DefaultTemplate is used when no activity template is configured.
+	DefaultTemplate = "{flagged} flagged users"
+)
+
+// Updater periodically sets the bot's activity to live review queue stats.
+// The last activity is re-applied whenever a shard reconnects, since Discord
+// drops the presence of a new gateway session.
+type Updater struct {
+	db       *database.Client
+	client   bot.Client
+	logger   *zap.Logger
+	interval time.Duration
+	template string
+	stop     chan struct{}
+	done     chan struct{}
+	started  bool
+	mu       sync.Mutex
+	activity string
+}
+
+// New creates an Updater from the bot's presence configuration.
+func New(db *database.Client, client bot.Client, cfg config.Presence, logger *zap.Logger) *Updater {
+	interval := time.Duration(cfg.Interval) * time.Minute
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	template := cfg.Template
+	if template == "" {
+		template = DefaultTemplate
+	}
+
+	return &Updater{
+		db:       db,
+		client:   client,
+		logger:   logger.Named("presence"),
+		interval: interval,
+		template: template,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start begins updating the presence in the background.
+func (u *Updater) Start() {
+	u.started = true
+	go u.run()
+}
+
+// Stop stops the background updates and waits for the current update to finish.
+func (u *Updater) Stop() {
+	if !u.started {
+		return
+	}
+	close(u.stop)
+	<-u.done
+}
+
+// OnReady re-applies the last activity when a shard starts a new session.
+func (u *Updater) OnReady(event *events.Ready) {
+	u.reapply(event.ShardID())
+}
+
+// OnResumed re-applies the last activity when a shard resumes its session.
+func (u *Updater) OnResumed(event *events.Resumed) {
+	u.reapply(event.ShardID())
+}
+
+// run updates the presence right away and then on every interval until stopped.
+func (u *Updater) run() {
+	defer close(u.done)
+
+	ticker := time.NewTicker(u.interval)
+	defer ticker.Stop()
+
+	u.update()
+	for {
+		select {
+		case <-u.stop:
+			return
+		case <-ticker.C:
+			u.update()
+		}
+	}
+}
+
+// update refreshes the activity from the cached dashboard counts and sends it to every shard.
+func (u *Updater) update() {
+	userCounts, groupCounts, err := u.db.Stats().GetCachedCounts(context.Background())
+	if err != nil {
+		u.logger.Error("Failed to get counts for presence", zap.Error(err))
+		return
+	}
+
+	activity := FormatActivity(u.template, userCounts, groupCounts)
+
+	u.mu.Lock()
+	u.activity = activity
+	u.mu.Unlock()
+
+	for shardID := range u.client.ShardManager().Shards() {
+		u.send(shardID, activity)
+	}
+}
+
+// reapply sends the last activity to a single shard if one has been set.
+func (u *Updater) reapply(shardID int) {
+	u.mu.Lock()
+	activity := u.activity
+	u.mu.Unlock()
+
+	if activity == "" {
+		return
+	}
+	u.send(shardID, activity)
+}
+
+// send sets the watching activity on a single shard.
+func (u *Updater) send(shardID int, activity string) {
+	err := u.client.SetPresenceForShard(context.Background(), shardID,
+		gateway.WithWatchingActivity(activity),
+		gateway.WithOnlineStatus(discord.OnlineStatusOnline),
+	)
+	if err != nil {
+		u.logger.Error("Failed to update presence",
+			zap.Error(err),
+			zap.Int("shardID", shardID))
+	}
+}
+
+// FormatActivity fills the count placeholders of a template, formatting each
+// count with thousands separators.
+func FormatActivity(template string, userCounts *types.UserCounts, groupCounts *types.GroupCounts) string {
+	replacer := strings.NewReplacer(
+		"{flagged}", formatCount(userCounts.Flagged),
+		"{confirmed}", formatCount(userCounts.Confirmed),
+		"{cleared}", formatCount(userCounts.Cleared),
+		"{banned}", formatCount(userCounts.Banned),
+		"{flagged_groups}", formatCount(groupCounts.Flagged),
+		"{confirmed_groups}", formatCount(groupCounts.Confirmed),
+		"{cleared_groups}", formatCount(groupCounts.Cleared),
+		"{locked_groups}", formatCount(groupCounts.Locked),
+	)
+	return replacer.Replace(template)
+}
+
+// formatCount formats a count with comma thousands separators.
+func formatCount(n int) string {
+	if n < 0 {
+		return "-" + formatCount(-n)
+	}
+	s := strconv.Itoa(n)
+
+	var result strings.Builder
+	for i, r := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			result.WriteByte(',')
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
@@ -0,0 +1,68 @@
+package presence
+
+import (
+	"testing"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatActivity(t *testing.T) {
+	userCounts := &types.UserCounts{Flagged: 1284, Confirmed: 56789, Cleared: 12, Banned: 1000000}
+	groupCounts := &types.GroupCounts{Flagged: 7, Confirmed: 1000, Cleared: 0, Locked: 3}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{
+			name:     "default template",
+			template: DefaultTemplate,
+			want:     "1,284 flagged users",
+		},
+		{
+			name:     "multiple placeholders",
+			template: "{flagged} flagged • {flagged_groups} groups",
+			want:     "1,284 flagged • 7 groups",
+		},
+		{
+			name:     "all counts",
+			template: "{confirmed} {cleared} {banned} {confirmed_groups} {cleared_groups} {locked_groups}",
+			want:     "56,789 12 1,000,000 1,000 0 3",
+		},
+		{
+			name:     "no placeholders",
+			template: "the review queue",
+			want:     "the review queue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatActivity(tt.template, userCounts, groupCounts)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatCount(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want string
+	}{
+		{name: "zero", n: 0, want: "0"},
+		{name: "hundreds", n: 999, want: "999"},
+		{name: "thousands", n: 1000, want: "1,000"},
+		{name: "millions", n: 1234567, want: "1,234,567"},
+		{name: "negative", n: -1234, want: "-1,234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatCount(tt.n)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
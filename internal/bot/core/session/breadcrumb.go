@@ -0,0 +1,8 @@
+package session
+
+// Breadcrumb is a page in the navigation history of a session together with the
+// pagination state it was left in, so navigating back shows it as it was.
+type Breadcrumb struct {
+	Page  string                 `json:"page"`
+	State map[string]interface{} `json:"state,omitempty"`
+}
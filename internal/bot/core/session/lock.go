@@ -0,0 +1,240 @@
+package session
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/redis/rueidis"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"go.uber.org/zap"
+)
+
+const (
+	// ReviewLockPrefix is prepended to the keys locking review targets, followed by
+	// the kind of target and its ID. The value of a key is the ID of the reviewer.
+	ReviewLockPrefix = "review_lock:"
+
+	// ReviewLockIndexPrefix is prepended to the keys of the sorted sets indexing the
+	// locked targets of each kind, so they can be listed without scanning the keyspace.
+	ReviewLockIndexPrefix = "review_locks:"
+
+	// ReviewLockTTL is how long a review lock lasts without being renewed. Locks are
+	// renewed whenever the session is touched, so a lock lapses together with an idle
+	// session or a bot instance that stopped.
+	ReviewLockTTL = SessionTimeout
+)
+
+// ReviewLockKind is the kind of target a review lock is for.
+type ReviewLockKind string
+
+const (
+	// ReviewLockUser locks a user served for review.
+	ReviewLockUser ReviewLockKind = "user"
+	// ReviewLockGroup locks a group served for review.
+	ReviewLockGroup ReviewLockKind = "group"
+)
+
+// ReviewLock records a target locked by a session and the page it is reviewed on.
+type ReviewLock struct {
+	TargetID uint64 `json:"targetId"`
+	Page     string `json:"page"`
+}
+
+// redisNowMs is the Lua snippet reading the current time of the Redis server in
+// milliseconds, so lock expiry does not depend on the clocks of the bot instances.
+const redisNowMs = `
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+`
+
+var (
+	// acquireReviewLockScript takes the lock unless it is held by another reviewer,
+	// refreshing it if the reviewer already holds it. The target is added to the index
+	// of locked targets with the time the lock expires.
+	acquireReviewLockScript = rueidis.NewLuaScript(redisNowMs + `
+local owner = redis.call('GET', KEYS[1])
+if owner and owner ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+redis.call('ZADD', KEYS[2], now + tonumber(ARGV[2]), ARGV[3])
+return 1
+`)
+
+	// renewReviewLockScript extends the lock and its index entry if the reviewer
+	// still holds it.
+	renewReviewLockScript = rueidis.NewLuaScript(redisNowMs + `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('ZADD', KEYS[2], now + tonumber(ARGV[2]), ARGV[3])
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+	// releaseReviewLockScript deletes the lock and its index entry if the reviewer
+	// still holds it.
+	releaseReviewLockScript = rueidis.NewLuaScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('ZREM', KEYS[2], ARGV[2])
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+	// lockedReviewTargetsScript drops the index entries of lapsed locks and returns
+	// the targets that are still locked.
+	lockedReviewTargetsScript = rueidis.NewLuaScript(redisNowMs + `
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now)
+return redis.call('ZRANGE', KEYS[1], 0, -1)
+`)
+)
+
+// AcquireReviewLock locks a target for the reviewer of this session so that reviewers
+// on other bot instances are not served it. Any other target of the same kind locked
+// by the session is released first. Returns false if another reviewer holds the lock.
+// If Redis cannot be reached, a warning is logged and true is returned so that reviews
+// carry on with only the last_viewed hold.
+func (s *Session) AcquireReviewLock(ctx context.Context, kind ReviewLockKind, targetID uint64, page string) bool {
+	if lock := s.reviewLock(kind); lock != nil && lock.TargetID != targetID {
+		s.ReleaseReviewLock(ctx, kind)
+	}
+
+	acquired, err := acquireReviewLockScript.Exec(ctx, s.redis,
+		[]string{reviewLockKey(kind, targetID), reviewLockIndexKey(kind)},
+		[]string{s.lockOwner(), strconv.FormatInt(ReviewLockTTL.Milliseconds(), 10), strconv.FormatUint(targetID, 10)},
+	).AsInt64()
+	if err != nil {
+		s.logger.Warn("Failed to acquire review lock, continuing without it",
+			zap.Error(err),
+			zap.String("kind", string(kind)),
+			zap.Uint64("target_id", targetID))
+		return true
+	}
+	if acquired == 0 {
+		return false
+	}
+
+	s.Set(reviewLockSessionKey(kind), &ReviewLock{TargetID: targetID, Page: page})
+	return true
+}
+
+// ReleaseReviewLock releases the target of the given kind locked by this session.
+func (s *Session) ReleaseReviewLock(ctx context.Context, kind ReviewLockKind) {
+	lock := s.reviewLock(kind)
+	if lock == nil {
+		return
+	}
+	s.Delete(reviewLockSessionKey(kind))
+
+	err := releaseReviewLockScript.Exec(ctx, s.redis,
+		[]string{reviewLockKey(kind, lock.TargetID), reviewLockIndexKey(kind)},
+		[]string{s.lockOwner(), strconv.FormatUint(lock.TargetID, 10)},
+	).Error()
+	if err != nil {
+		s.logger.Warn("Failed to release review lock",
+			zap.Error(err),
+			zap.String("kind", string(kind)),
+			zap.Uint64("target_id", lock.TargetID))
+	}
+}
+
+// renewReviewLocks extends the locks of this session while it is still on the page
+// each target is reviewed on, or on a page opened from it. Locks of targets the
+// reviewer navigated away from are released.
+func (s *Session) renewReviewLocks(ctx context.Context) {
+	currentPage := s.GetString(constants.SessionKeyCurrentPage)
+	var previousPages []Breadcrumb
+	s.GetInterface(constants.SessionKeyPreviousPages, &previousPages)
+
+	for _, kind := range []ReviewLockKind{ReviewLockUser, ReviewLockGroup} {
+		lock := s.reviewLock(kind)
+		if lock == nil {
+			continue
+		}
+
+		if !isOnPage(lock.Page, currentPage, previousPages) {
+			s.ReleaseReviewLock(ctx, kind)
+			continue
+		}
+
+		renewed, err := renewReviewLockScript.Exec(ctx, s.redis,
+			[]string{reviewLockKey(kind, lock.TargetID), reviewLockIndexKey(kind)},
+			[]string{s.lockOwner(), strconv.FormatInt(ReviewLockTTL.Milliseconds(), 10), strconv.FormatUint(lock.TargetID, 10)},
+		).AsInt64()
+		if err != nil {
+			s.logger.Warn("Failed to renew review lock",
+				zap.Error(err),
+				zap.String("kind", string(kind)),
+				zap.Uint64("target_id", lock.TargetID))
+			continue
+		}
+
+		// The lock lapsed and may have been taken by another reviewer
+		if renewed == 0 {
+			s.Delete(reviewLockSessionKey(kind))
+		}
+	}
+}
+
+// reviewLock returns the lock of the given kind held by this session, or nil if there is none.
+func (s *Session) reviewLock(kind ReviewLockKind) *ReviewLock {
+	var lock *ReviewLock
+	s.GetInterface(reviewLockSessionKey(kind), &lock)
+	return lock
+}
+
+// lockOwner returns the value identifying this session's reviewer as the holder of a lock.
+func (s *Session) lockOwner() string {
+	return strconv.FormatUint(s.userID, 10)
+}
+
+// LockedReviewTargets returns the IDs of the targets of the given kind that are locked
+// by any reviewer, read from the index of locked targets rather than the keyspace.
+// If Redis cannot be reached, a warning is logged and nil is returned so that reviews
+// carry on with only the last_viewed hold.
+func (m *Manager) LockedReviewTargets(ctx context.Context, kind ReviewLockKind) []uint64 {
+	members, err := lockedReviewTargetsScript.Exec(ctx, m.redis,
+		[]string{reviewLockIndexKey(kind)}, nil,
+	).AsStrSlice()
+	if err != nil {
+		m.logger.Warn("Failed to list review locks, continuing without them",
+			zap.Error(err),
+			zap.String("kind", string(kind)))
+		return nil
+	}
+
+	targetIDs := make([]uint64, 0, len(members))
+	for _, member := range members {
+		if targetID, err := strconv.ParseUint(member, 10, 64); err == nil {
+			targetIDs = append(targetIDs, targetID)
+		}
+	}
+	return targetIDs
+}
+
+// reviewLockKey returns the key locking a review target.
+func reviewLockKey(kind ReviewLockKind, targetID uint64) string {
+	return ReviewLockPrefix + string(kind) + ":" + strconv.FormatUint(targetID, 10)
+}
+
+// reviewLockIndexKey returns the key of the sorted set indexing the locked targets
+// of the given kind, scored by the time their lock expires.
+func reviewLockIndexKey(kind ReviewLockKind) string {
+	return ReviewLockIndexPrefix + string(kind)
+}
+
+// reviewLockSessionKey returns the session key recording the lock of the given kind.
+func reviewLockSessionKey(kind ReviewLockKind) string {
+	if kind == ReviewLockGroup {
+		return constants.SessionKeyGroupReviewLock
+	}
+	return constants.SessionKeyUserReviewLock
+}
+
+// isOnPage reports whether the session is on the page or on a page opened from it.
+func isOnPage(page, currentPage string, previousPages []Breadcrumb) bool {
+	return currentPage == page || slices.ContainsFunc(previousPages, func(b Breadcrumb) bool {
+		return b.Page == page
+	})
+}
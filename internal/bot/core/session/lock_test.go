@@ -0,0 +1,193 @@
+package session
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/redis/rueidis"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReviewLockKey(t *testing.T) {
+	assert.Equal(t, "review_lock:user:123", reviewLockKey(ReviewLockUser, 123))
+	assert.Equal(t, "review_lock:group:456", reviewLockKey(ReviewLockGroup, 456))
+}
+
+func TestReviewLockSessionKey(t *testing.T) {
+	assert.Equal(t, constants.SessionKeyUserReviewLock, reviewLockSessionKey(ReviewLockUser))
+	assert.Equal(t, constants.SessionKeyGroupReviewLock, reviewLockSessionKey(ReviewLockGroup))
+}
+
+func TestIsOnPage(t *testing.T) {
+	tests := []struct {
+		name          string
+		currentPage   string
+		previousPages []Breadcrumb
+		want          bool
+	}{
+		{name: "on the review page", currentPage: "Review Menu", previousPages: []Breadcrumb{{Page: "Dashboard"}}, want: true},
+		{name: "on a page opened from it", currentPage: "Friends Menu", previousPages: []Breadcrumb{{Page: "Dashboard"}, {Page: "Review Menu"}}, want: true},
+		{name: "navigated back", currentPage: "Dashboard", previousPages: nil, want: false},
+		{name: "on another page", currentPage: "Settings Menu", previousPages: []Breadcrumb{{Page: "Dashboard"}}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isOnPage("Review Menu", tt.currentPage, tt.previousPages))
+		})
+	}
+}
+
+// newLockTestSession creates a session for the reviewer backed by the given Redis client.
+func newLockTestSession(client rueidis.Client, reviewerID uint64) *Session {
+	return NewSession(nil, client, "session:"+strconv.FormatUint(reviewerID, 10), make(map[string]interface{}), zap.NewNop(), reviewerID)
+}
+
+// cleanupReviewLock deletes the lock of the target and the index of its kind when the test ends.
+func cleanupReviewLock(t *testing.T, client rueidis.Client, kind ReviewLockKind, targetID uint64) {
+	t.Helper()
+	t.Cleanup(func() {
+		ctx := context.Background()
+		_ = client.Do(ctx, client.B().Del().Key(reviewLockKey(kind, targetID), reviewLockIndexKey(kind)).Build()).Error()
+	})
+}
+
+func TestAcquireReviewLock(t *testing.T) {
+	client := testutil.OpenTestRedis(t)
+	ctx := context.Background()
+	manager := &Manager{redis: client, logger: zap.NewNop()}
+
+	const targetID = 9_000_000_501
+	cleanupReviewLock(t, client, ReviewLockUser, targetID)
+
+	s := newLockTestSession(client, 1)
+	require.True(t, s.AcquireReviewLock(ctx, ReviewLockUser, targetID, "Review Menu"))
+	assert.Equal(t, &ReviewLock{TargetID: targetID, Page: "Review Menu"}, s.reviewLock(ReviewLockUser))
+	assert.Contains(t, manager.LockedReviewTargets(ctx, ReviewLockUser), uint64(targetID))
+	assert.NotContains(t, manager.LockedReviewTargets(ctx, ReviewLockGroup), uint64(targetID))
+
+	// Acquiring again refreshes the reviewer's own lock
+	require.True(t, s.AcquireReviewLock(ctx, ReviewLockUser, targetID, "Review Menu"))
+
+	// Releasing removes the target from the index
+	s.ReleaseReviewLock(ctx, ReviewLockUser)
+	assert.Nil(t, s.reviewLock(ReviewLockUser))
+	assert.NotContains(t, manager.LockedReviewTargets(ctx, ReviewLockUser), uint64(targetID))
+}
+
+func TestAcquireReviewLockHeldByAnotherReviewer(t *testing.T) {
+	client := testutil.OpenTestRedis(t)
+	ctx := context.Background()
+
+	const targetID = 9_000_000_502
+	cleanupReviewLock(t, client, ReviewLockUser, targetID)
+
+	first := newLockTestSession(client, 1)
+	second := newLockTestSession(client, 2)
+	require.True(t, first.AcquireReviewLock(ctx, ReviewLockUser, targetID, "Review Menu"))
+
+	// Another reviewer cannot take the lock while it is held
+	assert.False(t, second.AcquireReviewLock(ctx, ReviewLockUser, targetID, "Review Menu"))
+	assert.Nil(t, second.reviewLock(ReviewLockUser))
+
+	// Releasing a lock the session does not hold leaves the holder's lock alone
+	second.ReleaseReviewLock(ctx, ReviewLockUser)
+	owner, err := client.Do(ctx, client.B().Get().Key(reviewLockKey(ReviewLockUser, targetID)).Build()).ToString()
+	require.NoError(t, err)
+	assert.Equal(t, "1", owner)
+
+	// The lock can be taken once the holder releases it
+	first.ReleaseReviewLock(ctx, ReviewLockUser)
+	assert.True(t, second.AcquireReviewLock(ctx, ReviewLockUser, targetID, "Review Menu"))
+}
+
+func TestLapsedReviewLockIsTakenOver(t *testing.T) {
+	client := testutil.OpenTestRedis(t)
+	ctx := context.Background()
+
+	const targetID = 9_000_000_506
+	cleanupReviewLock(t, client, ReviewLockUser, targetID)
+
+	first := newLockTestSession(client, 1)
+	first.Set(constants.SessionKeyCurrentPage, "Review Menu")
+	require.True(t, first.AcquireReviewLock(ctx, ReviewLockUser, targetID, "Review Menu"))
+
+	// The first reviewer's lock lapses and another reviewer takes it
+	require.NoError(t, client.Do(ctx, client.B().Del().Key(reviewLockKey(ReviewLockUser, targetID)).Build()).Error())
+	second := newLockTestSession(client, 2)
+	require.True(t, second.AcquireReviewLock(ctx, ReviewLockUser, targetID, "Review Menu"))
+
+	// Renewing does not take the lock back, and the first session forgets it
+	first.renewReviewLocks(ctx)
+	assert.Nil(t, first.reviewLock(ReviewLockUser))
+	owner, err := client.Do(ctx, client.B().Get().Key(reviewLockKey(ReviewLockUser, targetID)).Build()).ToString()
+	require.NoError(t, err)
+	assert.Equal(t, "2", owner)
+}
+
+func TestRenewReviewLocks(t *testing.T) {
+	client := testutil.OpenTestRedis(t)
+	ctx := context.Background()
+	manager := &Manager{redis: client, logger: zap.NewNop()}
+
+	const targetID = 9_000_000_503
+	cleanupReviewLock(t, client, ReviewLockGroup, targetID)
+
+	s := newLockTestSession(client, 1)
+	s.Set(constants.SessionKeyCurrentPage, "Group Review Menu")
+	require.True(t, s.AcquireReviewLock(ctx, ReviewLockGroup, targetID, "Group Review Menu"))
+
+	// Shorten the lock, then renew it while still on the review page
+	key := reviewLockKey(ReviewLockGroup, targetID)
+	require.NoError(t, client.Do(ctx, client.B().Pexpire().Key(key).Milliseconds(1000).Build()).Error())
+	s.renewReviewLocks(ctx)
+
+	ttl, err := client.Do(ctx, client.B().Pttl().Key(key).Build()).AsInt64()
+	require.NoError(t, err)
+	assert.Greater(t, ttl, int64(1000))
+	assert.Contains(t, manager.LockedReviewTargets(ctx, ReviewLockGroup), uint64(targetID))
+
+	// Leaving the review page releases the lock
+	s.Set(constants.SessionKeyCurrentPage, "Dashboard")
+	s.renewReviewLocks(ctx)
+	assert.Nil(t, s.reviewLock(ReviewLockGroup))
+	assert.NotContains(t, manager.LockedReviewTargets(ctx, ReviewLockGroup), uint64(targetID))
+}
+
+func TestLockedReviewTargetsDropsLapsedLocks(t *testing.T) {
+	client := testutil.OpenTestRedis(t)
+	ctx := context.Background()
+	manager := &Manager{redis: client, logger: zap.NewNop()}
+
+	const targetID = 9_000_000_504
+	cleanupReviewLock(t, client, ReviewLockUser, targetID)
+
+	// An index entry whose lock expired long ago, as left by a stopped bot instance
+	require.NoError(t, client.Do(ctx, client.B().Zadd().Key(reviewLockIndexKey(ReviewLockUser)).
+		ScoreMember().ScoreMember(1, strconv.FormatUint(targetID, 10)).Build()).Error())
+
+	assert.NotContains(t, manager.LockedReviewTargets(ctx, ReviewLockUser), uint64(targetID))
+	count, err := client.Do(ctx, client.B().Zcard().Key(reviewLockIndexKey(ReviewLockUser)).Build()).AsInt64()
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestReviewLocksWithRedisUnavailable(t *testing.T) {
+	client := testutil.NewUnavailableRedis(t)
+	ctx := context.Background()
+	manager := &Manager{redis: client, logger: zap.NewNop()}
+
+	// Reviews carry on without locks while Redis is down
+	s := newLockTestSession(client, 1)
+	assert.True(t, s.AcquireReviewLock(ctx, ReviewLockUser, 9_000_000_505, "Review Menu"))
+	assert.Nil(t, manager.LockedReviewTargets(ctx, ReviewLockUser))
+
+	s.Set(constants.SessionKeyCurrentPage, "Review Menu")
+	s.renewReviewLocks(ctx)
+	s.ReleaseReviewLock(ctx, ReviewLockUser)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/bytedance/sonic"
@@ -11,6 +12,8 @@ import (
 	"github.com/redis/rueidis"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"github.com/robalyx/rotector/internal/common/storage/redis"
 	"go.uber.org/zap"
 )
@@ -30,6 +33,10 @@ const (
 	// ScanBatchSize controls how many Redis keys are retrieved in each SCAN operation
 	// when listing active sessions. This helps balance memory usage and performance.
 	ScanBatchSize = 1000
+
+	// ReadOnlyKey stores the runtime read-only toggle. It is kept outside the session
+	// prefix so it is not counted as a session and persists across restarts.
+	ReadOnlyKey = "maintenance:read_only"
 )
 
 // Session errors.
@@ -44,13 +51,15 @@ var (
 // Manager manages the session lifecycle using Redis as the backing store.
 // Sessions are prefixed and stored with automatic expiration.
 type Manager struct {
-	db     *database.Client
-	redis  rueidis.Client
-	logger *zap.Logger
+	db             *database.Client
+	redis          rueidis.Client
+	forcedReadOnly bool
+	logger         *zap.Logger
 }
 
 // NewManager creates a new session manager that uses Redis as the backing store.
-func NewManager(db *database.Client, redisManager *redis.Manager, logger *zap.Logger) (*Manager, error) {
+// If forcedReadOnly is set, the bot stays in read-only mode regardless of the runtime toggle.
+func NewManager(db *database.Client, redisManager *redis.Manager, forcedReadOnly bool, logger *zap.Logger) (*Manager, error) {
 	// Get Redis client
 	redisClient, err := redisManager.GetClient(redis.SessionDBIndex)
 	if err != nil {
@@ -58,9 +67,10 @@ func NewManager(db *database.Client, redisManager *redis.Manager, logger *zap.Lo
 	}
 
 	return &Manager{
-		db:     db,
-		redis:  redisClient,
-		logger: logger,
+		db:             db,
+		redis:          redisClient,
+		forcedReadOnly: forcedReadOnly,
+		logger:         logger,
 	}, nil
 }
 
@@ -74,6 +84,9 @@ func (m *Manager) GetOrCreateSession(ctx context.Context, userID snowflake.ID) (
 		return nil, fmt.Errorf("%w: %w", ErrFailedToLoadSettings, err)
 	}
 
+	// Check whether writes are currently allowed
+	readOnly := m.IsReadOnly(ctx)
+
 	// Try loading existing session first
 	key := fmt.Sprintf("%s%d", SessionPrefix, userID)
 	result := m.redis.Do(ctx, m.redis.B().Get().Key(key).Build())
@@ -108,6 +121,8 @@ func (m *Manager) GetOrCreateSession(ctx context.Context, userID snowflake.ID) (
 
 		session := NewSession(m.db, m.redis, key, sessionData, m.logger, uint64(userID))
 		session.Set(constants.SessionKeyBotSettings, botSettings)
+		session.Set(constants.SessionKeyReadOnly, readOnly)
+		session.targetRemoved = m.validateTargets(ctx, session)
 		return session, nil
 	}
 
@@ -122,18 +137,161 @@ func (m *Manager) GetOrCreateSession(ctx context.Context, userID snowflake.ID) (
 	session := NewSession(m.db, m.redis, key, sessionData, m.logger, uint64(userID))
 	session.Set(constants.SessionKeyUserSettings, userSettings)
 	session.Set(constants.SessionKeyBotSettings, botSettings)
+	session.Set(constants.SessionKeyReadOnly, readOnly)
 	return session, nil
 }
 
+// validateTargets checks that the user and group targets stored in a loaded session
+// still exist and have not been banned or locked since the session was last used.
+// Targets that cannot be reviewed anymore are removed from the session and true is
+// returned. Targets are kept if they cannot be checked.
+func (m *Manager) validateTargets(ctx context.Context, s *Session) bool {
+	removed := false
+
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
+	if user != nil {
+		current, err := m.db.Users().GetUserByID(ctx, strconv.FormatUint(user.ID, 10), types.UserFields{Basic: true})
+		if err != nil && !errors.Is(err, types.ErrUserNotFound) {
+			m.logger.Error("Failed to validate session user target", zap.Error(err), zap.Uint64("userID", user.ID))
+		}
+		if errors.Is(err, types.ErrUserNotFound) || (err == nil && current.Status == enum.UserTypeBanned) {
+			s.Delete(constants.SessionKeyTarget)
+			removed = true
+		}
+	}
+
+	var group *types.ReviewGroup
+	s.GetInterface(constants.SessionKeyGroupTarget, &group)
+	if group != nil {
+		current, err := m.db.Groups().GetGroupByID(ctx, strconv.FormatUint(group.ID, 10), types.GroupFields{Basic: true})
+		if err != nil && !errors.Is(err, types.ErrGroupNotFound) {
+			m.logger.Error("Failed to validate session group target", zap.Error(err), zap.Uint64("groupID", group.ID))
+		}
+		if errors.Is(err, types.ErrGroupNotFound) || (err == nil && current.Status == enum.GroupTypeLocked) {
+			s.Delete(constants.SessionKeyGroupTarget)
+			removed = true
+		}
+	}
+
+	return removed
+}
+
 // CloseSession removes a user's session from Redis immediately rather than
 // waiting for expiration.
 func (m *Manager) CloseSession(ctx context.Context, userID uint64) {
 	key := fmt.Sprintf("%s%d", SessionPrefix, userID)
+
+	// Release anything the reviewer was holding before the session data is gone
+	if data, err := m.redis.Do(ctx, m.redis.B().Get().Key(key).Build()).AsBytes(); err == nil {
+		var sessionData map[string]interface{}
+		if err := sonic.Unmarshal(data, &sessionData); err == nil {
+			session := NewSession(m.db, m.redis, key, sessionData, m.logger, userID)
+			m.ReleaseUserReviews(ctx, session)
+			m.ReleaseGroupReview(ctx, session)
+		}
+	}
+
 	if err := m.redis.Do(ctx, m.redis.B().Del().Key(key).Build()).Error(); err != nil {
 		m.logger.Error("Failed to delete session", zap.Error(err))
 	}
 }
 
+// ReleaseUserReviews returns the user held in the session and any prefetched users
+// to the review queue so other reviewers can pick them up straight away, and releases
+// the review lock on the user. The prefetched users are dropped from the session since
+// they are no longer held.
+// Read-only sessions never mark users as viewed, so nothing is released for them.
+func (m *Manager) ReleaseUserReviews(ctx context.Context, s *Session) {
+	s.ReleaseReviewLock(ctx, ReviewLockUser)
+
+	if s.GetBool(constants.SessionKeyReadOnly) {
+		return
+	}
+
+	var userIDs []uint64
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
+	if user != nil {
+		userIDs = append(userIDs, user.ID)
+	}
+
+	// Only the users of the prefetched review queue are needed here
+	var queue struct {
+		Users []*types.ReviewUser `json:"users"`
+	}
+	s.GetInterface(constants.SessionKeyReviewQueue, &queue)
+	for _, queued := range queue.Users {
+		userIDs = append(userIDs, queued.ID)
+	}
+	s.Delete(constants.SessionKeyReviewQueue)
+
+	if err := m.db.Users().ReleaseReview(ctx, userIDs...); err != nil {
+		m.logger.Error("Failed to release held users",
+			zap.Error(err),
+			zap.Uint64("reviewer_id", s.UserID()))
+	}
+}
+
+// ReleaseGroupReview returns the group held in the session to the review queue and
+// releases its review lock so other reviewers can pick it up straight away.
+func (m *Manager) ReleaseGroupReview(ctx context.Context, s *Session) {
+	s.ReleaseReviewLock(ctx, ReviewLockGroup)
+
+	if s.GetBool(constants.SessionKeyReadOnly) {
+		return
+	}
+
+	var group *types.ReviewGroup
+	s.GetInterface(constants.SessionKeyGroupTarget, &group)
+	if group == nil {
+		return
+	}
+
+	if err := m.db.Groups().ReleaseReview(ctx, group.ID); err != nil {
+		m.logger.Error("Failed to release held group",
+			zap.Error(err),
+			zap.Uint64("reviewer_id", s.UserID()),
+			zap.Uint64("group_id", group.ID))
+	}
+}
+
+// IsReadOnly reports whether the bot is in read-only mode, either forced by
+// configuration or toggled at runtime by an admin.
+func (m *Manager) IsReadOnly(ctx context.Context) bool {
+	if m.forcedReadOnly {
+		return true
+	}
+
+	enabled, err := m.redis.Do(ctx, m.redis.B().Exists().Key(ReadOnlyKey).Build()).AsBool()
+	if err != nil {
+		m.logger.Error("Failed to check read-only mode", zap.Error(err))
+		return false
+	}
+	return enabled
+}
+
+// IsReadOnlyForced reports whether read-only mode is forced by configuration
+// and therefore cannot be disabled at runtime.
+func (m *Manager) IsReadOnlyForced() bool {
+	return m.forcedReadOnly
+}
+
+// SetReadOnly persists the runtime read-only toggle in Redis.
+func (m *Manager) SetReadOnly(ctx context.Context, enabled bool) error {
+	if enabled {
+		if err := m.redis.Do(ctx, m.redis.B().Set().Key(ReadOnlyKey).Value("1").Build()).Error(); err != nil {
+			return fmt.Errorf("failed to enable read-only mode: %w", err)
+		}
+		return nil
+	}
+
+	if err := m.redis.Do(ctx, m.redis.B().Del().Key(ReadOnlyKey).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to disable read-only mode: %w", err)
+	}
+	return nil
+}
+
 // GetActiveUsers scans Redis for all session keys and extracts the user IDs.
 // Uses cursor-based scanning to handle large numbers of sessions.
 func (m *Manager) GetActiveUsers(ctx context.Context) []snowflake.ID {
@@ -11,10 +11,15 @@ import (
 	"github.com/spf13/cast"
 
 	"github.com/bytedance/sonic"
+	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"go.uber.org/zap"
 )
 
+// transientKeys are session keys that only live for the interaction that set them.
+// They are left out when the session is persisted to Redis.
+var transientKeys = []string{constants.SessionKeyImageBuffer}
+
 // Session maintains user state through a Redis-backed key-value store where values are
 // serialized as JSON strings. The session automatically expires after a configured timeout.
 type Session struct {
@@ -24,6 +29,8 @@ type Session struct {
 	data   map[string]interface{}
 	logger *zap.Logger
 	userID uint64
+
+	targetRemoved bool
 }
 
 // NewSession creates a new session for the given user.
@@ -50,11 +57,20 @@ func (s *Session) UserID() uint64 {
 	return s.userID
 }
 
+// TargetRemoved reports whether a review target stored in the session was removed
+// when it was loaded, because it can no longer be reviewed.
+func (s *Session) TargetRemoved() bool {
+	return s.targetRemoved
+}
+
 // Touch serializes the session data to JSON and updates the TTL in Redis to prevent expiration.
+// The review locks held by the session are renewed or released along with it.
 // If serialization fails, the error is logged but the session continues.
 func (s *Session) Touch(ctx context.Context) {
+	s.renewReviewLocks(ctx)
+
 	// Serialize session data to JSON
-	data, err := sonic.MarshalString(s.data)
+	data, err := sonic.MarshalString(s.persistedData())
 	if err != nil {
 		s.logger.Error("Failed to marshal session data", zap.Error(err))
 		return
@@ -69,6 +85,18 @@ func (s *Session) Touch(ctx context.Context) {
 	}
 }
 
+// persistedData returns the session data without the transient keys.
+func (s *Session) persistedData() map[string]interface{} {
+	data := make(map[string]interface{}, len(s.data))
+	for key, value := range s.data {
+		data[key] = value
+	}
+	for _, key := range transientKeys {
+		delete(data, key)
+	}
+	return data
+}
+
 // Get retrieves a raw string value from the in-memory session cache.
 // Returns empty string if key doesn't exist.
 func (s *Session) Get(key string) interface{} {
@@ -0,0 +1,23 @@
+package session
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestPersistedDataExcludesTransientKeys(t *testing.T) {
+	s := NewSession(nil, nil, "session:1", make(map[string]interface{}), zap.NewNop(), 1)
+	s.SetBuffer(constants.SessionKeyImageBuffer, bytes.NewBufferString("grid"))
+	s.Set(constants.SessionKeyPaginationPage, 2)
+
+	data := s.persistedData()
+	assert.NotContains(t, data, constants.SessionKeyImageBuffer)
+	assert.Equal(t, 2, data[constants.SessionKeyPaginationPage])
+
+	// The buffer is still available for the rest of the interaction
+	assert.Equal(t, "grid", s.GetBuffer(constants.SessionKeyImageBuffer).String())
+}
@@ -8,6 +8,10 @@ import (
 type DashboardLayout interface {
 	// Show prepares and displays the dashboard menu.
 	Show(event CommonEvent, s *session.Session, content string)
+	// ShowUserLookup opens the user with the given ID for review.
+	ShowUserLookup(event CommonEvent, s *session.Session, userID uint64)
+	// ShowGroupLookup opens the group with the given ID for review.
+	ShowGroupLookup(event CommonEvent, s *session.Session, groupID uint64)
 }
 
 // UserReviewLayout defines the interface for handling user review-related actions.
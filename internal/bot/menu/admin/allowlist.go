@@ -0,0 +1,190 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	builder "github.com/robalyx/rotector/internal/bot/builder/admin"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"go.uber.org/zap"
+)
+
+// AllowlistMenu handles the interface for managing allowlisted groups.
+type AllowlistMenu struct {
+	layout *Layout
+	page   *pagination.Page
+}
+
+// NewAllowlistMenu creates an AllowlistMenu and sets up its page.
+func NewAllowlistMenu(layout *Layout) *AllowlistMenu {
+	m := &AllowlistMenu{layout: layout}
+	m.page = &pagination.Page{
+		Name: "Group Allowlist Menu",
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewAllowlistBuilder(s).Build()
+		},
+		ButtonHandlerFunc: m.handleButton,
+		ModalHandlerFunc:  m.handleModal,
+	}
+	return m
+}
+
+// Show loads the allowlisted groups and displays the management interface.
+func (m *AllowlistMenu) Show(event interfaces.CommonEvent, s *session.Session, content string) {
+	groups, err := m.layout.db.GroupAllowlist().List(context.Background())
+	if err != nil {
+		m.layout.logger.Error("Failed to get allowlisted groups", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to load allowlisted groups. Please try again.")
+		return
+	}
+
+	s.Set(constants.SessionKeyAllowlistedGroups, groups)
+	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
+}
+
+// handleButton processes button interactions.
+func (m *AllowlistMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
+	switch customID {
+	case constants.BackButtonCustomID:
+		m.layout.paginationManager.NavigateBack(event, s, "")
+	case constants.AddAllowlistButtonCustomID:
+		m.handleAllowlistModal(event, constants.AddAllowlistModalCustomID, "Add Allowlisted Group",
+			"Enter why this group should never be flagged...")
+	case constants.RemoveAllowlistButtonCustomID:
+		m.handleAllowlistModal(event, constants.RemoveAllowlistModalCustomID, "Remove Allowlisted Group",
+			"Enter why this group is being removed...")
+	}
+}
+
+// handleAllowlistModal opens a modal for entering a group ID and a reason.
+func (m *AllowlistMenu) handleAllowlistModal(event *events.ComponentInteractionCreate, customID, title, reasonPlaceholder string) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(customID).
+		SetTitle(title).
+		AddActionRow(
+			discord.NewTextInput(constants.AllowlistGroupInputCustomID, discord.TextInputStyleShort, "Group ID").
+				WithRequired(true).
+				WithPlaceholder("Enter the Roblox group ID..."),
+		).
+		AddActionRow(
+			discord.NewTextInput(constants.AllowlistReasonInputCustomID, discord.TextInputStyleParagraph, "Reason").
+				WithRequired(true).
+				WithPlaceholder(reasonPlaceholder).
+				WithMaxLength(512),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create group allowlist modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the group allowlist modal. Please try again.")
+	}
+}
+
+// handleModal processes modal submissions.
+func (m *AllowlistMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	switch event.Data.CustomID {
+	case constants.AddAllowlistModalCustomID:
+		m.handleAddModalSubmit(event, s)
+	case constants.RemoveAllowlistModalCustomID:
+		m.handleRemoveModalSubmit(event, s)
+	}
+}
+
+// handleAddModalSubmit adds the submitted group to the allowlist.
+func (m *AllowlistMenu) handleAddModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	groupID, err := strconv.ParseUint(strings.TrimSpace(event.Data.Text(constants.AllowlistGroupInputCustomID)), 10, 64)
+	if err != nil {
+		m.Show(event, s, "Invalid group ID format.")
+		return
+	}
+	reason := strings.TrimSpace(event.Data.Text(constants.AllowlistReasonInputCustomID))
+	if reason == "" {
+		m.Show(event, s, "A reason is required to allowlist a group.")
+		return
+	}
+
+	group := &types.AllowlistedGroup{
+		ID:      groupID,
+		Reason:  reason,
+		AddedBy: uint64(event.User().ID),
+		AddedAt: time.Now(),
+	}
+	if err := m.layout.db.GroupAllowlist().Add(context.Background(), group); err != nil {
+		m.layout.logger.Error("Failed to add allowlisted group", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to allowlist the group. Please try again.")
+		return
+	}
+
+	m.logAllowlistChange(event, groupID, true, reason)
+
+	m.Show(event, s, fmt.Sprintf("Group %d is now allowlisted.", groupID))
+}
+
+// handleRemoveModalSubmit removes the submitted group from the allowlist.
+func (m *AllowlistMenu) handleRemoveModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	groupID, err := strconv.ParseUint(strings.TrimSpace(event.Data.Text(constants.AllowlistGroupInputCustomID)), 10, 64)
+	if err != nil {
+		m.Show(event, s, "Invalid group ID format.")
+		return
+	}
+	reason := strings.TrimSpace(event.Data.Text(constants.AllowlistReasonInputCustomID))
+	if reason == "" {
+		m.Show(event, s, "A reason is required to remove a group from the allowlist.")
+		return
+	}
+
+	removed, err := m.layout.db.GroupAllowlist().Remove(context.Background(), groupID)
+	if err != nil {
+		m.layout.logger.Error("Failed to remove allowlisted group", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to remove the group from the allowlist. Please try again.")
+		return
+	}
+
+	if !removed {
+		m.Show(event, s, fmt.Sprintf("Group %d is not allowlisted.", groupID))
+		return
+	}
+
+	m.logAllowlistChange(event, groupID, false, reason)
+
+	m.Show(event, s, fmt.Sprintf("Group %d is no longer allowlisted.", groupID))
+}
+
+// logAllowlistChange records an allowlist change in the activity log.
+func (m *AllowlistMenu) logAllowlistChange(event *events.ModalSubmitInteractionCreate, groupID uint64, allowlisted bool, reason string) {
+	m.layout.logger.Info("Group allowlist changed",
+		zap.Uint64("group_id", groupID),
+		zap.Bool("allowlisted", allowlisted),
+		zap.Uint64("admin_id", uint64(event.User().ID)))
+
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			GroupID: groupID,
+		},
+		ReviewerID:        uint64(event.User().ID),
+		ActivityType:      enum.ActivityTypeGroupAllowlisted,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]interface{}{
+			"allowlisted": allowlisted,
+			"reason":      reason,
+		},
+	})
+}
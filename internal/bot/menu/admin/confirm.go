@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -56,6 +57,10 @@ func (m *ConfirmMenu) handleButton(event *events.ComponentInteractionCreate, s *
 
 // handleConfirm processes the confirmation action.
 func (m *ConfirmMenu) handleConfirm(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	action := s.GetString(constants.SessionKeyAdminAction)
 	id := s.GetString(constants.SessionKeyAdminActionID)
 	reason := s.GetString(constants.SessionKeyAdminReason)
@@ -67,6 +72,8 @@ func (m *ConfirmMenu) handleConfirm(event *events.ComponentInteractionCreate, s
 		m.handleUnbanUser(event, s, id, reason)
 	case constants.DeleteUserAction:
 		m.handleDeleteUser(event, s, id, reason)
+	case constants.RestoreUserAction:
+		m.handleRestoreUser(event, s, id, reason)
 	case constants.DeleteGroupAction:
 		m.handleDeleteGroup(event, s, id, reason)
 	}
@@ -116,7 +123,7 @@ func (m *ConfirmMenu) handleBanUser(event *events.ComponentInteractionCreate, s
 	}
 
 	// Log the ban action
-	go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			DiscordID: id,
 		},
@@ -159,7 +166,7 @@ func (m *ConfirmMenu) handleUnbanUser(event *events.ComponentInteractionCreate,
 	}
 
 	// Log the unban action
-	go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			DiscordID: id,
 		},
@@ -184,7 +191,7 @@ func (m *ConfirmMenu) handleDeleteUser(event *events.ComponentInteractionCreate,
 	}
 
 	// Delete user
-	found, err := m.layout.db.Users().DeleteUser(context.Background(), id)
+	found, err := m.layout.db.Users().DeleteUser(context.Background(), id, uint64(event.User().ID))
 	if err != nil {
 		m.layout.logger.Error("Failed to delete user",
 			zap.Error(err),
@@ -200,7 +207,7 @@ func (m *ConfirmMenu) handleDeleteUser(event *events.ComponentInteractionCreate,
 	}
 
 	// Log the deletion
-	go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			UserID: id,
 		},
@@ -208,11 +215,59 @@ func (m *ConfirmMenu) handleDeleteUser(event *events.ComponentInteractionCreate,
 		ActivityType:      enum.ActivityTypeUserDeleted,
 		ActivityTimestamp: time.Now(),
 		Details: map[string]interface{}{
-			"reason": reason,
+			"reason":   reason,
+			"archived": true,
+		},
+	})
+
+	m.layout.paginationManager.NavigateBack(event, s, fmt.Sprintf("Successfully deleted user %d. It can be restored from the admin menu.", id))
+}
+
+// handleRestoreUser processes the deleted user restore action.
+func (m *ConfirmMenu) handleRestoreUser(event *events.ComponentInteractionCreate, s *session.Session, idStr string, reason string) {
+	// Parse ID from modal
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		m.layout.paginationManager.RespondWithError(event, "Invalid ID format.")
+		return
+	}
+
+	// Restore user
+	deleted, err := m.layout.db.Users().RestoreDeletedUser(context.Background(), id)
+	switch {
+	case errors.Is(err, types.ErrUserNotDeleted):
+		m.layout.paginationManager.NavigateBack(event, s, "User ID not found among deleted users.")
+		return
+	case errors.Is(err, types.ErrUserExists):
+		m.layout.paginationManager.NavigateBack(event, s, "User was added to the database again since being deleted.")
+		return
+	case errors.Is(err, types.ErrUserProtected):
+		m.layout.paginationManager.NavigateBack(event, s, "User is a protected account and cannot be flagged again.")
+		return
+	case err != nil:
+		m.layout.logger.Error("Failed to restore user",
+			zap.Error(err),
+			zap.Uint64("id", id))
+		m.layout.paginationManager.RespondWithError(event, "Failed to restore user. Please try again.")
+		return
+	}
+
+	// Log the restore
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: id,
+		},
+		ReviewerID:        uint64(event.User().ID),
+		ActivityType:      enum.ActivityTypeUserRestored,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]interface{}{
+			"reason":     reason,
+			"deleted_at": deleted.DeletedAt,
+			"deleted_by": deleted.DeletedBy,
 		},
 	})
 
-	m.layout.paginationManager.NavigateBack(event, s, fmt.Sprintf("Successfully deleted user %d.", id))
+	m.layout.paginationManager.NavigateBack(event, s, fmt.Sprintf("Successfully restored user %d to the flagged users.", id))
 }
 
 // handleDeleteGroup processes the group deletion action.
@@ -241,7 +296,7 @@ func (m *ConfirmMenu) handleDeleteGroup(event *events.ComponentInteractionCreate
 	}
 
 	// Log the deletion
-	go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			GroupID: id,
 		},
@@ -17,6 +17,9 @@ type Layout struct {
 	logger            *zap.Logger
 	mainMenu          *MainMenu
 	confirmMenu       *ConfirmMenu
+	protectedMenu     *ProtectedMenu
+	allowlistMenu     *AllowlistMenu
+	trendsMenu        *TrendsMenu
 	settingLayout     interfaces.SettingLayout
 }
 
@@ -40,10 +43,16 @@ func New(
 	// Initialize menus with reference to this layout
 	l.mainMenu = NewMainMenu(l)
 	l.confirmMenu = NewConfirmMenu(l)
+	l.protectedMenu = NewProtectedMenu(l)
+	l.allowlistMenu = NewAllowlistMenu(l)
+	l.trendsMenu = NewTrendsMenu(l)
 
 	// Register pages with the pagination manager
 	paginationManager.AddPage(l.mainMenu.page)
 	paginationManager.AddPage(l.confirmMenu.page)
+	paginationManager.AddPage(l.protectedMenu.page)
+	paginationManager.AddPage(l.allowlistMenu.page)
+	paginationManager.AddPage(l.trendsMenu.page)
 
 	return l
 }
@@ -1,8 +1,11 @@
 package admin
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
@@ -12,6 +15,8 @@ import (
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
 	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"go.uber.org/zap"
 )
 
@@ -47,17 +52,113 @@ func (m *MainMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s
 	switch option {
 	case constants.BotSettingsButtonCustomID:
 		m.layout.settingLayout.ShowBot(event, s)
+	case constants.ProtectedButtonCustomID:
+		m.layout.protectedMenu.Show(event, s, "")
+	case constants.AllowlistButtonCustomID:
+		m.layout.allowlistMenu.Show(event, s, "")
+	case constants.TrendsButtonCustomID:
+		m.layout.trendsMenu.Show(event, s)
+	case constants.ToggleReadOnlyCustomID:
+		m.handleToggleReadOnly(event, s)
+	case constants.RefreshViewsCustomID:
+		m.handleRefreshViews(event, s)
 	case constants.BanUserButtonCustomID:
 		m.handleBanUserModal(event)
 	case constants.UnbanUserButtonCustomID:
 		m.handleUnbanUserModal(event)
 	case constants.DeleteUserButtonCustomID:
 		m.handleDeleteUserModal(event)
+	case constants.RestoreUserButtonCustomID:
+		m.handleRestoreUserModal(event)
 	case constants.DeleteGroupButtonCustomID:
 		m.handleDeleteGroupModal(event)
 	}
 }
 
+// handleToggleReadOnly switches read-only mode on or off and logs the change.
+func (m *MainMenu) handleToggleReadOnly(event *events.ComponentInteractionCreate, s *session.Session) {
+	enabled := !s.GetBool(constants.SessionKeyReadOnly)
+
+	// Read-only mode set in the config file cannot be lifted at runtime
+	if !enabled && m.layout.sessionManager.IsReadOnlyForced() {
+		m.Show(event, s, "Read-only mode is enabled in the bot config and cannot be disabled here.")
+		return
+	}
+
+	if err := m.layout.sessionManager.SetReadOnly(context.Background(), enabled); err != nil {
+		m.layout.logger.Error("Failed to toggle read-only mode", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to toggle read-only mode. Please try again.")
+		return
+	}
+	s.Set(constants.SessionKeyReadOnly, enabled)
+
+	// Log the toggle
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		ReviewerID:        uint64(event.User().ID),
+		ActivityType:      enum.ActivityTypeReadOnlyToggled,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]interface{}{
+			"enabled": enabled,
+		},
+	})
+
+	if enabled {
+		m.Show(event, s, "Read-only mode enabled. All changes are now refused.")
+	} else {
+		m.Show(event, s, "Read-only mode disabled. Changes are allowed again.")
+	}
+}
+
+// handleRefreshViews refreshes every leaderboard view right away instead of waiting
+// for them to become stale, such as after a change to how votes are verified.
+func (m *MainMenu) handleRefreshViews(event *events.ComponentInteractionCreate, s *session.Session) {
+	start := time.Now()
+	results, err := m.layout.db.Views().ForceRefreshAll(context.Background())
+	if err != nil {
+		m.layout.logger.Error("Failed to refresh materialized views", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to refresh the leaderboard views. Please try again.")
+		return
+	}
+	total := time.Since(start)
+
+	// Log the refresh
+	refreshed := make([]string, 0, len(results))
+	skipped := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Skipped {
+			skipped = append(skipped, result.Period.String())
+		} else {
+			refreshed = append(refreshed, result.Period.String())
+		}
+	}
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		ReviewerID:        uint64(event.User().ID),
+		ActivityType:      enum.ActivityTypeViewsRefreshed,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]interface{}{
+			"refreshed": refreshed,
+			"skipped":   skipped,
+			"duration":  total.String(),
+		},
+	})
+
+	m.Show(event, s, formatViewRefreshResults(results, total))
+}
+
+// formatViewRefreshResults describes how long each view took to refresh.
+func formatViewRefreshResults(results []*types.ViewRefreshResult, total time.Duration) string {
+	parts := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Skipped {
+			parts = append(parts, fmt.Sprintf("%s `already refreshing`", result.Period))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s `%s`", result.Period, result.Duration.Round(time.Millisecond)))
+	}
+	return fmt.Sprintf("Refreshed leaderboard views in %s: %s",
+		total.Round(time.Millisecond), strings.Join(parts, ", "))
+}
+
 // handleBanUserModal opens a modal for entering a user ID to ban.
 func (m *MainMenu) handleBanUserModal(event *events.ComponentInteractionCreate) {
 	modal := discord.NewModalCreateBuilder().
@@ -142,6 +243,30 @@ func (m *MainMenu) handleDeleteUserModal(event *events.ComponentInteractionCreat
 	}
 }
 
+// handleRestoreUserModal opens a modal for entering a deleted user ID to restore.
+func (m *MainMenu) handleRestoreUserModal(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.RestoreUserModalCustomID).
+		SetTitle("Restore User").
+		AddActionRow(
+			discord.NewTextInput(constants.RestoreUserInputCustomID, discord.TextInputStyleShort, "User ID").
+				WithRequired(true).
+				WithPlaceholder("Enter the deleted user ID to restore..."),
+		).
+		AddActionRow(
+			discord.NewTextInput(constants.AdminReasonInputCustomID, discord.TextInputStyleParagraph, "Reason").
+				WithRequired(true).
+				WithPlaceholder("Enter the reason for restoring...").
+				WithMaxLength(512),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create restore user modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the restore user modal. Please try again.")
+	}
+}
+
 // handleDeleteGroupModal opens a modal for entering a group ID to delete.
 func (m *MainMenu) handleDeleteGroupModal(event *events.ComponentInteractionCreate) {
 	modal := discord.NewModalCreateBuilder().
@@ -183,6 +308,8 @@ func (m *MainMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *se
 		m.handleUnbanUserModalSubmit(event, s)
 	case constants.DeleteUserModalCustomID:
 		m.handleDeleteUserModalSubmit(event, s)
+	case constants.RestoreUserModalCustomID:
+		m.handleRestoreUserModalSubmit(event, s)
 	case constants.DeleteGroupModalCustomID:
 		m.handleDeleteGroupModalSubmit(event, s)
 	}
@@ -230,6 +357,16 @@ func (m *MainMenu) handleDeleteUserModalSubmit(event *events.ModalSubmitInteract
 	m.layout.confirmMenu.Show(event, s, constants.DeleteUserAction, "")
 }
 
+// handleRestoreUserModalSubmit processes the user ID input and shows confirmation menu.
+func (m *MainMenu) handleRestoreUserModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	userID := event.Data.Text(constants.RestoreUserInputCustomID)
+	reason := event.Data.Text(constants.AdminReasonInputCustomID)
+
+	s.Set(constants.SessionKeyAdminActionID, userID)
+	s.Set(constants.SessionKeyAdminReason, reason)
+	m.layout.confirmMenu.Show(event, s, constants.RestoreUserAction, "")
+}
+
 // handleDeleteGroupModalSubmit processes the group ID input and shows confirmation menu.
 func (m *MainMenu) handleDeleteGroupModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
 	groupID := event.Data.Text(constants.DeleteGroupInputCustomID)
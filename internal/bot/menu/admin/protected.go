@@ -0,0 +1,199 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	builder "github.com/robalyx/rotector/internal/bot/builder/admin"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"go.uber.org/zap"
+)
+
+// ProtectedMenu handles the interface for managing protected accounts.
+type ProtectedMenu struct {
+	layout *Layout
+	page   *pagination.Page
+}
+
+// NewProtectedMenu creates a ProtectedMenu and sets up its page.
+func NewProtectedMenu(layout *Layout) *ProtectedMenu {
+	m := &ProtectedMenu{layout: layout}
+	m.page = &pagination.Page{
+		Name: "Protected Accounts Menu",
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewProtectedBuilder(s).Build()
+		},
+		ButtonHandlerFunc: m.handleButton,
+		ModalHandlerFunc:  m.handleModal,
+	}
+	return m
+}
+
+// Show loads the protected accounts and displays the management interface.
+func (m *ProtectedMenu) Show(event interfaces.CommonEvent, s *session.Session, content string) {
+	accounts, err := m.layout.db.Protected().GetProtectedAccounts(context.Background())
+	if err != nil {
+		m.layout.logger.Error("Failed to get protected accounts", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to load protected accounts. Please try again.")
+		return
+	}
+
+	s.Set(constants.SessionKeyProtectedAccounts, accounts)
+	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
+}
+
+// handleButton processes button interactions.
+func (m *ProtectedMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
+	switch customID {
+	case constants.BackButtonCustomID:
+		m.layout.paginationManager.NavigateBack(event, s, "")
+	case constants.AddProtectedButtonCustomID:
+		m.handleAddModal(event)
+	case constants.RemoveProtectedButtonCustomID:
+		m.handleRemoveModal(event)
+	}
+}
+
+// handleAddModal opens a modal for entering an account to protect.
+func (m *ProtectedMenu) handleAddModal(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.AddProtectedModalCustomID).
+		SetTitle("Add Protected Account").
+		AddActionRow(
+			discord.NewTextInput(constants.ProtectedUserInputCustomID, discord.TextInputStyleShort, "User ID").
+				WithRequired(true).
+				WithPlaceholder("Enter the Roblox user ID to protect..."),
+		).
+		AddActionRow(
+			discord.NewTextInput(constants.ProtectedNoteInputCustomID, discord.TextInputStyleParagraph, "Note").
+				WithRequired(true).
+				WithPlaceholder("Enter why this account is protected...").
+				WithMaxLength(512),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create add protected account modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the add account modal. Please try again.")
+	}
+}
+
+// handleRemoveModal opens a modal for entering an account to unprotect.
+func (m *ProtectedMenu) handleRemoveModal(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.RemoveProtectedModalCustomID).
+		SetTitle("Remove Protected Account").
+		AddActionRow(
+			discord.NewTextInput(constants.ProtectedUserInputCustomID, discord.TextInputStyleShort, "User ID").
+				WithRequired(true).
+				WithPlaceholder("Enter the Roblox user ID to remove..."),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create remove protected account modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the remove account modal. Please try again.")
+	}
+}
+
+// handleModal processes modal submissions.
+func (m *ProtectedMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	switch event.Data.CustomID {
+	case constants.AddProtectedModalCustomID:
+		m.handleAddModalSubmit(event, s)
+	case constants.RemoveProtectedModalCustomID:
+		m.handleRemoveModalSubmit(event, s)
+	}
+}
+
+// handleAddModalSubmit adds the submitted account to the protected list.
+func (m *ProtectedMenu) handleAddModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	userID, err := strconv.ParseUint(strings.TrimSpace(event.Data.Text(constants.ProtectedUserInputCustomID)), 10, 64)
+	if err != nil {
+		m.Show(event, s, "Invalid user ID format.")
+		return
+	}
+	note := strings.TrimSpace(event.Data.Text(constants.ProtectedNoteInputCustomID))
+
+	account := &types.ProtectedAccount{
+		ID:      userID,
+		Note:    note,
+		AddedBy: uint64(event.User().ID),
+		AddedAt: time.Now(),
+	}
+	if err := m.layout.db.Protected().AddProtectedAccount(context.Background(), account); err != nil {
+		m.layout.logger.Error("Failed to add protected account", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to add the protected account. Please try again.")
+		return
+	}
+
+	m.logProtectedChange(event, userID, true, note)
+
+	m.Show(event, s, fmt.Sprintf("Account %d is now protected.", userID))
+}
+
+// handleRemoveModalSubmit removes the submitted account from the protected list.
+func (m *ProtectedMenu) handleRemoveModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	userID, err := strconv.ParseUint(strings.TrimSpace(event.Data.Text(constants.ProtectedUserInputCustomID)), 10, 64)
+	if err != nil {
+		m.Show(event, s, "Invalid user ID format.")
+		return
+	}
+
+	removed, err := m.layout.db.Protected().RemoveProtectedAccount(context.Background(), userID)
+	if err != nil {
+		m.layout.logger.Error("Failed to remove protected account", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to remove the protected account. Please try again.")
+		return
+	}
+
+	if !removed {
+		m.Show(event, s, fmt.Sprintf("Account %d is not protected.", userID))
+		return
+	}
+
+	m.logProtectedChange(event, userID, false, "")
+
+	m.Show(event, s, fmt.Sprintf("Account %d is no longer protected.", userID))
+}
+
+// logProtectedChange records a protected account change in the activity log.
+func (m *ProtectedMenu) logProtectedChange(event *events.ModalSubmitInteractionCreate, userID uint64, protected bool, note string) {
+	m.layout.logger.Info("Protected account changed",
+		zap.Uint64("user_id", userID),
+		zap.Bool("protected", protected),
+		zap.Uint64("admin_id", uint64(event.User().ID)))
+
+	details := map[string]interface{}{"protected": protected}
+	if note != "" {
+		details["note"] = note
+	}
+
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: userID,
+		},
+		ReviewerID:        uint64(event.User().ID),
+		ActivityType:      enum.ActivityTypeUserProtected,
+		ActivityTimestamp: time.Now(),
+		Details:           details,
+	})
+}
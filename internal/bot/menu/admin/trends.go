@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	builder "github.com/robalyx/rotector/internal/bot/builder/admin"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"go.uber.org/zap"
+)
+
+// TrendsMenu handles the page showing terms rising in recently flagged content.
+type TrendsMenu struct {
+	layout *Layout
+	page   *pagination.Page
+}
+
+// NewTrendsMenu creates a TrendsMenu and sets up its page.
+func NewTrendsMenu(layout *Layout) *TrendsMenu {
+	m := &TrendsMenu{layout: layout}
+	m.page = &pagination.Page{
+		Name: "Trending Terms Menu",
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewTrendsBuilder(s).Build()
+		},
+		ButtonHandlerFunc: m.handleButton,
+	}
+	return m
+}
+
+// Show loads the stored trending terms and displays the first page.
+func (m *TrendsMenu) Show(event interfaces.CommonEvent, s *session.Session) {
+	terms, err := m.layout.db.Trends().GetTrendingTerms(context.Background())
+	if err != nil {
+		m.layout.logger.Error("Failed to get trending terms", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to load trending terms. Please try again.")
+		return
+	}
+
+	// Calculate total pages
+	totalPages := max((len(terms)-1)/constants.TrendingTermsPerPage, 0)
+
+	s.Set(constants.SessionKeyTrendingTerms, terms)
+	s.Set(constants.SessionKeyTotalPages, totalPages)
+	s.Set(constants.SessionKeyPaginationPage, 0)
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, "")
+}
+
+// handleButton processes navigation button interactions.
+func (m *TrendsMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
+	action := utils.ViewerAction(customID)
+	switch action {
+	case utils.ViewerFirstPage, utils.ViewerPrevPage, utils.ViewerNextPage, utils.ViewerLastPage:
+		page := action.ParsePageAction(s, action, s.GetInt(constants.SessionKeyTotalPages))
+		s.Set(constants.SessionKeyPaginationPage, page)
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "")
+	case constants.BackButtonCustomID:
+		m.layout.paginationManager.NavigateBack(event, s, "")
+	}
+}
@@ -0,0 +1,156 @@
+package appeal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+const (
+	// attachmentTimeout is how long downloading an attached image may take.
+	attachmentTimeout = 15 * time.Second
+	// maxAttachmentNameLength is the longest filename kept for an attached image.
+	maxAttachmentNameLength = 100
+
+	// DefaultMaxAttachmentSize is used when no valid attachment size limit is configured.
+	DefaultMaxAttachmentSize int64 = 4 * 1024 * 1024
+)
+
+var (
+	ErrInvalidAttachmentURL  = errors.New("not a Discord attachment link")
+	ErrUnsupportedAttachment = errors.New("attachment is not a PNG, JPEG or WebP image")
+	ErrAttachmentTooLarge    = errors.New("attachment is too large")
+	ErrAttachmentUnavailable = errors.New("attachment could not be downloaded")
+	ErrAttachmentRedirected  = errors.New("redirected away from the Discord CDN")
+)
+
+// attachmentHosts are the Discord CDN hosts that images are downloaded from.
+var attachmentHosts = []string{"cdn.discordapp.com", "media.discordapp.net"}
+
+// newAttachmentClient creates the HTTP client for downloading attached images.
+// Redirects are only followed within the Discord CDN.
+func newAttachmentClient() *http.Client {
+	return &http.Client{
+		Timeout: attachmentTimeout,
+		CheckRedirect: func(req *http.Request, _ []*http.Request) error {
+			if !slices.Contains(attachmentHosts, strings.ToLower(req.URL.Hostname())) {
+				return ErrAttachmentRedirected
+			}
+			return nil
+		},
+	}
+}
+
+// maxAttachmentSize returns the configured size limit in bytes for attached images,
+// falling back to DefaultMaxAttachmentSize if no valid limit is configured.
+func maxAttachmentSize(megabytes int) int64 {
+	if megabytes <= 0 {
+		return DefaultMaxAttachmentSize
+	}
+	return int64(megabytes) * 1024 * 1024
+}
+
+// parseAttachmentURL checks that the link points to a file uploaded to Discord.
+func parseAttachmentURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, ErrInvalidAttachmentURL
+	}
+
+	if u.Scheme != "https" || !slices.Contains(attachmentHosts, strings.ToLower(u.Hostname())) {
+		return nil, ErrInvalidAttachmentURL
+	}
+
+	// Attachment links look like /attachments/{channel}/{attachment}/{filename}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) != 4 || (parts[0] != "attachments" && parts[0] != "ephemeral-attachments") || parts[3] == "" {
+		return nil, ErrInvalidAttachmentURL
+	}
+
+	return u, nil
+}
+
+// readAttachment reads an image of at most maxSize bytes and detects its type from
+// its contents, so a renamed file of another type is rejected.
+func readAttachment(body io.Reader, maxSize int64) ([]byte, string, error) {
+	data, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrAttachmentUnavailable, err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, "", ErrAttachmentTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if _, ok := types.AppealAttachmentExtensions[contentType]; !ok {
+		return nil, "", fmt.Errorf("%w (detected %s)", ErrUnsupportedAttachment, contentType)
+	}
+
+	return data, contentType, nil
+}
+
+// attachmentFilename returns the filename of the attachment link with the extension
+// of the detected image type.
+func attachmentFilename(u *url.URL, contentType string) string {
+	name, err := url.PathUnescape(path.Base(u.Path))
+	if err != nil {
+		name = path.Base(u.Path)
+	}
+	name = strings.TrimSuffix(name, path.Ext(name))
+
+	if runes := []rune(name); len(runes) > maxAttachmentNameLength {
+		name = string(runes[:maxAttachmentNameLength])
+	}
+	if name == "" {
+		name = "image"
+	}
+
+	return name + types.AppealAttachmentExtensions[contentType]
+}
+
+// downloadAttachment downloads the image behind a Discord attachment link,
+// checking that it is a PNG, JPEG or WebP image of at most maxSize bytes.
+func downloadAttachment(ctx context.Context, client *http.Client, rawURL string, maxSize int64) (*types.AppealAttachment, error) {
+	u, err := parseAttachmentURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAttachmentUnavailable, err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAttachmentUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d", ErrAttachmentUnavailable, resp.StatusCode)
+	}
+	if resp.ContentLength > maxSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	data, contentType, err := readAttachment(resp.Body, maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AppealAttachment{
+		Filename:    attachmentFilename(u, contentType),
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package appeal
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pngHeader  = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	jpegHeader = []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")
+	webpHeader = []byte("RIFF\x24\x00\x00\x00WEBPVP8 ")
+	gifHeader  = []byte("GIF89a\x01\x00\x01\x00")
+)
+
+func TestParseAttachmentURL(t *testing.T) {
+	valid := []string{
+		"https://cdn.discordapp.com/attachments/123/456/screenshot.png",
+		"https://cdn.discordapp.com/attachments/123/456/screenshot.png?ex=1&is=2&hm=abc",
+		"https://media.discordapp.net/attachments/123/456/screenshot.webp",
+		"https://CDN.DISCORDAPP.COM/attachments/123/456/screenshot.jpg",
+		"https://cdn.discordapp.com/ephemeral-attachments/123/456/screenshot.png",
+		"  https://cdn.discordapp.com/attachments/123/456/screenshot.png\n",
+	}
+	for _, rawURL := range valid {
+		_, err := parseAttachmentURL(rawURL)
+		require.NoError(t, err, rawURL)
+	}
+
+	invalid := []string{
+		"",
+		"not a link",
+		"http://cdn.discordapp.com/attachments/123/456/screenshot.png",
+		"https://example.com/attachments/123/456/screenshot.png",
+		"https://cdn.discordapp.com.example.com/attachments/123/456/screenshot.png",
+		"https://cdn.discordapp.com/avatars/123/456.png",
+		"https://cdn.discordapp.com/attachments/123/456/",
+		"https://cdn.discordapp.com/attachments/123/456/a/b.png",
+	}
+	for _, rawURL := range invalid {
+		_, err := parseAttachmentURL(rawURL)
+		require.ErrorIs(t, err, ErrInvalidAttachmentURL, rawURL)
+	}
+}
+
+func TestMaxAttachmentSize(t *testing.T) {
+	assert.Equal(t, int64(8*1024*1024), maxAttachmentSize(8))
+
+	// Configs without a valid limit fall back to the default
+	assert.Equal(t, DefaultMaxAttachmentSize, maxAttachmentSize(0))
+	assert.Equal(t, DefaultMaxAttachmentSize, maxAttachmentSize(-1))
+}
+
+func TestReadAttachment(t *testing.T) {
+	tests := []struct {
+		name            string
+		data            []byte
+		wantContentType string
+	}{
+		{name: "png", data: pngHeader, wantContentType: "image/png"},
+		{name: "jpeg", data: jpegHeader, wantContentType: "image/jpeg"},
+		{name: "webp", data: webpHeader, wantContentType: "image/webp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, contentType, err := readAttachment(bytes.NewReader(tt.data), 1024)
+			require.NoError(t, err)
+			assert.Equal(t, tt.data, data)
+			assert.Equal(t, tt.wantContentType, contentType)
+		})
+	}
+
+	t.Run("other image types are rejected", func(t *testing.T) {
+		_, _, err := readAttachment(bytes.NewReader(gifHeader), 1024)
+		require.ErrorIs(t, err, ErrUnsupportedAttachment)
+	})
+
+	t.Run("text renamed to an image is rejected", func(t *testing.T) {
+		_, _, err := readAttachment(strings.NewReader("definitely a png"), 1024)
+		require.ErrorIs(t, err, ErrUnsupportedAttachment)
+	})
+
+	t.Run("images over the size limit are rejected", func(t *testing.T) {
+		data := append(append([]byte{}, pngHeader...), make([]byte, 1024)...)
+
+		_, _, err := readAttachment(bytes.NewReader(data), 1024)
+		require.ErrorIs(t, err, ErrAttachmentTooLarge)
+
+		_, _, err = readAttachment(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+	})
+}
+
+func TestAttachmentFilename(t *testing.T) {
+	tests := []struct {
+		name        string
+		rawURL      string
+		contentType string
+		want        string
+	}{
+		{
+			name:        "keeps the original name",
+			rawURL:      "https://cdn.discordapp.com/attachments/1/2/chat_log.png?ex=1",
+			contentType: "image/png",
+			want:        "chat_log.png",
+		},
+		{
+			name:        "decodes escaped characters",
+			rawURL:      "https://cdn.discordapp.com/attachments/1/2/my%20chat.jpeg",
+			contentType: "image/jpeg",
+			want:        "my chat.jpg",
+		},
+		{
+			name:        "uses the extension of the detected type",
+			rawURL:      "https://cdn.discordapp.com/attachments/1/2/image.png",
+			contentType: "image/webp",
+			want:        "image.webp",
+		},
+		{
+			name:        "shortens long names",
+			rawURL:      "https://cdn.discordapp.com/attachments/1/2/" + strings.Repeat("a", 150) + ".png",
+			contentType: "image/png",
+			want:        strings.Repeat("a", maxAttachmentNameLength) + ".png",
+		},
+		{
+			name:        "names files without a name",
+			rawURL:      "https://cdn.discordapp.com/attachments/1/2/.png",
+			contentType: "image/png",
+			want:        "image.png",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.rawURL)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, attachmentFilename(u, tt.contentType))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package appeal
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/sonic"
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+// UserRecordExport is the JSON document attached when a reviewer exports a user's review record.
+type UserRecordExport struct {
+	ExportedAt   time.Time              `json:"exportedAt"`
+	ExportedBy   uint64                 `json:"exportedBy"`
+	StreamerMode bool                   `json:"streamerMode"`
+	Appeal       *types.Appeal          `json:"appeal"`
+	User         *types.ReviewUser      `json:"user"`
+	ActivityLogs []*types.ActivityLog   `json:"activityLogs"`
+	Messages     []*types.AppealMessage `json:"messages"`
+}
+
+// NewUserRecordExport creates an export of the user's record. When streamer mode is
+// enabled, the usernames and display names shown in the review embed are censored,
+// which covers the user's current and previous names, friends, group names, group
+// owners and games. Any mention of the user's ID or names is censored in the
+// descriptive text of the user, appeal, activity logs and messages. The given
+// values are not modified.
+func NewUserRecordExport(
+	appeal *types.Appeal, user *types.ReviewUser, logs []*types.ActivityLog,
+	messages []*types.AppealMessage, exportedBy uint64, streamerMode bool,
+) *UserRecordExport {
+	export := &UserRecordExport{
+		ExportedAt:   time.Now(),
+		ExportedBy:   exportedBy,
+		StreamerMode: streamerMode,
+		Appeal:       appeal,
+		User:         user,
+		ActivityLogs: logs,
+		Messages:     messages,
+	}
+
+	if !streamerMode {
+		return export
+	}
+
+	targets := append([]string{strconv.FormatUint(user.ID, 10), user.Name, user.DisplayName}, user.PreviousNames...)
+	censor := func(text string) string {
+		return utils.CensorStringsInText(text, true, targets...)
+	}
+
+	// Censor the user details
+	censoredUser := *user
+	censoredUser.Name = utils.CensorString(user.Name, true)
+	censoredUser.DisplayName = utils.CensorString(user.DisplayName, true)
+	censoredUser.Description = censor(user.Description)
+	censoredUser.Reason = censor(user.Reason)
+	censoredUser.PreviousNames = make([]string, len(user.PreviousNames))
+	for i, name := range user.PreviousNames {
+		censoredUser.PreviousNames[i] = utils.CensorString(name, true)
+	}
+	censoredUser.Friends = censorFriends(user.Friends)
+	censoredUser.Groups = censorGroups(user.Groups)
+	censoredUser.Games = censorGames(user.Games)
+	export.User = &censoredUser
+
+	// Censor the appeal review reason
+	if appeal != nil {
+		censoredAppeal := *appeal
+		censoredAppeal.ReviewReason = censor(appeal.ReviewReason)
+		export.Appeal = &censoredAppeal
+	}
+
+	// Censor text values in the activity log details
+	export.ActivityLogs = make([]*types.ActivityLog, len(logs))
+	for i, log := range logs {
+		censoredLog := *log
+		censoredLog.Details = make(map[string]interface{}, len(log.Details))
+		for key, value := range log.Details {
+			if text, ok := value.(string); ok {
+				value = censor(text)
+			}
+			censoredLog.Details[key] = value
+		}
+		export.ActivityLogs[i] = &censoredLog
+	}
+
+	// Censor the appeal messages
+	export.Messages = make([]*types.AppealMessage, len(messages))
+	for i, msg := range messages {
+		censoredMsg := *msg
+		censoredMsg.Content = censor(msg.Content)
+		export.Messages[i] = &censoredMsg
+	}
+
+	return export
+}
+
+// censorFriends returns copies of the friends with their names censored.
+func censorFriends(friends []types.ExtendedFriend) []types.ExtendedFriend {
+	censored := make([]types.ExtendedFriend, len(friends))
+	for i, friend := range friends {
+		friend.Name = utils.CensorString(friend.Name, true)
+		friend.DisplayName = utils.CensorString(friend.DisplayName, true)
+		censored[i] = friend
+	}
+	return censored
+}
+
+// censorGroups returns copies of the groups with the group names and the names of
+// their owners and shout posters censored.
+func censorGroups(groups []*apiTypes.UserGroupRoles) []*apiTypes.UserGroupRoles {
+	censored := make([]*apiTypes.UserGroupRoles, len(groups))
+	for i, group := range groups {
+		censoredGroup := *group
+		censoredGroup.Group.Name = utils.CensorString(group.Group.Name, true)
+		if group.Group.Owner != nil {
+			owner := *group.Group.Owner
+			owner.Username = utils.CensorString(owner.Username, true)
+			owner.DisplayName = utils.CensorString(owner.DisplayName, true)
+			censoredGroup.Group.Owner = &owner
+		}
+		if group.Group.Shout != nil {
+			shout := *group.Group.Shout
+			shout.Poster.Username = utils.CensorString(shout.Poster.Username, true)
+			shout.Poster.DisplayName = utils.CensorString(shout.Poster.DisplayName, true)
+			censoredGroup.Group.Shout = &shout
+		}
+		censored[i] = &censoredGroup
+	}
+	return censored
+}
+
+// censorGames returns copies of the games with their names censored.
+func censorGames(games []*apiTypes.Game) []*apiTypes.Game {
+	censored := make([]*apiTypes.Game, len(games))
+	for i, game := range games {
+		censoredGame := *game
+		censoredGame.Name = utils.CensorString(game.Name, true)
+		censored[i] = &censoredGame
+	}
+	return censored
+}
+
+// Buffer serializes the export to indented JSON.
+func (e *UserRecordExport) Buffer() (*bytes.Buffer, error) {
+	data, err := sonic.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal user record export: %w", err)
+	}
+	return bytes.NewBuffer(data), nil
+}
@@ -0,0 +1,75 @@
+package appeal
+
+import (
+	"testing"
+
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newExportUser() *types.ReviewUser {
+	return &types.ReviewUser{User: types.User{
+		ID:            123456789,
+		Name:          "suspicious_user",
+		DisplayName:   "Suspicious",
+		PreviousNames: []string{"old_username"},
+		Reason:        "Renamed from old_username",
+		Friends: []types.ExtendedFriend{
+			{Friend: apiTypes.Friend{ID: 1}, Name: "friend_name", DisplayName: "Friend Display"},
+		},
+		Groups: []*apiTypes.UserGroupRoles{{
+			Group: apiTypes.GroupResponse{
+				ID:    2,
+				Name:  "Condo Group",
+				Owner: &apiTypes.GroupUser{UserID: 3, Username: "owner_name", DisplayName: "Owner Display"},
+				Shout: &apiTypes.GroupShout{Body: "join", Poster: apiTypes.GroupUser{Username: "poster_name", DisplayName: "Poster"}},
+			},
+		}},
+		Games: []*apiTypes.Game{{ID: 4, Name: "Hangout Game"}},
+	}}
+}
+
+func TestUserRecordExportStreamerMode(t *testing.T) {
+	user := newExportUser()
+
+	export := NewUserRecordExport(nil, user, nil, nil, 42, true)
+	censored := export.User
+
+	// Every name the review embed censors is censored in the export
+	assert.Equal(t, utils.CensorString("suspicious_user", true), censored.Name)
+	assert.Equal(t, utils.CensorString("Suspicious", true), censored.DisplayName)
+	assert.Equal(t, []string{utils.CensorString("old_username", true)}, censored.PreviousNames)
+	assert.NotContains(t, censored.Reason, "old_username")
+
+	require.Len(t, censored.Friends, 1)
+	assert.Equal(t, utils.CensorString("friend_name", true), censored.Friends[0].Name)
+	assert.Equal(t, utils.CensorString("Friend Display", true), censored.Friends[0].DisplayName)
+
+	require.Len(t, censored.Groups, 1)
+	group := censored.Groups[0].Group
+	assert.Equal(t, utils.CensorString("Condo Group", true), group.Name)
+	assert.Equal(t, utils.CensorString("owner_name", true), group.Owner.Username)
+	assert.Equal(t, utils.CensorString("Owner Display", true), group.Owner.DisplayName)
+	assert.Equal(t, utils.CensorString("poster_name", true), group.Shout.Poster.Username)
+
+	require.Len(t, censored.Games, 1)
+	assert.Equal(t, utils.CensorString("Hangout Game", true), censored.Games[0].Name)
+
+	// The user being exported is left untouched
+	fresh := newExportUser()
+	assert.Equal(t, fresh.PreviousNames, user.PreviousNames)
+	assert.Equal(t, fresh.Friends, user.Friends)
+	assert.Equal(t, fresh.Groups, user.Groups)
+	assert.Equal(t, fresh.Games, user.Games)
+}
+
+func TestUserRecordExportWithoutStreamerMode(t *testing.T) {
+	user := newExportUser()
+
+	export := NewUserRecordExport(nil, user, nil, nil, 42, false)
+
+	assert.Same(t, user, export.User)
+}
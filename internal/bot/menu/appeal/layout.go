@@ -1,20 +1,28 @@
 package appeal
 
 import (
+	"net/http"
+
 	"github.com/jaxron/roapi.go/pkg/api"
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
 	"github.com/robalyx/rotector/internal/common/setup"
-	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/setup/config"
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
 	"go.uber.org/zap"
 )
 
 // Layout handles the appeal menu and its dependencies.
 type Layout struct {
-	db                *database.Client
+	users             store.UserStore
+	appeals           store.AppealStore
+	activity          store.ActivityStore
+	settings          store.SettingStore
 	roAPI             *api.API
+	httpClient        *http.Client
 	logger            *zap.Logger
+	config            config.Appeals
 	sessionManager    *session.Manager
 	paginationManager *pagination.Manager
 	overviewMenu      *OverviewMenu
@@ -33,9 +41,14 @@ func New(
 ) *Layout {
 	// Initialize layout
 	l := &Layout{
-		db:                app.DB,
+		users:             app.DB.Users(),
+		appeals:           app.DB.Appeals(),
+		activity:          app.DB.Activity(),
+		settings:          app.DB.Settings(),
 		roAPI:             app.RoAPI,
+		httpClient:        newAttachmentClient(),
 		logger:            app.Logger,
+		config:            app.Config.Bot.Appeals,
 		sessionManager:    sessionManager,
 		paginationManager: paginationManager,
 		userReviewLayout:  userReviewLayout,
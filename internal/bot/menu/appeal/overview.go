@@ -3,7 +3,9 @@ package appeal
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
@@ -59,7 +61,7 @@ func (m *OverviewMenu) Show(event interfaces.CommonEvent, s *session.Session, co
 	userID := uint64(event.User().ID)
 	if settings.IsReviewer(userID) {
 		// Reviewers can see all appeals
-		appeals, firstCursor, nextCursor, err = m.layout.db.Appeals().GetAppealsToReview(
+		appeals, firstCursor, nextCursor, err = m.layout.appeals.GetAppealsToReview(
 			context.Background(),
 			userSettings.AppealDefaultSort,
 			userSettings.AppealStatusFilter,
@@ -69,7 +71,7 @@ func (m *OverviewMenu) Show(event interfaces.CommonEvent, s *session.Session, co
 		)
 	} else {
 		// Regular users only see their own appeals
-		appeals, firstCursor, nextCursor, err = m.layout.db.Appeals().GetAppealsByRequester(
+		appeals, firstCursor, nextCursor, err = m.layout.appeals.GetAppealsByRequester(
 			context.Background(),
 			userSettings.AppealStatusFilter,
 			userID,
@@ -115,7 +117,7 @@ func (m *OverviewMenu) handleSelectMenu(event *events.ComponentInteractionCreate
 
 		// Update user's default sort preference
 		settings.AppealStatusFilter = status
-		if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+		if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
 			m.layout.logger.Error("Failed to save user settings", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to save sort order. Please try again.")
 			return
@@ -140,7 +142,7 @@ func (m *OverviewMenu) handleSelectMenu(event *events.ComponentInteractionCreate
 
 		// Update user's default sort preference
 		settings.AppealDefaultSort = sortBy
-		if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+		if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
 			m.layout.logger.Error("Failed to save user settings", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to save sort order. Please try again.")
 			return
@@ -187,7 +189,7 @@ func (m *OverviewMenu) handleCreateAppeal(event *events.ComponentInteractionCrea
 		AddActionRow(
 			discord.NewTextInput(constants.AppealUserInputCustomID, discord.TextInputStyleShort, "User ID").
 				WithRequired(true).
-				WithPlaceholder("Enter the user ID to appeal..."),
+				WithPlaceholder("Enter the user ID or profile link to appeal..."),
 		).
 		AddActionRow(
 			discord.NewTextInput(constants.AppealReasonInputCustomID, discord.TextInputStyleParagraph, "Appeal Reason").
@@ -248,28 +250,19 @@ func (m *OverviewMenu) handleModal(event *events.ModalSubmitInteractionCreate, s
 
 // handleCreateAppealModalSubmit processes the appeal creation form submission.
 func (m *OverviewMenu) handleCreateAppealModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
-	// Get and validate the user ID input
-	userIDStr := event.Data.Text(constants.AppealUserInputCustomID)
-	userID, err := strconv.ParseUint(userIDStr, 10, 64)
-	if err != nil {
-		m.layout.paginationManager.NavigateTo(event, s, m.page, "Invalid user ID format. Please enter a valid number.")
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
 		return
 	}
 
-	// Check if the user ID already has a pending appeal
-	exists, err := m.layout.db.Appeals().HasPendingAppealByUserID(context.Background(), userID)
+	// Get and validate the user ID input
+	userID, err := utils.ParseRobloxUserID(event.Data.Text(constants.AppealUserInputCustomID))
 	if err != nil {
-		m.layout.logger.Error("Failed to check pending appeals for user", zap.Error(err))
-		m.layout.paginationManager.RespondWithError(event, "Failed to check pending appeals. Please try again.")
-		return
-	}
-	if exists {
-		m.layout.paginationManager.NavigateTo(event, s, m.page, "This user ID already has a pending appeal. Please wait for it to be reviewed.")
+		m.layout.paginationManager.NavigateTo(event, s, m.page, fmt.Sprintf("Invalid user ID: %v.", err))
 		return
 	}
 
 	// Check if the Discord user already has a pending appeal
-	exists, err = m.layout.db.Appeals().HasPendingAppealByRequester(context.Background(), uint64(event.User().ID))
+	exists, err := m.layout.appeals.HasPendingAppealByRequester(context.Background(), uint64(event.User().ID))
 	if err != nil {
 		m.layout.logger.Error("Failed to check pending appeals", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to check pending appeals. Please try again.")
@@ -280,21 +273,28 @@ func (m *OverviewMenu) handleCreateAppealModalSubmit(event *events.ModalSubmitIn
 		return
 	}
 
-	// Check if the user ID has been previously rejected
-	hasRejection, err := m.layout.db.Appeals().HasPreviousRejection(context.Background(), userID)
-	if err != nil {
-		m.layout.logger.Error("Failed to check previous rejections", zap.Error(err))
-		m.layout.paginationManager.RespondWithError(event, "Failed to check appeal history. Please try again.")
-		return
-	}
-	if hasRejection {
-		m.layout.paginationManager.NavigateTo(event, s, m.page,
-			"This user ID has a rejected appeal in the last 7 days. Please wait before submitting a new appeal.")
-		return
+	// Check if the user ID is within the cooldown of a rejected appeal.
+	// Reviewers creating an appeal on someone's behalf bypass the cooldown.
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+
+	if !botSettings.IsReviewer(uint64(event.User().ID)) && botSettings.AppealCooldownDays > 0 {
+		cooldown := time.Duration(botSettings.AppealCooldownDays) * 24 * time.Hour
+		hasRejection, cooldownEnd, err := m.layout.appeals.HasRecentRejection(context.Background(), userID, cooldown)
+		if err != nil {
+			m.layout.logger.Error("Failed to check recent rejections", zap.Error(err))
+			m.layout.paginationManager.RespondWithError(event, "Failed to check appeal history. Please try again.")
+			return
+		}
+		if hasRejection {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, fmt.Sprintf(
+				"This user ID had an appeal rejected recently. A new appeal can be submitted <t:%d:R>.", cooldownEnd.Unix()))
+			return
+		}
 	}
 
 	// Verify user exists in database
-	user, err := m.layout.db.Users().GetUserByID(context.Background(), userIDStr, types.UserFields{})
+	user, err := m.layout.users.GetUserByID(context.Background(), strconv.FormatUint(userID, 10), types.UserFields{})
 	if err != nil {
 		if errors.Is(err, types.ErrUserNotFound) {
 			m.layout.paginationManager.NavigateTo(event, s, m.page, "Cannot submit appeal - user is not in our database.")
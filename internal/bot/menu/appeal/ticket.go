@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
@@ -15,11 +16,19 @@ import (
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
 	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/setup/config"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"go.uber.org/zap"
 )
 
+const (
+	// DefaultUserMessageInterval is used when no user message interval is configured.
+	DefaultUserMessageInterval = 60 * time.Second
+	// DefaultModeratorMessageInterval is used when no moderator message interval is configured.
+	DefaultModeratorMessageInterval time.Duration = 0
+)
+
 // TicketMenu handles the display and interaction logic for individual appeal tickets.
 type TicketMenu struct {
 	layout *Layout
@@ -32,7 +41,7 @@ func NewTicketMenu(layout *Layout) *TicketMenu {
 	m.page = &pagination.Page{
 		Name: "Appeal Ticket",
 		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
-			return builder.NewTicketBuilder(s).Build()
+			return builder.NewTicketBuilder(s, layout.appeals).Build()
 		},
 		ButtonHandlerFunc: m.handleButton,
 		ModalHandlerFunc:  m.handleModal,
@@ -62,43 +71,49 @@ func (m *TicketMenu) Show(event interfaces.CommonEvent, s *session.Session, appe
 	}
 
 	// If appeal is pending, check if user's status has changed
-	if appeal.Status == enum.AppealStatusPending { //nolint:nestif
+	var thumbnailURL string
+	if appeal.Status == enum.AppealStatusPending {
 		// Get current user status
-		user, err := m.layout.db.Users().GetUserByID(context.Background(), strconv.FormatUint(appeal.UserID, 10), types.UserFields{})
+		user, closedReason, err := m.checkPendingAppeal(context.Background(), appeal)
 		if err != nil {
-			if !errors.Is(err, types.ErrUserNotFound) {
-				m.layout.logger.Error("Failed to get user status", zap.Error(err))
-				m.layout.paginationManager.RespondWithError(event, "Failed to verify user status. Please try again.")
-				return
-			}
-
-			// User no longer exists, auto-reject the appeal
-			if err := m.layout.db.Appeals().RejectAppeal(context.Background(), appeal.ID, 0, "User no longer exists in database."); err != nil {
-				m.layout.logger.Error("Failed to auto-reject appeal", zap.Error(err))
-			}
-			m.layout.ShowOverview(event, s, "Appeal automatically closed: User no longer exists in database.")
+			m.layout.logger.Error("Failed to get user status", zap.Error(err))
+			m.layout.paginationManager.RespondWithError(event, "Failed to verify user status. Please try again.")
 			return
 		}
-
-		if user.Status != enum.UserTypeConfirmed && user.Status != enum.UserTypeFlagged {
-			// User is no longer flagged or confirmed, auto-reject the appeal
-			reason := fmt.Sprintf("User status changed to %s", user.Status)
-			if err := m.layout.db.Appeals().RejectAppeal(context.Background(), appeal.ID, 0, reason); err != nil {
-				m.layout.logger.Error("Failed to auto-reject appeal", zap.Error(err))
-			}
-			m.layout.ShowOverview(event, s, "Appeal automatically closed: User status changed to "+user.Status.String())
+		if closedReason != "" {
+			m.layout.ShowOverview(event, s, "Appeal automatically closed: "+closedReason)
 			return
 		}
+
+		thumbnailURL = user.ThumbnailURL
+	} else {
+		// Get thumbnail for closed appeals without affecting the user's status
+		users, err := m.layout.users.GetUsersByIDs(context.Background(), []uint64{appeal.UserID}, types.UserFields{Thumbnail: true})
+		if err != nil {
+			m.layout.logger.Error("Failed to get user thumbnail", zap.Error(err))
+		} else if user, ok := users[appeal.UserID]; ok {
+			thumbnailURL = user.ThumbnailURL
+		}
 	}
 
 	// Get messages for the appeal
-	messages, err := m.layout.db.Appeals().GetAppealMessages(context.Background(), appealID)
+	messages, err := m.layout.appeals.GetAppealMessages(context.Background(), appealID)
 	if err != nil {
 		m.layout.logger.Error("Failed to get appeal messages", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to load appeal messages. Please try again.")
 		return
 	}
 
+	// Hide system notes from anyone who is not a reviewer
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+
+	if !botSettings.IsReviewer(uint64(event.User().ID)) {
+		messages = slices.DeleteFunc(messages, func(msg *types.AppealMessage) bool {
+			return msg.Role == enum.MessageRoleSystem
+		})
+	}
+
 	// Calculate total pages
 	totalPages := (len(messages) - 1) / constants.AppealMessagesPerPage
 	if totalPages < 0 {
@@ -108,12 +123,43 @@ func (m *TicketMenu) Show(event interfaces.CommonEvent, s *session.Session, appe
 	// Store data in session
 	s.Set(constants.SessionKeyAppeal, appeal)
 	s.Set(constants.SessionKeyAppealMessages, messages)
+	s.Set(constants.SessionKeyAppealThumbnail, thumbnailURL)
 	s.Set(constants.SessionKeyTotalPages, totalPages)
 	s.Set(constants.SessionKeyPaginationPage, 0) // Reset to first page
 
 	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
 }
 
+// checkPendingAppeal gets the user of a pending appeal and rejects the appeal
+// automatically if the user no longer exists or is no longer flagged or confirmed.
+// The reason the appeal was closed is returned, or an empty string if it is still open.
+func (m *TicketMenu) checkPendingAppeal(ctx context.Context, appeal *types.Appeal) (*types.ReviewUser, string, error) {
+	user, err := m.layout.users.GetUserByID(ctx, strconv.FormatUint(appeal.UserID, 10), types.UserFields{})
+	if err != nil {
+		if !errors.Is(err, types.ErrUserNotFound) {
+			return nil, "", err
+		}
+
+		// User no longer exists, auto-reject the appeal
+		reason := "User no longer exists in database."
+		if err := m.layout.appeals.RejectAppeal(ctx, appeal.ID, 0, reason); err != nil {
+			m.layout.logger.Error("Failed to auto-reject appeal", zap.Error(err))
+		}
+		return nil, reason, nil
+	}
+
+	if user.Status != enum.UserTypeConfirmed && user.Status != enum.UserTypeFlagged {
+		// User is no longer flagged or confirmed, auto-reject the appeal
+		reason := "User status changed to " + user.Status.String()
+		if err := m.layout.appeals.RejectAppeal(ctx, appeal.ID, 0, reason); err != nil {
+			m.layout.logger.Error("Failed to auto-reject appeal", zap.Error(err))
+		}
+		return user, reason, nil
+	}
+
+	return user, "", nil
+}
+
 // handleButton processes button interactions.
 func (m *TicketMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
 	action := utils.ViewerAction(customID)
@@ -130,44 +176,162 @@ func (m *TicketMenu) handleButton(event *events.ComponentInteractionCreate, s *s
 	case constants.BackButtonCustomID:
 		m.layout.paginationManager.NavigateBack(event, s, "")
 	case constants.AppealRespondButtonCustomID:
-		m.handleRespond(event)
+		m.handleRespond(event, s, false)
+	case constants.AppealAttachButtonCustomID:
+		m.handleRespond(event, s, true)
+	case constants.AppealClaimButtonCustomID:
+		m.handleClaimAppeal(event, s)
+	case constants.AppealUnclaimButtonCustomID:
+		m.handleUnclaimAppeal(event, s)
 	case constants.AppealLookupUserButtonCustomID:
 		m.handleLookupUser(event, s)
 	case constants.AcceptAppealButtonCustomID:
 		m.handleAcceptAppeal(event)
+	case constants.ReturnAppealButtonCustomID:
+		m.handleReturnAppeal(event)
 	case constants.RejectAppealButtonCustomID:
 		m.handleRejectAppeal(event)
 	case constants.AppealCloseButtonCustomID:
 		m.handleCloseAppeal(event, s)
+	case constants.AppealExportButtonCustomID:
+		m.handleExportRecord(event, s)
 	}
 }
 
-// handleRespond opens a modal for responding to the appeal.
-func (m *TicketMenu) handleRespond(event *events.ComponentInteractionCreate) {
+// handleRespond opens a modal for responding to the appeal, optionally with an image
+// attached. Reviewers are warned in the title if another reviewer has claimed the appeal.
+func (m *TicketMenu) handleRespond(event *events.ComponentInteractionCreate, s *session.Session, withAttachment bool) {
+	var appeal *types.Appeal
+	s.GetInterface(constants.SessionKeyAppeal, &appeal)
+
+	title := "Respond to Appeal"
+	userID := uint64(event.User().ID)
+	if appeal.ClaimedBy != 0 && appeal.ClaimedBy != userID && appeal.RequesterID != userID {
+		title = "Respond (claimed by another reviewer)"
+	}
+
 	modal := discord.NewModalCreateBuilder().
 		SetCustomID(constants.AppealRespondModalCustomID).
-		SetTitle("Respond to Appeal").
+		SetTitle(title).
 		AddActionRow(
 			discord.NewTextInput(constants.AppealReasonInputCustomID, discord.TextInputStyleParagraph, "Message").
-				WithRequired(true).
+				WithRequired(!withAttachment).
 				WithMaxLength(512).
 				WithPlaceholder("Type your response..."),
-		).
-		Build()
+		)
+
+	// Images are attached by linking a file uploaded to Discord
+	if withAttachment {
+		modal.SetCustomID(constants.AppealAttachModalCustomID).
+			AddActionRow(
+				discord.NewTextInput(constants.AppealImageInputCustomID, discord.TextInputStyleShort, "Image Link").
+					WithRequired(true).
+					WithPlaceholder("https://cdn.discordapp.com/attachments/..."),
+			)
+	}
 
-	if err := event.Modal(modal); err != nil {
+	if err := event.Modal(modal.Build()); err != nil {
 		m.layout.logger.Error("Failed to create response modal", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to open response modal. Please try again.")
 	}
 }
 
+// handleClaimAppeal assigns the appeal to the reviewer so others know it is being handled.
+func (m *TicketMenu) handleClaimAppeal(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	// Verify the user is a reviewer
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+
+	userID := uint64(event.User().ID)
+	if !botSettings.IsReviewer(userID) {
+		m.layout.logger.Error("Non-reviewer attempted to claim appeal", zap.Uint64("user_id", userID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to claim appeals.")
+		return
+	}
+
+	var appeal *types.Appeal
+	s.GetInterface(constants.SessionKeyAppeal, &appeal)
+
+	err := m.layout.appeals.ClaimAppeal(context.Background(), appeal.ID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrAppealAlreadyClaimed):
+			m.layout.ShowOverview(event, s, "This appeal has already been claimed by another reviewer.")
+		case errors.Is(err, types.ErrInvalidAppealStatus):
+			m.layout.ShowOverview(event, s, "This appeal is no longer pending.")
+		case errors.Is(err, types.ErrAppealNotFound):
+			m.layout.ShowOverview(event, s, "This appeal no longer exists.")
+		default:
+			m.layout.logger.Error("Failed to claim appeal", zap.Error(err))
+			m.layout.paginationManager.RespondWithError(event, "Failed to claim appeal. Please try again.")
+		}
+		return
+	}
+
+	now := time.Now()
+	appeal.ClaimedBy = userID
+	appeal.ClaimedAt = now
+	appeal.ClaimActivity = now
+	m.updateSessionAppeal(s, appeal)
+
+	m.Show(event, s, appeal.ID, "Appeal claimed.")
+}
+
+// handleUnclaimAppeal releases the reviewer's claim so other reviewers can pick up the appeal.
+func (m *TicketMenu) handleUnclaimAppeal(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var appeal *types.Appeal
+	s.GetInterface(constants.SessionKeyAppeal, &appeal)
+
+	err := m.layout.appeals.UnclaimAppeal(context.Background(), appeal.ID, uint64(event.User().ID))
+	if err != nil {
+		if errors.Is(err, types.ErrAppealNotClaimed) {
+			m.layout.ShowOverview(event, s, "You no longer hold the claim on this appeal.")
+			return
+		}
+		m.layout.logger.Error("Failed to unclaim appeal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to unclaim appeal. Please try again.")
+		return
+	}
+
+	appeal.ClaimedBy = 0
+	appeal.ClaimedAt = time.Time{}
+	appeal.ClaimActivity = time.Time{}
+	m.updateSessionAppeal(s, appeal)
+
+	m.Show(event, s, appeal.ID, "Appeal unclaimed.")
+}
+
+// updateSessionAppeal replaces the appeal in the session's appeal list so the
+// ticket and overview reflect changes without reloading the list.
+func (m *TicketMenu) updateSessionAppeal(s *session.Session, appeal *types.Appeal) {
+	var appeals []*types.Appeal
+	s.GetInterface(constants.SessionKeyAppeals, &appeals)
+
+	for i, a := range appeals {
+		if a.ID == appeal.ID {
+			appeals[i] = appeal
+			break
+		}
+	}
+
+	s.Set(constants.SessionKeyAppeals, appeals)
+}
+
 // handleLookupUser opens the review menu for the appealed user.
 func (m *TicketMenu) handleLookupUser(event *events.ComponentInteractionCreate, s *session.Session) {
 	var appeal *types.Appeal
 	s.GetInterface(constants.SessionKeyAppeal, &appeal)
 
 	// Get user from database
-	user, err := m.layout.db.Users().GetUserByID(context.Background(), strconv.FormatUint(appeal.UserID, 10), types.UserFields{})
+	user, err := m.layout.users.GetUserByID(context.Background(), strconv.FormatUint(appeal.UserID, 10), types.UserFields{})
 	if err != nil {
 		if errors.Is(err, types.ErrUserNotFound) {
 			m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to find user. They may not be in our database.")
@@ -183,7 +347,7 @@ func (m *TicketMenu) handleLookupUser(event *events.ComponentInteractionCreate,
 	m.layout.userReviewLayout.ShowReviewMenu(event, s)
 
 	// Log the lookup action
-	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			UserID: user.ID,
 		},
@@ -194,6 +358,64 @@ func (m *TicketMenu) handleLookupUser(event *events.ComponentInteractionCreate,
 	})
 }
 
+// handleExportRecord attaches a JSON export of the appealed user's review record,
+// including their activity logs and the appeal messages.
+func (m *TicketMenu) handleExportRecord(event *events.ComponentInteractionCreate, s *session.Session) {
+	// Verify the user is a reviewer
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+
+	userID := uint64(event.User().ID)
+	if !botSettings.IsReviewer(userID) {
+		m.layout.logger.Error("Non-reviewer attempted to export user record", zap.Uint64("user_id", userID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to export user records.")
+		return
+	}
+
+	var appeal *types.Appeal
+	s.GetInterface(constants.SessionKeyAppeal, &appeal)
+	var messages []*types.AppealMessage
+	s.GetInterface(constants.SessionKeyAppealMessages, &messages)
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	// Get user with all fields
+	user, err := m.layout.users.GetUserByID(context.Background(), strconv.FormatUint(appeal.UserID, 10), types.UserFields{})
+	if err != nil {
+		if errors.Is(err, types.ErrUserNotFound) {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to find user. They may no longer exist in our database.")
+			return
+		}
+		m.layout.logger.Error("Failed to get user for export", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to get user information. Please try again.")
+		return
+	}
+
+	// Get activity logs for the user
+	logs, _, err := m.layout.activity.GetLogs(context.Background(), types.ActivityFilter{
+		UserID:       appeal.UserID,
+		ActivityType: enum.ActivityTypeAll,
+	}, nil, constants.AppealExportLogLimit)
+	if err != nil {
+		m.layout.logger.Error("Failed to get activity logs for export", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to get activity logs. Please try again.")
+		return
+	}
+
+	// Serialize the record
+	buf, err := NewUserRecordExport(appeal, user, logs, messages, userID, settings.StreamerMode).Buffer()
+	if err != nil {
+		m.layout.logger.Error("Failed to export user record", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to export user record. Please try again.")
+		return
+	}
+
+	// Attach the export to this response only
+	s.SetBuffer(constants.SessionKeyAppealExport, buf)
+	m.layout.paginationManager.NavigateTo(event, s, m.page, "User record exported.")
+	s.Delete(constants.SessionKeyAppealExport)
+}
+
 // handleAcceptAppeal opens a modal for accepting the appeal with a reason.
 func (m *TicketMenu) handleAcceptAppeal(event *events.ComponentInteractionCreate) {
 	modal := discord.NewModalCreateBuilder().
@@ -212,6 +434,24 @@ func (m *TicketMenu) handleAcceptAppeal(event *events.ComponentInteractionCreate
 	}
 }
 
+// handleReturnAppeal opens a modal for accepting the appeal while returning the user to the review queue.
+func (m *TicketMenu) handleReturnAppeal(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.ReturnAppealModalCustomID).
+		SetTitle("Accept and Return to Queue").
+		AddActionRow(
+			discord.NewTextInput(constants.AppealReasonInputCustomID, discord.TextInputStyleParagraph, "Accept Reason").
+				WithRequired(true).
+				WithPlaceholder("Enter why this user should be reviewed again..."),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create return modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open accept modal. Please try again.")
+	}
+}
+
 // handleRejectAppeal opens a modal for rejecting the appeal with a reason.
 func (m *TicketMenu) handleRejectAppeal(event *events.ComponentInteractionCreate) {
 	modal := discord.NewModalCreateBuilder().
@@ -232,6 +472,10 @@ func (m *TicketMenu) handleRejectAppeal(event *events.ComponentInteractionCreate
 
 // handleCloseAppeal handles the user closing their own appeal ticket.
 func (m *TicketMenu) handleCloseAppeal(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	var appeal *types.Appeal
 	s.GetInterface(constants.SessionKeyAppeal, &appeal)
 
@@ -243,7 +487,7 @@ func (m *TicketMenu) handleCloseAppeal(event *events.ComponentInteractionCreate,
 	}
 
 	// Close the appeal by rejecting it
-	err := m.layout.db.Appeals().RejectAppeal(context.Background(), appeal.ID, userID, "Closed by appeal creator")
+	err := m.layout.appeals.RejectAppeal(context.Background(), appeal.ID, userID, "Closed by appeal creator")
 	if err != nil {
 		m.layout.logger.Error("Failed to close appeal",
 			zap.Error(err),
@@ -256,7 +500,7 @@ func (m *TicketMenu) handleCloseAppeal(event *events.ComponentInteractionCreate,
 	m.layout.ShowOverview(event, s, "Appeal closed successfully.")
 
 	// Log the appeal closing
-	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			UserID: appeal.UserID,
 		},
@@ -275,10 +519,12 @@ func (m *TicketMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *
 	s.GetInterface(constants.SessionKeyAppeal, &appeal)
 
 	switch event.Data.CustomID {
-	case constants.AppealRespondModalCustomID:
+	case constants.AppealRespondModalCustomID, constants.AppealAttachModalCustomID:
 		m.handleRespondModalSubmit(event, s, appeal)
 	case constants.AcceptAppealModalCustomID:
 		m.handleAcceptModalSubmit(event, s, appeal)
+	case constants.ReturnAppealModalCustomID:
+		m.handleReturnModalSubmit(event, s, appeal)
 	case constants.RejectAppealModalCustomID:
 		m.handleRejectModalSubmit(event, s, appeal)
 	}
@@ -286,6 +532,10 @@ func (m *TicketMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *
 
 // handleRespondModalSubmit processes the response message submission.
 func (m *TicketMenu) handleRespondModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session, appeal *types.Appeal) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	// Only allow responses for pending appeals
 	if appeal.Status != enum.AppealStatusPending {
 		m.layout.paginationManager.NavigateTo(event, s, m.page, "Cannot respond to a closed appeal.")
@@ -293,12 +543,13 @@ func (m *TicketMenu) handleRespondModalSubmit(event *events.ModalSubmitInteracti
 	}
 
 	content := event.Data.Text(constants.AppealReasonInputCustomID)
-	if content == "" {
+	imageLink := event.Data.Text(constants.AppealImageInputCustomID)
+	if content == "" && imageLink == "" {
 		m.layout.paginationManager.NavigateTo(event, s, m.page, "Response cannot be empty.")
 		return
 	}
 
-	// Get user role and check rate limit
+	// Get user role and check the consecutive message limit
 	var botSettings *types.BotSetting
 	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
 
@@ -318,29 +569,75 @@ func (m *TicketMenu) handleRespondModalSubmit(event *events.ModalSubmitInteracti
 		}
 	}
 
+	// Download and check the attached image
+	var attachment *types.AppealAttachment
+	if imageLink != "" {
+		var errorMsg string
+		attachment, errorMsg = m.fetchAttachment(imageLink)
+		if attachment == nil {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, errorMsg)
+			return
+		}
+	}
+
 	// Create new message
 	message := &types.AppealMessage{
-		AppealID:  appeal.ID,
-		UserID:    userID,
-		Role:      role,
-		Content:   content,
-		CreatedAt: time.Now(),
+		AppealID:   appeal.ID,
+		UserID:     userID,
+		Role:       role,
+		Content:    content,
+		CreatedAt:  time.Now(),
+		Attachment: attachment,
 	}
 
 	// Save message and update appeal
-	err := m.layout.db.Appeals().AddAppealMessage(context.Background(), message, appeal)
+	err := m.layout.appeals.AddAppealMessage(context.Background(), message, appeal, m.messageInterval(role))
 	if err != nil {
+		var rateLimitErr *types.RateLimitError
+		if errors.As(err, &rateLimitErr) {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, fmt.Sprintf(
+				"Please wait between messages. You can send another message <t:%d:R>.",
+				message.CreatedAt.Add(rateLimitErr.RetryAfter).Unix()))
+			return
+		}
+		if errors.Is(err, types.ErrAppealNotFound) {
+			m.layout.ShowOverview(event, s, "This appeal no longer exists.")
+			return
+		}
+
 		m.layout.logger.Error("Failed to add appeal message", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to save response. Please try again.")
 		return
 	}
 
+	// Responding to another reviewer's claim is allowed but worth pointing out
+	content = "Response added successfully."
+	if role == enum.MessageRoleModerator {
+		switch appeal.ClaimedBy {
+		case 0:
+			// The response auto-claims the appeal
+			appeal.ClaimedBy = userID
+			appeal.ClaimedAt = message.CreatedAt
+			appeal.ClaimActivity = message.CreatedAt
+			m.updateSessionAppeal(s, appeal)
+		case userID:
+			appeal.ClaimActivity = message.CreatedAt
+			m.updateSessionAppeal(s, appeal)
+		default:
+			content = fmt.Sprintf("Response added. Note: this appeal is claimed by <@%d>.", appeal.ClaimedBy)
+		}
+	}
+
 	// Refresh the ticket view
-	m.Show(event, s, appeal.ID, "Response added successfully.")
+	m.Show(event, s, appeal.ID, content)
 }
 
 // handleAcceptModalSubmit processes the accept appeal submission.
 func (m *TicketMenu) handleAcceptModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session, appeal *types.Appeal) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	reason := event.Data.Text(constants.AppealReasonInputCustomID)
 	if reason == "" {
 		m.layout.paginationManager.NavigateTo(event, s, m.page, "Accept reason cannot be empty.")
@@ -348,7 +645,7 @@ func (m *TicketMenu) handleAcceptModalSubmit(event *events.ModalSubmitInteractio
 	}
 
 	// Get user to clear
-	user, err := m.layout.db.Users().GetUserByID(context.Background(), strconv.FormatUint(appeal.UserID, 10), types.UserFields{})
+	user, err := m.layout.users.GetUserByID(context.Background(), strconv.FormatUint(appeal.UserID, 10), types.UserFields{})
 	if err != nil {
 		if errors.Is(err, types.ErrUserNotFound) {
 			m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to find user. They may no longer exist in our database.")
@@ -360,7 +657,7 @@ func (m *TicketMenu) handleAcceptModalSubmit(event *events.ModalSubmitInteractio
 	}
 
 	// Clear the user
-	if err := m.layout.db.Users().ClearUser(context.Background(), user); err != nil {
+	if err := m.layout.users.ClearUser(context.Background(), user); err != nil {
 		m.layout.logger.Error("Failed to clear user", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to clear user. Please try again.")
 		return
@@ -368,7 +665,7 @@ func (m *TicketMenu) handleAcceptModalSubmit(event *events.ModalSubmitInteractio
 
 	// Accept the appeal
 	userID := uint64(event.User().ID)
-	err = m.layout.db.Appeals().AcceptAppeal(context.Background(), appeal.ID, userID, reason)
+	err = m.layout.appeals.AcceptAppeal(context.Background(), appeal.ID, userID, reason)
 	if err != nil {
 		m.layout.logger.Error("Failed to accept appeal", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to accept appeal. Please try again.")
@@ -379,7 +676,7 @@ func (m *TicketMenu) handleAcceptModalSubmit(event *events.ModalSubmitInteractio
 	m.layout.ShowOverview(event, s, "Appeal accepted and user cleared.")
 
 	// Log the appeal acceptance
-	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			UserID: appeal.UserID,
 		},
@@ -393,8 +690,82 @@ func (m *TicketMenu) handleAcceptModalSubmit(event *events.ModalSubmitInteractio
 	})
 }
 
+// handleReturnModalSubmit processes the accept appeal submission that returns
+// the confirmed user to flagged_users for another review instead of clearing them.
+func (m *TicketMenu) handleReturnModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session, appeal *types.Appeal) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	reason := event.Data.Text(constants.AppealReasonInputCustomID)
+	if reason == "" {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Accept reason cannot be empty.")
+		return
+	}
+
+	// Get user to return
+	user, err := m.layout.users.GetUserByID(context.Background(), strconv.FormatUint(appeal.UserID, 10), types.UserFields{})
+	if err != nil {
+		if errors.Is(err, types.ErrUserNotFound) {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to find user. They may no longer exist in our database.")
+			return
+		}
+		m.layout.logger.Error("Failed to get user for returning", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to get user information. Please try again.")
+		return
+	}
+
+	// Only confirmed users can be returned to the queue
+	if user.Status != enum.UserTypeConfirmed {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Only confirmed users can be returned to the review queue.")
+		return
+	}
+
+	// Move the user back to flagged with the appeal outcome noted
+	note := fmt.Sprintf("Returned for review after appeal #%d: %s", appeal.ID, reason)
+	if err := m.layout.users.ReturnUserToFlagged(context.Background(), user, note); err != nil {
+		if errors.Is(err, types.ErrUserFlagged) {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "This user is already in the review queue.")
+			return
+		}
+		m.layout.logger.Error("Failed to return user to flagged", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to return user to the review queue. Please try again.")
+		return
+	}
+
+	// Accept the appeal
+	userID := uint64(event.User().ID)
+	err = m.layout.appeals.AcceptAppeal(context.Background(), appeal.ID, userID, reason)
+	if err != nil {
+		m.layout.logger.Error("Failed to accept appeal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to accept appeal. Please try again.")
+		return
+	}
+
+	// Refresh the ticket view
+	m.layout.ShowOverview(event, s, "Appeal accepted and user returned to the review queue.")
+
+	// Log the appeal acceptance
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: appeal.UserID,
+		},
+		ReviewerID:        userID,
+		ActivityType:      enum.ActivityTypeAppealAcceptedReturned,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]interface{}{
+			"reason":    reason,
+			"appeal_id": appeal.ID,
+		},
+	})
+}
+
 // handleRejectModalSubmit processes the reject appeal submission.
 func (m *TicketMenu) handleRejectModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session, appeal *types.Appeal) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	reason := event.Data.Text(constants.AppealReasonInputCustomID)
 	if reason == "" {
 		m.layout.paginationManager.NavigateTo(event, s, m.page, "Reject reason cannot be empty.")
@@ -403,7 +774,7 @@ func (m *TicketMenu) handleRejectModalSubmit(event *events.ModalSubmitInteractio
 
 	// Reject the appeal
 	userID := uint64(event.User().ID)
-	err := m.layout.db.Appeals().RejectAppeal(context.Background(), appeal.ID, userID, reason)
+	err := m.layout.appeals.RejectAppeal(context.Background(), appeal.ID, userID, reason)
 	if err != nil {
 		m.layout.logger.Error("Failed to reject appeal", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to reject appeal. Please try again.")
@@ -414,7 +785,7 @@ func (m *TicketMenu) handleRejectModalSubmit(event *events.ModalSubmitInteractio
 	m.layout.ShowOverview(event, s, "Appeal rejected.")
 
 	// Log the appeal rejection
-	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			UserID: appeal.UserID,
 		},
@@ -428,6 +799,27 @@ func (m *TicketMenu) handleRejectModalSubmit(event *events.ModalSubmitInteractio
 	})
 }
 
+// fetchAttachment downloads the image behind an attachment link. If the image cannot
+// be attached, nil is returned with a message explaining why.
+func (m *TicketMenu) fetchAttachment(link string) (*types.AppealAttachment, string) {
+	maxSize := maxAttachmentSize(m.layout.config.MaxAttachmentSize)
+
+	attachment, err := downloadAttachment(context.Background(), m.layout.httpClient, link, maxSize)
+	switch {
+	case err == nil:
+		return attachment, ""
+	case errors.Is(err, ErrInvalidAttachmentURL):
+		return nil, "Image links must point to a file uploaded to Discord. Upload the image to Discord first and copy its link."
+	case errors.Is(err, ErrUnsupportedAttachment):
+		return nil, "Only PNG, JPEG and WebP images can be attached."
+	case errors.Is(err, ErrAttachmentTooLarge):
+		return nil, fmt.Sprintf("Images cannot be larger than %d MB.", m.layout.config.MaxAttachmentSize)
+	default:
+		m.layout.logger.Warn("Failed to download appeal attachment", zap.Error(err))
+		return nil, "Failed to download the image. Discord links expire after a while, so copy a fresh link and try again."
+	}
+}
+
 // isMessageAllowed checks if a user is allowed to send a message based on spam prevention rules.
 func (m *TicketMenu) isMessageAllowed(messages []*types.AppealMessage, userID uint64) (bool, string) {
 	// Check if the last 3 messages were from this user
@@ -444,15 +836,25 @@ func (m *TicketMenu) isMessageAllowed(messages []*types.AppealMessage, userID ui
 		return false, "Please wait for a moderator to respond before sending more messages."
 	}
 
-	// Check rate limit
-	if len(messages) > 0 {
-		lastMsg := messages[len(messages)-1]
-		if lastMsg.UserID == userID &&
-			lastMsg.Role == enum.MessageRoleUser &&
-			time.Since(lastMsg.CreatedAt) < time.Minute {
-			return false, "Please wait at least 1 minute between messages."
-		}
+	return true, ""
+}
+
+// messageInterval returns the minimum time between messages sent to an appeal by the given role.
+func (m *TicketMenu) messageInterval(role enum.MessageRole) time.Duration {
+	return messageInterval(m.layout.config, role)
+}
+
+// messageInterval returns the configured minimum time between messages sent to an
+// appeal by the given role, or the default for the role if none is configured.
+// An interval of 0 disables the limit.
+func messageInterval(cfg config.Appeals, role enum.MessageRole) time.Duration {
+	interval, fallback := cfg.UserMessageInterval, DefaultUserMessageInterval
+	if role == enum.MessageRoleModerator {
+		interval, fallback = cfg.ModeratorMessageInterval, DefaultModeratorMessageInterval
 	}
 
-	return true, ""
+	if interval == nil || *interval < 0 {
+		return fallback
+	}
+	return time.Duration(*interval) * time.Second
 }
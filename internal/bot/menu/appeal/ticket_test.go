@@ -0,0 +1,101 @@
+package appeal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/setup/config"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/robalyx/rotector/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCheckPendingAppeal(t *testing.T) {
+	tests := []struct {
+		name         string
+		user         *types.ReviewUser
+		lookupErr    error
+		wantReason   string
+		wantRejected []testutil.AppealDecision
+		wantErr      bool
+	}{
+		{
+			name:       "flagged user keeps the appeal open",
+			user:       &types.ReviewUser{User: types.User{ID: 1}, Status: enum.UserTypeFlagged},
+			wantReason: "",
+		},
+		{
+			name:       "confirmed user keeps the appeal open",
+			user:       &types.ReviewUser{User: types.User{ID: 1}, Status: enum.UserTypeConfirmed},
+			wantReason: "",
+		},
+		{
+			name:       "missing user rejects the appeal",
+			wantReason: "User no longer exists in database.",
+			wantRejected: []testutil.AppealDecision{
+				{AppealID: 7, ReviewerID: 0, Reason: "User no longer exists in database."},
+			},
+		},
+		{
+			name:       "cleared user rejects the appeal",
+			user:       &types.ReviewUser{User: types.User{ID: 1}, Status: enum.UserTypeCleared},
+			wantReason: "User status changed to Cleared",
+			wantRejected: []testutil.AppealDecision{
+				{AppealID: 7, ReviewerID: 0, Reason: "User status changed to Cleared"},
+			},
+		},
+		{
+			name:      "lookup failure leaves the appeal open",
+			lookupErr: errors.New("connection refused"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := testutil.NewUserStore()
+			if tt.user != nil {
+				users = testutil.NewUserStore(tt.user)
+			}
+			users.Err = tt.lookupErr
+			appeals := &testutil.AppealStore{}
+			m := &TicketMenu{layout: &Layout{users: users, appeals: appeals, logger: zap.NewNop()}}
+
+			_, reason, err := m.checkPendingAppeal(context.Background(), &types.Appeal{ID: 7, UserID: 1})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantReason, reason)
+			assert.Equal(t, tt.wantRejected, appeals.Rejected)
+		})
+	}
+}
+
+func TestMessageInterval(t *testing.T) {
+	seconds := func(n int) *int { return &n }
+
+	t.Run("unset intervals use the defaults", func(t *testing.T) {
+		cfg := config.Appeals{}
+		assert.Equal(t, DefaultUserMessageInterval, messageInterval(cfg, enum.MessageRoleUser))
+		assert.Equal(t, DefaultModeratorMessageInterval, messageInterval(cfg, enum.MessageRoleModerator))
+	})
+
+	t.Run("configured intervals are used", func(t *testing.T) {
+		cfg := config.Appeals{UserMessageInterval: seconds(30), ModeratorMessageInterval: seconds(5)}
+		assert.Equal(t, 30*time.Second, messageInterval(cfg, enum.MessageRoleUser))
+		assert.Equal(t, 5*time.Second, messageInterval(cfg, enum.MessageRoleModerator))
+	})
+
+	t.Run("zero disables the limit", func(t *testing.T) {
+		cfg := config.Appeals{UserMessageInterval: seconds(0)}
+		assert.Equal(t, time.Duration(0), messageInterval(cfg, enum.MessageRoleUser))
+	})
+}
@@ -68,6 +68,10 @@ func (m *VerifyMenu) handleButton(event *events.ComponentInteractionCreate, s *s
 
 // verifyDescription checks if the user has updated their description with the verification code.
 func (m *VerifyMenu) verifyDescription(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	userID := s.GetUint64(constants.SessionKeyVerifyUserID)
 	expectedCode := s.GetString(constants.SessionKeyVerifyCode)
 	reason := s.GetString(constants.SessionKeyVerifyReason)
@@ -92,6 +96,17 @@ func (m *VerifyMenu) verifyDescription(event *events.ComponentInteractionCreate,
 		return
 	}
 
+	// Find appeals for the same user from other Discord accounts
+	existing, err := m.layout.appeals.GetAppealsByUserID(context.Background(), userID, true)
+	if err != nil {
+		m.layout.logger.Error("Failed to get existing appeals for user", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to submit appeal. Please try again.")
+		return
+	}
+	duplicates := types.FindDuplicateAppeals(
+		existing, uint64(event.User().ID), time.Now().Add(-constants.AppealDuplicateWindow),
+	)
+
 	// Create appeal
 	appeal := &types.Appeal{
 		UserID:      userID,
@@ -100,18 +115,28 @@ func (m *VerifyMenu) verifyDescription(event *events.ComponentInteractionCreate,
 	}
 
 	// Submit appeal
-	if err := m.layout.db.Appeals().CreateAppeal(context.Background(), appeal, reason); err != nil {
+	if err := m.layout.appeals.CreateAppeal(context.Background(), appeal, reason); err != nil {
 		m.layout.logger.Error("Failed to create appeal", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to submit appeal. Please try again.")
 		return
 	}
 
+	// Link the appeal with the duplicates so reviewers can see them together.
+	// The appeal was already submitted, so a failure here is only logged.
+	if len(duplicates) > 0 {
+		if err := m.layout.appeals.AnnotateDuplicateAppeal(context.Background(), appeal, duplicates); err != nil {
+			m.layout.logger.Error("Failed to annotate duplicate appeal",
+				zap.Error(err),
+				zap.Int64("appealID", appeal.ID))
+		}
+	}
+
 	s.Delete(constants.SessionKeyAppealCursor)
 	s.Delete(constants.SessionKeyAppealPrevCursors)
 	m.layout.ShowOverview(event, s, "✅ Account verified and appeal submitted successfully!")
 
 	// Log the appeal submission
-	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			UserID: userID,
 		},
@@ -161,11 +161,13 @@ func (m *Menu) handleModal(event *events.ModalSubmitInteractionCreate, s *sessio
 		s.GetInterface(constants.SessionKeyChatHistory, &history)
 
 		// Stream AI response
+		usageTracker := ai.NewUsageTracker()
 		responseChan, historyChan := m.layout.chatHandler.StreamResponse(
 			context.Background(),
 			history.ToGenAIHistory(),
 			userSettings.ChatModel.String(),
 			message,
+			usageTracker,
 		)
 
 		// Stream AI response
@@ -199,6 +201,9 @@ func (m *Menu) handleModal(event *events.ModalSubmitInteractionCreate, s *sessio
 			s.Set(constants.SessionKeyChatHistory, existingHistory)
 		}
 
+		// Attribute the token usage to the user
+		m.saveUsage(uint64(event.User().ID), userSettings.ChatModel.String(), usageTracker.Flush())
+
 		// Calculate new page number to show latest messages
 		s.Set(constants.SessionKeyPaginationPage, 0)
 		s.Set(constants.SessionKeyIsStreaming, false)
@@ -208,6 +213,25 @@ func (m *Menu) handleModal(event *events.ModalSubmitInteractionCreate, s *sessio
 	}
 }
 
+// saveUsage adds the token usage of a chat response to the daily statistics of the user.
+func (m *Menu) saveUsage(userID uint64, model string, usage ai.Usage) {
+	if usage.Requests == 0 {
+		return
+	}
+
+	err := m.layout.db.AIUsage().AddUsage(context.Background(), &types.AIUsageStats{
+		Date:             time.Now(),
+		Model:            model,
+		UserID:           userID,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		EstimatedCost:    ai.EstimateCost(usage, m.layout.pricing),
+	})
+	if err != nil {
+		m.layout.logger.Error("Failed to save chat AI usage", zap.Error(err), zap.Uint64("userID", userID))
+	}
+}
+
 // checkMessageLimits checks if the user has exceeded their daily message limit.
 // Returns true if the message should be allowed, false if it should be blocked.
 func (m *Menu) checkMessageLimits(s *session.Session, userSettings *types.UserSetting) (bool, string) {
@@ -6,6 +6,7 @@ import (
 	"github.com/robalyx/rotector/internal/bot/interfaces"
 	"github.com/robalyx/rotector/internal/common/client/ai"
 	"github.com/robalyx/rotector/internal/common/setup"
+	"github.com/robalyx/rotector/internal/common/setup/config"
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"go.uber.org/zap"
 )
@@ -16,6 +17,7 @@ type Layout struct {
 	sessionManager    *session.Manager
 	paginationManager *pagination.Manager
 	chatHandler       *ai.ChatHandler
+	pricing           config.GeminiAI
 	menu              *Menu
 	logger            *zap.Logger
 }
@@ -31,6 +33,7 @@ func New(
 		sessionManager:    sessionManager,
 		paginationManager: paginationManager,
 		chatHandler:       ai.NewChatHandler(app.GenAIClient, app.Logger),
+		pricing:           app.Config.Common.GeminiAI,
 		logger:            app.Logger,
 	}
 
@@ -1,10 +1,13 @@
 package dashboard
 
 import (
+	"strconv"
+
 	"github.com/redis/rueidis"
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/common/queue"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"github.com/robalyx/rotector/internal/common/storage/redis"
@@ -20,6 +23,9 @@ type Layout struct {
 	paginationManager *pagination.Manager
 	workerMonitor     *core.Monitor
 	mainMenu          *MainMenu
+	groupSearchMenu   *GroupSearchMenu
+	userNotFoundMenu  *UserNotFoundMenu
+	queueManager      *queue.Manager
 	logger            *zap.Logger
 	userReviewLayout  interfaces.UserReviewLayout
 	groupReviewLayout interfaces.GroupReviewLayout
@@ -71,6 +77,7 @@ func New(
 		paginationManager: paginationManager,
 		logger:            app.Logger,
 		workerMonitor:     core.NewMonitor(statusClient, app.Logger),
+		queueManager:      app.Queue,
 		userReviewLayout:  userReviewLayout,
 		groupReviewLayout: groupReviewLayout,
 		settingLayout:     settingLayout,
@@ -83,9 +90,13 @@ func New(
 		statusLayout:      statusLayout,
 	}
 	l.mainMenu = NewMainMenu(l)
+	l.groupSearchMenu = NewGroupSearchMenu(l)
+	l.userNotFoundMenu = NewUserNotFoundMenu(l)
 
-	// Initialize and register page
+	// Initialize and register pages
 	paginationManager.AddPage(l.mainMenu.page)
+	paginationManager.AddPage(l.groupSearchMenu.page)
+	paginationManager.AddPage(l.userNotFoundMenu.page)
 
 	return l
 }
@@ -95,3 +106,22 @@ func New(
 func (l *Layout) Show(event interfaces.CommonEvent, s *session.Session, content string) {
 	l.mainMenu.Show(event, s, content)
 }
+
+// ShowUserLookup looks up a user by ID and opens them in the review menu, or shows
+// the not found page if they are not in the database. The dashboard becomes the
+// previous page so the back button returns to it.
+func (l *Layout) ShowUserLookup(event interfaces.CommonEvent, s *session.Session, userID uint64) {
+	l.mainMenu.startFromDashboard(event, s)
+	if !l.mainMenu.openUser(event, s, strconv.FormatUint(userID, 10)) {
+		l.userNotFoundMenu.Show(event, s, userID)
+	}
+}
+
+// ShowGroupLookup looks up a group by ID and opens it in the review menu, or shows
+// the dashboard with a notice if it is not in the database.
+func (l *Layout) ShowGroupLookup(event interfaces.CommonEvent, s *session.Session, groupID uint64) {
+	l.mainMenu.startFromDashboard(event, s)
+	if !l.mainMenu.openGroup(event, s, strconv.FormatUint(groupID, 10)) {
+		l.mainMenu.Show(event, s, "Failed to find group. It may not be in our database.")
+	}
+}
@@ -3,20 +3,28 @@ package dashboard
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
+	"github.com/google/uuid"
 	builder "github.com/robalyx/rotector/internal/bot/builder/dashboard"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/bot/utils"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"go.uber.org/zap"
 )
 
+// ErrInvalidLookupInput indicates that a lookup input is neither a Roblox ID, a roblox.com link nor a UUID.
+var ErrInvalidLookupInput = errors.New("enter a numeric ID, a roblox.com link or a UUID")
+
 // MainMenu handles dashboard operations and their interactions.
 type MainMenu struct {
 	layout *Layout
@@ -41,18 +49,39 @@ func NewMainMenu(layout *Layout) *MainMenu {
 
 // Show prepares and displays the dashboard interface.
 func (m *MainMenu) Show(event interfaces.CommonEvent, s *session.Session, content string) {
-	// If the dashboard is already refreshed, directly navigate to the page
-	if s.GetBool(constants.SessionKeyIsRefreshed) {
-		m.layout.paginationManager.NavigateTo(event, s, m.page, content)
-		return
+	// Load statistics unless the dashboard is already refreshed
+	if !s.GetBool(constants.SessionKeyIsRefreshed) {
+		m.loadStats(event, s)
+	}
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
+}
+
+// startFromDashboard clears the page history and makes the dashboard the current
+// page without displaying it, so the next page opened returns to the dashboard.
+func (m *MainMenu) startFromDashboard(event interfaces.CommonEvent, s *session.Session) {
+	if !s.GetBool(constants.SessionKeyIsRefreshed) {
+		m.loadStats(event, s)
 	}
 
-	// Get all counts in a single transaction
-	userCounts, groupCounts, err := m.layout.db.Stats().GetCurrentCounts(context.Background())
+	s.Set(constants.SessionKeyPreviousPages, []session.Breadcrumb{})
+	s.Set(constants.SessionKeyCurrentPage, m.page.Name)
+}
+
+// loadStats loads the statistics and worker information shown on the dashboard into the session.
+func (m *MainMenu) loadStats(event interfaces.CommonEvent, s *session.Session) {
+	// Get all counts, reusing recent counts if available
+	userCounts, groupCounts, err := m.layout.db.Stats().GetCachedCounts(context.Background())
 	if err != nil {
 		m.layout.logger.Error("Failed to get counts", zap.Error(err))
 	}
 
+	// Get flagged and confirmed user counts by reason category, reusing recent counts if available
+	reasonCounts, err := m.layout.db.Stats().GetCachedUserCountsByReasonCategory(context.Background())
+	if err != nil {
+		m.layout.logger.Error("Failed to get reason category counts", zap.Error(err))
+	}
+
 	// Get vote statistics for the user
 	voteStats, err := m.layout.db.Votes().GetUserVoteStats(context.Background(), uint64(event.User().ID), enum.LeaderboardPeriodAllTime)
 	if err != nil {
@@ -69,15 +98,50 @@ func (m *MainMenu) Show(event interfaces.CommonEvent, s *session.Session, conten
 		m.layout.logger.Error("Failed to get worker statuses", zap.Error(err))
 	}
 
+	// Get today's estimated AI spend
+	aiCostToday, err := m.layout.db.AIUsage().GetDailyCost(context.Background(), time.Now())
+	if err != nil {
+		m.layout.logger.Error("Failed to get AI usage cost", zap.Error(err))
+	}
+
+	// Get the number of users and groups currently held by reviewers
+	heldUsers, heldGroups := m.getHeldCounts()
+
 	// Store data in session
 	s.Set(constants.SessionKeyUserCounts, userCounts)
 	s.Set(constants.SessionKeyGroupCounts, groupCounts)
+	s.Set(constants.SessionKeyReasonCounts, reasonCounts)
 	s.Set(constants.SessionKeyActiveUsers, activeUsers)
 	s.Set(constants.SessionKeyWorkerStatuses, workerStatuses)
 	s.Set(constants.SessionKeyVoteStats, voteStats)
+	s.Set(constants.SessionKeyAICostToday, aiCostToday)
+	s.Set(constants.SessionKeyHeldUsers, heldUsers)
+	s.Set(constants.SessionKeyHeldGroups, heldGroups)
 	s.Set(constants.SessionKeyIsRefreshed, true)
+}
 
-	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
+// getHeldCounts returns the total number of users and groups that are currently
+// being viewed by reviewers across all statuses.
+func (m *MainMenu) getHeldCounts() (int, int) {
+	var heldUsers, heldGroups int
+
+	userCounts, err := m.layout.db.Users().GetCurrentlyViewedCounts(context.Background())
+	if err != nil {
+		m.layout.logger.Error("Failed to get currently viewed user counts", zap.Error(err))
+	}
+	for _, count := range userCounts {
+		heldUsers += count
+	}
+
+	groupCounts, err := m.layout.db.Groups().GetCurrentlyViewedCounts(context.Background())
+	if err != nil {
+		m.layout.logger.Error("Failed to get currently viewed group counts", zap.Error(err))
+	}
+	for _, count := range groupCounts {
+		heldGroups += count
+	}
+
+	return heldUsers, heldGroups
 }
 
 // handleSelectMenu processes select menu interactions.
@@ -99,6 +163,13 @@ func (m *MainMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s
 		m.handleLookupUser(event)
 	case constants.LookupGroupButtonCustomID:
 		m.handleLookupGroup(event)
+	case constants.SearchGroupButtonCustomID:
+		if !settings.IsReviewer(uint64(event.User().ID)) {
+			m.layout.logger.Error("User is not in reviewer list but somehow attempted to search groups", zap.Uint64("user_id", uint64(event.User().ID)))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to search groups.")
+			return
+		}
+		m.layout.groupSearchMenu.handleSearchGroup(event)
 	case constants.UserSettingsButtonCustomID:
 		m.layout.settingLayout.ShowUser(event, s)
 	case constants.ActivityBrowserButtonCustomID:
@@ -152,7 +223,7 @@ func (m *MainMenu) handleLookupUser(event *events.ComponentInteractionCreate) {
 		AddActionRow(
 			discord.NewTextInput(constants.LookupUserInputCustomID, discord.TextInputStyleShort, "User ID or UUID").
 				WithRequired(true).
-				WithPlaceholder("Enter the user ID or UUID to lookup..."),
+				WithPlaceholder("Enter the user ID, profile link or UUID to lookup..."),
 		).
 		Build()
 	if err := event.Modal(modal); err != nil {
@@ -169,7 +240,7 @@ func (m *MainMenu) handleLookupGroup(event *events.ComponentInteractionCreate) {
 		AddActionRow(
 			discord.NewTextInput(constants.LookupGroupInputCustomID, discord.TextInputStyleShort, "Group ID or UUID").
 				WithRequired(true).
-				WithPlaceholder("Enter the group ID or UUID to lookup..."),
+				WithPlaceholder("Enter the group ID, group link or UUID to lookup..."),
 		).
 		Build()
 	if err := event.Modal(modal); err != nil {
@@ -185,24 +256,37 @@ func (m *MainMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *se
 		m.handleLookupUserModalSubmit(event, s)
 	case constants.LookupGroupModalCustomID:
 		m.handleLookupGroupModalSubmit(event, s)
+	case constants.SearchGroupModalCustomID:
+		m.layout.groupSearchMenu.handleSearchModalSubmit(event, s, m.page)
 	}
 }
 
 // handleLookupUserModalSubmit processes the user ID input and opens the review menu.
 func (m *MainMenu) handleLookupUserModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
 	// Get the user ID input
-	userIDStr := event.Data.Text(constants.LookupUserInputCustomID)
+	userIDStr, err := lookupInput(event.Data.Text(constants.LookupUserInputCustomID), utils.ParseRobloxUserID)
+	if err != nil {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, fmt.Sprintf("Invalid user ID: %v.", err))
+		return
+	}
 
+	if !m.openUser(event, s, userIDStr) {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to find user. They may not be in our database.")
+	}
+}
+
+// openUser loads the user with the given ID and opens them in the review menu.
+// Returns false without responding if the user is not in the database.
+func (m *MainMenu) openUser(event interfaces.CommonEvent, s *session.Session, userIDStr string) bool {
 	// Get user from database
 	user, err := m.layout.db.Users().GetUserByID(context.Background(), userIDStr, types.UserFields{})
 	if err != nil {
 		if errors.Is(err, types.ErrUserNotFound) {
-			m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to find user. They may not be in our database.")
-			return
+			return false
 		}
 		m.layout.logger.Error("Failed to fetch user", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to fetch user for review. Please try again.")
-		return
+		return true
 	}
 
 	// Store user in session and show review menu
@@ -219,23 +303,54 @@ func (m *MainMenu) handleLookupUserModalSubmit(event *events.ModalSubmitInteract
 		ActivityTimestamp: time.Now(),
 		Details:           map[string]interface{}{},
 	})
+
+	return true
 }
 
 // handleLookupGroupModalSubmit processes the group ID input and opens the review menu.
 func (m *MainMenu) handleLookupGroupModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
 	// Get the group ID input
-	groupIDStr := event.Data.Text(constants.LookupGroupInputCustomID)
+	groupIDStr, err := lookupInput(event.Data.Text(constants.LookupGroupInputCustomID), utils.ParseRobloxGroupID)
+	if err != nil {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, fmt.Sprintf("Invalid group ID: %v.", err))
+		return
+	}
 
+	if !m.openGroup(event, s, groupIDStr) {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to find group. It may not be in our database.")
+	}
+}
+
+// lookupInput converts the input of a lookup modal into the ID or UUID to look up.
+// IDs may also be given as links, which are parsed with the given parser.
+func lookupInput(input string, parse func(string) (uint64, error)) (string, error) {
+	input = strings.TrimSpace(input)
+
+	id, err := parse(input)
+	if err == nil {
+		return strconv.FormatUint(id, 10), nil
+	}
+	if errors.Is(err, utils.ErrInvalidRobloxID) {
+		if _, uuidErr := uuid.Parse(input); uuidErr == nil {
+			return input, nil
+		}
+		return "", ErrInvalidLookupInput
+	}
+	return "", err
+}
+
+// openGroup loads the group with the given ID and opens it in the review menu.
+// Returns false without responding if the group is not in the database.
+func (m *MainMenu) openGroup(event interfaces.CommonEvent, s *session.Session, groupIDStr string) bool {
 	// Get group from database
 	group, err := m.layout.db.Groups().GetGroupByID(context.Background(), groupIDStr, types.GroupFields{})
 	if err != nil {
 		if errors.Is(err, types.ErrGroupNotFound) {
-			m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to find group. It may not be in our database.")
-			return
+			return false
 		}
 		m.layout.logger.Error("Failed to fetch group", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to fetch group for review. Please try again.")
-		return
+		return true
 	}
 
 	// Store group in session and show review menu
@@ -252,6 +367,7 @@ func (m *MainMenu) handleLookupGroupModalSubmit(event *events.ModalSubmitInterac
 		ActivityTimestamp: time.Now(),
 		Details:           map[string]interface{}{},
 	})
+	return true
 }
 
 // handleButton processes button interactions, mainly handling refresh requests
@@ -0,0 +1,113 @@
+package dashboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	builder "github.com/robalyx/rotector/internal/bot/builder/dashboard"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/common/queue"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"go.uber.org/zap"
+)
+
+// UserNotFoundMenu handles the page shown when a user looked up by ID is not in the database.
+type UserNotFoundMenu struct {
+	layout *Layout
+	page   *pagination.Page
+}
+
+// NewUserNotFoundMenu creates a UserNotFoundMenu and sets up its page with message builders
+// and interaction handlers for queueing the user for scanning.
+func NewUserNotFoundMenu(layout *Layout) *UserNotFoundMenu {
+	m := &UserNotFoundMenu{layout: layout}
+	m.page = &pagination.Page{
+		Name: "User Not Found Menu",
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewUserNotFoundBuilder(s).Build()
+		},
+		ButtonHandlerFunc: m.handleButton,
+	}
+	return m
+}
+
+// Show displays the not found page for the given user ID.
+func (m *UserNotFoundMenu) Show(event interfaces.CommonEvent, s *session.Session, userID uint64) {
+	s.Set(constants.SessionKeyLookupUserID, userID)
+	m.layout.paginationManager.NavigateTo(event, s, m.page, "")
+}
+
+// handleButton processes navigation and queue button interactions.
+func (m *UserNotFoundMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
+	switch customID {
+	case constants.BackButtonCustomID:
+		m.layout.paginationManager.NavigateBack(event, s, "")
+	case constants.QueueLookupUserButtonCustomID:
+		m.handleQueueUser(event, s)
+	}
+}
+
+// handleQueueUser adds the looked up user to the high priority queue so they
+// are scanned, then shows the queue status menu.
+func (m *UserNotFoundMenu) handleQueueUser(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	if !botSettings.IsReviewer(uint64(event.User().ID)) {
+		m.layout.logger.Error("Non-reviewer attempted to queue a user", zap.Uint64("user_id", uint64(event.User().ID)))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to queue users.")
+		return
+	}
+
+	userID := s.GetUint64(constants.SessionKeyLookupUserID)
+
+	// Show the status menu if the user is already queued
+	status, _, _, err := m.layout.queueManager.GetQueueInfo(context.Background(), userID)
+	if err == nil && status != "" {
+		s.Set(constants.SessionKeyQueueUser, userID)
+		m.layout.userReviewLayout.ShowStatusMenu(event, s)
+		return
+	}
+
+	// Add to queue with reviewer information
+	err = m.layout.queueManager.AddToQueue(context.Background(), &queue.Item{
+		UserID:      userID,
+		Priority:    queue.HighPriority,
+		Reason:      constants.LookupQueueReason,
+		AddedBy:     uint64(event.User().ID),
+		AddedAt:     time.Now(),
+		Status:      queue.StatusPending,
+		CheckExists: false,
+	})
+	if err != nil {
+		m.layout.logger.Error("Failed to add user to queue", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to add user to queue")
+		return
+	}
+
+	// Store queue position information for status display
+	err = m.layout.queueManager.SetQueueInfo(
+		context.Background(),
+		userID,
+		queue.StatusPending,
+		queue.HighPriority,
+		m.layout.queueManager.GetQueueLength(context.Background(), queue.HighPriority),
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to update queue info", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to update queue info")
+		return
+	}
+
+	// Track the queued user in session for status updates
+	s.Set(constants.SessionKeyQueueUser, userID)
+	m.layout.userReviewLayout.ShowStatusMenu(event, s)
+}
@@ -0,0 +1,238 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	builder "github.com/robalyx/rotector/internal/bot/builder/dashboard"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"go.uber.org/zap"
+)
+
+// GroupSearchMenu handles the display and interaction logic for searching groups.
+type GroupSearchMenu struct {
+	layout *Layout
+	page   *pagination.Page
+}
+
+// NewGroupSearchMenu creates a GroupSearchMenu and sets up its page with message builders
+// and interaction handlers for browsing search results.
+func NewGroupSearchMenu(layout *Layout) *GroupSearchMenu {
+	m := &GroupSearchMenu{layout: layout}
+	m.page = &pagination.Page{
+		Name: "Group Search Menu",
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewGroupSearchBuilder(s).Build()
+		},
+		SelectHandlerFunc: m.handleSelectMenu,
+		ButtonHandlerFunc: m.handleButton,
+		ModalHandlerFunc:  m.handleModal,
+	}
+	return m
+}
+
+// Show fetches the current page of search results and displays them.
+func (m *GroupSearchMenu) Show(event interfaces.CommonEvent, s *session.Session) {
+	var statuses []enum.GroupType
+	s.GetInterface(constants.SessionKeyGroupSearchStatuses, &statuses)
+	var cursor *types.GroupSearchCursor
+	s.GetInterface(constants.SessionKeyGroupSearchCursor, &cursor)
+
+	// Fetch matching groups from database
+	results, nextCursor, err := m.layout.db.Groups().SearchGroups(
+		context.Background(),
+		s.GetString(constants.SessionKeyGroupSearchQuery),
+		statuses,
+		cursor,
+		constants.GroupSearchPerPage,
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to search groups", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to search groups. Please try again.")
+		return
+	}
+
+	var prevCursors []*types.GroupSearchCursor
+	s.GetInterface(constants.SessionKeyGroupSearchPrevCursors, &prevCursors)
+
+	// Store results and cursor in session
+	s.Set(constants.SessionKeyGroupSearchResults, results)
+	s.Set(constants.SessionKeyGroupSearchNextCursor, nextCursor)
+	s.Set(constants.SessionKeyHasNextPage, nextCursor != nil)
+	s.Set(constants.SessionKeyHasPrevPage, len(prevCursors) > 0)
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, "")
+}
+
+// handleSearchGroup opens a modal for entering a group search query.
+func (m *GroupSearchMenu) handleSearchGroup(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.SearchGroupModalCustomID).
+		SetTitle("Search Groups").
+		AddActionRow(
+			discord.NewTextInput(constants.SearchGroupInputCustomID, discord.TextInputStyleShort, "Search Query").
+				WithRequired(true).
+				WithMinLength(types.MinSearchQueryLength).
+				WithMaxLength(100).
+				WithPlaceholder("Enter part of a group name, description or reason..."),
+		).
+		Build()
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create group search modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the group search modal. Please try again.")
+	}
+}
+
+// handleSearchModalSubmit validates the query and shows the first page of results.
+// The page to return to on invalid input is provided by the caller.
+func (m *GroupSearchMenu) handleSearchModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session, returnPage *pagination.Page) {
+	query := strings.TrimSpace(event.Data.Text(constants.SearchGroupInputCustomID))
+	if utf8.RuneCountInString(query) < types.MinSearchQueryLength {
+		m.layout.paginationManager.NavigateTo(event, s, returnPage,
+			fmt.Sprintf("Search queries must be at least %d characters long.", types.MinSearchQueryLength))
+		return
+	}
+
+	// Reset search state for the new query
+	s.Set(constants.SessionKeyGroupSearchQuery, query)
+	s.Set(constants.SessionKeyGroupSearchCursor, nil)
+	s.Set(constants.SessionKeyGroupSearchPrevCursors, make([]*types.GroupSearchCursor, 0))
+
+	var statuses []enum.GroupType
+	s.GetInterface(constants.SessionKeyGroupSearchStatuses, &statuses)
+	if len(statuses) == 0 {
+		s.Set(constants.SessionKeyGroupSearchStatuses, allSearchStatuses())
+	}
+
+	m.Show(event, s)
+}
+
+// handleSelectMenu processes result selection and status filter changes.
+func (m *GroupSearchMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s *session.Session, customID string, option string) {
+	switch customID {
+	case constants.GroupSearchResultSelectID:
+		m.handleResultSelection(event, s, option)
+	case constants.GroupSearchStatusSelectID:
+		if option == constants.GroupSearchAllStatusesOption {
+			s.Set(constants.SessionKeyGroupSearchStatuses, allSearchStatuses())
+		} else {
+			status, err := enum.GroupTypeString(option)
+			if err != nil {
+				m.layout.logger.Error("Failed to parse group status", zap.Error(err))
+				m.layout.paginationManager.RespondWithError(event, "Failed to parse status filter. Please try again.")
+				return
+			}
+			s.Set(constants.SessionKeyGroupSearchStatuses, []enum.GroupType{status})
+		}
+
+		// Reset pagination when the filter changes
+		s.Set(constants.SessionKeyGroupSearchCursor, nil)
+		s.Set(constants.SessionKeyGroupSearchPrevCursors, make([]*types.GroupSearchCursor, 0))
+		m.Show(event, s)
+	}
+}
+
+// handleResultSelection opens the group review menu for the selected result.
+func (m *GroupSearchMenu) handleResultSelection(event *events.ComponentInteractionCreate, s *session.Session, groupIDStr string) {
+	group, err := m.layout.db.Groups().GetGroupByID(context.Background(), groupIDStr, types.GroupFields{})
+	if err != nil {
+		if errors.Is(err, types.ErrGroupNotFound) {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to find group. It may have been removed.")
+			return
+		}
+		m.layout.logger.Error("Failed to fetch group", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to fetch group for review. Please try again.")
+		return
+	}
+
+	// Store group in session and show review menu
+	s.Set(constants.SessionKeyGroupTarget, group)
+	m.layout.groupReviewLayout.Show(event, s)
+
+	// Log the lookup action
+	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			GroupID: group.ID,
+		},
+		ReviewerID:        uint64(event.User().ID),
+		ActivityType:      enum.ActivityTypeGroupLookup,
+		ActivityTimestamp: time.Now(),
+		Details:           map[string]interface{}{"query": s.GetString(constants.SessionKeyGroupSearchQuery)},
+	})
+}
+
+// handleButton processes navigation and new search button interactions.
+func (m *GroupSearchMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
+	switch customID {
+	case constants.BackButtonCustomID:
+		m.layout.paginationManager.NavigateBack(event, s, "")
+	case constants.SearchGroupButtonCustomID:
+		m.handleSearchGroup(event)
+	case string(utils.ViewerFirstPage), string(utils.ViewerPrevPage), string(utils.ViewerNextPage):
+		m.handlePagination(event, s, utils.ViewerAction(customID))
+	}
+}
+
+// handleModal processes new search queries submitted from the results page.
+func (m *GroupSearchMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if event.Data.CustomID == constants.SearchGroupModalCustomID {
+		m.handleSearchModalSubmit(event, s, m.page)
+	}
+}
+
+// handlePagination processes page navigation.
+func (m *GroupSearchMenu) handlePagination(event *events.ComponentInteractionCreate, s *session.Session, action utils.ViewerAction) {
+	switch action {
+	case utils.ViewerNextPage:
+		if s.GetBool(constants.SessionKeyHasNextPage) {
+			var cursor *types.GroupSearchCursor
+			s.GetInterface(constants.SessionKeyGroupSearchCursor, &cursor)
+			var nextCursor *types.GroupSearchCursor
+			s.GetInterface(constants.SessionKeyGroupSearchNextCursor, &nextCursor)
+			var prevCursors []*types.GroupSearchCursor
+			s.GetInterface(constants.SessionKeyGroupSearchPrevCursors, &prevCursors)
+
+			s.Set(constants.SessionKeyGroupSearchCursor, nextCursor)
+			s.Set(constants.SessionKeyGroupSearchPrevCursors, append(prevCursors, cursor))
+			m.Show(event, s)
+		}
+	case utils.ViewerPrevPage:
+		var prevCursors []*types.GroupSearchCursor
+		s.GetInterface(constants.SessionKeyGroupSearchPrevCursors, &prevCursors)
+
+		if len(prevCursors) > 0 {
+			lastIdx := len(prevCursors) - 1
+			s.Set(constants.SessionKeyGroupSearchPrevCursors, prevCursors[:lastIdx])
+			s.Set(constants.SessionKeyGroupSearchCursor, prevCursors[lastIdx])
+			m.Show(event, s)
+		}
+	case utils.ViewerFirstPage:
+		s.Set(constants.SessionKeyGroupSearchCursor, nil)
+		s.Set(constants.SessionKeyGroupSearchPrevCursors, make([]*types.GroupSearchCursor, 0))
+		m.Show(event, s)
+	case utils.ViewerLastPage:
+		return
+	}
+}
+
+// allSearchStatuses returns every group status that can be searched.
+func allSearchStatuses() []enum.GroupType {
+	return []enum.GroupType{
+		enum.GroupTypeFlagged,
+		enum.GroupTypeConfirmed,
+		enum.GroupTypeCleared,
+		enum.GroupTypeLocked,
+	}
+}
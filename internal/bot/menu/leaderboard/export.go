@@ -0,0 +1,86 @@
+package leaderboard
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+// reviewerStatsHeader is the header row of the reviewer stats CSV export.
+var reviewerStatsHeader = []string{"reviewer_id", "username", "confirms", "clears", "skips", "accuracy"}
+
+// BuildReviewerStatsCSV writes the reviewer stats as CSV. Unresolved usernames and
+// missing accuracies are left empty. Rows that would make the file larger than
+// maxBytes are left out and a warning row saying how many were omitted is added
+// in their place.
+func BuildReviewerStatsCSV(stats []*types.ReviewerStats, usernames map[uint64]string, maxBytes int) (*bytes.Buffer, error) {
+	header, err := encodeCSVRow(reviewerStatsHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(header)
+	for i, stat := range stats {
+		accuracy := ""
+		if stat.Accuracy != nil {
+			accuracy = strconv.FormatFloat(*stat.Accuracy, 'f', 4, 64)
+		}
+
+		row, err := encodeCSVRow([]string{
+			strconv.FormatUint(stat.ReviewerID, 10),
+			usernames[stat.ReviewerID],
+			strconv.FormatInt(stat.Confirms, 10),
+			strconv.FormatInt(stat.Clears, 10),
+			strconv.FormatInt(stat.Skips, 10),
+			accuracy,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Keep room for the warning row while more rows follow
+		warning, err := encodeCSVRow(truncatedWarningRow(len(stats) - i))
+		if err != nil {
+			return nil, err
+		}
+
+		required := buf.Len() + len(row)
+		if i < len(stats)-1 {
+			required += len(warning)
+		}
+		if required > maxBytes {
+			buf.Write(warning)
+			break
+		}
+
+		buf.Write(row)
+	}
+
+	return buf, nil
+}
+
+// truncatedWarningRow returns the row noting that the export was cut short.
+func truncatedWarningRow(omitted int) []string {
+	return []string{
+		"# truncated",
+		fmt.Sprintf("%d reviewers omitted to stay under the attachment size limit", omitted),
+		"", "", "", "",
+	}
+}
+
+// encodeCSVRow encodes a single CSV record.
+func encodeCSVRow(record []string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(record); err != nil {
+		return nil, fmt.Errorf("failed to write CSV row: %w", err)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to flush CSV row: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,46 @@
+package leaderboard
+
+import (
+	"testing"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildReviewerStatsCSV(t *testing.T) {
+	accuracy := 0.875
+	stats := []*types.ReviewerStats{
+		{ReviewerID: 1, Confirms: 10, Clears: 5, Skips: 2, Accuracy: &accuracy},
+		{ReviewerID: 2, Confirms: 3, Clears: 1, Skips: 0},
+		{ReviewerID: 3, Confirms: 1, Clears: 0, Skips: 4},
+	}
+	usernames := map[uint64]string{1: "alice", 2: "bob, jr"}
+
+	t.Run("all rows fit", func(t *testing.T) {
+		buf, err := BuildReviewerStatsCSV(stats, usernames, 1024)
+		require.NoError(t, err)
+
+		assert.Equal(t, "reviewer_id,username,confirms,clears,skips,accuracy\n"+
+			"1,alice,10,5,2,0.8750\n"+
+			"2,\"bob, jr\",3,1,0,\n"+
+			"3,,1,0,4,\n", buf.String())
+	})
+
+	t.Run("truncates with a warning row", func(t *testing.T) {
+		buf, err := BuildReviewerStatsCSV(stats, usernames, 160)
+		require.NoError(t, err)
+
+		assert.Equal(t, "reviewer_id,username,confirms,clears,skips,accuracy\n"+
+			"1,alice,10,5,2,0.8750\n"+
+			"# truncated,2 reviewers omitted to stay under the attachment size limit,,,,\n", buf.String())
+		assert.LessOrEqual(t, buf.Len(), 160)
+	})
+
+	t.Run("no reviewers", func(t *testing.T) {
+		buf, err := BuildReviewerStatsCSV(nil, nil, 1024)
+		require.NoError(t, err)
+
+		assert.Equal(t, "reviewer_id,username,confirms,clears,skips,accuracy\n", buf.String())
+	})
+}
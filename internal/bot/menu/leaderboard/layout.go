@@ -4,12 +4,14 @@ import (
 	"time"
 
 	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/snowflake/v2"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"go.uber.org/zap"
 )
@@ -17,10 +19,15 @@ import (
 // Layout handles leaderboard operations and their interactions.
 type Layout struct {
 	db                *database.Client
+	appeals           store.AppealStore
+	activity          store.ActivityStore
+	settings          store.SettingStore
 	client            bot.Client
 	sessionManager    *session.Manager
 	paginationManager *pagination.Manager
 	mainMenu          *MainMenu
+	reviewTimesMenu   *ReviewTimesMenu
+	usernameCache     *UsernameCache
 	logger            *zap.Logger
 }
 
@@ -35,15 +42,27 @@ func New(
 	// Initialize layout
 	l := &Layout{
 		db:                app.DB,
+		appeals:           app.DB.Appeals(),
+		activity:          app.DB.Activity(),
+		settings:          app.DB.Settings(),
 		client:            client,
 		sessionManager:    sessionManager,
 		paginationManager: paginationManager,
 		logger:            app.Logger,
 	}
+	l.usernameCache = NewUsernameCache(func(userID uint64) (string, error) {
+		user, err := client.Rest().GetUser(snowflake.ID(userID))
+		if err != nil {
+			return "", err
+		}
+		return user.Username, nil
+	}, constants.LeaderboardUsernameCacheTTL)
 	l.mainMenu = NewMainMenu(l)
+	l.reviewTimesMenu = NewReviewTimesMenu(l)
 
-	// Initialize and register page
+	// Initialize and register pages
 	paginationManager.AddPage(l.mainMenu.page)
+	paginationManager.AddPage(l.reviewTimesMenu.page)
 
 	return l
 }
@@ -57,6 +76,7 @@ func (l *Layout) Show(event interfaces.CommonEvent, s *session.Session) {
 func (l *Layout) ResetStats(s *session.Session) {
 	s.Set(constants.SessionKeyLeaderboardStats, []types.VoteAccuracy{})
 	s.Set(constants.SessionKeyLeaderboardUsernames, make(map[uint64]string))
+	s.Set(constants.SessionKeyLeaderboardAnonymous, make(map[uint64]bool))
 	s.Set(constants.SessionKeyLeaderboardCursor, nil)
 	s.Set(constants.SessionKeyLeaderboardNextCursor, nil)
 	s.Set(constants.SessionKeyLeaderboardPrevCursors, []*types.LeaderboardCursor{})
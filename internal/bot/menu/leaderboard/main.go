@@ -2,10 +2,10 @@ package leaderboard
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
-	"github.com/disgoorg/snowflake/v2"
 	builder "github.com/robalyx/rotector/internal/bot/builder/leaderboard"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
@@ -66,17 +66,31 @@ func (m *MainMenu) Show(event interfaces.CommonEvent, s *session.Session) {
 		m.layout.logger.Error("Failed to get refresh info", zap.Error(err))
 	}
 
-	// Fetch usernames for all users in stats
-	usernames := make(map[uint64]string)
-	for _, stat := range stats {
-		if user, err := m.layout.client.Rest().GetUser(snowflake.ID(stat.DiscordUserID)); err == nil {
-			usernames[stat.DiscordUserID] = user.Username
+	// Find users who chose to appear anonymously
+	userIDs := make([]uint64, len(stats))
+	for i, stat := range stats {
+		userIDs[i] = stat.DiscordUserID
+	}
+	anonymous, err := m.layout.settings.GetAnonymousLeaderboardUsers(context.Background(), userIDs)
+	if err != nil {
+		m.layout.logger.Error("Failed to get anonymous leaderboard users", zap.Error(err))
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to retrieve leaderboard data. Please try again.")
+		return
+	}
+
+	// Resolve usernames for the users who are shown by name
+	namedIDs := make([]uint64, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if !anonymous[userID] {
+			namedIDs = append(namedIDs, userID)
 		}
 	}
+	usernames := m.layout.usernameCache.Resolve(namedIDs)
 
 	// Store results in session
 	s.Set(constants.SessionKeyLeaderboardStats, stats)
 	s.Set(constants.SessionKeyLeaderboardUsernames, usernames)
+	s.Set(constants.SessionKeyLeaderboardAnonymous, anonymous)
 	s.Set(constants.SessionKeyLeaderboardNextCursor, nextCursor)
 	s.Set(constants.SessionKeyHasNextPage, nextCursor != nil)
 	s.Set(constants.SessionKeyHasPrevPage, cursor != nil)
@@ -105,7 +119,7 @@ func (m *MainMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s
 
 	// Update user's leaderboard period preference
 	settings.LeaderboardPeriod = period
-	if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+	if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
 		m.layout.logger.Error("Failed to save user settings", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to save time period preference. Please try again.")
 		return
@@ -125,11 +139,62 @@ func (m *MainMenu) handleButton(event *events.ComponentInteractionCreate, s *ses
 	case constants.RefreshButtonCustomID:
 		m.layout.ResetStats(s)
 		m.Show(event, s)
+	case constants.ReviewTimesButtonCustomID:
+		m.layout.reviewTimesMenu.Show(event, s)
+	case constants.ExportReviewerStatsButtonCustomID:
+		m.handleExportReviewerStats(event, s)
 	case string(utils.ViewerFirstPage), string(utils.ViewerPrevPage), string(utils.ViewerNextPage), string(utils.ViewerLastPage):
 		m.handlePagination(event, s, utils.ViewerAction(customID))
 	}
 }
 
+// handleExportReviewerStats attaches a CSV of every reviewer's decisions and vote
+// accuracy for the selected period.
+func (m *MainMenu) handleExportReviewerStats(event *events.ComponentInteractionCreate, s *session.Session) {
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	// Verify the user is a reviewer or admin
+	userID := uint64(event.User().ID)
+	if !botSettings.IsReviewer(userID) && !botSettings.IsAdmin(userID) {
+		m.layout.logger.Error("Non-reviewer attempted to export reviewer stats", zap.Uint64("user_id", userID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to export reviewer stats.")
+		return
+	}
+
+	// Get stats for every reviewer in the period
+	stats, err := m.layout.db.Votes().GetAllReviewerStats(
+		context.Background(), settings.LeaderboardPeriod, getPeriodStart(settings.LeaderboardPeriod),
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to get reviewer stats", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to retrieve reviewer stats. Please try again.")
+		return
+	}
+
+	// Resolve usernames for all reviewers
+	reviewerIDs := make([]uint64, len(stats))
+	for i, stat := range stats {
+		reviewerIDs[i] = stat.ReviewerID
+	}
+	usernames := m.layout.usernameCache.Resolve(reviewerIDs)
+
+	buf, err := BuildReviewerStatsCSV(stats, usernames, constants.ReviewerStatsExportMaxBytes)
+	if err != nil {
+		m.layout.logger.Error("Failed to export reviewer stats", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to export reviewer stats. Please try again.")
+		return
+	}
+
+	// Attach the export to this response only
+	s.SetBuffer(constants.SessionKeyReviewerStatsExport, buf)
+	m.layout.paginationManager.NavigateTo(event, s, m.page,
+		fmt.Sprintf("Exported stats for %d reviewers.", len(stats)))
+	s.Delete(constants.SessionKeyReviewerStatsExport)
+}
+
 // handlePagination processes page navigation.
 func (m *MainMenu) handlePagination(event *events.ComponentInteractionCreate, s *session.Session, action utils.ViewerAction) {
 	switch action {
@@ -0,0 +1,156 @@
+package leaderboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	builder "github.com/robalyx/rotector/internal/bot/builder/leaderboard"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"go.uber.org/zap"
+)
+
+// ReviewTimesMenu handles the display and interaction logic for viewing reviewer decision times.
+type ReviewTimesMenu struct {
+	layout *Layout
+	page   *pagination.Page
+}
+
+// NewReviewTimesMenu creates a ReviewTimesMenu and sets up its page with message builders and
+// interaction handlers.
+func NewReviewTimesMenu(l *Layout) *ReviewTimesMenu {
+	m := &ReviewTimesMenu{layout: l}
+	m.page = &pagination.Page{
+		Name: "Review Times Menu",
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewReviewTimesBuilder(s).Build()
+		},
+		SelectHandlerFunc: m.handleSelectMenu,
+		ButtonHandlerFunc: m.handleButton,
+	}
+	return m
+}
+
+// Show prepares and displays the review times interface.
+func (m *ReviewTimesMenu) Show(event interfaces.CommonEvent, s *session.Session) {
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	// Check reviewer permissions
+	if !botSettings.IsReviewer(uint64(event.User().ID)) {
+		m.layout.logger.Error("Non-reviewer attempted to view review times", zap.Uint64("user_id", uint64(event.User().ID)))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to view review times.")
+		return
+	}
+
+	// Fetch decision durations from database
+	since := getPeriodStart(settings.LeaderboardPeriod)
+	durations, err := m.layout.activity.GetDecisionDurations(context.Background(), since)
+	if err != nil {
+		m.layout.logger.Error("Failed to get decision durations", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to retrieve review times. Please try again.")
+		return
+	}
+
+	// Fetch appeal response times for the same period
+	appealTimes, err := m.layout.appeals.GetResponseTimeStats(context.Background(), since)
+	if err != nil {
+		m.layout.logger.Error("Failed to get appeal response times", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to retrieve review times. Please try again.")
+		return
+	}
+
+	// Fetch how often users of accepted appeals were flagged again
+	regressions, err := m.layout.appeals.GetRegressionStats(context.Background(), since)
+	if err != nil {
+		m.layout.logger.Error("Failed to get appeal regression stats", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to retrieve review times. Please try again.")
+		return
+	}
+
+	// Fetch how often the flags of each checker were confirmed
+	precision, err := m.layout.db.Stats().GetCheckerPrecision(context.Background(), since)
+	if err != nil {
+		m.layout.logger.Error("Failed to get checker precision", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to retrieve review times. Please try again.")
+		return
+	}
+
+	s.Set(constants.SessionKeyDecisionDurations, durations)
+	s.Set(constants.SessionKeyAppealResponseTimes, appealTimes)
+	s.Set(constants.SessionKeyAppealRegressions, regressions)
+	s.Set(constants.SessionKeyCheckerPrecision, precision)
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, "")
+}
+
+// handleSelectMenu processes select menu interactions.
+func (m *ReviewTimesMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s *session.Session, customID string, option string) {
+	if customID != constants.LeaderboardPeriodSelectMenuCustomID {
+		return
+	}
+
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	// Parse option to leaderboard period
+	period, err := enum.LeaderboardPeriodString(option)
+	if err != nil {
+		m.layout.logger.Error("Failed to parse leaderboard period", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to save time period preference. Please try again.")
+		return
+	}
+
+	// Update user's period preference shared with the leaderboard
+	settings.LeaderboardPeriod = period
+	if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
+		m.layout.logger.Error("Failed to save user settings", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to save time period preference. Please try again.")
+		return
+	}
+	s.Set(constants.SessionKeyUserSettings, settings)
+
+	m.layout.ResetStats(s)
+	m.Show(event, s)
+}
+
+// handleButton processes button interactions.
+func (m *ReviewTimesMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
+	switch customID {
+	case constants.BackButtonCustomID:
+		m.layout.paginationManager.NavigateBack(event, s, "")
+	case constants.RefreshButtonCustomID:
+		m.Show(event, s)
+	}
+}
+
+// getPeriodStart returns the start time of a leaderboard period.
+func getPeriodStart(period enum.LeaderboardPeriod) time.Time {
+	now := time.Now()
+	switch period {
+	case enum.LeaderboardPeriodDaily:
+		return now.AddDate(0, 0, -1)
+	case enum.LeaderboardPeriodWeekly:
+		return now.AddDate(0, 0, -7)
+	case enum.LeaderboardPeriodBiWeekly:
+		return now.AddDate(0, 0, -14)
+	case enum.LeaderboardPeriodMonthly:
+		return now.AddDate(0, -1, 0)
+	case enum.LeaderboardPeriodBiAnnually:
+		return now.AddDate(0, -6, 0)
+	case enum.LeaderboardPeriodAnnually:
+		return now.AddDate(-1, 0, 0)
+	case enum.LeaderboardPeriodAllTime:
+		return time.Time{}
+	default:
+		return now.AddDate(0, 0, -7)
+	}
+}
@@ -0,0 +1,108 @@
+package leaderboard
+
+import (
+	"sync"
+	"time"
+)
+
+// usernameEntry is a cached username and when it stops being valid.
+type usernameEntry struct {
+	username  string
+	expiresAt time.Time
+}
+
+// UsernameCache resolves Discord usernames for leaderboard entries and keeps them
+// for a limited time so paging through the leaderboard does not fetch the same
+// users again. It is safe for concurrent use by multiple sessions.
+type UsernameCache struct {
+	fetch   func(userID uint64) (string, error)
+	ttl     time.Duration
+	entries map[uint64]usernameEntry
+	mu      sync.RWMutex
+}
+
+// NewUsernameCache creates a UsernameCache that uses fetch to look up usernames
+// missing from the cache.
+func NewUsernameCache(fetch func(userID uint64) (string, error), ttl time.Duration) *UsernameCache {
+	return &UsernameCache{
+		fetch:   fetch,
+		ttl:     ttl,
+		entries: make(map[uint64]usernameEntry),
+	}
+}
+
+// Resolve returns the usernames for the given user IDs. Cached usernames are reused
+// and the rest are fetched concurrently in a single batch. Users that could not be
+// fetched or came back with an empty username are left out so they are shown as
+// unknown and fetched again next time.
+func (c *UsernameCache) Resolve(userIDs []uint64) map[uint64]string {
+	now := time.Now()
+	usernames := make(map[uint64]string, len(userIDs))
+	missing := make([]uint64, 0, len(userIDs))
+
+	// Use cached usernames that have not expired
+	c.mu.RLock()
+	for _, userID := range userIDs {
+		if _, ok := usernames[userID]; ok {
+			continue
+		}
+		if entry, ok := c.entries[userID]; ok && now.Before(entry.expiresAt) {
+			usernames[userID] = entry.username
+			continue
+		}
+		missing = append(missing, userID)
+	}
+	c.mu.RUnlock()
+
+	if len(missing) == 0 {
+		return usernames
+	}
+
+	// Fetch the remaining usernames concurrently
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	seen := make(map[uint64]struct{}, len(missing))
+	for _, userID := range missing {
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+		seen[userID] = struct{}{}
+
+		wg.Add(1)
+		go func(id uint64) {
+			defer wg.Done()
+
+			username, err := c.fetch(id)
+			if err != nil || username == "" {
+				c.invalidate(id)
+				return
+			}
+
+			mu.Lock()
+			usernames[id] = username
+			mu.Unlock()
+		}(userID)
+	}
+	wg.Wait()
+
+	// Store the fetched usernames
+	expiresAt := time.Now().Add(c.ttl)
+	c.mu.Lock()
+	for userID, username := range usernames {
+		if _, ok := seen[userID]; ok {
+			c.entries[userID] = usernameEntry{username: username, expiresAt: expiresAt}
+		}
+	}
+	c.mu.Unlock()
+
+	return usernames
+}
+
+// invalidate removes a user from the cache.
+func (c *UsernameCache) invalidate(userID uint64) {
+	c.mu.Lock()
+	delete(c.entries, userID)
+	c.mu.Unlock()
+}
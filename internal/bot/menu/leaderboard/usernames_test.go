@@ -0,0 +1,87 @@
+package leaderboard
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeUsernames counts how often each user is fetched.
+type fakeUsernames struct {
+	names map[uint64]string
+	calls map[uint64]int
+	mu    sync.Mutex
+}
+
+func (f *fakeUsernames) fetch(userID uint64) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls[userID]++
+	name, ok := f.names[userID]
+	if !ok {
+		return "", errors.New("unknown user")
+	}
+	return name, nil
+}
+
+func TestUsernameCacheResolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		names     map[uint64]string
+		ttl       time.Duration
+		userIDs   []uint64
+		want      map[uint64]string
+		wantCalls map[uint64]int
+	}{
+		{
+			name:      "cached usernames are not fetched again",
+			names:     map[uint64]string{1: "alice", 2: "bob"},
+			ttl:       time.Hour,
+			userIDs:   []uint64{1, 2},
+			want:      map[uint64]string{1: "alice", 2: "bob"},
+			wantCalls: map[uint64]int{1: 1, 2: 1},
+		},
+		{
+			name:      "duplicate IDs are fetched once",
+			names:     map[uint64]string{1: "alice"},
+			ttl:       time.Hour,
+			userIDs:   []uint64{1, 1, 1},
+			want:      map[uint64]string{1: "alice"},
+			wantCalls: map[uint64]int{1: 1},
+		},
+		{
+			name:      "failed and empty lookups are retried",
+			names:     map[uint64]string{1: "alice", 2: ""},
+			ttl:       time.Hour,
+			userIDs:   []uint64{1, 2, 3},
+			want:      map[uint64]string{1: "alice"},
+			wantCalls: map[uint64]int{1: 1, 2: 2, 3: 2},
+		},
+		{
+			name:      "expired usernames are fetched again",
+			names:     map[uint64]string{1: "alice"},
+			ttl:       0,
+			userIDs:   []uint64{1},
+			want:      map[uint64]string{1: "alice"},
+			wantCalls: map[uint64]int{1: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeUsernames{names: tt.names, calls: make(map[uint64]int)}
+			cache := NewUsernameCache(fake.fetch, tt.ttl)
+
+			// Resolve twice to simulate paging back to the same entries
+			cache.Resolve(tt.userIDs)
+			got := cache.Resolve(tt.userIDs)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCalls, fake.calls)
+		})
+	}
+}
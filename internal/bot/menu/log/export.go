@@ -0,0 +1,256 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+// logExportHeader is the header row of the CSV log export.
+var logExportHeader = []string{
+	"sequence", "timestamp", "activity_type", "reviewer_id", "discord_id", "user_id", "group_id", "details",
+}
+
+// LogExport describes an activity log export written to an attachment.
+type LogExport struct {
+	Rows      int  // Number of logs in the export
+	Truncated bool // Whether more logs matched the filters than were exported
+}
+
+// WriteLogExport writes every activity log matching the filter to w as CSV or JSON
+// lines, fetching the logs a page at a time with the cursor so the export is never
+// held in memory at once. The export stops after maxRows logs or before it would
+// grow larger than maxBytes, in which case a final row noting the truncation is added.
+func WriteLogExport(
+	ctx context.Context, w io.Writer, activity store.ActivityStore, filter types.ActivityFilter,
+	format string, maxRows, maxBytes int,
+) (*LogExport, error) {
+	encoder := logExportEncoder(format)
+
+	header, err := encoder.header()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write log export: %w", err)
+	}
+
+	export := &LogExport{}
+	written := len(header)
+	var cursor *types.LogCursor
+	for {
+		limit := min(constants.LogsExportPageSize, maxRows-export.Rows)
+		if limit <= 0 {
+			export.Truncated = true
+			break
+		}
+
+		logs, nextCursor, err := activity.GetLogs(ctx, filter, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, log := range logs {
+			row, err := encoder.row(log)
+			if err != nil {
+				return nil, err
+			}
+
+			// Keep room for the truncation notice while more logs follow
+			notice, err := encoder.notice(export.Rows + 1)
+			if err != nil {
+				return nil, err
+			}
+
+			required := written + len(row)
+			if i < len(logs)-1 || nextCursor != nil {
+				required += len(notice)
+			}
+			if required > maxBytes {
+				export.Truncated = true
+				break
+			}
+
+			if _, err := w.Write(row); err != nil {
+				return nil, fmt.Errorf("failed to write log export: %w", err)
+			}
+			written += len(row)
+			export.Rows++
+		}
+
+		if export.Truncated || nextCursor == nil {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if export.Truncated {
+		notice, err := encoder.notice(export.Rows)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(notice); err != nil {
+			return nil, fmt.Errorf("failed to write log export: %w", err)
+		}
+	}
+
+	return export, nil
+}
+
+// logExportFile returns the file name and content type of a log export in the format.
+func logExportFile(format string, now time.Time) (string, string) {
+	name := "activity_logs_" + now.UTC().Format("20060102_150405")
+	if format == constants.LogsExportJSONLinesOption {
+		return name + ".jsonl", "application/jsonl"
+	}
+	return name + ".csv", "text/csv"
+}
+
+// logEncoder encodes the rows of a log export.
+type logEncoder interface {
+	header() ([]byte, error)
+	row(log *types.ActivityLog) ([]byte, error)
+	notice(rows int) ([]byte, error)
+}
+
+// logExportEncoder returns the encoder for the format. CSV is used for unknown formats.
+func logExportEncoder(format string) logEncoder {
+	if format == constants.LogsExportJSONLinesOption {
+		return jsonLinesEncoder{}
+	}
+	return csvEncoder{}
+}
+
+// csvEncoder encodes logs as CSV with the details flattened into a JSON string column.
+type csvEncoder struct{}
+
+func (csvEncoder) header() ([]byte, error) {
+	return encodeCSVRow(logExportHeader)
+}
+
+func (csvEncoder) row(log *types.ActivityLog) ([]byte, error) {
+	details, err := encodeDetails(log.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeCSVRow([]string{
+		strconv.FormatInt(log.Sequence, 10),
+		log.ActivityTimestamp.UTC().Format(time.RFC3339),
+		log.ActivityType.String(),
+		formatExportID(log.ReviewerID),
+		formatExportID(log.ActivityTarget.DiscordID),
+		formatExportID(log.ActivityTarget.UserID),
+		formatExportID(log.ActivityTarget.GroupID),
+		details,
+	})
+}
+
+func (csvEncoder) notice(rows int) ([]byte, error) {
+	return encodeCSVRow([]string{
+		"# truncated",
+		fmt.Sprintf("export stopped after %d logs, narrow the filters to export the rest", rows),
+		"", "", "", "", "", "",
+	})
+}
+
+// jsonLinesEncoder encodes logs as one JSON object per line.
+type jsonLinesEncoder struct{}
+
+// jsonLinesRow is a single log in the JSON lines export. IDs are written as
+// strings since Discord IDs do not fit in the numbers of most JSON readers.
+type jsonLinesRow struct {
+	Sequence     int64                  `json:"sequence"`
+	Timestamp    string                 `json:"timestamp"`
+	ActivityType string                 `json:"activity_type"`
+	ReviewerID   string                 `json:"reviewer_id"`
+	DiscordID    string                 `json:"discord_id,omitempty"`
+	UserID       string                 `json:"user_id,omitempty"`
+	GroupID      string                 `json:"group_id,omitempty"`
+	Details      map[string]interface{} `json:"details,omitempty"`
+}
+
+// jsonLinesNotice is the final line of a truncated JSON lines export.
+type jsonLinesNotice struct {
+	Truncated bool   `json:"truncated"`
+	Rows      int    `json:"rows"`
+	Message   string `json:"message"`
+}
+
+func (jsonLinesEncoder) header() ([]byte, error) {
+	return nil, nil
+}
+
+func (jsonLinesEncoder) row(log *types.ActivityLog) ([]byte, error) {
+	return encodeJSONLine(jsonLinesRow{
+		Sequence:     log.Sequence,
+		Timestamp:    log.ActivityTimestamp.UTC().Format(time.RFC3339),
+		ActivityType: log.ActivityType.String(),
+		ReviewerID:   formatExportID(log.ReviewerID),
+		DiscordID:    formatExportID(log.ActivityTarget.DiscordID),
+		UserID:       formatExportID(log.ActivityTarget.UserID),
+		GroupID:      formatExportID(log.ActivityTarget.GroupID),
+		Details:      log.Details,
+	})
+}
+
+func (jsonLinesEncoder) notice(rows int) ([]byte, error) {
+	return encodeJSONLine(jsonLinesNotice{
+		Truncated: true,
+		Rows:      rows,
+		Message:   "narrow the filters to export the rest",
+	})
+}
+
+// formatExportID formats an ID for the export, leaving unset IDs empty.
+func formatExportID(id uint64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatUint(id, 10)
+}
+
+// encodeDetails encodes the details of a log as a JSON string with sorted keys.
+func encodeDetails(details map[string]interface{}) (string, error) {
+	if len(details) == 0 {
+		return "", nil
+	}
+
+	data, err := sonic.ConfigStd.Marshal(details)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode log details: %w", err)
+	}
+	return string(data), nil
+}
+
+// encodeJSONLine encodes a value as a single JSON line with sorted keys.
+func encodeJSONLine(v interface{}) ([]byte, error) {
+	data, err := sonic.ConfigStd.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write JSON line: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// encodeCSVRow encodes a single CSV record.
+func encodeCSVRow(record []string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(record); err != nil {
+		return nil, fmt.Errorf("failed to write CSV row: %w", err)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to flush CSV row: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,142 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/robalyx/rotector/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newExportStore(count int) *testutil.ActivityStore {
+	activity := &testutil.ActivityStore{}
+	start := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	for i := 1; i <= count; i++ {
+		activity.Log(context.Background(), &types.ActivityLog{
+			Sequence:          int64(i),
+			ReviewerID:        1000,
+			ActivityTarget:    types.ActivityTarget{UserID: uint64(i)},
+			ActivityType:      enum.ActivityTypeUserConfirmed,
+			ActivityTimestamp: start.Add(time.Duration(i) * time.Minute),
+			Details:           map[string]interface{}{"reason": "test, \"quoted\"", "confidence": 0.5},
+		})
+	}
+	return activity
+}
+
+func TestWriteLogExportCSV(t *testing.T) {
+	activity := newExportStore(2)
+	filter := types.ActivityFilter{ReviewerID: 1000}
+
+	var buf bytes.Buffer
+	export, err := WriteLogExport(context.Background(), &buf, activity, filter, constants.LogsExportCSVOption, 10, 1<<20)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, export.Rows)
+	assert.False(t, export.Truncated)
+	assert.Equal(t, "sequence,timestamp,activity_type,reviewer_id,discord_id,user_id,group_id,details\n"+
+		`2,2025-01-24T12:02:00Z,UserConfirmed,1000,,2,,"{""confidence"":0.5,""reason"":""test, \""quoted\""""}"`+"\n"+
+		`1,2025-01-24T12:01:00Z,UserConfirmed,1000,,1,,"{""confidence"":0.5,""reason"":""test, \""quoted\""""}"`+"\n",
+		buf.String())
+	assert.Equal(t, []types.ActivityFilter{filter}, activity.Filters)
+}
+
+func TestWriteLogExportJSONLines(t *testing.T) {
+	activity := newExportStore(1)
+
+	var buf bytes.Buffer
+	export, err := WriteLogExport(
+		context.Background(), &buf, activity, types.ActivityFilter{}, constants.LogsExportJSONLinesOption, 10, 1<<20,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, export.Rows)
+	assert.Equal(t, `{"sequence":1,"timestamp":"2025-01-24T12:01:00Z","activity_type":"UserConfirmed",`+
+		`"reviewer_id":"1000","user_id":"1","details":{"confidence":0.5,"reason":"test, \"quoted\""}}`+"\n",
+		buf.String())
+}
+
+func TestWriteLogExportPagesThroughAllLogs(t *testing.T) {
+	activity := newExportStore(constants.LogsExportPageSize + 5)
+
+	var buf bytes.Buffer
+	export, err := WriteLogExport(
+		context.Background(), &buf, activity, types.ActivityFilter{}, constants.LogsExportJSONLinesOption,
+		constants.LogsExportMaxRows, 1<<30,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, constants.LogsExportPageSize+5, export.Rows)
+	assert.False(t, export.Truncated)
+	assert.Len(t, activity.Filters, 2)
+}
+
+func TestWriteLogExportTruncates(t *testing.T) {
+	t.Run("row limit", func(t *testing.T) {
+		var buf bytes.Buffer
+		export, err := WriteLogExport(
+			context.Background(), &buf, newExportStore(5), types.ActivityFilter{}, constants.LogsExportCSVOption, 3, 1<<20,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, export.Rows)
+		assert.True(t, export.Truncated)
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 5)
+		assert.Equal(t, "# truncated,\"export stopped after 3 logs, narrow the filters to export the rest\",,,,,,", lines[4])
+	})
+
+	t.Run("size limit", func(t *testing.T) {
+		var buf bytes.Buffer
+		export, err := WriteLogExport(
+			context.Background(), &buf, newExportStore(5), types.ActivityFilter{}, constants.LogsExportJSONLinesOption, 10, 400,
+		)
+		require.NoError(t, err)
+
+		assert.True(t, export.Truncated)
+		assert.Less(t, export.Rows, 5)
+		assert.LessOrEqual(t, buf.Len(), 400)
+		assert.True(t, strings.HasSuffix(buf.String(),
+			`{"truncated":true,"rows":`+strconv.Itoa(export.Rows)+`,"message":"narrow the filters to export the rest"}`+"\n"))
+	})
+
+	t.Run("exactly at the row limit", func(t *testing.T) {
+		var buf bytes.Buffer
+		export, err := WriteLogExport(
+			context.Background(), &buf, newExportStore(3), types.ActivityFilter{}, constants.LogsExportCSVOption, 3, 1<<20,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, export.Rows)
+		assert.False(t, export.Truncated)
+	})
+}
+
+// failingWriter fails every write, as the attachment does when an upload is aborted.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestWriteLogExportStopsWhenWriteFails(t *testing.T) {
+	activity := newExportStore(constants.LogsExportPageSize + 5)
+
+	_, err := WriteLogExport(
+		context.Background(), failingWriter{}, activity, types.ActivityFilter{}, constants.LogsExportCSVOption,
+		constants.LogsExportMaxRows, 1<<30,
+	)
+	require.ErrorIs(t, err, io.ErrClosedPipe)
+
+	// No more logs are fetched once the upload is gone
+	assert.Empty(t, activity.Filters)
+}
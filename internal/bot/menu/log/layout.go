@@ -1,6 +1,8 @@
 package log
 
 import (
+	"bytes"
+	"context"
 	"time"
 
 	"github.com/robalyx/rotector/internal/bot/constants"
@@ -8,7 +10,7 @@ import (
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
 	"github.com/robalyx/rotector/internal/common/setup"
-	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"go.uber.org/zap"
@@ -16,10 +18,12 @@ import (
 
 // Layout handles log viewing operations and their interactions.
 type Layout struct {
-	db                *database.Client
+	activity          store.ActivityStore
+	settings          store.SettingStore
 	sessionManager    *session.Manager
 	paginationManager *pagination.Manager
 	mainMenu          *MainMenu
+	summaryMenu       *SummaryMenu
 	logger            *zap.Logger
 }
 
@@ -32,15 +36,18 @@ func New(
 ) *Layout {
 	// Initialize layout
 	l := &Layout{
-		db:                app.DB,
+		activity:          app.DB.Activity(),
+		settings:          app.DB.Settings(),
 		sessionManager:    sessionManager,
 		paginationManager: paginationManager,
 		logger:            app.Logger,
 	}
 	l.mainMenu = NewMainMenu(l)
+	l.summaryMenu = NewSummaryMenu(l)
 
-	// Initialize and register page
+	// Initialize and register pages
 	paginationManager.AddPage(l.mainMenu.page)
+	paginationManager.AddPage(l.summaryMenu.page)
 
 	return l
 }
@@ -62,6 +69,71 @@ func (l *Layout) ResetFilters(s *session.Session) {
 	s.Set(constants.SessionKeyDateRangeEndFilter, time.Time{})
 }
 
+// restoreFilters loads the log filters saved in the user's settings into a session
+// that does not have any filters yet. Sessions without saved filters use the defaults.
+func (l *Layout) restoreFilters(s *session.Session) {
+	if s.Get(constants.SessionKeyActivityTypeFilter) != nil {
+		return
+	}
+
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	filters := settings.LoadUIState().LogFilters
+	if filters == nil {
+		l.ResetFilters(s)
+		return
+	}
+
+	s.Set(constants.SessionKeyDiscordIDFilter, filters.DiscordID)
+	s.Set(constants.SessionKeyUserIDFilter, filters.UserID)
+	s.Set(constants.SessionKeyGroupIDFilter, filters.GroupID)
+	s.Set(constants.SessionKeyReviewerIDFilter, filters.ReviewerID)
+	s.Set(constants.SessionKeyActivityTypeFilter, filters.ActivityType)
+	s.Set(constants.SessionKeyDateRangeStartFilter, filters.StartDate)
+	s.Set(constants.SessionKeyDateRangeEndFilter, filters.EndDate)
+}
+
+// saveFilters stores the current log filters in the user's settings so they can be
+// restored in later sessions. Settings are only written when the filters changed
+// and never in read-only sessions, where the filters stay session-only.
+func (l *Layout) saveFilters(s *session.Session) {
+	if s.GetBool(constants.SessionKeyReadOnly) {
+		return
+	}
+
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	filters := &types.LogFilterState{
+		DiscordID:  s.GetUint64(constants.SessionKeyDiscordIDFilter),
+		UserID:     s.GetUint64(constants.SessionKeyUserIDFilter),
+		GroupID:    s.GetUint64(constants.SessionKeyGroupIDFilter),
+		ReviewerID: s.GetUint64(constants.SessionKeyReviewerIDFilter),
+		StartDate:  s.GetTime(constants.SessionKeyDateRangeStartFilter),
+		EndDate:    s.GetTime(constants.SessionKeyDateRangeEndFilter),
+	}
+	s.GetInterface(constants.SessionKeyActivityTypeFilter, &filters.ActivityType)
+
+	previous := settings.UIState
+	state := settings.LoadUIState()
+	state.LogFilters = filters
+	if err := settings.StoreUIState(state); err != nil {
+		l.logger.Warn("Failed to store log filters", zap.Error(err))
+		return
+	}
+
+	if bytes.Equal(previous, settings.UIState) {
+		return
+	}
+
+	if err := l.settings.SaveUserSettings(context.Background(), settings); err != nil {
+		l.logger.Error("Failed to save log filters", zap.Error(err))
+		return
+	}
+	s.Set(constants.SessionKeyUserSettings, settings)
+}
+
 // ResetLogs clears the logs from the session.
 func (l *Layout) ResetLogs(s *session.Session) {
 	s.Set(constants.SessionKeyLogs, []*types.ActivityLog{})
@@ -3,7 +3,10 @@ package log
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
@@ -44,25 +47,20 @@ func NewMainMenu(l *Layout) *MainMenu {
 // Show prepares and displays the logs based on current filters
 // and updates the session with the results.
 func (m *MainMenu) Show(event interfaces.CommonEvent, s *session.Session) {
+	// Restore saved filters if this session has none yet
+	m.layout.restoreFilters(s)
+
 	// Get query parameters from session
-	activityFilter := types.ActivityFilter{
-		DiscordID:  s.GetUint64(constants.SessionKeyDiscordIDFilter),
-		UserID:     s.GetUint64(constants.SessionKeyUserIDFilter),
-		GroupID:    s.GetUint64(constants.SessionKeyGroupIDFilter),
-		ReviewerID: s.GetUint64(constants.SessionKeyReviewerIDFilter),
-		StartDate:  s.GetTime(constants.SessionKeyDateRangeStartFilter),
-		EndDate:    s.GetTime(constants.SessionKeyDateRangeEndFilter),
-	}
-	s.GetInterface(constants.SessionKeyActivityTypeFilter, &activityFilter.ActivityType)
+	filter := activityFilter(s)
 
 	// Get cursor from session if it exists
 	var cursor *types.LogCursor
 	s.GetInterface(constants.SessionKeyLogCursor, &cursor)
 
 	// Fetch filtered logs from database
-	logs, nextCursor, err := m.layout.db.Activity().GetLogs(
+	logs, nextCursor, err := m.layout.activity.GetLogs(
 		context.Background(),
-		activityFilter,
+		filter,
 		cursor,
 		constants.LogsPerPage,
 	)
@@ -72,15 +70,6 @@ func (m *MainMenu) Show(event interfaces.CommonEvent, s *session.Session) {
 		return
 	}
 
-	// If this is the first page (cursor is nil), create a cursor from the first log
-	if cursor == nil && len(logs) > 0 {
-		log := logs[0]
-		cursor = &types.LogCursor{
-			Timestamp: log.ActivityTimestamp,
-			Sequence:  log.Sequence,
-		}
-	}
-
 	// Get previous cursors array
 	var prevCursors []*types.LogCursor
 	s.GetInterface(constants.SessionKeyLogPrevCursors, &prevCursors)
@@ -104,15 +93,20 @@ func (m *MainMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s
 		case constants.LogsQueryDiscordIDOption:
 			m.showQueryModal(event, option, "Discord ID", "ID", "Enter the Discord ID to query logs")
 		case constants.LogsQueryUserIDOption:
-			m.showQueryModal(event, option, "User ID", "ID", "Enter the User ID to query logs")
+			m.showQueryModal(event, option, "User ID", "ID", "Enter the User ID or profile link to query logs")
 		case constants.LogsQueryGroupIDOption:
-			m.showQueryModal(event, option, "Group ID", "ID", "Enter the Group ID to query logs")
+			m.showQueryModal(event, option, "Group ID", "ID", "Enter the Group ID or group link to query logs")
 		case constants.LogsQueryReviewerIDOption:
 			m.showQueryModal(event, option, "Reviewer ID", "ID", "Enter the Reviewer ID to query logs")
 		case constants.LogsQueryDateRangeOption:
 			m.showQueryModal(event, constants.LogsQueryDateRangeOption, "Date Range", "Date Range", "YYYY-MM-DD to YYYY-MM-DD")
+		case constants.LogsReviewerSummaryOption:
+			m.showSummaryModal(event)
 		}
 
+	case constants.LogsExportSelectMenuCustomID:
+		m.handleExport(event, s, option)
+
 	case constants.LogsQueryActivityTypeFilterCustomID:
 		// Convert activity type option to int and update filter
 		optionInt, err := strconv.Atoi(option)
@@ -123,10 +117,58 @@ func (m *MainMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s
 		}
 
 		s.Set(constants.SessionKeyActivityTypeFilter, enum.ActivityType(optionInt))
+		m.layout.saveFilters(s)
 		m.Show(event, s)
 	}
 }
 
+// handleExport attaches every log matching the current filters in the chosen format.
+func (m *MainMenu) handleExport(event *events.ComponentInteractionCreate, s *session.Session, format string) {
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+
+	// Verify the user is a reviewer
+	userID := uint64(event.User().ID)
+	if !botSettings.IsReviewer(userID) {
+		m.layout.logger.Error("Non-reviewer attempted to export logs", zap.Uint64("user_id", userID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to export logs.")
+		return
+	}
+
+	// Stream the export into the attachment as the logs are fetched
+	reader, writer := io.Pipe()
+	done := make(chan *LogExport, 1)
+	go func() {
+		export, err := WriteLogExport(
+			context.Background(), writer, m.layout.activity, activityFilter(s), format,
+			constants.LogsExportMaxRows, constants.LogsExportMaxBytes,
+		)
+		_ = writer.CloseWithError(err)
+		done <- export
+	}()
+
+	fileName, contentType := logExportFile(format, time.Now())
+	messageUpdate := discord.NewMessageUpdateBuilder().
+		AddFile(fileName, contentType, reader).
+		Build()
+	_, err := event.Client().Rest().UpdateInteractionResponse(event.ApplicationID(), event.Token(), messageUpdate)
+
+	// Stop the export if the upload failed before reading all of it
+	_ = reader.CloseWithError(err)
+	export := <-done
+	if err != nil || export == nil {
+		m.layout.logger.Error("Failed to export logs", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to export logs. Please try again.")
+		return
+	}
+
+	content := fmt.Sprintf("Exported %d logs.", export.Rows)
+	if export.Truncated {
+		content = fmt.Sprintf("Exported the latest %d logs. More logs match the filters, narrow them to export the rest.", export.Rows)
+	}
+	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
+}
+
 // handleButton processes button interactions by handling navigation
 // back to the dashboard and page navigation.
 func (m *MainMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
@@ -138,6 +180,7 @@ func (m *MainMenu) handleButton(event *events.ComponentInteractionCreate, s *ses
 		m.Show(event, s)
 	case constants.ClearFiltersButtonCustomID:
 		m.layout.ResetFilters(s)
+		m.layout.saveFilters(s)
 		m.Show(event, s)
 	case string(utils.ViewerFirstPage), string(utils.ViewerPrevPage), string(utils.ViewerNextPage), string(utils.ViewerLastPage):
 		m.handlePagination(event, s, utils.ViewerAction(customID))
@@ -156,6 +199,8 @@ func (m *MainMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *se
 		m.handleIDModalSubmit(event, s, customID)
 	case constants.LogsQueryDateRangeOption:
 		m.handleDateRangeModalSubmit(event, s)
+	case constants.LogsReviewerSummaryOption:
+		m.handleSummaryModalSubmit(event, s)
 	}
 }
 
@@ -177,13 +222,70 @@ func (m *MainMenu) showQueryModal(event *events.ComponentInteractionCreate, opti
 	}
 }
 
+// showSummaryModal creates and displays a modal for choosing the reviewer and day
+// of a reviewer summary.
+func (m *MainMenu) showSummaryModal(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.LogsReviewerSummaryOption).
+		SetTitle("Reviewer Summary").
+		AddActionRow(
+			discord.NewTextInput(constants.LogsSummaryReviewerInputCustomID, discord.TextInputStyleShort, "Reviewer ID").
+				WithPlaceholder("Enter the Discord ID of the reviewer").
+				WithRequired(true),
+		).
+		AddActionRow(
+			discord.NewTextInput(constants.LogsSummaryDateInputCustomID, discord.TextInputStyleShort, "Date (UTC)").
+				WithPlaceholder("YYYY-MM-DD, leave blank for today").
+				WithRequired(false),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to show reviewer summary modal", zap.Error(err))
+	}
+}
+
+// handleSummaryModalSubmit parses the reviewer and day from the modal and opens
+// the reviewer summary.
+func (m *MainMenu) handleSummaryModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	reviewerID, err := strconv.ParseUint(event.Data.Text(constants.LogsSummaryReviewerInputCustomID), 10, 64)
+	if err != nil {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Invalid ID provided. Please enter a valid numeric ID.")
+		return
+	}
+
+	day, err := utils.ParseDay(event.Data.Text(constants.LogsSummaryDateInputCustomID), time.Now())
+	if err != nil {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Invalid date provided. Please use the YYYY-MM-DD format.")
+		return
+	}
+
+	s.Set(constants.SessionKeyReviewerSummaryReviewer, reviewerID)
+	s.Set(constants.SessionKeyReviewerSummaryDate, day)
+	m.layout.summaryMenu.Show(event, s)
+}
+
 // handleIDModalSubmit processes ID-based query modal submissions by parsing
 // the ID and updating the appropriate session value.
 func (m *MainMenu) handleIDModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session, queryType string) {
-	idStr := event.Data.Text(constants.LogsQueryInputCustomID)
-	id, err := strconv.ParseUint(idStr, 10, 64)
+	idStr := strings.TrimSpace(event.Data.Text(constants.LogsQueryInputCustomID))
+
+	// Roblox IDs may also be pasted as profile or group links
+	var id uint64
+	var err error
+	switch queryType {
+	case constants.LogsQueryUserIDOption:
+		id, err = utils.ParseRobloxUserID(idStr)
+	case constants.LogsQueryGroupIDOption:
+		id, err = utils.ParseRobloxGroupID(idStr)
+	default:
+		if id, err = strconv.ParseUint(idStr, 10, 64); err != nil {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "Invalid ID provided. Please enter a valid numeric ID.")
+			return
+		}
+	}
 	if err != nil {
-		m.layout.paginationManager.NavigateTo(event, s, m.page, "Invalid ID provided. Please enter a valid numeric ID.")
+		m.layout.paginationManager.NavigateTo(event, s, m.page, fmt.Sprintf("Invalid ID provided: %v.", err))
 		return
 	}
 
@@ -199,6 +301,7 @@ func (m *MainMenu) handleIDModalSubmit(event *events.ModalSubmitInteractionCreat
 		s.Set(constants.SessionKeyReviewerIDFilter, id)
 	}
 
+	m.layout.saveFilters(s)
 	m.Show(event, s)
 }
 
@@ -215,6 +318,7 @@ func (m *MainMenu) handleDateRangeModalSubmit(event *events.ModalSubmitInteracti
 	s.Set(constants.SessionKeyDateRangeStartFilter, startDate)
 	s.Set(constants.SessionKeyDateRangeEndFilter, endDate)
 
+	m.layout.saveFilters(s)
 	m.Show(event, s)
 }
 
@@ -252,3 +356,17 @@ func (m *MainMenu) handlePagination(event *events.ComponentInteractionCreate, s
 		return
 	}
 }
+
+// activityFilter returns the log filters stored in the session.
+func activityFilter(s *session.Session) types.ActivityFilter {
+	filter := types.ActivityFilter{
+		DiscordID:  s.GetUint64(constants.SessionKeyDiscordIDFilter),
+		UserID:     s.GetUint64(constants.SessionKeyUserIDFilter),
+		GroupID:    s.GetUint64(constants.SessionKeyGroupIDFilter),
+		ReviewerID: s.GetUint64(constants.SessionKeyReviewerIDFilter),
+		StartDate:  s.GetTime(constants.SessionKeyDateRangeStartFilter),
+		EndDate:    s.GetTime(constants.SessionKeyDateRangeEndFilter),
+	}
+	s.GetInterface(constants.SessionKeyActivityTypeFilter, &filter.ActivityType)
+	return filter
+}
@@ -0,0 +1,66 @@
+package log
+
+import (
+	"context"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	builder "github.com/robalyx/rotector/internal/bot/builder/log"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"go.uber.org/zap"
+)
+
+// SummaryMenu handles the display and interaction logic for a reviewer's daily activity summary.
+type SummaryMenu struct {
+	layout *Layout
+	page   *pagination.Page
+}
+
+// NewSummaryMenu creates a SummaryMenu and sets up its page with message builders and
+// interaction handlers.
+func NewSummaryMenu(l *Layout) *SummaryMenu {
+	m := &SummaryMenu{layout: l}
+	m.page = &pagination.Page{
+		Name: "Reviewer Summary Menu",
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewSummaryBuilder(s).Build()
+		},
+		ButtonHandlerFunc: m.handleButton,
+	}
+	return m
+}
+
+// Show counts the activities of the reviewer and day stored in the session
+// and displays them.
+func (m *SummaryMenu) Show(event interfaces.CommonEvent, s *session.Session) {
+	reviewerID := s.GetUint64(constants.SessionKeyReviewerSummaryReviewer)
+	start := s.GetTime(constants.SessionKeyReviewerSummaryDate)
+
+	counts, err := m.layout.activity.GetReviewerSummary(
+		context.Background(), reviewerID, start, start.AddDate(0, 0, 1),
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to get reviewer summary",
+			zap.Error(err),
+			zap.Uint64("reviewerID", reviewerID))
+		m.layout.paginationManager.RespondWithError(event, "Failed to retrieve reviewer summary. Please try again.")
+		return
+	}
+
+	s.Set(constants.SessionKeyReviewerSummary, counts)
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, "")
+}
+
+// handleButton processes button interactions.
+func (m *SummaryMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
+	switch customID {
+	case constants.BackButtonCustomID:
+		m.layout.paginationManager.NavigateBack(event, s, "")
+	case constants.RefreshButtonCustomID:
+		m.Show(event, s)
+	}
+}
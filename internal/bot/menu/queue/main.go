@@ -2,7 +2,7 @@ package queue
 
 import (
 	"context"
-	"strconv"
+	"fmt"
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
@@ -31,12 +31,11 @@ func NewMainMenu(layout *Layout) *MainMenu {
 	m.page = &pagination.Page{
 		Name: "Queue Menu",
 		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
-			// Load current queue lengths for display
-			highCount := s.GetInt(constants.SessionKeyQueueHighCount)
-			normalCount := s.GetInt(constants.SessionKeyQueueNormalCount)
-			lowCount := s.GetInt(constants.SessionKeyQueueLowCount)
+			// Load current queue stats for display
+			var stats []*queue.PriorityStats
+			s.GetInterface(constants.SessionKeyQueueStats, &stats)
 
-			return builder.NewBuilder(highCount, normalCount, lowCount).Build()
+			return builder.NewBuilder(stats).Build()
 		},
 		SelectHandlerFunc: m.handleSelectMenu,
 		ButtonHandlerFunc: m.handleButton,
@@ -46,12 +45,16 @@ func NewMainMenu(layout *Layout) *MainMenu {
 }
 
 // Show prepares and displays the queue interface by loading
-// current queue lengths into the session.
+// current queue stats into the session.
 func (m *MainMenu) Show(event interfaces.CommonEvent, s *session.Session, content string) {
-	// Store current queue lengths in session for the message builder
-	s.Set(constants.SessionKeyQueueHighCount, m.layout.queueManager.GetQueueLength(context.Background(), queue.HighPriority))
-	s.Set(constants.SessionKeyQueueNormalCount, m.layout.queueManager.GetQueueLength(context.Background(), queue.NormalPriority))
-	s.Set(constants.SessionKeyQueueLowCount, m.layout.queueManager.GetQueueLength(context.Background(), queue.LowPriority))
+	// Store current queue stats in session for the message builder
+	stats, err := m.layout.queueManager.GetStats(context.Background())
+	if err != nil {
+		m.layout.logger.Error("Failed to get queue stats", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to get queue stats. Please try again.")
+		return
+	}
+	s.Set(constants.SessionKeyQueueStats, stats)
 
 	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
 }
@@ -70,7 +73,7 @@ func (m *MainMenu) handleSelectMenu(event *events.ComponentInteractionCreate, _
 		AddActionRow(
 			discord.NewTextInput(constants.UserIDInputCustomID, discord.TextInputStyleShort, "User ID").
 				WithRequired(true).
-				WithPlaceholder("Enter the user ID"),
+				WithPlaceholder("Enter the user ID or profile link"),
 		).
 		AddActionRow(
 			discord.NewTextInput(constants.ReasonInputCustomID, discord.TextInputStyleParagraph, "Reason").
@@ -98,13 +101,16 @@ func (m *MainMenu) handleButton(event *events.ComponentInteractionCreate, s *ses
 // handleModal processes modal submissions by adding the user to the queue
 // with the specified priority and reason.
 func (m *MainMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	// Parse user ID and get reason from modal
-	userIDStr := event.Data.Text(constants.UserIDInputCustomID)
 	reason := event.Data.Text(constants.ReasonInputCustomID)
 
-	userID, err := strconv.ParseUint(userIDStr, 10, 64)
+	userID, err := utils.ParseRobloxUserID(event.Data.Text(constants.UserIDInputCustomID))
 	if err != nil {
-		m.Show(event, s, "Invalid user ID format.")
+		m.Show(event, s, fmt.Sprintf("Invalid user ID: %v.", err))
 		return
 	}
 
@@ -5,29 +5,44 @@ import (
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/bot/menu/review/shared"
 	"github.com/robalyx/rotector/internal/common/client/fetcher"
+	"github.com/robalyx/rotector/internal/common/queue"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/robalyx/rotector/internal/common/translator"
 	"go.uber.org/zap"
 )
 
 // Layout handles all review-related menus and their interactions.
 type Layout struct {
 	db                *database.Client
+	users             store.UserStore
+	groups            store.GroupStore
+	activity          store.ActivityStore
+	settings          store.SettingStore
+	notes             store.NoteStore
+	noteHandler       *shared.NoteHandler
 	roAPI             *api.API
 	sessionManager    *session.Manager
 	paginationManager *pagination.Manager
+	queueManager      *queue.Manager
 	reviewMenu        *ReviewMenu
 	membersMenu       *MembersMenu
 	groupFetcher      *fetcher.GroupFetcher
+	userFetcher       *fetcher.UserFetcher
 	thumbnailFetcher  *fetcher.ThumbnailFetcher
 	presenceFetcher   *fetcher.PresenceFetcher
 	imageStreamer     *pagination.ImageStreamer
+	translations      *translator.Cache
 	logger            *zap.Logger
 	settingLayout     interfaces.SettingLayout
 	logLayout         interfaces.LogLayout
 	chatLayout        interfaces.ChatLayout
 	captchaLayout     interfaces.CaptchaLayout
+	userReviewLayout  interfaces.UserReviewLayout
 }
 
 // New creates a Layout by initializing all review menus and registering their
@@ -41,24 +56,39 @@ func New(
 	logLayout interfaces.LogLayout,
 	chatLayout interfaces.ChatLayout,
 	captchaLayout interfaces.CaptchaLayout,
+	userReviewLayout interfaces.UserReviewLayout,
 ) *Layout {
 	// Initialize layout
 	l := &Layout{
 		db:                app.DB,
+		users:             app.DB.Users(),
+		groups:            app.DB.Groups(),
+		activity:          app.DB.Activity(),
+		settings:          app.DB.Settings(),
+		notes:             app.DB.Notes(),
 		roAPI:             app.RoAPI,
 		sessionManager:    sessionManager,
 		paginationManager: paginationManager,
-		groupFetcher:      fetcher.NewGroupFetcher(app.RoAPI, app.Logger),
+		queueManager:      app.Queue,
+		groupFetcher:      fetcher.NewGroupFetcher(app.RoAPI, app.DB.Shouts(), app.Logger),
+		userFetcher:       fetcher.NewUserFetcher(app, app.Logger),
 		thumbnailFetcher:  fetcher.NewThumbnailFetcher(app.RoAPI, app.Logger),
 		presenceFetcher:   fetcher.NewPresenceFetcher(app.RoAPI, app.Logger),
 		imageStreamer:     pagination.NewImageStreamer(paginationManager, app.Logger, app.RoAPI.GetClient()),
+		translations:      app.Translations,
 		logger:            app.Logger,
 		settingLayout:     settingLayout,
 		logLayout:         logLayout,
 		chatLayout:        chatLayout,
 		captchaLayout:     captchaLayout,
+		userReviewLayout:  userReviewLayout,
 	}
 
+	// Share the note handling with the other review menu
+	l.noteHandler = shared.NewNoteHandler(
+		l.notes, l.activity, paginationManager, app.Logger, enum.NoteTargetTypeGroup, reviewedGroupID,
+	)
+
 	// Initialize all menus with references to this layout
 	l.reviewMenu = NewReviewMenu(l)
 	l.membersMenu = NewMembersMenu(l)
@@ -3,7 +3,12 @@ package group
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
@@ -34,12 +39,15 @@ func NewMembersMenu(layout *Layout) *MembersMenu {
 		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
 			return builder.NewMembersBuilder(s).Build()
 		},
+		SelectHandlerFunc: m.handleSelectMenu,
 		ButtonHandlerFunc: m.handlePageNavigation,
 	}
 	return m
 }
 
 // Show prepares and displays the members interface for a specific page.
+// Only member statuses are loaded for the full list so it can be sorted, while
+// details, presences and thumbnails are fetched for the visible page alone.
 func (m *MembersMenu) Show(event *events.ComponentInteractionCreate, s *session.Session, page int) {
 	var memberIDs []uint64
 	s.GetInterface(constants.SessionKeyGroupMemberIDs, &memberIDs)
@@ -50,20 +58,18 @@ func (m *MembersMenu) Show(event *events.ComponentInteractionCreate, s *session.
 		return
 	}
 
-	// Get user data from database
-	members, err := m.layout.db.Users().GetUsersByIDs(context.Background(), memberIDs, types.UserFields{
-		Basic:      true,
-		Reason:     true,
-		Confidence: true,
+	// Get member statuses to sort the full member list
+	statuses, err := m.layout.users.GetUsersByIDs(context.Background(), memberIDs, types.UserFields{
+		Basic: true,
 	})
 	if err != nil {
-		m.layout.logger.Error("Failed to get user data", zap.Error(err))
+		m.layout.logger.Error("Failed to get member statuses", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to fetch member data. Please try again.")
 		return
 	}
 
 	// Sort members by status
-	sortedMemberIDs := m.sortMembersByStatus(memberIDs, members)
+	sortedMemberIDs := m.sortMembersByStatus(memberIDs, statuses)
 
 	// Calculate page boundaries
 	start := page * constants.MembersPerPage
@@ -73,14 +79,40 @@ func (m *MembersMenu) Show(event *events.ComponentInteractionCreate, s *session.
 	}
 	pageMembers := sortedMemberIDs[start:end]
 
-	// Fetch presence data for the current page of members
-	presenceMap := m.fetchPresences(pageMembers)
+	// Fetch member details and presences for the current page concurrently
+	var (
+		wg          sync.WaitGroup
+		members     map[uint64]*types.ReviewUser
+		membersErr  error
+		presenceMap map[uint64]*apiTypes.UserPresenceResponse
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		members, membersErr = m.layout.users.GetUsersByIDs(context.Background(), pageMembers, types.UserFields{
+			Basic:      true,
+			Reason:     true,
+			Confidence: true,
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		presenceMap = m.fetchPresences(pageMembers)
+	}()
+	wg.Wait()
+
+	if membersErr != nil {
+		m.layout.logger.Error("Failed to get user data", zap.Error(membersErr))
+		m.layout.paginationManager.RespondWithError(event, "Failed to fetch member data. Please try again.")
+		return
+	}
 
 	// Store data in session for the message builder
 	s.Set(constants.SessionKeyGroupMemberIDs, sortedMemberIDs)
 	s.Set(constants.SessionKeyGroupMembers, members)
 	s.Set(constants.SessionKeyGroupPageMembers, pageMembers)
-	s.Set(constants.SessionKeyPresences, presenceMap)
+	s.Set(constants.SessionKeyGroupMemberPresences, presenceMap)
+	s.Delete(constants.SessionKeyGroupSelectedMembers)
 	s.Set(constants.SessionKeyStart, start)
 	s.Set(constants.SessionKeyPaginationPage, page)
 	s.Set(constants.SessionKeyTotalItems, len(sortedMemberIDs))
@@ -114,6 +146,12 @@ func (m *MembersMenu) handlePageNavigation(event *events.ComponentInteractionCre
 
 		m.Show(event, s, page)
 
+	case constants.MembersConfirmButtonCustomID:
+		m.handleBulkAction(event, s, true)
+
+	case constants.MembersClearButtonCustomID:
+		m.handleBulkAction(event, s, false)
+
 	case constants.BackButtonCustomID:
 		m.layout.paginationManager.NavigateBack(event, s, "")
 
@@ -123,6 +161,240 @@ func (m *MembersMenu) handlePageNavigation(event *events.ComponentInteractionCre
 	}
 }
 
+// handleSelectMenu processes select menu interactions.
+func (m *MembersMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s *session.Session, customID string, _ string) {
+	if customID != constants.MembersSelectMenuCustomID {
+		return
+	}
+
+	var pageMembers []uint64
+	s.GetInterface(constants.SessionKeyGroupPageMembers, &pageMembers)
+
+	onPage := make(map[uint64]bool, len(pageMembers))
+	for _, id := range pageMembers {
+		onPage[id] = true
+	}
+
+	// Only keep members from the current page to limit the batch size
+	values := event.StringSelectMenuInteractionData().Values
+	selected := make([]uint64, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || !onPage[id] {
+			continue
+		}
+		selected = append(selected, id)
+	}
+
+	s.Set(constants.SessionKeyGroupSelectedMembers, selected)
+	m.layout.paginationManager.NavigateTo(event, s, m.page, fmt.Sprintf("Selected %d member(s).", len(selected)))
+}
+
+// handleBulkAction confirms or clears the selected members and logs the action
+// for each of them. The members are moved in a single transaction and the
+// response summarizes which members succeeded and which failed.
+func (m *MembersMenu) handleBulkAction(event *events.ComponentInteractionCreate, s *session.Session, confirm bool) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+
+	if !botSettings.IsReviewer(uint64(event.User().ID)) {
+		m.layout.logger.Error("Non-reviewer attempted bulk member action",
+			zap.Uint64("user_id", uint64(event.User().ID)),
+			zap.Bool("confirm", confirm))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to confirm or clear users.")
+		return
+	}
+
+	if settings.ReviewMode == enum.ReviewModeTraining {
+		m.layout.paginationManager.RespondWithError(event, "Bulk actions are not available in training mode.")
+		return
+	}
+
+	var selected []uint64
+	s.GetInterface(constants.SessionKeyGroupSelectedMembers, &selected)
+	if len(selected) == 0 {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "No members selected.")
+		return
+	}
+	if len(selected) > constants.MembersPerPage {
+		selected = selected[:constants.MembersPerPage]
+	}
+
+	ctx := context.Background()
+
+	// Get full user data as it is copied into the target table
+	users, err := m.layout.users.GetUsersByIDs(ctx, selected, types.UserFields{})
+	if err != nil {
+		m.layout.logger.Error("Failed to get selected members", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to fetch member data. Please try again.")
+		return
+	}
+
+	var protectedIDs map[uint64]bool
+	if confirm {
+		protectedIDs, err = m.layout.db.Protected().GetProtectedIDs(ctx, selected)
+		if err != nil {
+			m.layout.logger.Error("Failed to get protected members", zap.Error(err))
+			m.layout.paginationManager.RespondWithError(event, "Failed to fetch member data. Please try again.")
+			return
+		}
+	}
+
+	// Check each member before moving them
+	failures := make(map[uint64]string)
+	batch := make([]*types.ReviewUser, 0, len(selected))
+	for _, id := range selected {
+		user, ok := users[id]
+		if !ok || user.Status == enum.UserTypeUnflagged {
+			failures[id] = "not flagged"
+			continue
+		}
+		if protectedIDs[id] {
+			failures[id] = "protected account"
+			continue
+		}
+		if reason := m.checkVoteConsensus(ctx, id, confirm); reason != "" {
+			failures[id] = reason
+			continue
+		}
+		batch = append(batch, user)
+	}
+
+	// Move the members in a single transaction
+	if len(batch) > 0 {
+		var moveErrs map[uint64]error
+		if confirm {
+			moveErrs, err = m.layout.users.ConfirmUsers(ctx, batch)
+		} else {
+			moveErrs, err = m.layout.users.ClearUsers(ctx, batch)
+		}
+		if err != nil {
+			m.layout.logger.Error("Failed to move selected members", zap.Error(err), zap.Bool("confirm", confirm))
+			m.layout.paginationManager.RespondWithError(event, "Failed to update the selected members. Please try again.")
+			return
+		}
+
+		for id, moveErr := range moveErrs {
+			if errors.Is(moveErr, types.ErrUserProtected) {
+				failures[id] = "protected account"
+				continue
+			}
+			m.layout.logger.Error("Failed to move member", zap.Error(moveErr), zap.Uint64("userID", id))
+			failures[id] = "database error"
+		}
+	}
+
+	// Log the action for each member that was moved
+	var members map[uint64]*types.ReviewUser
+	s.GetInterface(constants.SessionKeyGroupMembers, &members)
+
+	newStatus := enum.UserTypeCleared
+	if confirm {
+		newStatus = enum.UserTypeConfirmed
+	}
+
+	succeeded := make([]uint64, 0, len(batch))
+	for _, user := range batch {
+		if _, failed := failures[user.ID]; failed {
+			continue
+		}
+		succeeded = append(succeeded, user.ID)
+		m.logBulkAction(event, user, confirm)
+
+		if member, ok := members[user.ID]; ok {
+			member.Status = newStatus
+		}
+	}
+
+	m.layout.logger.Info("Bulk member action completed",
+		zap.Uint64("reviewer_id", uint64(event.User().ID)),
+		zap.Bool("confirm", confirm),
+		zap.Int("succeeded", len(succeeded)),
+		zap.Int("failed", len(failures)))
+
+	// Show the new statuses on the current page
+	s.Set(constants.SessionKeyGroupMembers, members)
+	s.Delete(constants.SessionKeyGroupSelectedMembers)
+	m.layout.paginationManager.NavigateTo(event, s, m.page,
+		formatBulkSummary(confirm, selected, succeeded, failures, settings.StreamerMode))
+}
+
+// checkVoteConsensus returns why a member cannot be moved if there is a strong
+// vote consensus against the action, or an empty string if the member can be moved.
+func (m *MembersMenu) checkVoteConsensus(ctx context.Context, userID uint64, confirm bool) string {
+	reputation, err := m.layout.db.Reputation().GetUserReputation(ctx, userID)
+	if err != nil {
+		m.layout.logger.Error("Failed to get member reputation", zap.Error(err), zap.Uint64("userID", userID))
+		return "database error"
+	}
+
+	totalVotes := float64(reputation.Upvotes + reputation.Downvotes)
+	if totalVotes < constants.MinimumVotesRequired {
+		return ""
+	}
+
+	// Upvotes indicate a user is safe while downvotes indicate a user is suspicious
+	against := float64(reputation.Upvotes)
+	if !confirm {
+		against = float64(reputation.Downvotes)
+	}
+	if against/totalVotes >= constants.VoteConsensusThreshold {
+		return "vote consensus"
+	}
+	return ""
+}
+
+// logBulkAction records a bulk confirm or clear of a member in the activity log.
+func (m *MembersMenu) logBulkAction(event *events.ComponentInteractionCreate, user *types.ReviewUser, confirm bool) {
+	activityType := enum.ActivityTypeUserCleared
+	if confirm {
+		activityType = enum.ActivityTypeUserConfirmed
+	} else {
+		// Remove user from group tracking
+		go m.layout.db.Tracking().RemoveUserFromGroups(context.Background(), user.ID, user.Groups)
+	}
+
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: user.ID,
+		},
+		ReviewerID:        uint64(event.User().ID),
+		ActivityType:      activityType,
+		ActivityTimestamp: time.Now(),
+		// The review time is unknown as members are not reviewed individually
+		Details: utils.AddDecisionDetails(
+			map[string]interface{}{"reason": user.Reason, "bulk": true}, user.Reason, user.Confidence, time.Time{},
+		),
+	})
+}
+
+// formatBulkSummary describes the outcome of a bulk action for each selected member.
+func formatBulkSummary(confirm bool, selected, succeeded []uint64, failures map[uint64]string, censor bool) string {
+	action := "Cleared"
+	if confirm {
+		action = "Confirmed"
+	}
+
+	summary := fmt.Sprintf("%s %d of %d member(s).", action, len(succeeded), len(selected))
+	if len(failures) == 0 {
+		return summary
+	}
+
+	parts := make([]string, 0, len(failures))
+	for _, id := range selected {
+		if reason, ok := failures[id]; ok {
+			parts = append(parts, fmt.Sprintf("%s (%s)", utils.CensorString(strconv.FormatUint(id, 10), censor), reason))
+		}
+	}
+	return summary + " Failed: " + strings.Join(parts, ", ")
+}
+
 // sortMembersByStatus sorts members by their status in priority order.
 func (m *MembersMenu) sortMembersByStatus(memberIDs []uint64, flaggedUsers map[uint64]*types.ReviewUser) []uint64 {
 	// Group members by status
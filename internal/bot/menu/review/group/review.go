@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
@@ -14,8 +16,12 @@ import (
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/common/client/fetcher"
+	"github.com/robalyx/rotector/internal/common/queue"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/robalyx/rotector/internal/common/translator"
 	"go.uber.org/zap"
 )
 
@@ -34,7 +40,7 @@ func NewReviewMenu(layout *Layout) *ReviewMenu {
 	m.page = &pagination.Page{
 		Name: "Group Review Menu",
 		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
-			return builder.NewReviewBuilder(s, layout.db).Build()
+			return builder.NewReviewBuilder(s, layout.translations, layout.db).Build()
 		},
 		SelectHandlerFunc: m.handleSelectMenu,
 		ButtonHandlerFunc: m.handleButton,
@@ -54,7 +60,7 @@ func (m *ReviewMenu) Show(event interfaces.CommonEvent, s *session.Session, cont
 	// Force training mode if user is not a reviewer
 	if !settings.IsReviewer(uint64(event.User().ID)) && userSettings.ReviewMode != enum.ReviewModeTraining {
 		userSettings.ReviewMode = enum.ReviewModeTraining
-		if err := m.layout.db.Settings().SaveUserSettings(context.Background(), userSettings); err != nil {
+		if err := m.layout.settings.SaveUserSettings(context.Background(), userSettings); err != nil {
 			m.layout.logger.Error("Failed to enforce training mode", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to enforce training mode. Please try again.")
 			return
@@ -99,9 +105,131 @@ func (m *ReviewMenu) Show(event interfaces.CommonEvent, s *session.Session, cont
 	// Store group info in session
 	s.Set(constants.SessionKeyGroupInfo, groupInfo)
 
+	// Record the shout if it changed since the group was stored, unless the session is read-only
+	if shout := groupInfo.Shout; shout != nil && shout.Body != "" && !s.GetBool(constants.SessionKeyReadOnly) &&
+		(group.Shout == nil || group.Shout.Body != shout.Body) {
+		if _, err := m.layout.db.Shouts().AddShout(context.Background(), group.ID, shout.Body, shout.Updated); err != nil {
+			m.layout.logger.Error("Failed to record group shout",
+				zap.Error(err),
+				zap.Uint64("groupID", group.ID))
+		}
+	}
+
+	// Fetch the breakdown of tracked members, which is optional for display
+	memberCounts, err := m.layout.groups.GetGroupFlaggedMemberCounts(context.Background(), group.ID)
+	if err != nil {
+		m.layout.logger.Error("Failed to fetch group member counts",
+			zap.Error(err),
+			zap.Uint64("groupID", group.ID))
+		s.Delete(constants.SessionKeyGroupMemberCounts)
+	} else {
+		s.Set(constants.SessionKeyGroupMemberCounts, memberCounts)
+	}
+
+	// Load the other groups owned by the group's owner
+	m.loadOwnerGroups(s, group)
+
+	// Translate the description and shout now so building the embed never waits on the network
+	m.translateText(s, constants.SessionKeyTranslatedGroupDesc, group.Description, group.ID)
+	var shout string
+	if group.Shout != nil {
+		shout = group.Shout.Body
+	}
+	m.translateText(s, constants.SessionKeyTranslatedGroupShout, shout, group.ID)
+
 	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
 }
 
+// translateText stores the translation of a group's text in the session under the
+// given key. A stored translation of the same text is reused. The translation is
+// left out if it fails, in which case the original text is shown.
+func (m *ReviewMenu) translateText(s *session.Session, key string, text string, groupID uint64) {
+	input, ok := translator.PrepareInput(text)
+	if !ok {
+		s.Delete(key)
+		return
+	}
+
+	var existing *translator.Translation
+	s.GetInterface(key, &existing)
+	if existing != nil && existing.Source == text {
+		return
+	}
+
+	translated, err := m.layout.translations.Translate(context.Background(), input, "auto", "en")
+	if err != nil {
+		m.layout.logger.Warn("Failed to translate group text",
+			zap.Error(err),
+			zap.String("key", key),
+			zap.Uint64("groupID", groupID))
+		s.Delete(key)
+		return
+	}
+
+	s.Set(key, &translator.Translation{
+		Source: text,
+		Text:   translated,
+	})
+}
+
+// loadOwnerGroups stores the groups owned by the group's owner in session, along
+// with their current status in our database. The owner's groups are only fetched
+// from the API when the owner changes, but their statuses are refreshed every time.
+func (m *ReviewMenu) loadOwnerGroups(s *session.Session, group *types.ReviewGroup) {
+	if group.Owner == nil || group.Owner.UserID == 0 {
+		s.Delete(constants.SessionKeyOwnerGroupsOwnerID)
+		s.Delete(constants.SessionKeyOwnerGroups)
+		return
+	}
+	ownerID := group.Owner.UserID
+
+	var ownerGroups []*types.OwnedGroup
+	if s.GetUint64(constants.SessionKeyOwnerGroupsOwnerID) == ownerID {
+		s.GetInterface(constants.SessionKeyOwnerGroups, &ownerGroups)
+	}
+
+	if ownerGroups == nil {
+		groups, err := m.layout.groupFetcher.GetGroupsByOwner(context.Background(), ownerID)
+		if err != nil {
+			m.layout.logger.Error("Failed to fetch groups owned by group owner",
+				zap.Error(err),
+				zap.Uint64("ownerID", ownerID))
+			s.Delete(constants.SessionKeyOwnerGroupsOwnerID)
+			s.Delete(constants.SessionKeyOwnerGroups)
+			return
+		}
+
+		ownerGroups = make([]*types.OwnedGroup, 0, len(groups))
+		for _, owned := range groups {
+			ownerGroups = append(ownerGroups, &types.OwnedGroup{ID: owned.ID, Name: owned.Name})
+		}
+	}
+
+	// Refresh the status of each group
+	groupIDs := make([]uint64, 0, len(ownerGroups))
+	for _, owned := range ownerGroups {
+		groupIDs = append(groupIDs, owned.ID)
+	}
+
+	statuses, err := m.layout.groups.CheckExistingGroups(context.Background(), groupIDs)
+	if err != nil {
+		m.layout.logger.Error("Failed to check status of owner's groups",
+			zap.Error(err),
+			zap.Uint64("ownerID", ownerID))
+	}
+
+	for _, owned := range ownerGroups {
+		status, ok := statuses[owned.ID]
+		if !ok {
+			status = enum.GroupTypeUnflagged
+		}
+		owned.Status = status
+	}
+
+	s.Set(constants.SessionKeyOwnerGroupsOwnerID, ownerID)
+	s.Set(constants.SessionKeyOwnerGroups, ownerGroups)
+}
+
 // handleSelectMenu processes select menu interactions.
 func (m *ReviewMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s *session.Session, customID string, option string) {
 	if m.checkCaptchaRequired(event, s) {
@@ -113,11 +241,17 @@ func (m *ReviewMenu) handleSelectMenu(event *events.ComponentInteractionCreate,
 		m.handleSortOrderSelection(event, s, option)
 	case constants.ActionSelectMenuCustomID:
 		m.handleActionSelection(event, s, option)
+	case constants.ReasonPresetSelectMenuCustomID:
+		m.handleReasonPresetSelection(event, s, option)
 	}
 }
 
 // handleSortOrderSelection processes sort order menu selections.
 func (m *ReviewMenu) handleSortOrderSelection(event *events.ComponentInteractionCreate, s *session.Session, option string) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	// Retrieve user settings from session
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
@@ -132,7 +266,7 @@ func (m *ReviewMenu) handleSortOrderSelection(event *events.ComponentInteraction
 
 	// Update user's group sort preference
 	settings.GroupDefaultSort = sortBy
-	if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+	if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
 		m.layout.logger.Error("Failed to save user settings", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to save sort order. Please try again.")
 		return
@@ -166,13 +300,36 @@ func (m *ReviewMenu) handleActionSelection(event *events.ComponentInteractionCre
 			return
 		}
 		m.handleViewGroupLogs(event, s)
+	case constants.GroupReviewOwnerButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to review group owner", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to review group owners.")
+			return
+		}
+		m.handleReviewOwner(event, s)
 	case constants.GroupConfirmWithReasonButtonCustomID:
 		if !settings.IsReviewer(userID) {
 			m.layout.logger.Error("Non-reviewer attempted to use confirm with reason", zap.Uint64("user_id", userID))
 			m.layout.paginationManager.RespondWithError(event, "You do not have permission to confirm groups with custom reasons.")
 			return
 		}
-		m.handleConfirmWithReason(event, s)
+		var group *types.ReviewGroup
+		s.GetInterface(constants.SessionKeyGroupTarget, &group)
+		m.handleConfirmWithReason(event, group.Reason)
+	case constants.AddNoteButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to add note", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to add notes.")
+			return
+		}
+		m.layout.noteHandler.HandleAddNote(event)
+	case constants.DeleteNoteButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to delete note", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to delete notes.")
+			return
+		}
+		m.layout.noteHandler.HandleDeleteNote(event)
 	case constants.ReviewModeOption:
 		if !settings.IsReviewer(userID) {
 			m.layout.logger.Error("Non-reviewer attempted to change review mode", zap.Uint64("user_id", userID))
@@ -199,10 +356,33 @@ func (m *ReviewMenu) fetchNewTarget(event interfaces.CommonEvent, s *session.Ses
 		// Continue anyway - not a big requirement
 	}
 
-	// Get the next group to review
-	group, err := m.layout.db.Groups().GetGroupToReview(context.Background(), settings.GroupDefaultSort, settings.ReviewTargetMode, reviewerID)
-	if err != nil {
-		return nil, isBanned, err
+	// Release the lock on the previous group before moving on
+	ctx := context.Background()
+	s.ReleaseReviewLock(ctx, session.ReviewLockGroup)
+
+	// Get the next group to review, skipping groups locked by reviewers on other bot instances
+	readOnly := s.GetBool(constants.SessionKeyReadOnly)
+	lockedIDs := m.layout.sessionManager.LockedReviewTargets(ctx, session.ReviewLockGroup)
+	var group *types.ReviewGroup
+	for {
+		group, err = m.layout.groups.GetGroupToReview(
+			ctx, settings.GroupDefaultSort, settings.ReviewTargetMode, reviewerID, lockedIDs, readOnly,
+		)
+		if err != nil {
+			return nil, isBanned, err
+		}
+		if readOnly || s.AcquireReviewLock(ctx, session.ReviewLockGroup, group.ID, m.page.Name) {
+			break
+		}
+
+		// The group was locked by another reviewer after the locks were listed, so
+		// undo the hold placed on it while fetching it
+		if err := m.layout.db.Groups().ReleaseReview(ctx, group.ID); err != nil {
+			m.layout.logger.Error("Failed to release locked group",
+				zap.Error(err),
+				zap.Uint64("group_id", group.ID))
+		}
+		lockedIDs = append(lockedIDs, group.ID)
 	}
 
 	// Get flagged users from tracking
@@ -215,16 +395,18 @@ func (m *ReviewMenu) fetchNewTarget(event interfaces.CommonEvent, s *session.Ses
 	s.Set(constants.SessionKeyGroupTarget, group)
 	s.Set(constants.SessionKeyGroupMemberIDs, flaggedUsers)
 
-	// Log the view action
-	go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
-		ActivityTarget: types.ActivityTarget{
-			GroupID: group.ID,
-		},
-		ReviewerID:        reviewerID,
-		ActivityType:      enum.ActivityTypeGroupViewed,
-		ActivityTimestamp: time.Now(),
-		Details:           map[string]interface{}{},
-	})
+	// Log the view action unless writes are disabled
+	if !readOnly {
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
+			ActivityTarget: types.ActivityTarget{
+				GroupID: group.ID,
+			},
+			ReviewerID:        reviewerID,
+			ActivityType:      enum.ActivityTypeGroupViewed,
+			ActivityTimestamp: time.Now(),
+			Details:           map[string]interface{}{},
+		})
+	}
 
 	return group, isBanned, nil
 }
@@ -237,6 +419,7 @@ func (m *ReviewMenu) handleButton(event *events.ComponentInteractionCreate, s *s
 
 	switch customID {
 	case constants.BackButtonCustomID:
+		m.layout.sessionManager.ReleaseGroupReview(context.Background(), s)
 		m.layout.paginationManager.NavigateBack(event, s, "")
 	case constants.ConfirmButtonCustomID:
 		m.handleConfirmGroup(event, s)
@@ -244,6 +427,12 @@ func (m *ReviewMenu) handleButton(event *events.ComponentInteractionCreate, s *s
 		m.handleClearGroup(event, s)
 	case constants.SkipButtonCustomID:
 		m.handleSkipGroup(event, s)
+	case constants.GroupQueueMembersButtonCustomID:
+		m.handleQueueMembers(event, s)
+	case constants.GroupFlagOwnerGroupsButtonCustomID:
+		m.handleFlagOwnerGroups(event, s)
+	case constants.GroupQueueOwnerButtonCustomID:
+		m.handleQueueOwner(event, s)
 	}
 }
 
@@ -256,9 +445,20 @@ func (m *ReviewMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *
 	switch event.Data.CustomID {
 	case constants.ConfirmWithReasonModalCustomID:
 		m.handleConfirmWithReasonModalSubmit(event, s)
+	case constants.AddNoteModalCustomID:
+		m.layout.noteHandler.HandleAddNoteModalSubmit(event, s, m.page)
+	case constants.DeleteNoteModalCustomID:
+		m.layout.noteHandler.HandleDeleteNoteModalSubmit(event, s, m.page)
 	}
 }
 
+// reviewedGroupID returns the ID of the group being reviewed in the session.
+func reviewedGroupID(s *session.Session) uint64 {
+	var group *types.ReviewGroup
+	s.GetInterface(constants.SessionKeyGroupTarget, &group)
+	return group.ID
+}
+
 // handleViewGroupLogs handles the shortcut to view group logs.
 // It stores the group ID in session for log filtering and shows the logs menu.
 func (m *ReviewMenu) handleViewGroupLogs(event *events.ComponentInteractionCreate, s *session.Session) {
@@ -315,12 +515,38 @@ Flagged Members: %d</context>`,
 	m.layout.chatLayout.Show(event, s)
 }
 
-// handleConfirmWithReason opens a modal for entering a custom confirm reason.
-// The modal pre-fills with the current reason if one exists.
-func (m *ReviewMenu) handleConfirmWithReason(event *events.ComponentInteractionCreate, s *session.Session) {
+// handleReasonPresetSelection opens the confirm reason modal pre-filled with
+// the selected preset after substituting its placeholders.
+func (m *ReviewMenu) handleReasonPresetSelection(event *events.ComponentInteractionCreate, s *session.Session, option string) {
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	userID := uint64(event.User().ID)
+
+	if !botSettings.IsReviewer(userID) {
+		m.layout.logger.Error("Non-reviewer attempted to use reason preset", zap.Uint64("user_id", userID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to confirm groups with custom reasons.")
+		return
+	}
+
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	index, err := strconv.Atoi(strings.TrimSuffix(option, constants.ModalOpenSuffix))
+	if err != nil || index < 0 || index >= len(settings.ReasonPresets) {
+		m.layout.paginationManager.RespondWithError(event, "Invalid reason preset selected. Please try again.")
+		return
+	}
+
 	var group *types.ReviewGroup
 	s.GetInterface(constants.SessionKeyGroupTarget, &group)
 
+	reason := utils.FormatReasonPreset(settings.ReasonPresets[index].Template, group.Name, group.Confidence)
+	m.handleConfirmWithReason(event, reason)
+}
+
+// handleConfirmWithReason opens a modal for entering a custom confirm reason.
+// The modal pre-fills with the given reason so it can be edited before submitting.
+func (m *ReviewMenu) handleConfirmWithReason(event *events.ComponentInteractionCreate, reason string) {
 	// Create modal with pre-filled reason field
 	modal := discord.NewModalCreateBuilder().
 		SetCustomID(constants.ConfirmWithReasonModalCustomID).
@@ -329,7 +555,7 @@ func (m *ReviewMenu) handleConfirmWithReason(event *events.ComponentInteractionC
 			discord.NewTextInput(constants.ConfirmReasonInputCustomID, discord.TextInputStyleParagraph, "Confirm Reason").
 				WithRequired(true).
 				WithPlaceholder("Enter the reason for confirming this group...").
-				WithValue(group.Reason),
+				WithValue(reason),
 		).
 		Build()
 
@@ -342,6 +568,15 @@ func (m *ReviewMenu) handleConfirmWithReason(event *events.ComponentInteractionC
 
 // handleConfirmGroup moves a group to the confirmed state and logs the action.
 func (m *ReviewMenu) handleConfirmGroup(event interfaces.CommonEvent, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	// Drop any queue offers left from the previous group
+	m.clearMemberQueueOffer(s)
+	m.clearOwnerQueueOffer(s)
+	m.clearOwnerGroupsOffer(s)
+
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
 	var botSettings *types.BotSetting
@@ -353,6 +588,10 @@ func (m *ReviewMenu) handleConfirmGroup(event interfaces.CommonEvent, s *session
 	if settings.ReviewMode == enum.ReviewModeTraining {
 		// Training mode - increment downvotes
 		if err := m.layout.db.Reputation().UpdateGroupVotes(context.Background(), group.ID, uint64(event.User().ID), false); err != nil {
+			if errors.Is(err, types.ErrTargetDecided) {
+				m.showDecidedTarget(event, s, group.ID)
+				return
+			}
 			m.layout.logger.Error("Failed to update downvotes", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to update downvotes. Please try again.")
 			return
@@ -361,7 +600,7 @@ func (m *ReviewMenu) handleConfirmGroup(event interfaces.CommonEvent, s *session
 		actionMsg = "downvoted"
 
 		// Log the training downvote action
-		go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
 			ActivityTarget: types.ActivityTarget{
 				GroupID: group.ID,
 			},
@@ -397,22 +636,27 @@ func (m *ReviewMenu) handleConfirmGroup(event interfaces.CommonEvent, s *session
 		}
 
 		// Confirm the group
-		if err := m.layout.db.Groups().ConfirmGroup(context.Background(), group); err != nil {
+		if err := m.layout.groups.ConfirmGroup(context.Background(), group); err != nil {
 			m.layout.logger.Error("Failed to confirm group", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to confirm the group. Please try again.")
 			return
 		}
 		actionMsg = "confirmed"
 
+		// Offer a follow-up for the confirmed group
+		m.offerConfirmFollowUp(s, group.ID)
+
 		// Log the confirm action
-		go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
 			ActivityTarget: types.ActivityTarget{
 				GroupID: group.ID,
 			},
 			ReviewerID:        uint64(event.User().ID),
 			ActivityType:      enum.ActivityTypeGroupConfirmed,
 			ActivityTimestamp: time.Now(),
-			Details:           map[string]interface{}{"reason": group.Reason},
+			Details: utils.AddDecisionDetails(
+				map[string]interface{}{"reason": group.Reason}, group.Reason, group.Confidence, group.LastViewed,
+			),
 		})
 	}
 
@@ -424,6 +668,15 @@ func (m *ReviewMenu) handleConfirmGroup(event interfaces.CommonEvent, s *session
 
 // handleClearGroup removes a group from the flagged state and logs the action.
 func (m *ReviewMenu) handleClearGroup(event interfaces.CommonEvent, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	// Drop any queue offers left from the previous group
+	m.clearMemberQueueOffer(s)
+	m.clearOwnerQueueOffer(s)
+	m.clearOwnerGroupsOffer(s)
+
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
 	var botSettings *types.BotSetting
@@ -435,6 +688,10 @@ func (m *ReviewMenu) handleClearGroup(event interfaces.CommonEvent, s *session.S
 	if settings.ReviewMode == enum.ReviewModeTraining {
 		// Training mode - increment upvotes
 		if err := m.layout.db.Reputation().UpdateGroupVotes(context.Background(), group.ID, uint64(event.User().ID), true); err != nil {
+			if errors.Is(err, types.ErrTargetDecided) {
+				m.showDecidedTarget(event, s, group.ID)
+				return
+			}
 			m.layout.logger.Error("Failed to update upvotes", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to update upvotes. Please try again.")
 			return
@@ -443,7 +700,7 @@ func (m *ReviewMenu) handleClearGroup(event interfaces.CommonEvent, s *session.S
 		actionMsg = "upvoted"
 
 		// Log the training upvote action
-		go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
 			ActivityTarget: types.ActivityTarget{
 				GroupID: group.ID,
 			},
@@ -479,7 +736,7 @@ func (m *ReviewMenu) handleClearGroup(event interfaces.CommonEvent, s *session.S
 		}
 
 		// Clear the group
-		if err := m.layout.db.Groups().ClearGroup(context.Background(), group); err != nil {
+		if err := m.layout.groups.ClearGroup(context.Background(), group); err != nil {
 			m.layout.logger.Error("Failed to clear group", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to clear the group. Please try again.")
 			return
@@ -487,14 +744,14 @@ func (m *ReviewMenu) handleClearGroup(event interfaces.CommonEvent, s *session.S
 		actionMsg = "cleared"
 
 		// Log the clear action
-		go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
 			ActivityTarget: types.ActivityTarget{
 				GroupID: group.ID,
 			},
 			ReviewerID:        uint64(event.User().ID),
 			ActivityType:      enum.ActivityTypeGroupCleared,
 			ActivityTimestamp: time.Now(),
-			Details:           map[string]interface{}{},
+			Details:           utils.AddDecisionDetails(map[string]interface{}{}, group.Reason, group.Confidence, group.LastViewed),
 		})
 	}
 
@@ -504,10 +761,36 @@ func (m *ReviewMenu) handleClearGroup(event interfaces.CommonEvent, s *session.S
 	m.updateCounters(s)
 }
 
+// showDecidedTarget reloads a group that was confirmed or cleared by a reviewer
+// while a training vote was being cast, as votes on it are no longer accepted.
+func (m *ReviewMenu) showDecidedTarget(event interfaces.CommonEvent, s *session.Session, groupID uint64) {
+	group, err := m.layout.db.Groups().GetGroupByID(context.Background(), strconv.FormatUint(groupID, 10), types.GroupFields{})
+	if err != nil {
+		// The group may have been removed since, so move on to the next one
+		s.Delete(constants.SessionKeyGroupTarget)
+		m.Show(event, s, "This group was already decided.")
+		return
+	}
+
+	s.Set(constants.SessionKeyGroupTarget, group)
+	m.Show(event, s, "This group was already decided by a reviewer, so your vote was not counted.")
+}
+
 // handleSkipGroup logs the skip action and moves to the next group.
 func (m *ReviewMenu) handleSkipGroup(event interfaces.CommonEvent, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	// Drop any queue offers left from the previous group
+	m.clearMemberQueueOffer(s)
+	m.clearOwnerQueueOffer(s)
+	m.clearOwnerGroupsOffer(s)
+
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var group *types.ReviewGroup
+	s.GetInterface(constants.SessionKeyGroupTarget, &group)
 
 	// Check if skipping is allowed
 	if msg := settings.SkipUsage.CanSkip(); msg != "" {
@@ -525,7 +808,7 @@ func (m *ReviewMenu) handleSkipGroup(event interfaces.CommonEvent, s *session.Se
 
 	settings.SkipUsage.IncrementSkips()
 	settings.CaptchaUsage.IncrementReviews(settings, botSettings)
-	if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+	if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
 		m.layout.logger.Error("Failed to update skip tracking", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to update skip tracking. Please try again.")
 		return
@@ -533,22 +816,28 @@ func (m *ReviewMenu) handleSkipGroup(event interfaces.CommonEvent, s *session.Se
 	s.Set(constants.SessionKeyUserSettings, settings)
 
 	// Log the skip action
-	var group *types.ReviewGroup
-	s.GetInterface(constants.SessionKeyGroupTarget, &group)
-
-	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			GroupID: group.ID,
 		},
 		ReviewerID:        uint64(event.User().ID),
 		ActivityType:      enum.ActivityTypeGroupSkipped,
 		ActivityTimestamp: time.Now(),
-		Details:           map[string]interface{}{},
+		Details:           utils.AddDecisionDetails(map[string]interface{}{}, group.Reason, group.Confidence, group.LastViewed),
 	})
 }
 
 // handleConfirmWithReasonModalSubmit processes the custom confirm reason from the modal.
 func (m *ReviewMenu) handleConfirmWithReasonModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	// Drop any queue offers left from the previous group
+	m.clearMemberQueueOffer(s)
+	m.clearOwnerQueueOffer(s)
+	m.clearOwnerGroupsOffer(s)
+
 	var group *types.ReviewGroup
 	s.GetInterface(constants.SessionKeyGroupTarget, &group)
 
@@ -559,31 +848,455 @@ func (m *ReviewMenu) handleConfirmWithReasonModalSubmit(event *events.ModalSubmi
 		return
 	}
 
+	// Keep the original flag reason to categorize the decision
+	details := utils.AddDecisionDetails(map[string]interface{}{"reason": reason}, group.Reason, group.Confidence, group.LastViewed)
+
 	// Update group's reason with the custom input
 	group.Reason = reason
 
 	// Update group status in database
-	if err := m.layout.db.Groups().ConfirmGroup(context.Background(), group); err != nil {
+	if err := m.layout.groups.ConfirmGroup(context.Background(), group); err != nil {
 		m.layout.logger.Error("Failed to confirm group", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to confirm the group. Please try again.")
 		return
 	}
 
+	// Offer a follow-up for the confirmed group
+	m.offerConfirmFollowUp(s, group.ID)
+
 	// Clear current group and load next one
 	s.Delete(constants.SessionKeyGroupTarget)
 	m.Show(event, s, "Group confirmed.")
 	m.updateCounters(s)
 
 	// Log the custom confirm action
-	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			GroupID: group.ID,
 		},
 		ReviewerID:        uint64(event.User().ID),
 		ActivityType:      enum.ActivityTypeGroupConfirmedCustom,
 		ActivityTimestamp: time.Now(),
-		Details:           map[string]interface{}{"reason": group.Reason},
+		Details:           details,
+	})
+}
+
+// offerConfirmFollowUp replaces any pending offer with a single follow-up for the
+// confirmed group. Queueing its tracked members is offered first, and flagging the
+// owner's other groups only when the group has no tracked members.
+func (m *ReviewMenu) offerConfirmFollowUp(s *session.Session, groupID uint64) {
+	m.clearMemberQueueOffer(s)
+	m.clearOwnerQueueOffer(s)
+	m.clearOwnerGroupsOffer(s)
+
+	if m.offerMemberQueue(s, groupID) {
+		return
+	}
+	m.offerOwnerGroups(s, groupID)
+}
+
+// offerMemberQueue stores the confirmed group in session so the review page can
+// offer to queue its tracked members for recheck. Returns false if the group has
+// no tracked members to offer.
+func (m *ReviewMenu) offerMemberQueue(s *session.Session, groupID uint64) bool {
+	var memberIDs []uint64
+	s.GetInterface(constants.SessionKeyGroupMemberIDs, &memberIDs)
+	if len(memberIDs) == 0 {
+		return false
+	}
+
+	s.Set(constants.SessionKeyConfirmedGroupID, groupID)
+	s.Set(constants.SessionKeyConfirmedGroupMemberCount, min(len(memberIDs), constants.MaxGroupMembersQueued))
+	return true
+}
+
+// clearMemberQueueOffer removes the pending member queue offer from session.
+func (m *ReviewMenu) clearMemberQueueOffer(s *session.Session) {
+	s.Delete(constants.SessionKeyConfirmedGroupID)
+	s.Delete(constants.SessionKeyConfirmedGroupMemberCount)
+}
+
+// handleQueueMembers adds the tracked members of the last confirmed group to the
+// normal priority queue for recheck. Users that are already confirmed or queued are
+// skipped, and a single activity entry is logged for the whole batch.
+func (m *ReviewMenu) handleQueueMembers(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	reviewerID := uint64(event.User().ID)
+
+	if !botSettings.IsReviewer(reviewerID) {
+		m.layout.logger.Error("Non-reviewer attempted to queue group members", zap.Uint64("user_id", reviewerID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to queue group members.")
+		return
+	}
+
+	groupID := s.GetUint64(constants.SessionKeyConfirmedGroupID)
+	if groupID == 0 {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "No confirmed group to queue members for.")
+		return
+	}
+	m.clearMemberQueueOffer(s)
+
+	// Get the tracked members of the confirmed group
+	memberIDs, err := m.layout.db.Tracking().GetFlaggedUsers(context.Background(), groupID)
+	if err != nil {
+		m.layout.logger.Error("Failed to get tracked group members", zap.Error(err), zap.Uint64("groupID", groupID))
+		m.layout.paginationManager.RespondWithError(event, "Failed to get tracked group members. Please try again.")
+		return
+	}
+
+	if len(memberIDs) == 0 {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "No tracked members to queue.")
+		return
+	}
+
+	// Cap the number of members queued at once
+	overflow := 0
+	if len(memberIDs) > constants.MaxGroupMembersQueued {
+		overflow = len(memberIDs) - constants.MaxGroupMembersQueued
+		memberIDs = memberIDs[:constants.MaxGroupMembersQueued]
+	}
+
+	// Skip members that are already confirmed
+	users, err := m.layout.users.GetUsersByIDs(context.Background(), memberIDs, types.UserFields{Basic: true})
+	if err != nil {
+		m.layout.logger.Error("Failed to get tracked group member statuses", zap.Error(err), zap.Uint64("groupID", groupID))
+		m.layout.paginationManager.RespondWithError(event, "Failed to check tracked group members. Please try again.")
+		return
+	}
+
+	now := time.Now()
+	reason := fmt.Sprintf("Member of confirmed group %d", groupID)
+	items := make([]*queue.Item, 0, len(memberIDs))
+	skippedConfirmed := 0
+	for _, memberID := range memberIDs {
+		if user, ok := users[memberID]; ok && user.Status == enum.UserTypeConfirmed {
+			skippedConfirmed++
+			continue
+		}
+
+		items = append(items, &queue.Item{
+			UserID:      memberID,
+			Priority:    queue.NormalPriority,
+			Reason:      reason,
+			AddedBy:     reviewerID,
+			AddedAt:     now,
+			Status:      queue.StatusPending,
+			CheckExists: true,
+		})
+	}
+
+	// Add members to queue, skipping those already queued
+	queued, err := m.layout.queueManager.AddBatchToQueue(context.Background(), items)
+	if err != nil {
+		m.layout.logger.Error("Failed to queue tracked group members",
+			zap.Error(err),
+			zap.Uint64("groupID", groupID),
+			zap.Int("queued", queued))
+		m.layout.paginationManager.RespondWithError(event, "Failed to queue tracked group members. Please try again.")
+		return
+	}
+	skippedQueued := len(items) - queued
+
+	// Log a single entry for the whole batch
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			GroupID: groupID,
+		},
+		ReviewerID:        reviewerID,
+		ActivityType:      enum.ActivityTypeGroupMembersQueued,
+		ActivityTimestamp: now,
+		Details: map[string]interface{}{
+			"queued":            queued,
+			"skipped_confirmed": skippedConfirmed,
+			"skipped_queued":    skippedQueued,
+			"over_limit":        overflow,
+		},
+	})
+
+	content := fmt.Sprintf("Queued %d tracked members for recheck. Skipped %d already confirmed and %d already queued.",
+		queued, skippedConfirmed, skippedQueued)
+	if overflow > 0 {
+		content += fmt.Sprintf(" %d members over the limit of %d were not queued.", overflow, constants.MaxGroupMembersQueued)
+	}
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
+}
+
+// offerOwnerGroups stores the other groups of the confirmed group's owner that are
+// not in our database yet, so the review page can offer to flag them for scanning.
+func (m *ReviewMenu) offerOwnerGroups(s *session.Session, groupID uint64) {
+	var ownerGroups []*types.OwnedGroup
+	s.GetInterface(constants.SessionKeyOwnerGroups, &ownerGroups)
+
+	groupIDs := make([]uint64, 0, len(ownerGroups))
+	for _, owned := range ownerGroups {
+		if owned.ID == groupID || owned.Status != enum.GroupTypeUnflagged {
+			continue
+		}
+		if len(groupIDs) >= constants.MaxOwnerGroupsFlagged {
+			break
+		}
+		groupIDs = append(groupIDs, owned.ID)
+	}
+	if len(groupIDs) == 0 {
+		return
+	}
+
+	s.Set(constants.SessionKeyOwnerGroupsOfferSource, groupID)
+	s.Set(constants.SessionKeyOwnerGroupsOffer, groupIDs)
+}
+
+// clearOwnerGroupsOffer removes the pending owner groups offer from session.
+func (m *ReviewMenu) clearOwnerGroupsOffer(s *session.Session) {
+	s.Delete(constants.SessionKeyOwnerGroupsOfferSource)
+	s.Delete(constants.SessionKeyOwnerGroupsOffer)
+}
+
+// handleFlagOwnerGroups flags the other groups owned by the owner of the last
+// confirmed group, so the group worker scans their members. Groups that were added
+// to the database in the meantime are skipped, and a single activity entry is
+// logged for the whole batch.
+func (m *ReviewMenu) handleFlagOwnerGroups(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	reviewerID := uint64(event.User().ID)
+
+	if !botSettings.IsReviewer(reviewerID) {
+		m.layout.logger.Error("Non-reviewer attempted to flag owner groups", zap.Uint64("user_id", reviewerID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to flag groups.")
+		return
+	}
+
+	sourceID := s.GetUint64(constants.SessionKeyOwnerGroupsOfferSource)
+	var groupIDs []uint64
+	s.GetInterface(constants.SessionKeyOwnerGroupsOffer, &groupIDs)
+	m.clearOwnerGroupsOffer(s)
+	if sourceID == 0 || len(groupIDs) == 0 {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "No owner groups to flag.")
+		return
+	}
+
+	// Skip groups that were added to the database since the offer was made
+	existing, err := m.layout.groups.CheckExistingGroups(context.Background(), groupIDs)
+	if err != nil {
+		m.layout.logger.Error("Failed to check existing owner groups", zap.Error(err), zap.Uint64("groupID", sourceID))
+		m.layout.paginationManager.RespondWithError(event, "Failed to check the owner's groups. Please try again.")
+		return
+	}
+
+	newIDs := make([]uint64, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		if _, ok := existing[groupID]; !ok {
+			newIDs = append(newIDs, groupID)
+		}
+	}
+	skippedExisting := len(groupIDs) - len(newIDs)
+
+	if len(newIDs) == 0 {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "All of the owner's other groups are already in the database.")
+		return
+	}
+
+	// Fetch the latest group information
+	now := time.Now()
+	reason := fmt.Sprintf("Owned by the owner of confirmed group %d", sourceID)
+	groups := make(map[uint64]*types.Group)
+	for _, groupInfo := range m.layout.groupFetcher.FetchGroupInfos(newIDs) {
+		groups[groupInfo.ID] = &types.Group{
+			ID:             groupInfo.ID,
+			Name:           groupInfo.Name,
+			Description:    groupInfo.Description,
+			Owner:          groupInfo.Owner,
+			Shout:          groupInfo.Shout,
+			Reason:         reason,
+			Confidence:     constants.OwnerGroupConfidence,
+			LastUpdated:    now,
+			LastPurgeCheck: now,
+		}
+	}
+	skippedUnavailable := len(newIDs) - len(groups)
+
+	if len(groups) == 0 {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Failed to fetch any of the owner's other groups.")
+		return
+	}
+
+	// Save the groups as flagged so the group worker scans their members
+	groups = m.layout.thumbnailFetcher.AddGroupImageURLs(groups)
+	if err := m.layout.db.Groups().SaveGroups(context.Background(), groups); err != nil {
+		m.layout.logger.Error("Failed to flag owner groups", zap.Error(err), zap.Uint64("groupID", sourceID))
+		m.layout.paginationManager.RespondWithError(event, "Failed to flag the owner's groups. Please try again.")
+		return
+	}
+
+	// Log a single entry for the whole batch
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			GroupID: sourceID,
+		},
+		ReviewerID:        reviewerID,
+		ActivityType:      enum.ActivityTypeGroupOwnerGroupsFlagged,
+		ActivityTimestamp: now,
+		Details: map[string]interface{}{
+			"flagged":             len(groups),
+			"skipped_existing":    skippedExisting,
+			"skipped_unavailable": skippedUnavailable,
+		},
 	})
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, fmt.Sprintf(
+		"Flagged %d of the owner's other groups for scanning. Skipped %d already in the database and %d unavailable.",
+		len(groups), skippedExisting, skippedUnavailable,
+	))
+}
+
+// handleReviewOwner opens the review page for the group's owner. Owners that are
+// not in our database are looked up live so the reviewer can queue them instead.
+func (m *ReviewMenu) handleReviewOwner(event *events.ComponentInteractionCreate, s *session.Session) {
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var group *types.ReviewGroup
+	s.GetInterface(constants.SessionKeyGroupTarget, &group)
+
+	// Abandoned groups have no owner
+	if group.Owner == nil || group.Owner.UserID == 0 {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "This group has no owner. It may have been abandoned.")
+		return
+	}
+	ownerID := group.Owner.UserID
+
+	// Open the review page if the owner is already in our database
+	user, err := m.layout.users.GetUserByID(context.Background(), strconv.FormatUint(ownerID, 10), types.UserFields{})
+	if err == nil {
+		m.clearOwnerQueueOffer(s)
+		s.Set(constants.SessionKeyTarget, user)
+		m.layout.userReviewLayout.ShowReviewMenu(event, s)
+
+		// Log the lookup action
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
+			ActivityTarget: types.ActivityTarget{
+				UserID: user.ID,
+			},
+			ReviewerID:        uint64(event.User().ID),
+			ActivityType:      enum.ActivityTypeUserLookup,
+			ActivityTimestamp: time.Now(),
+			Details:           map[string]interface{}{"group_id": group.ID},
+		})
+		return
+	}
+	if !errors.Is(err, types.ErrUserNotFound) {
+		m.layout.logger.Error("Failed to fetch group owner", zap.Error(err), zap.Uint64("ownerID", ownerID))
+		m.layout.paginationManager.RespondWithError(event, "Failed to fetch the group owner. Please try again.")
+		return
+	}
+
+	// Otherwise fetch the owner's live profile
+	profile, err := m.layout.userFetcher.FetchProfile(context.Background(), ownerID)
+	if err != nil {
+		switch {
+		case errors.Is(err, fetcher.ErrUserBanned):
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "The owner of this group is banned from Roblox.")
+		case errors.Is(err, fetcher.ErrDeleted):
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "The owner of this group no longer exists.")
+		default:
+			m.layout.logger.Error("Failed to fetch group owner profile", zap.Error(err), zap.Uint64("ownerID", ownerID))
+			m.layout.paginationManager.RespondWithError(event, "Failed to fetch the group owner's profile. Please try again.")
+		}
+		return
+	}
+
+	// Offer to queue the owner for a check in place of any other pending offer
+	m.clearMemberQueueOffer(s)
+	m.clearOwnerGroupsOffer(s)
+	s.Set(constants.SessionKeyOwnerQueueOfferID, ownerID)
+	s.Set(constants.SessionKeyOwnerQueueOfferName, profile.Name)
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, fmt.Sprintf(
+		"Owner %s is not in our database.", utils.CensorString(profile.Name, settings.StreamerMode),
+	))
+}
+
+// clearOwnerQueueOffer removes the pending owner queue offer from session.
+func (m *ReviewMenu) clearOwnerQueueOffer(s *session.Session) {
+	s.Delete(constants.SessionKeyOwnerQueueOfferID)
+	s.Delete(constants.SessionKeyOwnerQueueOfferName)
+}
+
+// handleQueueOwner adds the owner of the current group to the high priority queue
+// so the worker can check and flag them.
+func (m *ReviewMenu) handleQueueOwner(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	reviewerID := uint64(event.User().ID)
+
+	if !botSettings.IsReviewer(reviewerID) {
+		m.layout.logger.Error("Non-reviewer attempted to queue group owner", zap.Uint64("user_id", reviewerID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to queue group owners.")
+		return
+	}
+
+	var group *types.ReviewGroup
+	s.GetInterface(constants.SessionKeyGroupTarget, &group)
+
+	// Make sure the offer still belongs to the group being reviewed
+	ownerID := s.GetUint64(constants.SessionKeyOwnerQueueOfferID)
+	m.clearOwnerQueueOffer(s)
+	if ownerID == 0 || group.Owner == nil || group.Owner.UserID != ownerID {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "No group owner to queue.")
+		return
+	}
+
+	// Skip owners that are already queued
+	status, _, _, err := m.layout.queueManager.GetQueueInfo(context.Background(), ownerID)
+	if err == nil && (status == queue.StatusPending || status == queue.StatusProcessing) {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "The group owner is already queued.")
+		return
+	}
+
+	// Add owner to queue
+	err = m.layout.queueManager.AddToQueue(context.Background(), &queue.Item{
+		UserID:      ownerID,
+		Priority:    queue.HighPriority,
+		Reason:      fmt.Sprintf("Owner of flagged group %d", group.ID),
+		AddedBy:     reviewerID,
+		AddedAt:     time.Now(),
+		Status:      queue.StatusPending,
+		CheckExists: true,
+	})
+	if err != nil {
+		m.layout.logger.Error("Failed to queue group owner", zap.Error(err), zap.Uint64("ownerID", ownerID))
+		m.layout.paginationManager.RespondWithError(event, "Failed to queue the group owner. Please try again.")
+		return
+	}
+
+	// Update queue info with position
+	err = m.layout.queueManager.SetQueueInfo(
+		context.Background(),
+		ownerID,
+		queue.StatusPending,
+		queue.HighPriority,
+		m.layout.queueManager.GetQueueLength(context.Background(), queue.HighPriority),
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to update queue info", zap.Error(err), zap.Uint64("ownerID", ownerID))
+		m.layout.paginationManager.RespondWithError(event, "Failed to update queue info. Please try again.")
+		return
+	}
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, "Queued the group owner for a check.")
 }
 
 // checkCaptchaRequired checks if CAPTCHA verification is needed.
@@ -606,7 +1319,7 @@ func (m *ReviewMenu) updateCounters(s *session.Session) {
 
 	settings.CaptchaUsage.IncrementReviews(settings, botSettings)
 	settings.SkipUsage.ResetSkips()
-	if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+	if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
 		m.layout.logger.Error("Failed to update counters", zap.Error(err))
 	}
 	s.Set(constants.SessionKeyUserSettings, settings)
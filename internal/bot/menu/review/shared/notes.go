@@ -0,0 +1,210 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"go.uber.org/zap"
+)
+
+// NoteHandler handles adding and deleting reviewer notes on the user or group
+// shown in a review menu, so both review menus behave the same.
+type NoteHandler struct {
+	notes             store.NoteStore
+	activity          store.ActivityStore
+	paginationManager *pagination.Manager
+	logger            *zap.Logger
+	targetType        enum.NoteTargetType
+	targetID          func(s *session.Session) uint64
+}
+
+// NewNoteHandler creates a NoteHandler for notes on the given target type.
+// The targetID function returns the ID of the user or group being reviewed.
+func NewNoteHandler(
+	notes store.NoteStore,
+	activity store.ActivityStore,
+	paginationManager *pagination.Manager,
+	logger *zap.Logger,
+	targetType enum.NoteTargetType,
+	targetID func(s *session.Session) uint64,
+) *NoteHandler {
+	return &NoteHandler{
+		notes:             notes,
+		activity:          activity,
+		paginationManager: paginationManager,
+		logger:            logger,
+		targetType:        targetType,
+		targetID:          targetID,
+	}
+}
+
+// HandleAddNote opens a modal for writing a note on the current target.
+func (h *NoteHandler) HandleAddNote(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.AddNoteModalCustomID).
+		SetTitle("Add Note").
+		AddActionRow(
+			discord.NewTextInput(constants.NoteInputCustomID, discord.TextInputStyleParagraph, "Note").
+				WithRequired(true).
+				WithMaxLength(types.MaxNoteLength).
+				WithPlaceholder("Context for other reviewers, e.g. \"owner claims hacked, verifying\""),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		h.logger.Error("Failed to create modal", zap.Error(err))
+		h.paginationManager.RespondWithError(event, "Failed to open the note form. Please try again.")
+	}
+}
+
+// HandleAddNoteModalSubmit saves the submitted note on the current target and logs the action.
+func (h *NoteHandler) HandleAddNoteModalSubmit(
+	event *events.ModalSubmitInteractionCreate, s *session.Session, page *pagination.Page,
+) {
+	if h.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	userID := uint64(event.User().ID)
+
+	if !botSettings.IsReviewer(userID) {
+		h.logger.Error("Non-reviewer attempted to add note", zap.Uint64("user_id", userID))
+		h.paginationManager.RespondWithError(event, "You do not have permission to add notes.")
+		return
+	}
+
+	content := strings.TrimSpace(event.Data.Text(constants.NoteInputCustomID))
+	if content == "" {
+		h.paginationManager.NavigateTo(event, s, page, "Note cannot be empty. Please try again.")
+		return
+	}
+
+	note := &types.ReviewNote{
+		TargetType: h.targetType,
+		TargetID:   h.targetID(s),
+		AuthorID:   userID,
+		Content:    content,
+		CreatedAt:  time.Now(),
+	}
+	if err := h.notes.AddNote(context.Background(), note); err != nil {
+		h.logger.Error("Failed to add note", zap.Error(err))
+		h.paginationManager.RespondWithError(event, "Failed to add the note. Please try again.")
+		return
+	}
+
+	h.paginationManager.NavigateTo(event, s, page, "Note added.")
+
+	// Log the note
+	h.activity.Log(context.Background(), h.activityLog(note.TargetID, userID, false,
+		map[string]interface{}{"note_id": note.ID, "content": content}))
+}
+
+// HandleDeleteNote opens a modal for entering the ID of the note to delete.
+func (h *NoteHandler) HandleDeleteNote(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.DeleteNoteModalCustomID).
+		SetTitle("Delete Note").
+		AddActionRow(
+			discord.NewTextInput(constants.NoteIDInputCustomID, discord.TextInputStyleShort, "Note ID").
+				WithRequired(true).
+				WithPlaceholder("The number shown next to the note"),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		h.logger.Error("Failed to create modal", zap.Error(err))
+		h.paginationManager.RespondWithError(event, "Failed to open the delete note form. Please try again.")
+	}
+}
+
+// HandleDeleteNoteModalSubmit deletes the submitted note from the current target and logs the action.
+// Reviewers can only delete their own notes while admins can delete any note.
+func (h *NoteHandler) HandleDeleteNoteModalSubmit(
+	event *events.ModalSubmitInteractionCreate, s *session.Session, page *pagination.Page,
+) {
+	if h.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	userID := uint64(event.User().ID)
+
+	if !botSettings.IsReviewer(userID) {
+		h.logger.Error("Non-reviewer attempted to delete note", zap.Uint64("user_id", userID))
+		h.paginationManager.RespondWithError(event, "You do not have permission to delete notes.")
+		return
+	}
+
+	input := strings.TrimPrefix(strings.TrimSpace(event.Data.Text(constants.NoteIDInputCustomID)), "#")
+	noteID, err := strconv.ParseInt(input, 10, 64)
+	if err != nil {
+		h.paginationManager.NavigateTo(event, s, page, "Invalid note ID. Please try again.")
+		return
+	}
+
+	targetID := h.targetID(s)
+	note, err := h.notes.DeleteNote(
+		context.Background(), h.targetType, targetID, noteID, userID, botSettings.IsAdmin(userID),
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrNoteNotFound):
+			h.paginationManager.NavigateTo(event, s, page,
+				"This "+strings.ToLower(h.targetType.String())+" has no note with that ID.")
+		case errors.Is(err, types.ErrNotNoteAuthor):
+			h.paginationManager.NavigateTo(event, s, page, "Only the author of a note or an admin can delete it.")
+		default:
+			h.logger.Error("Failed to delete note", zap.Error(err))
+			h.paginationManager.RespondWithError(event, "Failed to delete the note. Please try again.")
+		}
+		return
+	}
+
+	h.paginationManager.NavigateTo(event, s, page, "Note deleted.")
+
+	// Log the deletion
+	h.activity.Log(context.Background(), h.activityLog(targetID, userID, true,
+		map[string]interface{}{"note_id": note.ID, "author_id": note.AuthorID, "content": note.Content}))
+}
+
+// activityLog builds the activity log of a note being added or deleted on the target.
+func (h *NoteHandler) activityLog(
+	targetID, reviewerID uint64, deleted bool, details map[string]interface{},
+) *types.ActivityLog {
+	log := &types.ActivityLog{
+		ReviewerID:        reviewerID,
+		ActivityTimestamp: time.Now(),
+		Details:           details,
+	}
+
+	switch h.targetType {
+	case enum.NoteTargetTypeUser:
+		log.ActivityTarget.UserID = targetID
+		log.ActivityType = enum.ActivityTypeUserNoteAdded
+		if deleted {
+			log.ActivityType = enum.ActivityTypeUserNoteDeleted
+		}
+	case enum.NoteTargetTypeGroup:
+		log.ActivityTarget.GroupID = targetID
+		log.ActivityType = enum.ActivityTypeGroupNoteAdded
+		if deleted {
+			log.ActivityType = enum.ActivityTypeGroupNoteDeleted
+		}
+	}
+
+	return log
+}
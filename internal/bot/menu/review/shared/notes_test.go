@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoteHandlerActivityLog(t *testing.T) {
+	details := map[string]interface{}{"note_id": int64(3)}
+
+	tests := []struct {
+		name       string
+		targetType enum.NoteTargetType
+		deleted    bool
+		wantTarget types.ActivityTarget
+		wantType   enum.ActivityType
+	}{
+		{
+			name:       "user note added",
+			targetType: enum.NoteTargetTypeUser,
+			wantTarget: types.ActivityTarget{UserID: 10},
+			wantType:   enum.ActivityTypeUserNoteAdded,
+		},
+		{
+			name:       "user note deleted",
+			targetType: enum.NoteTargetTypeUser,
+			deleted:    true,
+			wantTarget: types.ActivityTarget{UserID: 10},
+			wantType:   enum.ActivityTypeUserNoteDeleted,
+		},
+		{
+			name:       "group note added",
+			targetType: enum.NoteTargetTypeGroup,
+			wantTarget: types.ActivityTarget{GroupID: 10},
+			wantType:   enum.ActivityTypeGroupNoteAdded,
+		},
+		{
+			name:       "group note deleted",
+			targetType: enum.NoteTargetTypeGroup,
+			deleted:    true,
+			wantTarget: types.ActivityTarget{GroupID: 10},
+			wantType:   enum.ActivityTypeGroupNoteDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &NoteHandler{targetType: tt.targetType}
+
+			log := h.activityLog(10, 20, tt.deleted, details)
+			assert.Equal(t, tt.wantTarget, log.ActivityTarget)
+			assert.Equal(t, tt.wantType, log.ActivityType)
+			assert.Equal(t, uint64(20), log.ReviewerID)
+			assert.Equal(t, details, log.Details)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/rueidis"
+)
+
+// RefreshCooldownKeyPrefix prefixes the keys marking users whose counts were
+// recently refreshed.
+const RefreshCooldownKeyPrefix = "refresh_counts:"
+
+// RefreshCooldowns limits how often the counts of the same user can be refreshed.
+// The cooldown is kept in Redis so it is shared by every reviewer and session
+// and does not depend on the copy of the user stored in a session.
+type RefreshCooldowns struct {
+	client rueidis.Client
+	window time.Duration
+}
+
+// NewRefreshCooldowns creates a RefreshCooldowns allowing one refresh per user
+// within the given window.
+func NewRefreshCooldowns(client rueidis.Client, window time.Duration) *RefreshCooldowns {
+	return &RefreshCooldowns{
+		client: client,
+		window: window,
+	}
+}
+
+// Acquire reserves a refresh of the user. If the user was refreshed within the
+// window, it returns false and how long until the user can be refreshed again.
+func (c *RefreshCooldowns) Acquire(ctx context.Context, userID uint64) (bool, time.Duration, error) {
+	key := refreshCooldownKey(userID)
+
+	err := c.client.Do(ctx, c.client.B().Set().Key(key).Value("1").Nx().Px(c.window).Build()).Error()
+	if err == nil {
+		return true, 0, nil
+	}
+	if !rueidis.IsRedisNil(err) {
+		return false, 0, fmt.Errorf("failed to reserve refresh: %w", err)
+	}
+
+	// The key already exists so report how long it has left
+	ttl, err := c.client.Do(ctx, c.client.B().Pttl().Key(key).Build()).AsInt64()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to get refresh cooldown: %w", err)
+	}
+	if ttl < 0 {
+		ttl = 0
+	}
+	return false, time.Duration(ttl) * time.Millisecond, nil
+}
+
+// Release removes the reservation of the user so a failed refresh can be retried.
+func (c *RefreshCooldowns) Release(ctx context.Context, userID uint64) error {
+	if err := c.client.Do(ctx, c.client.B().Del().Key(refreshCooldownKey(userID)).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to release refresh: %w", err)
+	}
+	return nil
+}
+
+// refreshCooldownKey returns the key marking a user as recently refreshed.
+func refreshCooldownKey(userID uint64) string {
+	return RefreshCooldownKeyPrefix + strconv.FormatUint(userID, 10)
+}
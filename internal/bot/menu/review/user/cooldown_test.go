@@ -0,0 +1,73 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cleanupRefreshCooldowns deletes the refresh cooldown keys of the given users.
+func cleanupRefreshCooldowns(t *testing.T, cooldowns *RefreshCooldowns, userIDs ...uint64) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		for _, userID := range userIDs {
+			_ = cooldowns.Release(context.Background(), userID)
+		}
+	})
+}
+
+func TestRefreshCooldowns(t *testing.T) {
+	ctx := context.Background()
+	cooldowns := NewRefreshCooldowns(testutil.OpenTestRedis(t), time.Hour)
+	cleanupRefreshCooldowns(t, cooldowns, 9_000_000_021, 9_000_000_022)
+
+	acquired, _, err := cooldowns.Acquire(ctx, 9_000_000_021)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// A second refresh of the same user waits for the window
+	acquired, remaining, err := cooldowns.Acquire(ctx, 9_000_000_021)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+	assert.InDelta(t, time.Hour.Seconds(), remaining.Seconds(), 5)
+
+	// Other users are not affected
+	acquired, _, err = cooldowns.Acquire(ctx, 9_000_000_022)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// Releasing allows a failed refresh to be retried
+	require.NoError(t, cooldowns.Release(ctx, 9_000_000_021))
+	acquired, _, err = cooldowns.Acquire(ctx, 9_000_000_021)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+}
+
+func TestRefreshCooldownsExpiry(t *testing.T) {
+	ctx := context.Background()
+	cooldowns := NewRefreshCooldowns(testutil.OpenTestRedis(t), time.Second)
+	cleanupRefreshCooldowns(t, cooldowns, 9_000_000_031)
+
+	acquired, _, err := cooldowns.Acquire(ctx, 9_000_000_031)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// The user can be refreshed again once the window passes
+	time.Sleep(1500 * time.Millisecond)
+	acquired, _, err = cooldowns.Acquire(ctx, 9_000_000_031)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+}
+
+func TestRefreshCooldownsRedisUnavailable(t *testing.T) {
+	cooldowns := NewRefreshCooldowns(testutil.NewUnavailableRedis(t), time.Hour)
+
+	acquired, _, err := cooldowns.Acquire(context.Background(), 1)
+	require.Error(t, err)
+	assert.False(t, acquired)
+}
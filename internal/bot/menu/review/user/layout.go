@@ -2,13 +2,18 @@ package user
 
 import (
 	"github.com/jaxron/roapi.go/pkg/api"
+	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/bot/menu/review/shared"
 	"github.com/robalyx/rotector/internal/common/client/fetcher"
 	"github.com/robalyx/rotector/internal/common/queue"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/robalyx/rotector/internal/common/storage/redis"
 	"github.com/robalyx/rotector/internal/common/translator"
 	"go.uber.org/zap"
 )
@@ -16,16 +21,26 @@ import (
 // Layout handles all review-related menus and their interactions.
 type Layout struct {
 	db                *database.Client
+	users             store.UserStore
+	groups            store.GroupStore
+	activity          store.ActivityStore
+	settings          store.SettingStore
+	notes             store.NoteStore
+	noteHandler       *shared.NoteHandler
+	secondOpinions    store.SecondOpinionStore
 	roAPI             *api.API
 	sessionManager    *session.Manager
 	paginationManager *pagination.Manager
 	queueManager      *queue.Manager
-	translator        *translator.Translator
+	translations      *translator.Cache
 	reviewMenu        *ReviewMenu
 	outfitsMenu       *OutfitsMenu
 	friendsMenu       *FriendsMenu
 	groupsMenu        *GroupsMenu
 	statusMenu        *StatusMenu
+	nameSearchMenu    *NameSearchMenu
+	userFetcher       *fetcher.UserFetcher
+	refreshCooldowns  *RefreshCooldowns
 	thumbnailFetcher  *fetcher.ThumbnailFetcher
 	presenceFetcher   *fetcher.PresenceFetcher
 	imageStreamer     *pagination.ImageStreamer
@@ -47,14 +62,28 @@ func New(
 	chatLayout interfaces.ChatLayout,
 	captchaLayout interfaces.CaptchaLayout,
 ) *Layout {
+	// Get Redis client for the refresh cooldowns
+	cacheClient, err := app.RedisManager.GetClient(redis.CacheDBIndex)
+	if err != nil {
+		app.Logger.Fatal("Failed to get Redis client for refresh cooldowns", zap.Error(err))
+	}
+
 	// Initialize layout
 	l := &Layout{
 		db:                app.DB,
+		users:             app.DB.Users(),
+		groups:            app.DB.Groups(),
+		activity:          app.DB.Activity(),
+		settings:          app.DB.Settings(),
+		notes:             app.DB.Notes(),
+		secondOpinions:    app.DB.SecondOpinions(),
 		roAPI:             app.RoAPI,
 		sessionManager:    sessionManager,
 		paginationManager: paginationManager,
 		queueManager:      app.Queue,
-		translator:        translator.New(app.RoAPI.GetClient()),
+		translations:      app.Translations,
+		userFetcher:       fetcher.NewUserFetcher(app, app.Logger),
+		refreshCooldowns:  NewRefreshCooldowns(cacheClient, constants.UserCountsRefreshCooldown),
 		thumbnailFetcher:  fetcher.NewThumbnailFetcher(app.RoAPI, app.Logger),
 		presenceFetcher:   fetcher.NewPresenceFetcher(app.RoAPI, app.Logger),
 		imageStreamer:     pagination.NewImageStreamer(paginationManager, app.Logger, app.RoAPI.GetClient()),
@@ -65,12 +94,18 @@ func New(
 		captchaLayout:     captchaLayout,
 	}
 
+	// Share the note handling with the other review menu
+	l.noteHandler = shared.NewNoteHandler(
+		l.notes, l.activity, paginationManager, app.Logger, enum.NoteTargetTypeUser, reviewedUserID,
+	)
+
 	// Initialize all menus with references to this layout
 	l.reviewMenu = NewReviewMenu(l)
 	l.outfitsMenu = NewOutfitsMenu(l)
 	l.friendsMenu = NewFriendsMenu(l)
 	l.groupsMenu = NewGroupsMenu(l)
 	l.statusMenu = NewStatusMenu(l)
+	l.nameSearchMenu = NewNameSearchMenu(l)
 
 	// Register menu pages with the pagination manager
 	paginationManager.AddPage(l.reviewMenu.page)
@@ -78,6 +113,7 @@ func New(
 	paginationManager.AddPage(l.friendsMenu.page)
 	paginationManager.AddPage(l.groupsMenu.page)
 	paginationManager.AddPage(l.statusMenu.page)
+	paginationManager.AddPage(l.nameSearchMenu.page)
 
 	return l
 }
@@ -4,18 +4,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
 	builder "github.com/robalyx/rotector/internal/bot/builder/review/user"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/pagination"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/bot/utils"
 	"github.com/robalyx/rotector/internal/common/queue"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/robalyx/rotector/internal/common/translator"
 	"go.uber.org/zap"
 )
 
@@ -33,7 +40,7 @@ func NewReviewMenu(layout *Layout) *ReviewMenu {
 	m.page = &pagination.Page{
 		Name: "Review Menu",
 		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
-			return builder.NewReviewBuilder(s, layout.translator, layout.db).Build()
+			return builder.NewReviewBuilder(s, layout.translations, layout.db).Build()
 		},
 		SelectHandlerFunc: m.handleSelectMenu,
 		ButtonHandlerFunc: m.handleButton,
@@ -52,7 +59,7 @@ func (m *ReviewMenu) Show(event interfaces.CommonEvent, s *session.Session, cont
 	// Force training mode if user is not a reviewer
 	if !settings.IsReviewer(uint64(event.User().ID)) && userSettings.ReviewMode != enum.ReviewModeTraining {
 		userSettings.ReviewMode = enum.ReviewModeTraining
-		if err := m.layout.db.Settings().SaveUserSettings(context.Background(), userSettings); err != nil {
+		if err := m.layout.settings.SaveUserSettings(context.Background(), userSettings); err != nil {
 			m.layout.logger.Error("Failed to enforce training mode", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to enforce training mode. Please try again.")
 			return
@@ -60,6 +67,9 @@ func (m *ReviewMenu) Show(event interfaces.CommonEvent, s *session.Session, cont
 		s.Set(constants.SessionKeyUserSettings, userSettings)
 	}
 
+	// Start today's decision tally from the activity logs if needed
+	m.ensureDecisionTally(s, uint64(event.User().ID), userSettings.ReviewMode == enum.ReviewModeTraining)
+
 	var user *types.ReviewUser
 	s.GetInterface(constants.SessionKeyTarget, &user)
 
@@ -84,6 +94,18 @@ func (m *ReviewMenu) Show(event interfaces.CommonEvent, s *session.Session, cont
 		}
 	}
 
+	// Let the reviewer know when no users met their confidence threshold
+	if s.GetBool(constants.SessionKeyThresholdRelaxed) {
+		s.Delete(constants.SessionKeyThresholdRelaxed)
+		note := fmt.Sprintf("No users met your confidence threshold of %.2f, so it was relaxed.",
+			userSettings.ReviewConfidenceThreshold)
+		if content != "" {
+			content = note + " " + content
+		} else {
+			content = note
+		}
+	}
+
 	// Check friend status and get friend data by looking up each friend in the database
 	var flaggedFriends map[uint64]*types.ReviewUser
 	if len(user.Friends) > 0 {
@@ -95,7 +117,7 @@ func (m *ReviewMenu) Show(event interfaces.CommonEvent, s *session.Session, cont
 
 		// Get full user data and types for friends that exist in the database
 		var err error
-		flaggedFriends, err = m.layout.db.Users().GetUsersByIDs(context.Background(), friendIDs, types.UserFields{
+		flaggedFriends, err = m.layout.users.GetUsersByIDs(context.Background(), friendIDs, types.UserFields{
 			Basic:      true,
 			Reason:     true,
 			Confidence: true,
@@ -106,8 +128,20 @@ func (m *ReviewMenu) Show(event interfaces.CommonEvent, s *session.Session, cont
 		}
 	}
 
+	// Check how many of the confirmed and flagged friends are friends with each other
+	var networkCluster *types.NetworkClusterInfo
+	if networkFriendIDs := getNetworkFriendIDs(flaggedFriends); len(networkFriendIDs) > 0 {
+		var err error
+		networkCluster, err = m.layout.db.Tracking().GetNetworkClusterInfo(context.Background(), user.ID, networkFriendIDs)
+		if err != nil {
+			// The cluster is only shown as extra context so the review can continue without it
+			m.layout.logger.Error("Failed to get network cluster info", zap.Error(err))
+		}
+	}
+
 	// Check group status
 	var flaggedGroups map[uint64]*types.ReviewGroup
+	var allowlistedGroups map[uint64]bool
 	if len(user.Groups) > 0 {
 		// Extract group IDs for batch lookup
 		groupIDs := make([]uint64, len(user.Groups))
@@ -117,7 +151,7 @@ func (m *ReviewMenu) Show(event interfaces.CommonEvent, s *session.Session, cont
 
 		// Get full group data and types
 		var err error
-		flaggedGroups, err = m.layout.db.Groups().GetGroupsByIDs(context.Background(), groupIDs, types.GroupFields{
+		flaggedGroups, err = m.layout.groups.GetGroupsByIDs(context.Background(), groupIDs, types.GroupFields{
 			Basic:      true,
 			Reason:     true,
 			Confidence: true,
@@ -126,15 +160,60 @@ func (m *ReviewMenu) Show(event interfaces.CommonEvent, s *session.Session, cont
 			m.layout.logger.Error("Failed to get group data", zap.Error(err))
 			return
 		}
+
+		// Get groups that are excluded from flagging
+		allowlistedGroups, err = m.layout.db.GroupAllowlist().GetAllowlistedIDs(context.Background(), groupIDs)
+		if err != nil {
+			m.layout.logger.Error("Failed to get allowlisted groups", zap.Error(err))
+			return
+		}
 	}
 
+	// Translate the description now so building the embed never waits on the network
+	m.translateDescription(s, user)
+
 	// Store data in session for the message builder
 	s.Set(constants.SessionKeyFlaggedFriends, flaggedFriends)
+	s.Set(constants.SessionKeyNetworkCluster, networkCluster)
 	s.Set(constants.SessionKeyFlaggedGroups, flaggedGroups)
+	s.Set(constants.SessionKeyAllowlistedGroupIDs, allowlistedGroups)
 
 	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
 }
 
+// translateDescription stores the translation of the user's description in the session.
+// The translation is left out if it fails, in which case the original description is shown.
+func (m *ReviewMenu) translateDescription(s *session.Session, user *types.ReviewUser) {
+	input, ok := translator.PrepareInput(user.Description)
+	if !ok {
+		s.Delete(constants.SessionKeyTranslatedDescription)
+		return
+	}
+
+	translated, err := m.layout.translations.Translate(context.Background(), input, "auto", "en")
+	if err != nil {
+		m.layout.logger.Warn("Failed to translate description", zap.Error(err), zap.Uint64("userID", user.ID))
+		s.Delete(constants.SessionKeyTranslatedDescription)
+		return
+	}
+
+	s.Set(constants.SessionKeyTranslatedDescription, &translator.Translation{
+		Source: user.Description,
+		Text:   translated,
+	})
+}
+
+// getNetworkFriendIDs returns the IDs of the confirmed and flagged friends.
+func getNetworkFriendIDs(friends map[uint64]*types.ReviewUser) []uint64 {
+	friendIDs := make([]uint64, 0, len(friends))
+	for friendID, friend := range friends {
+		if friend.Status == enum.UserTypeConfirmed || friend.Status == enum.UserTypeFlagged {
+			friendIDs = append(friendIDs, friendID)
+		}
+	}
+	return friendIDs
+}
+
 // handleSelectMenu processes select menu interactions.
 func (m *ReviewMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s *session.Session, customID string, option string) {
 	if m.checkCaptchaRequired(event, s) {
@@ -146,11 +225,58 @@ func (m *ReviewMenu) handleSelectMenu(event *events.ComponentInteractionCreate,
 		m.handleSortOrderSelection(event, s, option)
 	case constants.ActionSelectMenuCustomID:
 		m.handleActionSelection(event, s, option)
+	case constants.ReasonPresetSelectMenuCustomID:
+		m.handleReasonPresetSelection(event, s, option)
+	case constants.DecisiveContentSelectMenuCustomID:
+		m.handleDecisiveContentSelection(event, s)
+	}
+}
+
+// handleDecisiveContentSelection marks the selected flagged content items as decisive.
+// The marks are kept on the session target and saved when the user is confirmed.
+func (m *ReviewMenu) handleDecisiveContentSelection(event *events.ComponentInteractionCreate, s *session.Session) {
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+
+	if !botSettings.IsReviewer(uint64(event.User().ID)) {
+		m.layout.logger.Error("Non-reviewer attempted to mark decisive content",
+			zap.Uint64("user_id", uint64(event.User().ID)))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to mark decisive content.")
+		return
 	}
+
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
+
+	// Map the selected option indexes back to their content items
+	values := event.StringSelectMenuInteractionData().Values
+	decisive := make([]string, 0, len(values))
+	for _, value := range values {
+		index, err := strconv.Atoi(value)
+		if err != nil || index < 0 || index >= len(user.FlaggedContent) {
+			continue
+		}
+		decisive = append(decisive, user.FlaggedContent[index])
+	}
+
+	user.DecisiveContent = decisive
+	s.Set(constants.SessionKeyTarget, user)
+
+	var content string
+	if len(decisive) == 0 {
+		content = "Cleared decisive content marks."
+	} else {
+		content = fmt.Sprintf("Marked %d content item(s) as decisive. Marks are saved when the user is confirmed.", len(decisive))
+	}
+	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
 }
 
 // handleSortOrderSelection processes sort order menu selections.
 func (m *ReviewMenu) handleSortOrderSelection(event *events.ComponentInteractionCreate, s *session.Session, option string) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	// Retrieve user settings from session
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
@@ -165,7 +291,7 @@ func (m *ReviewMenu) handleSortOrderSelection(event *events.ComponentInteraction
 
 	// Update user's default sort preference
 	settings.UserDefaultSort = sortBy
-	if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+	if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
 		m.layout.logger.Error("Failed to save user settings", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to save sort order. Please try again.")
 		return
@@ -216,7 +342,51 @@ func (m *ReviewMenu) handleActionSelection(event *events.ComponentInteractionCre
 			m.layout.paginationManager.RespondWithError(event, "You do not have permission to confirm users with custom reasons.")
 			return
 		}
-		m.handleConfirmWithReason(event, s)
+		var user *types.ReviewUser
+		s.GetInterface(constants.SessionKeyTarget, &user)
+		m.handleConfirmWithReason(event, user.Reason)
+	case constants.PinClearedUserButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to pin user", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to pin users.")
+			return
+		}
+		m.handleTogglePin(event, s)
+	case constants.RefreshCountsButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to refresh counts", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to refresh users.")
+			return
+		}
+		m.handleRefreshCounts(event, s)
+	case constants.ReflagUserButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to reflag user", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to reflag users.")
+			return
+		}
+		m.handleReflagUser(event)
+	case constants.AddNoteButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to add note", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to add notes.")
+			return
+		}
+		m.layout.noteHandler.HandleAddNote(event)
+	case constants.DeleteNoteButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to delete note", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to delete notes.")
+			return
+		}
+		m.layout.noteHandler.HandleDeleteNote(event)
+	case constants.RequestSecondOpinionButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to request second opinion", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to request second opinions.")
+			return
+		}
+		m.handleRequestSecondOpinion(event)
 	case constants.ReviewModeOption:
 		if !settings.IsReviewer(userID) {
 			m.layout.logger.Error("Non-reviewer attempted to change review mode", zap.Uint64("user_id", userID))
@@ -224,11 +394,60 @@ func (m *ReviewMenu) handleActionSelection(event *events.ComponentInteractionCre
 			return
 		}
 		m.layout.settingLayout.ShowUpdate(event, s, constants.UserSettingPrefix, constants.ReviewModeOption)
+	case constants.SearchUserNameButtonCustomID:
+		if !settings.IsReviewer(userID) {
+			m.layout.logger.Error("Non-reviewer attempted to search users by name", zap.Uint64("user_id", userID))
+			m.layout.paginationManager.RespondWithError(event, "You do not have permission to search users.")
+			return
+		}
+		m.layout.nameSearchMenu.handleSearchUserName(event)
+	case constants.SkipWithNoteButtonCustomID:
+		m.handleSkipWithNote(event)
 	case constants.ReviewTargetModeOption:
 		m.layout.settingLayout.ShowUpdate(event, s, constants.UserSettingPrefix, constants.ReviewTargetModeOption)
+	case constants.ReviewConfidenceThresholdOption:
+		m.layout.settingLayout.ShowUpdate(event, s, constants.UserSettingPrefix, constants.ReviewConfidenceThresholdOption)
 	}
 }
 
+// handleReasonPresetSelection opens the confirm reason modal pre-filled with
+// the selected preset after substituting its placeholders.
+func (m *ReviewMenu) handleReasonPresetSelection(event *events.ComponentInteractionCreate, s *session.Session, option string) {
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	userID := uint64(event.User().ID)
+
+	if !botSettings.IsReviewer(userID) {
+		m.layout.logger.Error("Non-reviewer attempted to use reason preset", zap.Uint64("user_id", userID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to confirm users with custom reasons.")
+		return
+	}
+
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	index, err := strconv.Atoi(strings.TrimSuffix(option, constants.ModalOpenSuffix))
+	if err != nil || index < 0 || index >= len(settings.ReasonPresets) {
+		m.layout.paginationManager.RespondWithError(event, "Invalid reason preset selected. Please try again.")
+		return
+	}
+
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
+	var flaggedGroups map[uint64]*types.ReviewGroup
+	s.GetInterface(constants.SessionKeyFlaggedGroups, &flaggedGroups)
+
+	// Collect flagged group names for the placeholder
+	groupNames := make([]string, 0, len(flaggedGroups))
+	for _, group := range flaggedGroups {
+		groupNames = append(groupNames, group.Name)
+	}
+	sort.Strings(groupNames)
+
+	reason := utils.FormatReasonPreset(settings.ReasonPresets[index].Template, strings.Join(groupNames, ", "), user.Confidence)
+	m.handleConfirmWithReason(event, reason)
+}
+
 // handleButton handles the buttons for the review menu.
 func (m *ReviewMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
 	if m.checkCaptchaRequired(event, s) {
@@ -237,6 +456,7 @@ func (m *ReviewMenu) handleButton(event *events.ComponentInteractionCreate, s *s
 
 	switch customID {
 	case constants.BackButtonCustomID:
+		m.layout.sessionManager.ReleaseUserReviews(context.Background(), s)
 		m.layout.paginationManager.NavigateBack(event, s, "")
 	case constants.ConfirmButtonCustomID:
 		m.handleConfirmUser(event, s)
@@ -258,6 +478,18 @@ func (m *ReviewMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *
 		m.handleConfirmWithReasonModalSubmit(event, s)
 	case constants.RecheckReasonModalCustomID:
 		m.handleRecheckModalSubmit(event, s)
+	case constants.SkipNoteModalCustomID:
+		m.handleSkipNoteModalSubmit(event, s)
+	case constants.ReflagReasonModalCustomID:
+		m.handleReflagModalSubmit(event, s)
+	case constants.AddNoteModalCustomID:
+		m.layout.noteHandler.HandleAddNoteModalSubmit(event, s, m.page)
+	case constants.DeleteNoteModalCustomID:
+		m.layout.noteHandler.HandleDeleteNoteModalSubmit(event, s, m.page)
+	case constants.SecondOpinionModalCustomID:
+		m.handleSecondOpinionModalSubmit(event, s)
+	case constants.SearchUserNameModalCustomID:
+		m.layout.nameSearchMenu.handleSearchModalSubmit(event, s, m.page)
 	}
 }
 
@@ -295,6 +527,10 @@ func (m *ReviewMenu) handleRecheck(event *events.ComponentInteractionCreate, s *
 // handleRecheckModalSubmit processes the custom recheck reason from the modal
 // and performs the recheck with the provided reason.
 func (m *ReviewMenu) handleRecheckModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	var user *types.ReviewUser
 	s.GetInterface(constants.SessionKeyTarget, &user)
 	var settings *types.UserSetting
@@ -349,6 +585,380 @@ func (m *ReviewMenu) handleRecheckModalSubmit(event *events.ModalSubmitInteracti
 	m.layout.statusMenu.Show(event, s)
 }
 
+// handleTogglePin pins or unpins the current cleared user so they are kept
+// past the cleared user purge.
+func (m *ReviewMenu) handleTogglePin(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
+
+	pinned := !user.IsPinned
+	err := m.layout.users.SetClearedUserPinned(context.Background(), user.ID, pinned, int(botSettings.MaxPinnedUsers))
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrPinLimitReached):
+			m.layout.paginationManager.NavigateTo(event, s, m.page,
+				fmt.Sprintf("Cannot pin - the limit of %d pinned users has been reached.", botSettings.MaxPinnedUsers))
+		case errors.Is(err, types.ErrUserNotCleared):
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "Only cleared users can be pinned.")
+		default:
+			m.layout.logger.Error("Failed to update pinned status", zap.Error(err))
+			m.layout.paginationManager.RespondWithError(event, "Failed to update pinned status. Please try again.")
+		}
+		return
+	}
+
+	user.IsPinned = pinned
+	s.Set(constants.SessionKeyTarget, user)
+
+	content := "User pinned. They will not be purged with other cleared users."
+	if !pinned {
+		content = "User unpinned. They will be purged with other cleared users."
+	}
+	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
+
+	// Log the pin action
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: user.ID,
+		},
+		ReviewerID:        uint64(event.User().ID),
+		ActivityType:      enum.ActivityTypeUserPinned,
+		ActivityTimestamp: time.Now(),
+		Details:           map[string]interface{}{"pinned": pinned},
+	})
+}
+
+// handleRefreshCounts fetches the current follow counts and games of the user
+// and stores them without rescanning the user. Users that were banned since they
+// were saved are moved to the banned users instead.
+func (m *ReviewMenu) handleRefreshCounts(event *events.ComponentInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
+
+	// Banned users are no longer stored in a table that can be refreshed
+	if user.Status == enum.UserTypeBanned {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Banned users cannot be refreshed.")
+		return
+	}
+
+	// Limit how often the same user can be refreshed
+	ctx := context.Background()
+	acquired, remaining, err := m.layout.refreshCooldowns.Acquire(ctx, user.ID)
+	if err != nil {
+		m.layout.logger.Error("Failed to check refresh cooldown", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to refresh the user. Please try again.")
+		return
+	}
+	if !acquired {
+		m.layout.paginationManager.NavigateTo(event, s, m.page,
+			fmt.Sprintf("This user was refreshed recently. Try again <t:%d:R>.", time.Now().Add(remaining).Unix()))
+		return
+	}
+
+	result, err := m.layout.userFetcher.RefreshCounts(ctx, []uint64{user.ID})
+	if err != nil {
+		m.releaseRefresh(ctx, user.ID)
+		m.layout.logger.Error("Failed to refresh user counts", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to refresh the user. Please try again.")
+		return
+	}
+
+	// Remove the user if they were banned since they were saved
+	if len(result.BannedIDs) > 0 {
+		if err := m.layout.users.RemoveBannedUsers(ctx, result.BannedIDs); err != nil {
+			m.layout.logger.Error("Failed to remove banned user", zap.Error(err))
+			m.layout.paginationManager.RespondWithError(event, "Failed to remove the banned user. Please try again.")
+			return
+		}
+
+		user.Status = enum.UserTypeBanned
+		s.Set(constants.SessionKeyTarget, user)
+		m.layout.paginationManager.NavigateTo(event, s, m.page,
+			"User is banned or no longer exists and was moved to the banned users.")
+		return
+	}
+
+	counts, ok := result.Counts[user.ID]
+	if !ok {
+		m.releaseRefresh(ctx, user.ID)
+		m.layout.logger.Error("Failed to fetch user counts", zap.Error(result.Failed[user.ID]))
+		m.layout.paginationManager.RespondWithError(event, "Failed to fetch the user from Roblox. Please try again later.")
+		return
+	}
+
+	if _, err := m.layout.users.UpdateCounts(ctx, map[uint64]*types.UserFollowCounts{user.ID: counts}); err != nil {
+		m.releaseRefresh(ctx, user.ID)
+		m.layout.logger.Error("Failed to update user counts", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to save the refreshed user. Please try again.")
+		return
+	}
+
+	// Update the session so the embed shows the refreshed values
+	user.FollowerCount = counts.FollowerCount
+	user.FollowingCount = counts.FollowingCount
+	user.Games = counts.Games
+	user.LastUpdated = time.Now()
+	s.Set(constants.SessionKeyTarget, user)
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, "Refreshed follower counts and games.")
+}
+
+// releaseRefresh lets the user be refreshed again after a refresh failed.
+func (m *ReviewMenu) releaseRefresh(ctx context.Context, userID uint64) {
+	if err := m.layout.refreshCooldowns.Release(ctx, userID); err != nil {
+		m.layout.logger.Error("Failed to release refresh cooldown", zap.Error(err))
+	}
+}
+
+// handleReflagUser opens a modal for entering the reason a cleared user is sent back to review.
+func (m *ReviewMenu) handleReflagUser(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.ReflagReasonModalCustomID).
+		SetTitle("Re-flag User").
+		AddActionRow(
+			discord.NewTextInput(constants.ReflagReasonInputCustomID, discord.TextInputStyleParagraph, "Reason").
+				WithRequired(true).
+				WithPlaceholder("Why should this cleared user be reviewed again?"),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the re-flag form. Please try again.")
+	}
+}
+
+// handleReflagModalSubmit moves the current cleared user back to the flagged users
+// with the submitted reason and logs the action.
+func (m *ReviewMenu) handleReflagModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	userID := uint64(event.User().ID)
+
+	if !botSettings.IsReviewer(userID) {
+		m.layout.logger.Error("Non-reviewer attempted to reflag user", zap.Uint64("user_id", userID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to reflag users.")
+		return
+	}
+
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
+
+	// Get and validate the reason
+	reason := strings.TrimSpace(event.Data.Text(constants.ReflagReasonInputCustomID))
+	if reason == "" {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Re-flag reason cannot be empty. Please try again.")
+		return
+	}
+
+	if user.Status != enum.UserTypeCleared {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Only cleared users can be re-flagged.")
+		return
+	}
+
+	if err := m.layout.users.ReflagUser(context.Background(), user, reason); err != nil {
+		if errors.Is(err, types.ErrUserNotCleared) {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "Only cleared users can be re-flagged.")
+			return
+		}
+		if errors.Is(err, types.ErrUserProtected) {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "Cannot re-flag - this account is protected and must never be flagged.")
+			return
+		}
+		m.layout.logger.Error("Failed to reflag user", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to re-flag the user. Please try again.")
+		return
+	}
+
+	// Keep the user on screen with the updated status
+	user.Reason = reason
+	user.Status = enum.UserTypeFlagged
+	user.ClearedAt = time.Time{}
+	user.IsPinned = false
+	s.Set(constants.SessionKeyTarget, user)
+
+	m.layout.paginationManager.NavigateTo(event, s, m.page, "User re-flagged and sent back to the review queue.")
+
+	// Log the reflag action
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: user.ID,
+		},
+		ReviewerID:        userID,
+		ActivityType:      enum.ActivityTypeUserReflagged,
+		ActivityTimestamp: time.Now(),
+		Details:           map[string]interface{}{"reason": reason},
+	})
+}
+
+// handleRequestSecondOpinion opens a modal for asking another reviewer to decide on the current user.
+func (m *ReviewMenu) handleRequestSecondOpinion(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.SecondOpinionModalCustomID).
+		SetTitle("Request Second Opinion").
+		AddActionRow(
+			discord.NewTextInput(constants.SecondOpinionNoteInputCustomID, discord.TextInputStyleParagraph, "Note").
+				WithRequired(false).
+				WithMaxLength(types.MaxSecondOpinionNoteLength).
+				WithPlaceholder("What you are unsure about, e.g. \"outfits look borderline\""),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the second opinion form. Please try again.")
+	}
+}
+
+// handleSecondOpinionModalSubmit saves the second opinion request and moves the
+// reviewer on, as the user will be served to a different reviewer.
+func (m *ReviewMenu) handleSecondOpinionModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	var botSettings *types.BotSetting
+	s.GetInterface(constants.SessionKeyBotSettings, &botSettings)
+	userID := uint64(event.User().ID)
+
+	if !botSettings.IsReviewer(userID) {
+		m.layout.logger.Error("Non-reviewer attempted to request second opinion", zap.Uint64("user_id", userID))
+		m.layout.paginationManager.RespondWithError(event, "You do not have permission to request second opinions.")
+		return
+	}
+
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
+
+	note := strings.TrimSpace(event.Data.Text(constants.SecondOpinionNoteInputCustomID))
+	err := m.layout.secondOpinions.RequestSecondOpinion(context.Background(), &types.SecondOpinion{
+		UserID:      user.ID,
+		RequestedBy: userID,
+		Note:        note,
+		RequestedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, types.ErrSecondOpinionRequested) {
+			m.layout.paginationManager.NavigateTo(event, s, m.page,
+				"Another reviewer already asked for a second opinion on this user.")
+			return
+		}
+		m.layout.logger.Error("Failed to request second opinion", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to request a second opinion. Please try again.")
+		return
+	}
+
+	// Log the request
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: user.ID,
+		},
+		ReviewerID:        userID,
+		ActivityType:      enum.ActivityTypeUserSecondOpinionRequested,
+		ActivityTimestamp: time.Now(),
+		Details:           map[string]interface{}{"note": note},
+	})
+
+	// Clear current user and load next one
+	s.Delete(constants.SessionKeyTarget)
+	m.showNextTarget(event, s, "Requested a second opinion", false)
+}
+
+// pendingSecondOpinion returns the second opinion request on a user about to be
+// decided, or nil if there is none. The request itself is removed by the decision.
+func (m *ReviewMenu) pendingSecondOpinion(ctx context.Context, userID uint64) *types.SecondOpinion {
+	opinion, err := m.layout.secondOpinions.GetSecondOpinion(ctx, userID)
+	if err != nil {
+		m.layout.logger.Error("Failed to get second opinion", zap.Error(err), zap.Uint64("userID", userID))
+		return nil
+	}
+	return opinion
+}
+
+// resolveSecondOpinion logs how a second opinion request on a user that was just
+// decided was resolved and lets the reviewer who asked know the outcome.
+func (m *ReviewMenu) resolveSecondOpinion(event interfaces.CommonEvent, opinion *types.SecondOpinion, decision string) {
+	reviewerID := uint64(event.User().ID)
+
+	if !m.logSecondOpinion(context.Background(), opinion, reviewerID, decision) || opinion.RequestedBy == reviewerID {
+		return
+	}
+
+	go func() {
+		if err := notifySecondOpinion(event.Client().Rest(), opinion, reviewerID, decision); err != nil {
+			m.layout.logger.Warn("Failed to notify reviewer of second opinion",
+				zap.Error(err),
+				zap.Uint64("userID", opinion.UserID),
+				zap.Uint64("requestedBy", opinion.RequestedBy))
+		}
+	}()
+}
+
+// logSecondOpinion logs how a second opinion request was resolved. Returns false
+// if there was no request.
+func (m *ReviewMenu) logSecondOpinion(
+	ctx context.Context, opinion *types.SecondOpinion, reviewerID uint64, decision string,
+) bool {
+	if opinion == nil {
+		return false
+	}
+
+	m.layout.activity.Log(ctx, &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: opinion.UserID,
+		},
+		ReviewerID:        reviewerID,
+		ActivityType:      enum.ActivityTypeUserSecondOpinionResolved,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]interface{}{
+			"requestedBy": opinion.RequestedBy,
+			"decision":    decision,
+		},
+	})
+
+	return true
+}
+
+// notifySecondOpinion sends a DM to the reviewer who asked for a second opinion
+// with the decision made on the user.
+func notifySecondOpinion(client rest.Rest, opinion *types.SecondOpinion, reviewerID uint64, decision string) error {
+	channel, err := client.CreateDMChannel(snowflake.ID(opinion.RequestedBy))
+	if err != nil {
+		return fmt.Errorf("failed to create DM channel: %w", err)
+	}
+
+	_, err = client.CreateMessage(channel.ID(), discord.NewMessageCreateBuilder().
+		SetContentf("The user `%d` you asked for a second opinion on was %s by <@%d>.",
+			opinion.UserID, decision, reviewerID).
+		Build())
+	if err != nil {
+		return fmt.Errorf("failed to send DM: %w", err)
+	}
+
+	return nil
+}
+
+// reviewedUserID returns the ID of the user being reviewed in the session.
+func reviewedUserID(s *session.Session) uint64 {
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
+	return user.ID
+}
+
 // handleViewUserLogs handles the shortcut to view user logs.
 // It stores the user ID in session for log filtering and shows the logs menu.
 func (m *ReviewMenu) handleViewUserLogs(event *events.ComponentInteractionCreate, s *session.Session) {
@@ -372,6 +982,10 @@ func (m *ReviewMenu) handleViewUserLogs(event *events.ComponentInteractionCreate
 // handleConfirmUser moves a user to the confirmed state and logs the action.
 // After confirming, it loads a new user for review.
 func (m *ReviewMenu) handleConfirmUser(event interfaces.CommonEvent, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
 	var botSettings *types.BotSetting
@@ -383,6 +997,10 @@ func (m *ReviewMenu) handleConfirmUser(event interfaces.CommonEvent, s *session.
 	if settings.ReviewMode == enum.ReviewModeTraining {
 		// Training mode - increment downvotes
 		if err := m.layout.db.Reputation().UpdateUserVotes(context.Background(), user.ID, uint64(event.User().ID), false); err != nil {
+			if errors.Is(err, types.ErrTargetDecided) {
+				m.showDecidedTarget(event, s, user.ID)
+				return
+			}
 			m.layout.logger.Error("Failed to update downvotes", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to update downvotes. Please try again.")
 			return
@@ -391,7 +1009,7 @@ func (m *ReviewMenu) handleConfirmUser(event interfaces.CommonEvent, s *session.
 		actionMsg = "downvoted"
 
 		// Log the training downvote action
-		go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
 			ActivityTarget: types.ActivityTarget{
 				UserID: user.ID,
 			},
@@ -426,41 +1044,81 @@ func (m *ReviewMenu) handleConfirmUser(event interfaces.CommonEvent, s *session.
 			}
 		}
 
-		// Confirm the user
-		if err := m.layout.db.Users().ConfirmUser(context.Background(), user); err != nil {
+		// Prevent confirming protected accounts
+		if user.IsProtected {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "Cannot confirm - this account is protected and must never be flagged.")
+			return
+		}
+
+		// Confirm the user and prioritize scans of their confirmed groups
+		opinion := m.pendingSecondOpinion(context.Background(), user.ID)
+		queuedGroups, err := m.confirmUser(context.Background(), user, uint64(event.User().ID))
+		if errors.Is(err, types.ErrUserProtected) {
+			m.layout.paginationManager.NavigateTo(event, s, m.page, "Cannot confirm - this account is protected and must never be flagged.")
+			return
+		}
+		if err != nil {
 			m.layout.logger.Error("Failed to confirm user", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to confirm the user. Please try again.")
 			return
 		}
-		actionMsg = "confirmed"
+		actionMsg = "confirmed" + formatQueuedGroups(queuedGroups)
 
-		// Log the confirm action
-		go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
-			ActivityTarget: types.ActivityTarget{
-				UserID: user.ID,
-			},
-			ReviewerID:        uint64(event.User().ID),
-			ActivityType:      enum.ActivityTypeUserConfirmed,
-			ActivityTimestamp: time.Now(),
-			Details:           map[string]interface{}{"reason": user.Reason},
-		})
-	}
-
-	// Get the number of flagged users left to review
-	flaggedCount, err := m.layout.db.Users().GetFlaggedUsersCount(context.Background())
-	if err != nil {
-		m.layout.logger.Error("Failed to get flagged users count", zap.Error(err))
+		m.resolveSecondOpinion(event, opinion, "confirmed")
 	}
 
 	// Clear current user and load next one
 	s.Delete(constants.SessionKeyTarget)
-	m.Show(event, s, fmt.Sprintf("User %s. %d users left to review.", actionMsg, flaggedCount))
+	m.showNextTarget(event, s, "User "+actionMsg, true)
 	m.updateCounters(s)
 }
 
+// showDecidedTarget reloads a user that was confirmed or cleared by a reviewer
+// while a training vote was being cast, as votes on it are no longer accepted.
+func (m *ReviewMenu) showDecidedTarget(event interfaces.CommonEvent, s *session.Session, userID uint64) {
+	user, err := m.layout.users.GetUserByID(context.Background(), strconv.FormatUint(userID, 10), types.UserFields{})
+	if err != nil {
+		// The user may have been removed since, so move on to the next one
+		s.Delete(constants.SessionKeyTarget)
+		m.showNextTarget(event, s, "This user was already decided", false)
+		return
+	}
+
+	s.Set(constants.SessionKeyTarget, user)
+	m.Show(event, s, "This user was already decided by a reviewer, so your vote was not counted.")
+}
+
+// confirmUser confirms the user on behalf of the reviewer and logs the decision.
+// Returns how many of the user's confirmed groups were queued for a priority scan.
+func (m *ReviewMenu) confirmUser(ctx context.Context, user *types.ReviewUser, reviewerID uint64) (int, error) {
+	queuedGroups, err := m.layout.users.ConfirmUserWithPropagation(ctx, user)
+	if err != nil {
+		return 0, err
+	}
+
+	// Log the confirm action
+	m.layout.activity.Log(ctx, &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: user.ID,
+		},
+		ReviewerID:        reviewerID,
+		ActivityType:      enum.ActivityTypeUserConfirmed,
+		ActivityTimestamp: time.Now(),
+		Details: utils.AddDecisionDetails(
+			map[string]interface{}{"reason": user.Reason}, user.Reason, user.Confidence, user.LastViewed,
+		),
+	})
+
+	return queuedGroups, nil
+}
+
 // handleClearUser removes a user from the flagged state and logs the action.
 // After clearing, it loads a new user for review.
 func (m *ReviewMenu) handleClearUser(event interfaces.CommonEvent, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
 	var botSettings *types.BotSetting
@@ -472,6 +1130,10 @@ func (m *ReviewMenu) handleClearUser(event interfaces.CommonEvent, s *session.Se
 	if settings.ReviewMode == enum.ReviewModeTraining {
 		// Training mode - increment upvotes
 		if err := m.layout.db.Reputation().UpdateUserVotes(context.Background(), user.ID, uint64(event.User().ID), true); err != nil {
+			if errors.Is(err, types.ErrTargetDecided) {
+				m.showDecidedTarget(event, s, user.ID)
+				return
+			}
 			m.layout.logger.Error("Failed to update upvotes", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to update upvotes. Please try again.")
 			return
@@ -480,7 +1142,7 @@ func (m *ReviewMenu) handleClearUser(event interfaces.CommonEvent, s *session.Se
 		actionMsg = "upvoted"
 
 		// Log the training upvote action
-		go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
 			ActivityTarget: types.ActivityTarget{
 				UserID: user.ID,
 			},
@@ -516,7 +1178,8 @@ func (m *ReviewMenu) handleClearUser(event interfaces.CommonEvent, s *session.Se
 		}
 
 		// Clear the user
-		if err := m.layout.db.Users().ClearUser(context.Background(), user); err != nil {
+		opinion := m.pendingSecondOpinion(context.Background(), user.ID)
+		if err := m.layout.users.ClearUser(context.Background(), user); err != nil {
 			m.layout.logger.Error("Failed to clear user", zap.Error(err))
 			m.layout.paginationManager.RespondWithError(event, "Failed to clear the user. Please try again.")
 			return
@@ -527,34 +1190,72 @@ func (m *ReviewMenu) handleClearUser(event interfaces.CommonEvent, s *session.Se
 		go m.layout.db.Tracking().RemoveUserFromGroups(context.Background(), user.ID, user.Groups)
 
 		// Log the clear action
-		go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
 			ActivityTarget: types.ActivityTarget{
 				UserID: user.ID,
 			},
 			ReviewerID:        uint64(event.User().ID),
 			ActivityType:      enum.ActivityTypeUserCleared,
 			ActivityTimestamp: time.Now(),
-			Details:           map[string]interface{}{},
+			Details:           utils.AddDecisionDetails(map[string]interface{}{}, user.Reason, user.Confidence, user.LastViewed),
 		})
-	}
 
-	// Get the number of flagged users left to review
-	flaggedCount, err := m.layout.db.Users().GetFlaggedUsersCount(context.Background())
-	if err != nil {
-		m.layout.logger.Error("Failed to get flagged users count", zap.Error(err))
+		m.resolveSecondOpinion(event, opinion, "cleared")
 	}
 
 	// Clear current user and load next one
 	s.Delete(constants.SessionKeyTarget)
-	m.Show(event, s, fmt.Sprintf("User %s. %d users left to review.", actionMsg, flaggedCount))
+	m.showNextTarget(event, s, "User "+actionMsg, true)
 	m.updateCounters(s)
 }
 
 // handleSkipUser logs the skip action and moves to the next user without
 // changing the current user's status.
 func (m *ReviewMenu) handleSkipUser(event interfaces.CommonEvent, s *session.Session) {
+	m.skipUser(event, s, "")
+}
+
+// handleSkipWithNote opens a modal for entering a note to keep with the skip.
+func (m *ReviewMenu) handleSkipWithNote(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.SkipNoteModalCustomID).
+		SetTitle("Skip with Note").
+		AddActionRow(
+			discord.NewTextInput(constants.SkipNoteInputCustomID, discord.TextInputStyleParagraph, "Note").
+				WithRequired(true).
+				WithMaxLength(constants.MaxSkipNoteLength).
+				WithPlaceholder("Why are you skipping this user? e.g. waiting on appeal, need a second opinion"),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the skip note form. Please try again.")
+	}
+}
+
+// handleSkipNoteModalSubmit skips the current user and keeps the submitted note
+// with the skip so later reviewers can see it in the review history.
+func (m *ReviewMenu) handleSkipNoteModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	note := strings.TrimSpace(event.Data.Text(constants.SkipNoteInputCustomID))
+	if note == "" {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Skip note cannot be empty. Please try again.")
+		return
+	}
+
+	m.skipUser(event, s, note)
+}
+
+// skipUser logs the skip action with an optional note and moves to the next user.
+func (m *ReviewMenu) skipUser(event interfaces.CommonEvent, s *session.Session, note string) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	var settings *types.UserSetting
 	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	var user *types.ReviewUser
+	s.GetInterface(constants.SessionKeyTarget, &user)
 
 	// Check if skipping is allowed
 	if msg := settings.SkipUsage.CanSkip(); msg != "" {
@@ -562,15 +1263,15 @@ func (m *ReviewMenu) handleSkipUser(event interfaces.CommonEvent, s *session.Ses
 		return
 	}
 
-	// Get the number of flagged users left to review
-	flaggedCount, err := m.layout.db.Users().GetFlaggedUsersCount(context.Background())
-	if err != nil {
-		m.layout.logger.Error("Failed to get flagged users count", zap.Error(err))
+	// Keep the note with the skip if one was given
+	details := map[string]interface{}{}
+	if note != "" {
+		details["note"] = note
 	}
 
 	// Clear current user and load next one
 	s.Delete(constants.SessionKeyTarget)
-	m.Show(event, s, fmt.Sprintf("Skipped user. %d users left to review.", flaggedCount))
+	m.showNextTarget(event, s, "Skipped user", false)
 
 	// Update skip and captcha counters
 	var botSettings *types.BotSetting
@@ -578,7 +1279,7 @@ func (m *ReviewMenu) handleSkipUser(event interfaces.CommonEvent, s *session.Ses
 
 	settings.SkipUsage.IncrementSkips()
 	settings.CaptchaUsage.IncrementReviews(settings, botSettings)
-	if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+	if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
 		m.layout.logger.Error("Failed to update skip tracking", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to update skip tracking. Please try again.")
 		return
@@ -586,17 +1287,14 @@ func (m *ReviewMenu) handleSkipUser(event interfaces.CommonEvent, s *session.Ses
 	s.Set(constants.SessionKeyUserSettings, settings)
 
 	// Log the skip action
-	var user *types.ReviewUser
-	s.GetInterface(constants.SessionKeyTarget, &user)
-
-	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			UserID: user.ID,
 		},
 		ReviewerID:        uint64(event.User().ID),
 		ActivityType:      enum.ActivityTypeUserSkipped,
 		ActivityTimestamp: time.Now(),
-		Details:           map[string]interface{}{},
+		Details:           utils.AddDecisionDetails(details, user.Reason, user.Confidence, user.LastViewed),
 	})
 }
 
@@ -635,11 +1333,8 @@ Confidence: %.2f</context>`,
 }
 
 // handleConfirmWithReason opens a modal for entering a custom confirm reason.
-// The modal pre-fills with the current reason if one exists.
-func (m *ReviewMenu) handleConfirmWithReason(event *events.ComponentInteractionCreate, s *session.Session) {
-	var user *types.ReviewUser
-	s.GetInterface(constants.SessionKeyTarget, &user)
-
+// The modal pre-fills with the given reason so it can be edited before submitting.
+func (m *ReviewMenu) handleConfirmWithReason(event *events.ComponentInteractionCreate, reason string) {
 	// Create modal with pre-filled reason field
 	modal := discord.NewModalCreateBuilder().
 		SetCustomID(constants.ConfirmWithReasonModalCustomID).
@@ -648,7 +1343,7 @@ func (m *ReviewMenu) handleConfirmWithReason(event *events.ComponentInteractionC
 			discord.NewTextInput(constants.ConfirmReasonInputCustomID, discord.TextInputStyleParagraph, "Confirm Reason").
 				WithRequired(true).
 				WithPlaceholder("Enter the reason for confirming this user...").
-				WithValue(user.Reason),
+				WithValue(reason),
 		).
 		Build()
 
@@ -662,6 +1357,10 @@ func (m *ReviewMenu) handleConfirmWithReason(event *events.ComponentInteractionC
 // handleConfirmWithReasonModalSubmit processes the custom confirm reason from the modal
 // and performs the confirm with the provided reason.
 func (m *ReviewMenu) handleConfirmWithReasonModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	var user *types.ReviewUser
 	s.GetInterface(constants.SessionKeyTarget, &user)
 
@@ -672,11 +1371,26 @@ func (m *ReviewMenu) handleConfirmWithReasonModalSubmit(event *events.ModalSubmi
 		return
 	}
 
+	// Prevent confirming protected accounts
+	if user.IsProtected {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Cannot confirm - this account is protected and must never be flagged.")
+		return
+	}
+
+	// Keep the original flag reason to categorize the decision
+	details := utils.AddDecisionDetails(map[string]interface{}{"reason": reason}, user.Reason, user.Confidence, user.LastViewed)
+
 	// Update user's reason with the custom input
 	user.Reason = reason
 
 	// Update user status in database
-	if err := m.layout.db.Users().ConfirmUser(context.Background(), user); err != nil {
+	opinion := m.pendingSecondOpinion(context.Background(), user.ID)
+	queuedGroups, err := m.layout.users.ConfirmUserWithPropagation(context.Background(), user)
+	if errors.Is(err, types.ErrUserProtected) {
+		m.layout.paginationManager.NavigateTo(event, s, m.page, "Cannot confirm - this account is protected and must never be flagged.")
+		return
+	}
+	if err != nil {
 		m.layout.logger.Error("Failed to confirm user", zap.Error(err))
 		m.layout.paginationManager.RespondWithError(event, "Failed to confirm the user. Please try again.")
 		return
@@ -684,19 +1398,34 @@ func (m *ReviewMenu) handleConfirmWithReasonModalSubmit(event *events.ModalSubmi
 
 	// Clear current user and load next one
 	s.Delete(constants.SessionKeyTarget)
-	m.Show(event, s, "User confirmed.")
+	m.showNextTarget(event, s, "User confirmed"+formatQueuedGroups(queuedGroups), true)
 	m.updateCounters(s)
 
 	// Log the custom confirm action
-	m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			UserID: user.ID,
 		},
 		ReviewerID:        uint64(event.User().ID),
 		ActivityType:      enum.ActivityTypeUserConfirmedCustom,
 		ActivityTimestamp: time.Now(),
-		Details:           map[string]interface{}{"reason": user.Reason},
+		Details:           details,
 	})
+
+	m.resolveSecondOpinion(event, opinion, "confirmed")
+}
+
+// formatQueuedGroups describes the confirmed groups queued for a priority scan after
+// a confirm, or returns an empty string if there were none.
+func formatQueuedGroups(count int) string {
+	switch count {
+	case 0:
+		return ""
+	case 1:
+		return ". 1 group queued for priority scan"
+	default:
+		return fmt.Sprintf(". %d groups queued for priority scan", count)
+	}
 }
 
 // fetchNewTarget gets a new user to review based on the current sort order.
@@ -713,8 +1442,12 @@ func (m *ReviewMenu) fetchNewTarget(event interfaces.CommonEvent, s *session.Ses
 		// Continue anyway - not a big requirement
 	}
 
+	// Release the lock on the previous user before moving on
+	s.ReleaseReviewLock(context.Background(), session.ReviewLockUser)
+
 	// Get the next user to review
-	user, err := m.layout.db.Users().GetUserToReview(context.Background(), settings.UserDefaultSort, settings.ReviewTargetMode, reviewerID)
+	readOnly := s.GetBool(constants.SessionKeyReadOnly)
+	user, err := m.nextTarget(s, settings, reviewerID, readOnly)
 	if err != nil {
 		return nil, isBanned, err
 	}
@@ -722,20 +1455,214 @@ func (m *ReviewMenu) fetchNewTarget(event interfaces.CommonEvent, s *session.Ses
 	// Store the user in session for the message builder
 	s.Set(constants.SessionKeyTarget, user)
 
-	// Log the view action
-	go m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
-		ActivityTarget: types.ActivityTarget{
-			UserID: user.ID,
-		},
-		ReviewerID:        reviewerID,
-		ActivityType:      enum.ActivityTypeUserViewed,
-		ActivityTimestamp: time.Now(),
-		Details:           map[string]interface{}{},
-	})
+	// Log the view action unless writes are disabled
+	if !readOnly {
+		m.layout.activity.Log(context.Background(), &types.ActivityLog{
+			ActivityTarget: types.ActivityTarget{
+				UserID: user.ID,
+			},
+			ReviewerID:        reviewerID,
+			ActivityType:      enum.ActivityTypeUserViewed,
+			ActivityTimestamp: time.Now(),
+			Details:           map[string]interface{}{},
+		})
+	}
 
 	return user, isBanned, nil
 }
 
+// reviewQueue holds users prefetched for review along with the settings they were
+// selected with, so the queue is discarded once the reviewer changes them. Relaxed is
+// set when no users met the confidence threshold and the queue was filled without it.
+type reviewQueue struct {
+	SortBy        enum.ReviewSortBy     `json:"sortBy"`
+	TargetMode    enum.ReviewTargetMode `json:"targetMode"`
+	MinConfidence float64               `json:"minConfidence"`
+	Relaxed       bool                  `json:"relaxed"`
+	FetchedAt     time.Time             `json:"fetchedAt"`
+	Users         []*types.ReviewUser   `json:"users"`
+}
+
+// usable checks if users can still be taken from the queue. The queue must have been
+// built with the reviewer's current settings, and its users are only held for this
+// reviewer in the database for constants.ReviewPrefetchMaxAge after being fetched.
+func (q *reviewQueue) usable(settings *types.UserSetting, now time.Time) bool {
+	return q != nil &&
+		q.SortBy == settings.UserDefaultSort &&
+		q.TargetMode == settings.ReviewTargetMode &&
+		q.MinConfidence == settings.ReviewConfidenceThreshold &&
+		now.Sub(q.FetchedAt) < constants.ReviewPrefetchMaxAge
+}
+
+// nextTarget returns the next user from the prefetched review queue, refilling the
+// queue from the database once it is empty or its hold on the users has lapsed.
+// Queued users locked by a reviewer on another bot instance are skipped. The returned
+// user is locked for this reviewer.
+// Read-only sessions always fetch a single user without locking it since they cannot
+// mark users as viewed. If no users meet the reviewer's confidence threshold, users
+// are fetched without it and the session is marked so the review message can mention it.
+func (m *ReviewMenu) nextTarget(
+	s *session.Session, settings *types.UserSetting, reviewerID uint64, readOnly bool,
+) (*types.ReviewUser, error) {
+	ctx := context.Background()
+	threshold := settings.ReviewConfidenceThreshold
+	canRelax := threshold > 0 && settings.UserDefaultSort != enum.ReviewSortByReputation
+	lockedIDs := m.layout.sessionManager.LockedReviewTargets(ctx, session.ReviewLockUser)
+	if readOnly {
+		s.Delete(constants.SessionKeyReviewQueue)
+		user, err := m.layout.users.GetUserToReview(
+			ctx, settings.UserDefaultSort, settings.ReviewTargetMode, threshold, reviewerID, lockedIDs, true,
+		)
+		if errors.Is(err, types.ErrNoUsersToReview) && canRelax {
+			user, err = m.layout.users.GetUserToReview(
+				ctx, settings.UserDefaultSort, settings.ReviewTargetMode, 0, reviewerID, lockedIDs, true,
+			)
+			if err == nil {
+				s.Set(constants.SessionKeyThresholdRelaxed, true)
+			}
+		}
+		return user, err
+	}
+
+	// Take users from the queue if it was built with the current settings
+	var queue *reviewQueue
+	s.GetInterface(constants.SessionKeyReviewQueue, &queue)
+	if queue.usable(settings, time.Now()) {
+		for len(queue.Users) > 0 {
+			user := queue.Users[0]
+			queue.Users = queue.Users[1:]
+
+			if s.AcquireReviewLock(ctx, session.ReviewLockUser, user.ID, m.page.Name) {
+				s.Set(constants.SessionKeyReviewQueue, queue)
+				if queue.Relaxed {
+					s.Set(constants.SessionKeyThresholdRelaxed, true)
+				}
+				return user, nil
+			}
+		}
+	}
+
+	// Refill the queue from the database
+	relaxed := false
+	users, err := m.layout.users.GetUsersToReview(
+		ctx, settings.UserDefaultSort, settings.ReviewTargetMode, threshold, reviewerID, lockedIDs,
+		constants.ReviewPrefetchSize,
+	)
+	if errors.Is(err, types.ErrNoUsersToReview) && canRelax {
+		relaxed = true
+		users, err = m.layout.users.GetUsersToReview(
+			ctx, settings.UserDefaultSort, settings.ReviewTargetMode, 0, reviewerID, lockedIDs,
+			constants.ReviewPrefetchSize,
+		)
+	}
+	if err != nil {
+		s.Delete(constants.SessionKeyReviewQueue)
+		return nil, err
+	}
+
+	// Serve the first user that was not locked by another reviewer in the meantime
+	for i, user := range users {
+		if !s.AcquireReviewLock(ctx, session.ReviewLockUser, user.ID, m.page.Name) {
+			continue
+		}
+
+		s.Set(constants.SessionKeyReviewQueue, &reviewQueue{
+			SortBy:        settings.UserDefaultSort,
+			TargetMode:    settings.ReviewTargetMode,
+			MinConfidence: threshold,
+			Relaxed:       relaxed,
+			FetchedAt:     time.Now(),
+			Users:         users[i+1:],
+		})
+		if relaxed {
+			s.Set(constants.SessionKeyThresholdRelaxed, true)
+		}
+		return user, nil
+	}
+
+	s.Delete(constants.SessionKeyReviewQueue)
+	return nil, types.ErrNoUsersToReview
+}
+
+// showNextTarget loads the next user and shows it with short feedback about the action
+// just taken. Decisions are added to today's tally so the reviewer sees a running count.
+func (m *ReviewMenu) showNextTarget(event interfaces.CommonEvent, s *session.Session, action string, isDecision bool) {
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+	training := settings.ReviewMode == enum.ReviewModeTraining
+
+	// Count the decision if the tally is still for today
+	var count int
+	if isDecision {
+		var tally *utils.DecisionTally
+		s.GetInterface(constants.SessionKeyDecisionTally, &tally)
+		if tally.IsCurrent(time.Now(), training) {
+			tally.Count++
+			s.Set(constants.SessionKeyDecisionTally, tally)
+			count = tally.Count
+		}
+	}
+
+	// Get the number of flagged users left to review
+	flaggedCount, err := m.layout.db.Users().GetFlaggedUsersCount(context.Background())
+	if err != nil {
+		m.layout.logger.Error("Failed to get flagged users count", zap.Error(err))
+	}
+
+	// Load the next user so its stats can be included in the feedback
+	user, isBanned, err := m.fetchNewTarget(event, s, uint64(event.User().ID))
+	if err != nil {
+		if errors.Is(err, types.ErrNoUsersToReview) {
+			m.layout.paginationManager.NavigateBack(event, s, action+". No users left to review.")
+			return
+		}
+		m.layout.logger.Error("Failed to fetch a new user", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to fetch a new user. Please try again.")
+		return
+	}
+
+	if isBanned {
+		m.layout.paginationManager.RespondWithError(event, "You have been banned for suspicious voting patterns.")
+		return
+	}
+
+	m.Show(event, s, utils.FormatDecisionFeedback(action, count, training, flaggedCount, user.Confidence))
+}
+
+// ensureDecisionTally starts a new decision tally from today's activity logs when the
+// session has none yet, or when the day or review mode has changed since it was started.
+func (m *ReviewMenu) ensureDecisionTally(s *session.Session, reviewerID uint64, training bool) {
+	now := time.Now()
+
+	var tally *utils.DecisionTally
+	s.GetInterface(constants.SessionKeyDecisionTally, &tally)
+	if tally.IsCurrent(now, training) {
+		return
+	}
+
+	activityTypes := []enum.ActivityType{
+		enum.ActivityTypeUserConfirmed,
+		enum.ActivityTypeUserConfirmedCustom,
+		enum.ActivityTypeUserCleared,
+	}
+	if training {
+		activityTypes = []enum.ActivityType{
+			enum.ActivityTypeUserTrainingUpvote,
+			enum.ActivityTypeUserTrainingDownvote,
+		}
+	}
+
+	count, err := m.layout.activity.CountReviewerActivities(
+		context.Background(), reviewerID, activityTypes, now.UTC().Truncate(24*time.Hour),
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to count today's decisions", zap.Error(err))
+		// Continue with an empty tally - the count is informational only
+	}
+
+	s.Set(constants.SessionKeyDecisionTally, utils.NewDecisionTally(now, training, count))
+}
+
 // checkCaptchaRequired checks if CAPTCHA verification is needed.
 func (m *ReviewMenu) checkCaptchaRequired(event interfaces.CommonEvent, s *session.Session) bool {
 	var settings *types.UserSetting
@@ -756,7 +1683,7 @@ func (m *ReviewMenu) updateCounters(s *session.Session) {
 
 	settings.CaptchaUsage.IncrementReviews(settings, botSettings)
 	settings.SkipUsage.ResetSkips()
-	if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+	if err := m.layout.settings.SaveUserSettings(context.Background(), settings); err != nil {
 		m.layout.logger.Error("Failed to update counters", zap.Error(err))
 	}
 	s.Set(constants.SessionKeyUserSettings, settings)
@@ -0,0 +1,126 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/robalyx/rotector/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestReviewMenu(users *testutil.UserStore, activity *testutil.ActivityStore) *ReviewMenu {
+	return &ReviewMenu{layout: &Layout{
+		users:    users,
+		activity: activity,
+		logger:   zap.NewNop(),
+	}}
+}
+
+func TestConfirmUserLogsActivity(t *testing.T) {
+	user := &types.ReviewUser{
+		User:   types.User{ID: 1, Reason: "AI Analysis: inappropriate bio", Confidence: 0.8},
+		Status: enum.UserTypeFlagged,
+	}
+	users := testutil.NewUserStore(user)
+	activity := &testutil.ActivityStore{}
+	m := newTestReviewMenu(users, activity)
+
+	queuedGroups, err := m.confirmUser(context.Background(), user, 42)
+	require.NoError(t, err)
+
+	assert.Zero(t, queuedGroups)
+	assert.Equal(t, []uint64{1}, users.Confirmed)
+	assert.Equal(t, enum.UserTypeConfirmed, user.Status)
+
+	logs := activity.Logs()
+	require.Len(t, logs, 1)
+	assert.Equal(t, enum.ActivityTypeUserConfirmed, logs[0].ActivityType)
+	assert.Equal(t, uint64(1), logs[0].ActivityTarget.UserID)
+	assert.Equal(t, uint64(42), logs[0].ReviewerID)
+	assert.Equal(t, "AI Analysis: inappropriate bio", logs[0].Details["reason"])
+	assert.InDelta(t, 0.8, logs[0].Details[types.DetailKeyConfidence], 1e-9)
+}
+
+func TestConfirmUserFailureLogsNothing(t *testing.T) {
+	user := &types.ReviewUser{User: types.User{ID: 1}, Status: enum.UserTypeFlagged}
+	users := testutil.NewUserStore(user)
+	users.Err = errors.New("connection refused")
+	activity := &testutil.ActivityStore{}
+	m := newTestReviewMenu(users, activity)
+
+	_, err := m.confirmUser(context.Background(), user, 42)
+
+	require.Error(t, err)
+	assert.Empty(t, users.Confirmed)
+	assert.Empty(t, activity.Logs(), "a failed confirm must not be logged")
+}
+
+func TestLogSecondOpinionLogsResolution(t *testing.T) {
+	activity := &testutil.ActivityStore{}
+	m := newTestReviewMenu(testutil.NewUserStore(), activity)
+	m.layout.secondOpinions = testutil.NewSecondOpinionStore(&types.SecondOpinion{UserID: 1, RequestedBy: 7})
+
+	opinion := m.pendingSecondOpinion(context.Background(), 1)
+	require.NotNil(t, opinion)
+	assert.Equal(t, uint64(7), opinion.RequestedBy)
+
+	assert.True(t, m.logSecondOpinion(context.Background(), opinion, 42, "confirmed"))
+
+	logs := activity.Logs()
+	require.Len(t, logs, 1)
+	assert.Equal(t, enum.ActivityTypeUserSecondOpinionResolved, logs[0].ActivityType)
+	assert.Equal(t, uint64(1), logs[0].ActivityTarget.UserID)
+	assert.Equal(t, uint64(42), logs[0].ReviewerID)
+	assert.Equal(t, uint64(7), logs[0].Details["requestedBy"])
+	assert.Equal(t, "confirmed", logs[0].Details["decision"])
+}
+
+func TestLogSecondOpinionWithoutRequestLogsNothing(t *testing.T) {
+	activity := &testutil.ActivityStore{}
+	m := newTestReviewMenu(testutil.NewUserStore(), activity)
+	opinions := testutil.NewSecondOpinionStore(&types.SecondOpinion{UserID: 1, RequestedBy: 7})
+	opinions.Err = errors.New("connection refused")
+	m.layout.secondOpinions = opinions
+
+	opinion := m.pendingSecondOpinion(context.Background(), 1)
+	assert.Nil(t, opinion)
+	assert.False(t, m.logSecondOpinion(context.Background(), opinion, 42, "cleared"))
+	assert.Empty(t, activity.Logs())
+}
+
+func TestReviewQueueUsable(t *testing.T) {
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	settings := &types.UserSetting{
+		UserDefaultSort:           enum.ReviewSortByConfidence,
+		ReviewTargetMode:          enum.ReviewTargetModeFlagged,
+		ReviewConfidenceThreshold: 0.5,
+	}
+	newQueue := func() *reviewQueue {
+		return &reviewQueue{
+			SortBy:        enum.ReviewSortByConfidence,
+			TargetMode:    enum.ReviewTargetModeFlagged,
+			MinConfidence: 0.5,
+			FetchedAt:     now.Add(-time.Minute),
+		}
+	}
+
+	assert.True(t, newQueue().usable(settings, now))
+
+	var missing *reviewQueue
+	assert.False(t, missing.usable(settings, now))
+
+	changed := newQueue()
+	changed.SortBy = enum.ReviewSortByRandom
+	assert.False(t, changed.usable(settings, now), "queue built with other settings")
+
+	expired := newQueue()
+	expired.FetchedAt = now.Add(-constants.ReviewPrefetchMaxAge)
+	assert.False(t, expired.usable(settings, now), "queue whose hold has lapsed")
+}
@@ -0,0 +1,145 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	builder "github.com/robalyx/rotector/internal/bot/builder/review/user"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"go.uber.org/zap"
+)
+
+// NameSearchMenu handles searching users by name and opening a match for review.
+type NameSearchMenu struct {
+	layout *Layout
+	page   *pagination.Page
+}
+
+// NewNameSearchMenu creates a NameSearchMenu and sets up its page with message builders
+// and interaction handlers for picking a user from the search results.
+func NewNameSearchMenu(layout *Layout) *NameSearchMenu {
+	m := &NameSearchMenu{layout: layout}
+	m.page = &pagination.Page{
+		Name: "User Name Search Menu",
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewNameSearchBuilder(s).Build()
+		},
+		SelectHandlerFunc: m.handleSelectMenu,
+		ButtonHandlerFunc: m.handleButton,
+		ModalHandlerFunc:  m.handleModal,
+	}
+	return m
+}
+
+// Show displays the search results stored in the session.
+func (m *NameSearchMenu) Show(event interfaces.CommonEvent, s *session.Session, content string) {
+	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
+}
+
+// handleSearchUserName opens a modal for entering part of a username or display name.
+func (m *NameSearchMenu) handleSearchUserName(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.SearchUserNameModalCustomID).
+		SetTitle("Search Users by Name").
+		AddActionRow(
+			discord.NewTextInput(constants.SearchUserNameInputCustomID, discord.TextInputStyleShort, "Name").
+				WithRequired(true).
+				WithMinLength(types.MinSearchQueryLength).
+				WithMaxLength(50).
+				WithPlaceholder("Enter part of a username or display name..."),
+		).
+		Build()
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create user name search modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the user search modal. Please try again.")
+	}
+}
+
+// handleSearchModalSubmit searches for users matching the query and shows the results.
+// The page to return to when the query is invalid or nothing matches is provided by the caller.
+func (m *NameSearchMenu) handleSearchModalSubmit(event *events.ModalSubmitInteractionCreate, s *session.Session, returnPage *pagination.Page) {
+	query := strings.TrimSpace(event.Data.Text(constants.SearchUserNameInputCustomID))
+	if utf8.RuneCountInString(query) < types.MinSearchQueryLength {
+		m.layout.paginationManager.NavigateTo(event, s, returnPage,
+			fmt.Sprintf("Search queries must be at least %d characters long.", types.MinSearchQueryLength))
+		return
+	}
+
+	users, err := m.layout.users.SearchUsersByName(context.Background(), query, constants.UserNameSearchLimit)
+	if err != nil {
+		m.layout.logger.Error("Failed to search users by name", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to search users. Please try again.")
+		return
+	}
+
+	if len(users) == 0 {
+		m.layout.paginationManager.NavigateTo(event, s, returnPage,
+			fmt.Sprintf("No users found with a name containing `%s`. Try a different part of the name.", query))
+		return
+	}
+
+	s.Set(constants.SessionKeyUserNameSearchQuery, query)
+	s.Set(constants.SessionKeyUserNameSearchResults, users)
+	m.Show(event, s, "")
+}
+
+// handleSelectMenu opens the selected user in the review menu.
+func (m *NameSearchMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s *session.Session, customID string, option string) {
+	if customID != constants.UserNameSearchResultSelectID {
+		return
+	}
+
+	user, err := m.layout.users.GetUserByID(context.Background(), option, types.UserFields{})
+	if err != nil {
+		if errors.Is(err, types.ErrUserNotFound) {
+			m.Show(event, s, "Failed to find user. They may have been removed.")
+			return
+		}
+		m.layout.logger.Error("Failed to fetch user", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to fetch user for review. Please try again.")
+		return
+	}
+
+	// Store user in session and show review menu
+	s.Set(constants.SessionKeyTarget, user)
+	m.layout.reviewMenu.Show(event, s, "")
+
+	// Log the lookup action
+	m.layout.activity.Log(context.Background(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: user.ID,
+		},
+		ReviewerID:        uint64(event.User().ID),
+		ActivityType:      enum.ActivityTypeUserLookup,
+		ActivityTimestamp: time.Now(),
+		Details:           map[string]interface{}{"query": s.GetString(constants.SessionKeyUserNameSearchQuery)},
+	})
+}
+
+// handleButton processes navigation and new search button interactions.
+func (m *NameSearchMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
+	switch customID {
+	case constants.BackButtonCustomID:
+		m.layout.paginationManager.NavigateBack(event, s, "")
+	case constants.SearchUserNameButtonCustomID:
+		m.handleSearchUserName(event)
+	}
+}
+
+// handleModal processes new search queries submitted from the results page.
+func (m *NameSearchMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if event.Data.CustomID == constants.SearchUserNameModalCustomID {
+		m.handleSearchModalSubmit(event, s, m.page)
+	}
+}
@@ -18,6 +18,7 @@ type Layout struct {
 	updateMenu        *UpdateMenu
 	userMenu          *UserMenu
 	botMenu           *BotMenu
+	reasonPresetsMenu *ReasonPresetsMenu
 	registry          *setting.Registry
 	logger            *zap.Logger
 }
@@ -42,11 +43,13 @@ func New(
 	l.updateMenu = NewUpdateMenu(l)
 	l.userMenu = NewUserMenu(l)
 	l.botMenu = NewBotMenu(l)
+	l.reasonPresetsMenu = NewReasonPresetsMenu(l)
 
 	// Register menu pages with the pagination manager
 	paginationManager.AddPage(l.userMenu.page)
 	paginationManager.AddPage(l.botMenu.page)
 	paginationManager.AddPage(l.updateMenu.page)
+	paginationManager.AddPage(l.reasonPresetsMenu.page)
 
 	return l
 }
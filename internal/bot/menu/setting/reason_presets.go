@@ -0,0 +1,167 @@
+package setting
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/robalyx/rotector/internal/bot/builder/setting"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/pagination"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/interfaces"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"go.uber.org/zap"
+)
+
+// ReasonPresetsMenu handles the interface for managing confirm reason presets.
+type ReasonPresetsMenu struct {
+	layout *Layout
+	page   *pagination.Page
+}
+
+// NewReasonPresetsMenu creates a ReasonPresetsMenu and sets up its page.
+func NewReasonPresetsMenu(l *Layout) *ReasonPresetsMenu {
+	m := &ReasonPresetsMenu{layout: l}
+	m.page = &pagination.Page{
+		Name: "Reason Presets Menu",
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return setting.NewReasonPresetsBuilder(s).Build()
+		},
+		SelectHandlerFunc: m.handleSelectMenu,
+		ButtonHandlerFunc: m.handleButton,
+		ModalHandlerFunc:  m.handleModal,
+	}
+	return m
+}
+
+// Show displays the reason presets interface.
+func (m *ReasonPresetsMenu) Show(event interfaces.CommonEvent, s *session.Session, content string) {
+	m.layout.paginationManager.NavigateTo(event, s, m.page, content)
+}
+
+// handleSelectMenu processes preset deletion selections.
+func (m *ReasonPresetsMenu) handleSelectMenu(event *events.ComponentInteractionCreate, s *session.Session, customID string, option string) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	if customID != constants.DeleteReasonPresetSelectID {
+		return
+	}
+
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	index, err := strconv.Atoi(option)
+	if err != nil || index < 0 || index >= len(settings.ReasonPresets) {
+		m.Show(event, s, "Invalid preset selected.")
+		return
+	}
+
+	// Remove the selected preset
+	name := settings.ReasonPresets[index].Name
+	settings.ReasonPresets = append(settings.ReasonPresets[:index], settings.ReasonPresets[index+1:]...)
+
+	if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+		m.layout.logger.Error("Failed to save user settings", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to delete the preset. Please try again.")
+		return
+	}
+	s.Set(constants.SessionKeyUserSettings, settings)
+
+	m.Show(event, s, fmt.Sprintf("Deleted preset '%s'.", name))
+}
+
+// handleButton processes button interactions.
+func (m *ReasonPresetsMenu) handleButton(event *events.ComponentInteractionCreate, s *session.Session, customID string) {
+	switch customID {
+	case constants.BackButtonCustomID:
+		m.layout.paginationManager.NavigateBack(event, s, "")
+	case constants.AddReasonPresetButtonID:
+		m.handleAddPreset(event)
+	}
+}
+
+// handleAddPreset opens a modal for entering a new preset.
+func (m *ReasonPresetsMenu) handleAddPreset(event *events.ComponentInteractionCreate) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.ReasonPresetModalCustomID).
+		SetTitle("Add Reason Preset").
+		AddActionRow(
+			discord.NewTextInput(constants.ReasonPresetNameInputID, discord.TextInputStyleShort, "Name").
+				WithRequired(true).
+				WithMaxLength(constants.MaxReasonPresetNameLength).
+				WithPlaceholder("Enter a short name for this preset..."),
+		).
+		AddActionRow(
+			discord.NewTextInput(constants.ReasonPresetTemplateInputID, discord.TextInputStyleParagraph, "Reason").
+				WithRequired(true).
+				WithMaxLength(constants.MaxReasonPresetLength).
+				WithPlaceholder("Enter the reason. You may use {group} and {confidence}..."),
+		).
+		Build()
+
+	if err := event.Modal(modal); err != nil {
+		m.layout.logger.Error("Failed to create modal", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to open the preset form. Please try again.")
+	}
+}
+
+// handleModal processes new preset submissions.
+func (m *ReasonPresetsMenu) handleModal(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
+	if event.Data.CustomID != constants.ReasonPresetModalCustomID {
+		return
+	}
+
+	var settings *types.UserSetting
+	s.GetInterface(constants.SessionKeyUserSettings, &settings)
+
+	name := strings.TrimSpace(event.Data.Text(constants.ReasonPresetNameInputID))
+	template := strings.TrimSpace(event.Data.Text(constants.ReasonPresetTemplateInputID))
+
+	// Validate the preset
+	switch {
+	case len(settings.ReasonPresets) >= constants.MaxReasonPresets:
+		m.Show(event, s, fmt.Sprintf("You cannot save more than %d presets.", constants.MaxReasonPresets))
+		return
+	case name == "" || template == "":
+		m.Show(event, s, "Preset name and reason cannot be empty.")
+		return
+	case len(name) > constants.MaxReasonPresetNameLength:
+		m.Show(event, s, fmt.Sprintf("Preset name cannot exceed %d characters.", constants.MaxReasonPresetNameLength))
+		return
+	case len(template) > constants.MaxReasonPresetLength:
+		m.Show(event, s, fmt.Sprintf("Preset reason cannot exceed %d characters.", constants.MaxReasonPresetLength))
+		return
+	}
+
+	for _, preset := range settings.ReasonPresets {
+		if strings.EqualFold(preset.Name, name) {
+			m.Show(event, s, fmt.Sprintf("A preset named '%s' already exists.", name))
+			return
+		}
+	}
+
+	// Save the new preset
+	settings.ReasonPresets = append(settings.ReasonPresets, types.ReasonPreset{
+		Name:     name,
+		Template: template,
+	})
+
+	if err := m.layout.db.Settings().SaveUserSettings(context.Background(), settings); err != nil {
+		m.layout.logger.Error("Failed to save user settings", zap.Error(err))
+		m.layout.paginationManager.RespondWithError(event, "Failed to save the preset. Please try again.")
+		return
+	}
+	s.Set(constants.SessionKeyUserSettings, settings)
+
+	m.Show(event, s, fmt.Sprintf("Added preset '%s'.", name))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
@@ -64,6 +65,10 @@ func (m *UpdateMenu) Show(event interfaces.CommonEvent, s *session.Session, sett
 
 // handleSettingChange processes setting value changes.
 func (m *UpdateMenu) handleSettingChange(event *events.ComponentInteractionCreate, s *session.Session, _ string, option string) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	settingType := s.GetString(constants.SessionKeySettingType)
 	settingKey := s.GetString(constants.SessionKeyCustomID)
 	setting := m.getSetting(settingType, settingKey)
@@ -102,12 +107,36 @@ func (m *UpdateMenu) updateSetting(s *session.Session, setting setting.Setting,
 			return err
 		}
 		s.Set(constants.SessionKeyUserSettings, userSettings)
+
+		// Log changes to how the user appears on the leaderboard
+		if setting.Key == constants.LeaderboardAnonymousOption {
+			m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+				ReviewerID:        s.UserID(),
+				ActivityType:      enum.ActivityTypeUserSettingUpdated,
+				ActivityTimestamp: time.Now(),
+				Details: map[string]interface{}{
+					"setting": setting.Key,
+					"value":   value,
+				},
+			})
+		}
 	} else {
 		err := m.layout.db.Settings().SaveBotSettings(context.Background(), botSettings)
 		if err != nil {
 			return err
 		}
 		s.Set(constants.SessionKeyBotSettings, botSettings)
+
+		// Log the bot setting change
+		m.layout.db.Activity().Log(context.Background(), &types.ActivityLog{
+			ReviewerID:        s.UserID(),
+			ActivityType:      enum.ActivityTypeBotSettingUpdated,
+			ActivityTimestamp: time.Now(),
+			Details: map[string]interface{}{
+				"setting": setting.Key,
+				"value":   value,
+			},
+		})
 	}
 
 	return nil
@@ -133,7 +162,7 @@ func (m *UpdateMenu) handleSettingButton(event *events.ComponentInteractionCreat
 
 		switch setting.Type {
 		case enum.SettingTypeID:
-			textInput.WithPlaceholder("Enter the user ID to toggle...")
+			textInput.WithPlaceholder("Enter the ID to toggle...")
 			modalTitle = "Toggle " + setting.Name
 		case enum.SettingTypeNumber:
 			textInput.WithPlaceholder("Enter a number...").
@@ -161,6 +190,10 @@ func (m *UpdateMenu) handleSettingButton(event *events.ComponentInteractionCreat
 
 // handleSettingModal processes modal submissions.
 func (m *UpdateMenu) handleSettingModal(event *events.ModalSubmitInteractionCreate, s *session.Session) {
+	if m.layout.paginationManager.RejectIfReadOnly(event, s) {
+		return
+	}
+
 	settingType := s.GetString(constants.SessionKeySettingType)
 	settingKey := s.GetString(constants.SessionKeyCustomID)
 	setting := m.getSetting(settingType, settingKey)
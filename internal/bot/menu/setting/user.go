@@ -41,6 +41,12 @@ func (m *UserMenu) Show(event interfaces.CommonEvent, s *session.Session) {
 // handleUserSettingSelection processes select menu interactions by determining
 // which setting was chosen and showing the appropriate change menu.
 func (m *UserMenu) handleUserSettingSelection(event *events.ComponentInteractionCreate, s *session.Session, _ string, option string) {
+	// Reason presets use their own management menu
+	if option == constants.ReasonPresetsOption {
+		m.layout.reasonPresetsMenu.Show(event, s, "")
+		return
+	}
+
 	// Show the change menu for the selected setting
 	m.layout.updateMenu.Show(event, s, constants.UserSettingPrefix, option)
 }
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"go.uber.org/zap"
+)
+
+// componentLogger records sanitized component text so systematic issues can be spotted.
+var componentLogger = zap.NewNop()
+
+// SetComponentLogger sets the logger used when component text is truncated.
+func SetComponentLogger(logger *zap.Logger) {
+	componentLogger = logger
+}
+
+// SanitizeOptionLabel makes user-controlled text safe to use as a select menu option label.
+// Empty labels are replaced with a placeholder since Discord rejects them.
+func SanitizeOptionLabel(s string) string {
+	return sanitizeComponentText(s, constants.MaxSelectOptionLabelLength, constants.EmptyComponentLabel, "option label")
+}
+
+// SanitizeOptionDescription makes user-controlled text safe to use as a select menu option description.
+// Empty descriptions are left empty as Discord treats them as omitted.
+func SanitizeOptionDescription(s string) string {
+	return sanitizeComponentText(s, constants.MaxSelectOptionDescriptionLength, "", "option description")
+}
+
+// SanitizeButtonLabel makes user-controlled text safe to use as a button label.
+// Empty labels are replaced with a placeholder since Discord rejects them.
+func SanitizeButtonLabel(s string) string {
+	return sanitizeComponentText(s, constants.MaxButtonLabelLength, constants.EmptyComponentLabel, "button label")
+}
+
+// sanitizeComponentText flattens newlines, strips control characters and truncates
+// the text by characters so multibyte runes are never split.
+func sanitizeComponentText(s string, maxLength int, placeholder string, kind string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return ' '
+		case unicode.IsControl(r):
+			return -1
+		}
+		return r
+	}, s)
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return placeholder
+	}
+
+	runes := []rune(s)
+	if len(runes) > maxLength {
+		componentLogger.Warn("Truncated component text",
+			zap.String("kind", kind),
+			zap.Int("length", len(runes)),
+			zap.Int("maxLength", maxLength))
+		return string(runes[:maxLength-3]) + "..."
+	}
+
+	return s
+}
@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeOptionLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "short label",
+			input: "Group Name",
+			want:  "Group Name",
+		},
+		{
+			name:  "200 character label",
+			input: strings.Repeat("a", 200),
+			want:  strings.Repeat("a", 97) + "...",
+		},
+		{
+			name:  "emoji only label",
+			input: "🔥🔥🔥",
+			want:  "🔥🔥🔥",
+		},
+		{
+			name:  "long emoji only label",
+			input: strings.Repeat("🔥", 200),
+			want:  strings.Repeat("🔥", 97) + "...",
+		},
+		{
+			name:  "empty label",
+			input: "",
+			want:  constants.EmptyComponentLabel,
+		},
+		{
+			name:  "whitespace only label",
+			input: " \n\t ",
+			want:  constants.EmptyComponentLabel,
+		},
+		{
+			name:  "control characters",
+			input: "bad\x00na\x1bme\nhere",
+			want:  "badname here",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SanitizeOptionLabel(tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, utf8.RuneCountInString(got), constants.MaxSelectOptionLabelLength)
+		})
+	}
+}
+
+func TestSanitizeOptionDescription(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "short description",
+			input: "View appeal",
+			want:  "View appeal",
+		},
+		{
+			name:  "200 character description",
+			input: strings.Repeat("b", 200),
+			want:  strings.Repeat("b", 97) + "...",
+		},
+		{
+			name:  "empty description",
+			input: "",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SanitizeOptionDescription(tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, utf8.RuneCountInString(got), constants.MaxSelectOptionDescriptionLength)
+		})
+	}
+}
+
+func TestSanitizeButtonLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "short label",
+			input: "Confirm",
+			want:  "Confirm",
+		},
+		{
+			name:  "200 character label",
+			input: strings.Repeat("c", 200),
+			want:  strings.Repeat("c", 77) + "...",
+		},
+		{
+			name:  "emoji only label",
+			input: "✅",
+			want:  "✅",
+		},
+		{
+			name:  "empty label",
+			input: "",
+			want:  constants.EmptyComponentLabel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SanitizeButtonLabel(tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, utf8.RuneCountInString(got), constants.MaxButtonLabelLength)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+)
+
+// detailKeyOrder lists well-known activity detail keys in the order they are shown.
+// Keys not listed here are shown afterwards in alphabetical order.
+var detailKeyOrder = []string{ //nolint:gochecknoglobals
+	"reason",
+	"note",
+	"reason_category",
+	"confidence",
+	"upvotes",
+	"downvotes",
+	"appeal_id",
+	"review_seconds",
+	"diffs",
+}
+
+// detailKeyLabels maps well-known activity detail keys to display labels.
+var detailKeyLabels = map[string]string{ //nolint:gochecknoglobals
+	"reason":          "Reason",
+	"note":            "Note",
+	"reason_category": "Reason Category",
+	"confidence":      "Confidence",
+	"upvotes":         "Upvotes",
+	"downvotes":       "Downvotes",
+	"appeal_id":       "Appeal",
+	"review_seconds":  "Review Time",
+	"diffs":           "Changes",
+}
+
+// markdownEscaper escapes characters that Discord treats as markdown outside code spans.
+var markdownEscaper = strings.NewReplacer( //nolint:gochecknoglobals
+	`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "|", `\|`, ">", `\>`, "#", `\#`, "[", `\[`, "]", `\]`, "`", "",
+	"\n", " ", "\r", " ",
+)
+
+// FormatLogDetails renders activity log details as "Key: value" lines, each starting
+// with a newline. Well-known keys are shown first with tailored formatting, values are
+// escaped and truncated, nested structures are summarized, and the total output is
+// capped so a single entry cannot break the embed.
+func FormatLogDetails(details map[string]interface{}) string {
+	if len(details) == 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	keys := sortedDetailKeys(details)
+	for i, key := range keys {
+		line := fmt.Sprintf("\n%s: %s", detailLabel(key), formatDetailValue(key, details[key]))
+
+		// Stop once the cap is reached, leaving room for the overflow note
+		remaining := len(keys) - i
+		if result.Len()+len(line) > constants.MaxLogDetailsLength-len(detailOverflowNote(remaining)) {
+			result.WriteString(detailOverflowNote(remaining))
+			break
+		}
+		result.WriteString(line)
+	}
+
+	return result.String()
+}
+
+// FormatHistoryNote renders a note left by a reviewer for the review history,
+// escaped and truncated the same way as log detail values.
+func FormatHistoryNote(note string) string {
+	return codeSpan(note)
+}
+
+// sortedDetailKeys returns the detail keys with well-known keys first.
+func sortedDetailKeys(details map[string]interface{}) []string {
+	keys := make([]string, 0, len(details))
+	for _, key := range detailKeyOrder {
+		if _, ok := details[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+
+	others := make([]string, 0, len(details))
+	for key := range details {
+		if _, known := detailKeyLabels[key]; !known {
+			others = append(others, key)
+		}
+	}
+	sort.Strings(others)
+
+	return append(keys, others...)
+}
+
+// detailLabel returns the display label for a detail key.
+func detailLabel(key string) string {
+	if label, ok := detailKeyLabels[key]; ok {
+		return label
+	}
+
+	label := strings.ReplaceAll(key, "_", " ")
+	label = truncateRunes(label, 40)
+	if label == "" {
+		return "Unknown"
+	}
+	r, size := utf8.DecodeRuneInString(label)
+	return markdownEscaper.Replace(strings.ToUpper(string(r)) + label[size:])
+}
+
+// formatDetailValue formats a single detail value based on its key and type.
+func formatDetailValue(key string, value interface{}) string {
+	switch key {
+	case "appeal_id":
+		if id, ok := value.(float64); ok {
+			return fmt.Sprintf("`#%s`", strconv.FormatFloat(id, 'f', -1, 64))
+		}
+	case "review_seconds":
+		if seconds, ok := value.(float64); ok {
+			return fmt.Sprintf("`%ss`", strconv.FormatFloat(seconds, 'f', -1, 64))
+		}
+	case "diffs":
+		if diffs, ok := value.(map[string]interface{}); ok {
+			return formatDetailDiffs(diffs)
+		}
+	}
+
+	return codeSpan(summarizeDetailValue(value))
+}
+
+// formatDetailDiffs renders a map of field changes, where each field holds
+// "old" and "new" values, as "field: old → new" pairs.
+func formatDetailDiffs(diffs map[string]interface{}) string {
+	fields := make([]string, 0, len(diffs))
+	for field := range diffs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		change, ok := diffs[field].(map[string]interface{})
+		if !ok {
+			parts = append(parts, fmt.Sprintf("%s %s", detailLabel(field), codeSpan(summarizeDetailValue(diffs[field]))))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s %s → %s",
+			detailLabel(field),
+			codeSpan(summarizeDetailValue(change["old"])),
+			codeSpan(summarizeDetailValue(change["new"]))))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// summarizeDetailValue converts a value to plain text, collapsing nested
+// structures into a short summary instead of dumping them.
+func summarizeDetailValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "none"
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return fmt.Sprintf("{%d fields: %s}", len(v), strings.Join(keys, ", "))
+	case []interface{}:
+		return fmt.Sprintf("[%d items]", len(v))
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// codeSpan wraps text in an inline code span after removing characters that
+// would break out of it and truncating it to the per-value limit.
+func codeSpan(s string) string {
+	s = strings.ReplaceAll(NormalizeString(s), "\r", " ")
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "`-`"
+	}
+	return "`" + truncateRunes(s, constants.MaxLogDetailValueLength) + "`"
+}
+
+// truncateRunes truncates a string to at most maxRunes runes without splitting
+// a multi-byte character, adding an ellipsis when truncated.
+func truncateRunes(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:maxRunes-3]) + "..."
+}
+
+// detailOverflowNote returns the note shown when details are cut off.
+func detailOverflowNote(remaining int) string {
+	return fmt.Sprintf("\n... and %d more", remaining)
+}
@@ -0,0 +1,175 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatLogDetails(t *testing.T) {
+	tests := []struct {
+		name    string
+		details map[string]interface{}
+		want    string
+	}{
+		{
+			name:    "empty details",
+			details: map[string]interface{}{},
+			want:    "",
+		},
+		{
+			name: "known keys in fixed order",
+			details: map[string]interface{}{
+				"downvotes":  float64(2),
+				"upvotes":    float64(5),
+				"reason":     "spam",
+				"confidence": 0.85,
+			},
+			want: "\nReason: `spam`\nConfidence: `0.85`\nUpvotes: `5`\nDownvotes: `2`",
+		},
+		{
+			name: "unknown keys sorted after known keys",
+			details: map[string]interface{}{
+				"zeta_value":  "z",
+				"alpha_value": "a",
+				"reason":      "test",
+			},
+			want: "\nReason: `test`\nAlpha value: `a`\nZeta value: `z`",
+		},
+		{
+			name: "appeal id and review time",
+			details: map[string]interface{}{
+				"appeal_id":      float64(42),
+				"review_seconds": float64(90),
+			},
+			want: "\nAppeal: `#42`\nReview Time: `90s`",
+		},
+		{
+			name: "markdown injection in value",
+			details: map[string]interface{}{
+				"reason": "`` **bold** ``\n> @everyone",
+			},
+			want: "\nReason: `**bold**  > @everyone`",
+		},
+		{
+			name: "note follows reason",
+			details: map[string]interface{}{
+				"confidence": 0.5,
+				"note":       "waiting on appeal",
+				"reason":     "bad",
+			},
+			want: "\nReason: `bad`\nNote: `waiting on appeal`\nConfidence: `0.5`",
+		},
+		{
+			name: "markdown injection in key",
+			details: map[string]interface{}{
+				"**evil**": "x",
+			},
+			want: "\n\\*\\*evil\\*\\*: `x`",
+		},
+		{
+			name: "empty and nil values",
+			details: map[string]interface{}{
+				"reason": "``",
+				"note":   nil,
+			},
+			want: "\nReason: `-`\nNote: `none`",
+		},
+		{
+			name: "nested map is summarized",
+			details: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"b": map[string]interface{}{"deep": "value"},
+					"a": "x",
+				},
+			},
+			want: "\nMetadata: `{2 fields: a, b}`",
+		},
+		{
+			name: "slice is summarized",
+			details: map[string]interface{}{
+				"ids": []interface{}{float64(1), float64(2), float64(3)},
+			},
+			want: "\nIds: `[3 items]`",
+		},
+		{
+			name: "field diffs",
+			details: map[string]interface{}{
+				"diffs": map[string]interface{}{
+					"name":  map[string]interface{}{"old": "before", "new": "after"},
+					"count": map[string]interface{}{"old": float64(1), "new": float64(2)},
+				},
+			},
+			want: "\nChanges: Count `1` → `2`, Name `before` → `after`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatLogDetails(tt.details)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatLogDetailsLimits(t *testing.T) {
+	t.Run("long value is truncated", func(t *testing.T) {
+		got := FormatLogDetails(map[string]interface{}{
+			"reason": strings.Repeat("a", 10000),
+		})
+		assert.Equal(t, "\nReason: `"+strings.Repeat("a", constants.MaxLogDetailValueLength-3)+"...`", got)
+	})
+
+	t.Run("multi-byte value is truncated on rune boundary", func(t *testing.T) {
+		got := FormatLogDetails(map[string]interface{}{
+			"reason": strings.Repeat("é", 500),
+		})
+		assert.True(t, utf8.ValidString(got))
+		assert.True(t, strings.HasSuffix(got, "...`"))
+	})
+
+	t.Run("total length is capped", func(t *testing.T) {
+		details := make(map[string]interface{})
+		for _, key := range []string{"a1", "a2", "a3", "a4", "a5", "a6", "a7", "a8"} {
+			details[key] = strings.Repeat("x", 500)
+		}
+
+		got := FormatLogDetails(details)
+		assert.LessOrEqual(t, len(got), constants.MaxLogDetailsLength)
+		assert.Contains(t, got, "\nA1: ")
+		assert.Regexp(t, `\n\.\.\. and \d+ more$`, got)
+	})
+}
+
+func TestFormatHistoryNote(t *testing.T) {
+	tests := []struct {
+		name string
+		note string
+		want string
+	}{
+		{
+			name: "plain note",
+			note: "need second opinion",
+			want: "`need second opinion`",
+		},
+		{
+			name: "backticks and newlines are removed",
+			note: "see `this`\nline",
+			want: "`see this line`",
+		},
+		{
+			name: "blank note",
+			note: "   ",
+			want: "`-`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatHistoryNote(tt.note))
+		})
+	}
+}
@@ -18,3 +18,21 @@ func FormatNumber(n uint64) string {
 	}
 	return fmt.Sprintf("%.1fB", float64(n)/1000000000)
 }
+
+// FormatOrdinal formats a number with its English ordinal suffix (1st, 2nd, 3rd, 4th).
+func FormatOrdinal(n int) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.Itoa(n) + suffix
+}
@@ -51,3 +51,29 @@ func TestFormatNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatOrdinal(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want string
+	}{
+		{name: "first", n: 1, want: "1st"},
+		{name: "second", n: 2, want: "2nd"},
+		{name: "third", n: 3, want: "3rd"},
+		{name: "fourth", n: 4, want: "4th"},
+		{name: "eleventh", n: 11, want: "11th"},
+		{name: "twelfth", n: 12, want: "12th"},
+		{name: "thirteenth", n: 13, want: "13th"},
+		{name: "twenty first", n: 21, want: "21st"},
+		{name: "hundred twelfth", n: 112, want: "112th"},
+		{name: "hundred first", n: 101, want: "101st"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatOrdinal(tt.n)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
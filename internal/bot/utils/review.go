@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+)
+
+const (
+	// ReasonCategoryMultiple is used when a reason contains more than one known category.
+	ReasonCategoryMultiple = "Multiple Sources"
+	// ReasonCategoryCustom is used when a reason does not match any known category.
+	ReasonCategoryCustom = "Custom"
+)
+
+// GetReasonCategory determines the category of a flag reason from the markers
+// that the checkers add to the reasons they generate.
+func GetReasonCategory(reason string) string {
+	categories := []struct {
+		marker   string
+		category string
+	}{
+		{"AI Analysis:", "AI Analysis"},
+		{"Friend Analysis:", "Friend Analysis"},
+		{"Group Analysis:", "Group Analysis"},
+		{"large number of flagged users", "Flagged Member Count"},
+		{"large percentage of flagged users", "Flagged Member Percentage"},
+	}
+
+	var matched string
+	for _, c := range categories {
+		if !strings.Contains(reason, c.marker) {
+			continue
+		}
+		if matched != "" {
+			return ReasonCategoryMultiple
+		}
+		matched = c.category
+	}
+
+	if matched == "" {
+		return ReasonCategoryCustom
+	}
+	return matched
+}
+
+// AddDecisionDetails adds the review time, reason category and confidence of a
+// review decision to the given activity log details. The review time is only
+// added when the time the target was shown is known.
+func AddDecisionDetails(details map[string]interface{}, reason string, confidence float64, shownAt time.Time) map[string]interface{} {
+	if details == nil {
+		details = make(map[string]interface{})
+	}
+
+	details[types.DetailKeyReasonCategory] = GetReasonCategory(reason)
+	details[types.DetailKeyConfidence] = confidence
+
+	if !shownAt.IsZero() {
+		details[types.DetailKeyReviewSeconds] = math.Round(time.Since(shownAt).Seconds()*100) / 100
+	}
+
+	return details
+}
+
+// DecisionTally counts the decisions a reviewer made today so the review feedback
+// can show running context without querying the activity logs after every action.
+// Training mode votes are tallied separately from standard mode decisions.
+type DecisionTally struct {
+	Day      string `json:"day"`
+	Training bool   `json:"training"`
+	Count    int    `json:"count"`
+}
+
+// NewDecisionTally creates a tally for the day of the given time.
+func NewDecisionTally(now time.Time, training bool, count int) *DecisionTally {
+	return &DecisionTally{
+		Day:      now.UTC().Format(time.DateOnly),
+		Training: training,
+		Count:    count,
+	}
+}
+
+// IsCurrent reports whether the tally belongs to the day of the given time and review mode.
+func (t *DecisionTally) IsCurrent(now time.Time, training bool) bool {
+	return t != nil && t.Day == now.UTC().Format(time.DateOnly) && t.Training == training
+}
+
+// FormatDecisionFeedback builds the short message shown after a review action, such as
+// "User confirmed (your 47th today) • 120 users left to review • next: 0.91 confidence".
+// The count is left out when it is zero, which is used for actions that are not
+// decisions like skips.
+func FormatDecisionFeedback(action string, count int, training bool, usersLeft int, nextConfidence float64) string {
+	var tally string
+	if count > 0 {
+		if training {
+			tally = fmt.Sprintf(" (your %s vote today)", FormatOrdinal(count))
+		} else {
+			tally = fmt.Sprintf(" (your %s today)", FormatOrdinal(count))
+		}
+	}
+
+	return fmt.Sprintf("%s%s • %d users left to review • next: %.2f confidence", action, tally, usersLeft, nextConfidence)
+}
+
+// FormatVoteBreakdown formats the share of voters that marked a target as safe,
+// such as "👍 62% (13 of 21 voters)".
+func FormatVoteBreakdown(breakdown *types.VoteBreakdown) string {
+	if breakdown.Voters == 0 {
+		return "👍 No votes yet"
+	}
+
+	noun := "voters"
+	if breakdown.Voters == 1 {
+		noun = "voter"
+	}
+
+	return fmt.Sprintf("👍 %.0f%% (%d of %d %s)",
+		breakdown.UpvoteRatio()*100, breakdown.Upvotes, breakdown.Voters, noun)
+}
+
+// FormatReviewNotes formats the latest reviewer notes of a user or group, newest
+// first, with their ID, author and when they were written. Notes beyond the
+// review notes limit are summarized in a trailing line.
+func FormatReviewNotes(notes []*types.ReviewNote) string {
+	if len(notes) == 0 {
+		return constants.NotApplicable
+	}
+
+	lines := make([]string, 0, constants.ReviewNotesLimit+1)
+	for i, note := range notes {
+		if i >= constants.ReviewNotesLimit {
+			lines = append(lines, "... and more")
+			break
+		}
+		lines = append(lines, fmt.Sprintf("- `#%d` <@%d> - <t:%d:R>\n  - %s",
+			note.ID, note.AuthorID, note.CreatedAt.Unix(), FormatHistoryNote(note.Content)))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,255 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReasonCategory(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason string
+		want   string
+	}{
+		{
+			name:   "ai analysis",
+			reason: "AI Analysis: inappropriate description",
+			want:   "AI Analysis",
+		},
+		{
+			name:   "friend analysis with warning prefix",
+			reason: "⚠️ **WARNING: Popular user with large amount of followers**\n\nFriend Analysis: many flagged friends",
+			want:   "Friend Analysis",
+		},
+		{
+			name:   "group analysis",
+			reason: "Group Analysis: Member of multiple inappropriate groups.",
+			want:   "Group Analysis",
+		},
+		{
+			name:   "group flagged member count",
+			reason: "Group has large number of flagged users",
+			want:   "Flagged Member Count",
+		},
+		{
+			name:   "multiple categories",
+			reason: "AI Analysis: bad description\n\nFriend Analysis: bad friends",
+			want:   ReasonCategoryMultiple,
+		},
+		{
+			name:   "custom reason",
+			reason: "Confirmed by moderator",
+			want:   ReasonCategoryCustom,
+		},
+		{
+			name:   "empty reason",
+			reason: "",
+			want:   ReasonCategoryCustom,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetReasonCategory(tt.reason)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddDecisionDetails(t *testing.T) {
+	tests := []struct {
+		name        string
+		details     map[string]interface{}
+		shownAt     time.Time
+		wantSeconds bool
+		wantKeys    []string
+	}{
+		{
+			name:        "keeps existing details",
+			details:     map[string]interface{}{"reason": "AI Analysis: test"},
+			shownAt:     time.Now().Add(-90 * time.Second),
+			wantSeconds: true,
+			wantKeys:    []string{"reason", types.DetailKeyReasonCategory, types.DetailKeyConfidence},
+		},
+		{
+			name:        "nil details",
+			details:     nil,
+			shownAt:     time.Now().Add(-time.Minute),
+			wantSeconds: true,
+			wantKeys:    []string{types.DetailKeyReasonCategory, types.DetailKeyConfidence},
+		},
+		{
+			name:        "unknown shown time",
+			details:     map[string]interface{}{},
+			shownAt:     time.Time{},
+			wantSeconds: false,
+			wantKeys:    []string{types.DetailKeyReasonCategory, types.DetailKeyConfidence},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AddDecisionDetails(tt.details, "AI Analysis: test", 0.75, tt.shownAt)
+
+			for _, key := range tt.wantKeys {
+				assert.Contains(t, got, key)
+			}
+			assert.Equal(t, "AI Analysis", got[types.DetailKeyReasonCategory])
+			assert.InDelta(t, 0.75, got[types.DetailKeyConfidence], 0.0001)
+
+			seconds, ok := got[types.DetailKeyReviewSeconds]
+			assert.Equal(t, tt.wantSeconds, ok)
+			if tt.wantSeconds {
+				assert.GreaterOrEqual(t, seconds, time.Since(tt.shownAt).Seconds()-1)
+			}
+		})
+	}
+}
+
+func TestDecisionTallyIsCurrent(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	tally := NewDecisionTally(now, false, 5)
+
+	tests := []struct {
+		name     string
+		tally    *DecisionTally
+		now      time.Time
+		training bool
+		want     bool
+	}{
+		{
+			name:  "same day and mode",
+			tally: tally,
+			now:   now.Add(6 * time.Hour),
+			want:  true,
+		},
+		{
+			name:  "next day",
+			tally: tally,
+			now:   now.Add(12 * time.Hour),
+			want:  false,
+		},
+		{
+			name:     "different mode",
+			tally:    tally,
+			now:      now,
+			training: true,
+			want:     false,
+		},
+		{
+			name:  "nil tally",
+			tally: nil,
+			now:   now,
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.tally.IsCurrent(tt.now, tt.training)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatDecisionFeedback(t *testing.T) {
+	tests := []struct {
+		name           string
+		action         string
+		count          int
+		training       bool
+		usersLeft      int
+		nextConfidence float64
+		want           string
+	}{
+		{
+			name:           "standard decision",
+			action:         "User confirmed",
+			count:          47,
+			usersLeft:      120,
+			nextConfidence: 0.912,
+			want:           "User confirmed (your 47th today) • 120 users left to review • next: 0.91 confidence",
+		},
+		{
+			name:           "training vote",
+			action:         "User upvoted",
+			count:          1,
+			training:       true,
+			usersLeft:      8,
+			nextConfidence: 0.5,
+			want:           "User upvoted (your 1st vote today) • 8 users left to review • next: 0.50 confidence",
+		},
+		{
+			name:           "skip without count",
+			action:         "Skipped user",
+			usersLeft:      3,
+			nextConfidence: 0.75,
+			want:           "Skipped user • 3 users left to review • next: 0.75 confidence",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatDecisionFeedback(tt.action, tt.count, tt.training, tt.usersLeft, tt.nextConfidence)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatVoteBreakdown(t *testing.T) {
+	tests := []struct {
+		name      string
+		breakdown *types.VoteBreakdown
+		want      string
+	}{
+		{
+			name:      "no votes",
+			breakdown: &types.VoteBreakdown{},
+			want:      "👍 No votes yet",
+		},
+		{
+			name:      "rounds the ratio",
+			breakdown: &types.VoteBreakdown{Voters: 21, Upvotes: 13},
+			want:      "👍 62% (13 of 21 voters)",
+		},
+		{
+			name:      "single voter",
+			breakdown: &types.VoteBreakdown{Voters: 1, Upvotes: 0, HasVoted: true},
+			want:      "👍 0% (0 of 1 voter)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatVoteBreakdown(tt.breakdown))
+		})
+	}
+}
+
+func TestFormatReviewNotes(t *testing.T) {
+	assert.Equal(t, "N/A", FormatReviewNotes(nil))
+
+	createdAt := time.Unix(1700000000, 0)
+	notes := []*types.ReviewNote{
+		{ID: 9, AuthorID: 111, Content: "owner claims hacked, verifying", CreatedAt: createdAt},
+		{ID: 7, AuthorID: 222, Content: "see `profile`\nagain", CreatedAt: createdAt.Add(-time.Hour)},
+	}
+	assert.Equal(t,
+		"- `#9` <@111> - <t:1700000000:R>\n  - `owner claims hacked, verifying`\n"+
+			"- `#7` <@222> - <t:1699996400:R>\n  - `see profile again`",
+		FormatReviewNotes(notes))
+
+	// Notes past the limit are summarized
+	notes = append(notes,
+		&types.ReviewNote{ID: 5, AuthorID: 111, Content: "first", CreatedAt: createdAt},
+		&types.ReviewNote{ID: 3, AuthorID: 111, Content: "older", CreatedAt: createdAt},
+	)
+	formatted := FormatReviewNotes(notes)
+	assert.Contains(t, formatted, "`#5`")
+	assert.NotContains(t, formatted, "`#3`")
+	assert.True(t, strings.HasSuffix(formatted, "\n... and more"))
+}
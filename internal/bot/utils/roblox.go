@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidRobloxID indicates that the input is neither a Roblox ID nor a profile or group link.
+	ErrInvalidRobloxID = errors.New("enter a numeric ID or a roblox.com profile or group link")
+	// ErrNotUserID indicates that a group link was given where a user was expected.
+	ErrNotUserID = errors.New("that is a group link, not a user")
+	// ErrNotGroupID indicates that a profile link was given where a group was expected.
+	ErrNotGroupID = errors.New("that is a profile link, not a group")
+)
+
+// RobloxIDKind tells what a parsed Roblox ID refers to.
+type RobloxIDKind int
+
+const (
+	// RobloxIDBare is a numeric ID entered on its own, which may be a user or a group.
+	RobloxIDBare RobloxIDKind = iota
+	// RobloxIDUser is an ID taken from a profile link.
+	RobloxIDUser
+	// RobloxIDGroup is an ID taken from a group or community link.
+	RobloxIDGroup
+)
+
+// RobloxID is an ID entered by a user along with what its link said it refers to.
+type RobloxID struct {
+	ID   uint64
+	Kind RobloxIDKind
+}
+
+// ParseRobloxID extracts the ID from a bare numeric ID, a profile link such as
+// https://www.roblox.com/users/123/profile, or a group link such as
+// https://www.roblox.com/groups/123/name. The scheme, query string, fragment and
+// trailing slashes are optional, and the www and web subdomains are accepted.
+func ParseRobloxID(input string) (RobloxID, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return RobloxID{}, ErrInvalidRobloxID
+	}
+
+	// Bare IDs are the common case
+	if id, ok := parseRobloxNumber(input); ok {
+		return RobloxID{ID: id, Kind: RobloxIDBare}, nil
+	}
+
+	if !strings.Contains(input, "://") {
+		input = "https://" + input
+	}
+
+	link, err := url.Parse(input)
+	if err != nil {
+		return RobloxID{}, ErrInvalidRobloxID
+	}
+
+	host := strings.ToLower(link.Hostname())
+	host = strings.TrimPrefix(strings.TrimPrefix(host, "www."), "web.")
+	if host != "roblox.com" {
+		return RobloxID{}, ErrInvalidRobloxID
+	}
+
+	segments := strings.FieldsFunc(link.Path, func(r rune) bool { return r == '/' })
+	if len(segments) < 2 {
+		return RobloxID{}, ErrInvalidRobloxID
+	}
+
+	var kind RobloxIDKind
+	switch strings.ToLower(segments[0]) {
+	case "users":
+		kind = RobloxIDUser
+	case "groups", "communities":
+		kind = RobloxIDGroup
+	default:
+		return RobloxID{}, ErrInvalidRobloxID
+	}
+
+	id, ok := parseRobloxNumber(segments[1])
+	if !ok {
+		return RobloxID{}, ErrInvalidRobloxID
+	}
+
+	return RobloxID{ID: id, Kind: kind}, nil
+}
+
+// ParseRobloxUserID parses a user ID from a bare ID or a profile link.
+// Returns ErrNotUserID if a group link was given.
+func ParseRobloxUserID(input string) (uint64, error) {
+	parsed, err := ParseRobloxID(input)
+	if err != nil {
+		return 0, err
+	}
+	if parsed.Kind == RobloxIDGroup {
+		return 0, ErrNotUserID
+	}
+	return parsed.ID, nil
+}
+
+// ParseRobloxGroupID parses a group ID from a bare ID or a group link.
+// Returns ErrNotGroupID if a profile link was given.
+func ParseRobloxGroupID(input string) (uint64, error) {
+	parsed, err := ParseRobloxID(input)
+	if err != nil {
+		return 0, err
+	}
+	if parsed.Kind == RobloxIDUser {
+		return 0, ErrNotGroupID
+	}
+	return parsed.ID, nil
+}
+
+// parseRobloxNumber parses a positive ID that fits in the bigint columns IDs are stored in.
+func parseRobloxNumber(s string) (uint64, bool) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || id == 0 || id > math.MaxInt64 {
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRobloxID(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  RobloxID
+	}{
+		{name: "bare ID", input: "123", want: RobloxID{ID: 123, Kind: RobloxIDBare}},
+		{name: "bare ID with spaces", input: "  123\n", want: RobloxID{ID: 123, Kind: RobloxIDBare}},
+		{name: "profile URL", input: "https://www.roblox.com/users/123/profile", want: RobloxID{ID: 123, Kind: RobloxIDUser}},
+		{name: "profile URL with trailing slash", input: "https://www.roblox.com/users/123/profile/", want: RobloxID{ID: 123, Kind: RobloxIDUser}},
+		{name: "profile URL without page", input: "https://www.roblox.com/users/123", want: RobloxID{ID: 123, Kind: RobloxIDUser}},
+		{
+			name:  "profile URL with query string",
+			input: "https://www.roblox.com/users/123/profile?friendshipSourceType=PlayerSearch",
+			want:  RobloxID{ID: 123, Kind: RobloxIDUser},
+		},
+		{name: "profile URL without scheme", input: "www.roblox.com/users/123/profile", want: RobloxID{ID: 123, Kind: RobloxIDUser}},
+		{name: "profile URL without subdomain", input: "http://roblox.com/users/123/profile", want: RobloxID{ID: 123, Kind: RobloxIDUser}},
+		{name: "old web domain", input: "https://web.roblox.com/users/123/profile", want: RobloxID{ID: 123, Kind: RobloxIDUser}},
+		{name: "uppercase host", input: "https://WWW.ROBLOX.COM/users/123/profile", want: RobloxID{ID: 123, Kind: RobloxIDUser}},
+		{name: "group URL", input: "https://www.roblox.com/groups/456/Some-Group#!/about", want: RobloxID{ID: 456, Kind: RobloxIDGroup}},
+		{name: "group URL with trailing slash", input: "https://www.roblox.com/groups/456/", want: RobloxID{ID: 456, Kind: RobloxIDGroup}},
+		{name: "community URL", input: "https://www.roblox.com/communities/456/Some-Group", want: RobloxID{ID: 456, Kind: RobloxIDGroup}},
+		{name: "old web domain group", input: "web.roblox.com/groups/456/Some-Group?rsc=1", want: RobloxID{ID: 456, Kind: RobloxIDGroup}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRobloxID(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseRobloxIDInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "zero", input: "0"},
+		{name: "negative", input: "-5"},
+		{name: "out of range", input: "9223372036854775808"},
+		{name: "overflow", input: "99999999999999999999"},
+		{name: "not a number", input: "abc"},
+		{name: "other domain", input: "https://www.example.com/users/123/profile"},
+		{name: "lookalike domain", input: "https://roblox.com.example.com/users/123/profile"},
+		{name: "unknown path", input: "https://www.roblox.com/games/123/name"},
+		{name: "missing ID", input: "https://www.roblox.com/users/"},
+		{name: "non-numeric ID", input: "https://www.roblox.com/users/abc/profile"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRobloxID(tt.input)
+			require.ErrorIs(t, err, ErrInvalidRobloxID)
+		})
+	}
+}
+
+func TestParseRobloxUserID(t *testing.T) {
+	id, err := ParseRobloxUserID("123")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(123), id)
+
+	id, err = ParseRobloxUserID("https://www.roblox.com/users/123/profile")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(123), id)
+
+	_, err = ParseRobloxUserID("https://www.roblox.com/groups/456/name")
+	require.ErrorIs(t, err, ErrNotUserID)
+
+	_, err = ParseRobloxUserID("abc")
+	require.ErrorIs(t, err, ErrInvalidRobloxID)
+}
+
+func TestParseRobloxGroupID(t *testing.T) {
+	id, err := ParseRobloxGroupID("456")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(456), id)
+
+	id, err = ParseRobloxGroupID("https://www.roblox.com/communities/456/name")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(456), id)
+
+	_, err = ParseRobloxGroupID("https://www.roblox.com/users/123/profile")
+	require.ErrorIs(t, err, ErrNotGroupID)
+}
@@ -5,8 +5,11 @@ import (
 	"math"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/robalyx/rotector/internal/bot/constants"
 )
 
 // Regular expression to clean up excessive newlines in descriptions.
@@ -124,3 +127,17 @@ func CensorStringsInText(text string, streamerMode bool, targets ...string) stri
 
 	return result
 }
+
+// FormatReasonPreset substitutes the supported placeholders in a reason preset template.
+// {group} is replaced with the given group names and {confidence} with the confidence score.
+func FormatReasonPreset(template string, group string, confidence float64) string {
+	if group == "" {
+		group = constants.NotApplicable
+	}
+
+	replacer := strings.NewReplacer(
+		"{group}", group,
+		"{confidence}", strconv.FormatFloat(confidence, 'f', 2, 64),
+	)
+	return replacer.Replace(template)
+}
@@ -145,3 +145,63 @@ func TestCensorStringsInText(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatReasonPreset(t *testing.T) {
+	tests := []struct {
+		name       string
+		template   string
+		group      string
+		confidence float64
+		want       string
+	}{
+		{
+			name:       "no placeholders",
+			template:   "Inappropriate profile",
+			group:      "Test Group",
+			confidence: 0.85,
+			want:       "Inappropriate profile",
+		},
+		{
+			name:       "group placeholder",
+			template:   "Member of {group}",
+			group:      "Test Group",
+			confidence: 0.85,
+			want:       "Member of Test Group",
+		},
+		{
+			name:       "confidence placeholder",
+			template:   "Flagged with {confidence} confidence",
+			group:      "",
+			confidence: 0.856,
+			want:       "Flagged with 0.86 confidence",
+		},
+		{
+			name:       "both placeholders repeated",
+			template:   "{group} ({confidence}) - {group}",
+			group:      "Group A, Group B",
+			confidence: 1,
+			want:       "Group A, Group B (1.00) - Group A, Group B",
+		},
+		{
+			name:       "empty group",
+			template:   "Member of {group}",
+			group:      "",
+			confidence: 0,
+			want:       "Member of N/A",
+		},
+		{
+			name:       "unknown placeholder untouched",
+			template:   "{unknown} {group}",
+			group:      "Test",
+			confidence: 0,
+			want:       "{unknown} Test",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatReasonPreset(tt.template, tt.group, tt.confidence)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
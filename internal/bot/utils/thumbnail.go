@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"bytes"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/assets"
+	"github.com/robalyx/rotector/internal/common/client/fetcher"
+)
+
+// PlaceholderImageName is the attachment file name used for the placeholder image.
+const PlaceholderImageName = "content_deleted.png"
+
+// placeholderImage holds the placeholder image bytes, read once from the embedded assets.
+var placeholderImage = mustReadPlaceholderImage() //nolint:gochecknoglobals
+
+// mustReadPlaceholderImage reads the placeholder image from the embedded assets.
+func mustReadPlaceholderImage() []byte {
+	data, err := assets.Images.ReadFile("images/" + PlaceholderImageName)
+	if err != nil {
+		panic("failed to read placeholder image: " + err.Error())
+	}
+	return data
+}
+
+// PlaceholderImage returns a reader over the cached placeholder image bytes.
+func PlaceholderImage() *bytes.Reader {
+	return bytes.NewReader(placeholderImage)
+}
+
+// IsMissingThumbnail checks if a thumbnail URL is empty or marked as unavailable by the fetcher.
+func IsMissingThumbnail(thumbnailURL string) bool {
+	return thumbnailURL == "" || thumbnailURL == fetcher.ThumbnailPlaceholder
+}
+
+// SetThumbnail sets the thumbnail of an embed to the given URL. If the thumbnail
+// is missing, the placeholder image is attached to the message and used instead.
+func SetThumbnail(embed *discord.EmbedBuilder, builder *discord.MessageUpdateBuilder, thumbnailURL string) {
+	if !IsMissingThumbnail(thumbnailURL) {
+		embed.SetThumbnail(thumbnailURL)
+		return
+	}
+
+	builder.AddFile(PlaceholderImageName, "", PlaceholderImage())
+	embed.SetThumbnail("attachment://" + PlaceholderImageName)
+}
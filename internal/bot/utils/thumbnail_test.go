@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/common/client/fetcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsMissingThumbnail(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want bool
+	}{
+		{
+			name: "empty url",
+			url:  "",
+			want: true,
+		},
+		{
+			name: "fetcher placeholder",
+			url:  fetcher.ThumbnailPlaceholder,
+			want: true,
+		},
+		{
+			name: "valid url",
+			url:  "https://tr.rbxcdn.com/thumbnail.png",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsMissingThumbnail(tt.url)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetThumbnail(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		wantThumbnail string
+		wantFiles     int
+	}{
+		{
+			name:          "valid url",
+			url:           "https://tr.rbxcdn.com/thumbnail.png",
+			wantThumbnail: "https://tr.rbxcdn.com/thumbnail.png",
+			wantFiles:     0,
+		},
+		{
+			name:          "empty url",
+			url:           "",
+			wantThumbnail: "attachment://" + PlaceholderImageName,
+			wantFiles:     1,
+		},
+		{
+			name:          "fetcher placeholder",
+			url:           fetcher.ThumbnailPlaceholder,
+			wantThumbnail: "attachment://" + PlaceholderImageName,
+			wantFiles:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			embed := discord.NewEmbedBuilder()
+			builder := discord.NewMessageUpdateBuilder()
+
+			SetThumbnail(embed, builder, tt.url)
+
+			built := embed.Build()
+			require.NotNil(t, built.Thumbnail)
+			assert.Equal(t, tt.wantThumbnail, built.Thumbnail.URL)
+			assert.Len(t, builder.Files, tt.wantFiles)
+		})
+	}
+}
+
+func TestPlaceholderImage(t *testing.T) {
+	first := PlaceholderImage()
+	second := PlaceholderImage()
+
+	assert.Positive(t, first.Len())
+	assert.Equal(t, first.Len(), second.Len())
+}
@@ -14,6 +14,8 @@ var (
 	ErrInvalidStartDate = errors.New("invalid start date")
 	// ErrInvalidEndDate indicates that the end date could not be parsed from the provided string.
 	ErrInvalidEndDate = errors.New("invalid end date")
+	// ErrInvalidDate indicates that a single date could not be parsed from the provided string.
+	ErrInvalidDate = errors.New("invalid date")
 	// ErrEndDateBeforeStartDate indicates that the end date occurs before the start date.
 	ErrEndDateBeforeStartDate = errors.New("end date cannot be before start date")
 	// ErrPermanentBan indicates that no duration was specified, meaning a permanent ban.
@@ -62,6 +64,23 @@ func ParseDateRange(dateRangeStr string) (time.Time, time.Time, error) {
 	return startDate, endDate, nil
 }
 
+// ParseDay converts a "YYYY-MM-DD" string into the start of that day in UTC.
+// A blank string selects the current day in UTC.
+func ParseDay(dayStr string, now time.Time) (time.Time, error) {
+	dayStr = strings.TrimSpace(dayStr)
+	if dayStr == "" {
+		now = now.UTC()
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+
+	day, err := time.Parse("2006-01-02", dayStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", ErrInvalidDate, err)
+	}
+
+	return day, nil
+}
+
 // ParseBanDuration parses a duration string like "7d" or "24h" into a time.Duration.
 // Returns ErrPermanentBan if the duration is empty.
 func ParseBanDuration(durationStr string) (*time.Time, error) {
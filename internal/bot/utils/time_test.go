@@ -49,6 +49,51 @@ func TestParseDateRange(t *testing.T) {
 	}
 }
 
+func TestParseDay(t *testing.T) {
+	now := time.Date(2025, 3, 4, 23, 30, 0, 0, time.FixedZone("UTC-5", -5*60*60))
+
+	tests := []struct {
+		name    string
+		day     string
+		wantErr error
+		want    string
+	}{
+		{
+			name: "explicit date",
+			day:  "2025-01-15",
+			want: "2025-01-15 00:00:00 +0000 UTC",
+		},
+		{
+			name: "surrounding spaces",
+			day:  " 2025-01-15 ",
+			want: "2025-01-15 00:00:00 +0000 UTC",
+		},
+		{
+			name: "blank defaults to today in UTC",
+			day:  "",
+			want: "2025-03-05 00:00:00 +0000 UTC",
+		},
+		{
+			name:    "invalid date",
+			day:     "15/01/2025",
+			wantErr: ErrInvalidDate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day, err := ParseDay(tt.day, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, day.String())
+		})
+	}
+}
+
 func TestParseBanDuration(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -57,7 +57,10 @@ func NewChatHandler(genAIClient *genai.Client, logger *zap.Logger) *ChatHandler
 }
 
 // StreamResponse sends a message to the AI and streams both the response and history through channels.
-func (h *ChatHandler) StreamResponse(ctx context.Context, history []*genai.Content, modelName string, message string) (chan string, chan []*genai.Content) {
+// The token usage of the response is recorded to the given tracker before the channels are closed.
+func (h *ChatHandler) StreamResponse(
+	ctx context.Context, history []*genai.Content, modelName string, message string, usage *UsageTracker,
+) (chan string, chan []*genai.Content) {
 	responseChan := make(chan string)
 	historyChan := make(chan []*genai.Content, 1)
 
@@ -104,6 +107,8 @@ func (h *ChatHandler) StreamResponse(ctx context.Context, history []*genai.Conte
 		}
 
 		// Stream responses as they arrive
+		var lastUsage *genai.GenerateContentResponse
+		defer func() { usage.Record(lastUsage) }()
 		for {
 			resp, err := iter.Next()
 			if errors.Is(err, iterator.Done) {
@@ -115,6 +120,11 @@ func (h *ChatHandler) StreamResponse(ctx context.Context, history []*genai.Conte
 				return
 			}
 
+			// The latest chunk carries the usage of the whole response
+			if resp.UsageMetadata != nil {
+				lastUsage = resp
+			}
+
 			// Extract text from response
 			for _, cand := range resp.Candidates {
 				if cand.Content != nil {
@@ -43,11 +43,13 @@ type FriendAnalysis struct {
 type FriendAnalyzer struct {
 	genModel *genai.GenerativeModel
 	minify   *minify.M
+	usage    *UsageTracker
 	logger   *zap.Logger
 }
 
 // NewFriendAnalyzer creates a FriendAnalyzer.
-func NewFriendAnalyzer(app *setup.App, logger *zap.Logger) *FriendAnalyzer {
+// Token usage of the model calls is recorded to the given tracker, which may be nil.
+func NewFriendAnalyzer(app *setup.App, usage *UsageTracker, logger *zap.Logger) *FriendAnalyzer {
 	// Create friend analysis model
 	friendModel := app.GenAIClient.GenerativeModel(app.Config.Common.GeminiAI.Model)
 	friendModel.SystemInstruction = genai.NewUserContent(genai.Text(FriendSystemPrompt))
@@ -76,6 +78,7 @@ func NewFriendAnalyzer(app *setup.App, logger *zap.Logger) *FriendAnalyzer {
 	return &FriendAnalyzer{
 		genModel: friendModel,
 		minify:   m,
+		usage:    usage,
 		logger:   logger,
 	}
 }
@@ -163,6 +166,7 @@ func (a *FriendAnalyzer) GenerateFriendReason(userInfo *fetcher.Info, confirmedF
 		if err != nil {
 			return nil, fmt.Errorf("gemini API error: %w", err)
 		}
+		a.usage.Record(resp)
 
 		if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
 			return nil, fmt.Errorf("%w: no response from Gemini", ErrModelResponse)
@@ -0,0 +1,86 @@
+package ai
+
+import (
+	"sync"
+
+	"github.com/google/generative-ai-go/genai"
+	"github.com/robalyx/rotector/internal/common/setup/config"
+)
+
+// Usage holds the token counts of one or more model calls.
+type Usage struct {
+	Requests         int64
+	PromptTokens     int64
+	CompletionTokens int64
+}
+
+// Add returns the sum of both usages.
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		Requests:         u.Requests + other.Requests,
+		PromptTokens:     u.PromptTokens + other.PromptTokens,
+		CompletionTokens: u.CompletionTokens + other.CompletionTokens,
+	}
+}
+
+// UsageFromResponse returns the token usage reported in a model response.
+func UsageFromResponse(resp *genai.GenerateContentResponse) Usage {
+	if resp == nil || resp.UsageMetadata == nil {
+		return Usage{}
+	}
+	return Usage{
+		Requests:         1,
+		PromptTokens:     int64(resp.UsageMetadata.PromptTokenCount),
+		CompletionTokens: int64(resp.UsageMetadata.CandidatesTokenCount),
+	}
+}
+
+// EstimateCost returns the estimated USD cost of the usage using the
+// configured per million token prices.
+func EstimateCost(usage Usage, cfg config.GeminiAI) float64 {
+	return (float64(usage.PromptTokens)*cfg.InputPrice + float64(usage.CompletionTokens)*cfg.OutputPrice) / 1_000_000
+}
+
+// UsageTracker accumulates the token usage of model calls made by a worker.
+// A nil tracker ignores all usage, so analyzers can be used without one.
+type UsageTracker struct {
+	pending Usage
+	total   Usage
+	mu      sync.Mutex
+}
+
+// NewUsageTracker creates a new usage tracker.
+func NewUsageTracker() *UsageTracker {
+	return &UsageTracker{}
+}
+
+// Record adds the usage reported in a model response.
+func (t *UsageTracker) Record(resp *genai.GenerateContentResponse) {
+	if t == nil {
+		return
+	}
+
+	usage := UsageFromResponse(resp)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = t.pending.Add(usage)
+}
+
+// Flush returns the usage recorded since the last flush and adds it to the total.
+func (t *UsageTracker) Flush() Usage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	pending := t.pending
+	t.total = t.total.Add(pending)
+	t.pending = Usage{}
+	return pending
+}
+
+// Total returns the usage flushed since the tracker was created.
+func (t *UsageTracker) Total() Usage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.total
+}
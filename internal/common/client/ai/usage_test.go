@@ -0,0 +1,45 @@
+package ai
+
+import (
+	"testing"
+
+	"github.com/google/generative-ai-go/genai"
+	"github.com/robalyx/rotector/internal/common/setup/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageTracker(t *testing.T) {
+	tracker := NewUsageTracker()
+	tracker.Record(&genai.GenerateContentResponse{
+		UsageMetadata: &genai.UsageMetadata{PromptTokenCount: 100, CandidatesTokenCount: 20},
+	})
+	tracker.Record(&genai.GenerateContentResponse{
+		UsageMetadata: &genai.UsageMetadata{PromptTokenCount: 50, CandidatesTokenCount: 10},
+	})
+	tracker.Record(&genai.GenerateContentResponse{})
+
+	assert.Equal(t, Usage{Requests: 2, PromptTokens: 150, CompletionTokens: 30}, tracker.Flush())
+	assert.Equal(t, Usage{}, tracker.Flush(), "flushing again returns nothing new")
+
+	tracker.Record(&genai.GenerateContentResponse{
+		UsageMetadata: &genai.UsageMetadata{PromptTokenCount: 10, CandidatesTokenCount: 5},
+	})
+	tracker.Flush()
+	assert.Equal(t, Usage{Requests: 3, PromptTokens: 160, CompletionTokens: 35}, tracker.Total())
+}
+
+func TestNilUsageTrackerIgnoresUsage(t *testing.T) {
+	var tracker *UsageTracker
+	assert.NotPanics(t, func() {
+		tracker.Record(&genai.GenerateContentResponse{
+			UsageMetadata: &genai.UsageMetadata{PromptTokenCount: 100},
+		})
+	})
+}
+
+func TestEstimateCost(t *testing.T) {
+	cfg := config.GeminiAI{InputPrice: 0.5, OutputPrice: 2}
+	usage := Usage{PromptTokens: 2_000_000, CompletionTokens: 500_000}
+
+	assert.InDelta(t, 2.0, EstimateCost(usage, cfg), 0.0001)
+}
@@ -8,9 +8,11 @@ import (
 
 	"github.com/bytedance/sonic"
 	"github.com/google/generative-ai-go/genai"
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
 	"github.com/robalyx/rotector/internal/common/client/fetcher"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"github.com/robalyx/rotector/internal/common/translator"
 	"github.com/robalyx/rotector/internal/common/utils"
 	"github.com/tdewolff/minify/v2"
@@ -26,6 +28,7 @@ You will receive a list of user profiles in JSON format. Each profile contains:
 - Username
 - Display name (if different from username)
 - Profile description/bio
+- Outfit names (if any)
 
 Analyze each profile and identify users engaging in inappropriate behavior. Return a list of users that violate or potentially violate the guidelines, including:
 - The exact username
@@ -42,6 +45,7 @@ Guidelines for flagging:
 - Do not repeat the same content in flaggedContent array for the same user
 - Do not add users with no violations to the list
 - Do not flag empty descriptions
+- Outfit names are named by the user and can contain violations just like descriptions
 
 Look for predatory behavior:
 - Grooming attempts and manipulation:
@@ -107,6 +111,13 @@ Confidence level grading:
 // MaxFriendDataTokens is the maximum number of tokens allowed for friend data.
 const MaxFriendDataTokens = 400
 
+const (
+	// MaxPromptOutfits is the maximum number of outfit names sent per user.
+	MaxPromptOutfits = 10
+	// MaxOutfitNameLength is the maximum length of an outfit name sent to the model.
+	MaxOutfitNameLength = 50
+)
+
 // FlaggedUsers holds a list of users that the AI has identified as inappropriate.
 // The JSON schema is used to ensure consistent responses from the AI.
 type FlaggedUsers struct {
@@ -127,11 +138,13 @@ type UserAnalyzer struct {
 	userModel  *genai.GenerativeModel
 	minify     *minify.M
 	translator *translator.Translator
+	usage      *UsageTracker
 	logger     *zap.Logger
 }
 
 // NewUserAnalyzer creates an UserAnalyzer with separate models for user and friend analysis.
-func NewUserAnalyzer(app *setup.App, translator *translator.Translator, logger *zap.Logger) *UserAnalyzer {
+// Token usage of the model calls is recorded to the given tracker, which may be nil.
+func NewUserAnalyzer(app *setup.App, translator *translator.Translator, usage *UsageTracker, logger *zap.Logger) *UserAnalyzer {
 	// Create user analysis model
 	userModel := app.GenAIClient.GenerativeModel(app.Config.Common.GeminiAI.Model)
 	userModel.SystemInstruction = genai.NewUserContent(genai.Text(ReviewSystemPrompt))
@@ -182,64 +195,89 @@ func NewUserAnalyzer(app *setup.App, translator *translator.Translator, logger *
 		userModel:  userModel,
 		minify:     m,
 		translator: translator,
+		usage:      usage,
 		logger:     logger,
 	}
 }
 
+// userSummary is the trimmed user profile sent to the model for analysis.
+type userSummary struct {
+	Name        string   `json:"name"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Description string   `json:"description"`
+	Outfits     []string `json:"outfits,omitempty"`
+}
+
+// UserPreview holds the result of a dry run of the user analysis.
+type UserPreview struct {
+	Prompt         string                 // Exact prompt that would be sent to the model
+	FlaggedUsers   *FlaggedUsers          // Raw model response, nil if the model was not called
+	ValidatedUsers map[uint64]*types.User // Users that passed validation, nil if the model was not called
+}
+
 // ProcessUsers sends user information to a Gemini model for analysis after translating descriptions.
 // Returns validated users and IDs of users that failed validation for retry.
 func (a *UserAnalyzer) ProcessUsers(userInfos []*fetcher.Info) (map[uint64]*types.User, []uint64, error) {
-	// Create a struct for user summaries for AI analysis
-	type UserSummary struct {
-		Name        string `json:"name"`
-		DisplayName string `json:"displayName,omitempty"`
-		Description string `json:"description"`
-	}
-
 	// Translate all descriptions concurrently
 	translatedInfos, originalInfos := a.prepareUserInfos(userInfos)
 
-	// Convert map to slice for AI request
-	userInfosWithoutID := make([]UserSummary, 0, len(translatedInfos))
-	for _, userInfo := range translatedInfos {
-		summary := UserSummary{
-			Name: userInfo.Name,
-		}
+	// Build the prompt from the translated infos
+	prompt, err := buildUserPrompt(a.minify, orderUserInfos(userInfos, translatedInfos))
+	if err != nil {
+		a.logger.Error("Error building user prompt", zap.Error(err))
+		return nil, nil, err
+	}
 
-		// Only include display name if it's different from the username
-		if userInfo.DisplayName != userInfo.Name {
-			summary.DisplayName = userInfo.DisplayName
-		}
+	// Generate content and parse response
+	flaggedUsers, err := a.analyzePrompt(context.Background(), prompt)
+	if err != nil {
+		a.logger.Error("Error processing Gemini response", zap.Error(err))
+		return nil, nil, err
+	}
 
-		// Replace empty descriptions with placeholder
-		description := userInfo.Description
-		if description == "" {
-			description = "[Empty profile]"
-		}
-		summary.Description = description
+	a.logger.Info("Received AI response",
+		zap.Int("totalUsers", len(userInfos)),
+		zap.Int("flaggedUsers", len(flaggedUsers.Users)))
 
-		userInfosWithoutID = append(userInfosWithoutID, summary)
-	}
+	// Validate AI responses against translated content but use original descriptions for storage
+	validatedUsers, failedValidationIDs := a.validateFlaggedUsers(flaggedUsers, translatedInfos, originalInfos)
 
-	// Minify JSON to reduce token usage
-	userInfoJSON, err := sonic.Marshal(userInfosWithoutID)
+	return validatedUsers, failedValidationIDs, nil
+}
+
+// PreviewUsers builds the exact prompt that ProcessUsers would send for the given users
+// without saving anything. The model is only called if callModel is true.
+func (a *UserAnalyzer) PreviewUsers(ctx context.Context, userInfos []*fetcher.Info, callModel bool) (*UserPreview, error) {
+	translatedInfos, originalInfos := a.prepareUserInfos(userInfos)
+
+	prompt, err := buildUserPrompt(a.minify, orderUserInfos(userInfos, translatedInfos))
 	if err != nil {
-		a.logger.Error("Error marshaling user info", zap.Error(err))
-		return nil, nil, fmt.Errorf("%w: %w", ErrJSONProcessing, err)
+		return nil, err
+	}
+
+	preview := &UserPreview{Prompt: prompt}
+	if !callModel {
+		return preview, nil
 	}
 
-	userInfoJSON, err = a.minify.Bytes("application/json", userInfoJSON)
+	flaggedUsers, err := a.analyzePrompt(ctx, prompt)
 	if err != nil {
-		a.logger.Error("Error minifying user info", zap.Error(err))
-		return nil, nil, fmt.Errorf("%w: %w", ErrJSONProcessing, err)
+		return nil, err
 	}
+	preview.FlaggedUsers = flaggedUsers
+	preview.ValidatedUsers, _ = a.validateFlaggedUsers(flaggedUsers, translatedInfos, originalInfos)
 
-	// Generate content and parse response using Gemini model with retry
-	flaggedUsers, err := withRetry(context.Background(), func() (*FlaggedUsers, error) {
-		resp, err := a.userModel.GenerateContent(context.Background(), genai.Text(string(userInfoJSON)))
+	return preview, nil
+}
+
+// analyzePrompt sends the prompt to the Gemini model with retry and parses the response.
+func (a *UserAnalyzer) analyzePrompt(ctx context.Context, prompt string) (*FlaggedUsers, error) {
+	flaggedUsers, err := withRetry(ctx, func() (*FlaggedUsers, error) {
+		resp, err := a.userModel.GenerateContent(ctx, genai.Text(prompt))
 		if err != nil {
 			return nil, fmt.Errorf("gemini API error: %w", err)
 		}
+		a.usage.Record(resp)
 
 		// Check for empty response
 		if len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil || len(resp.Candidates[0].Content.Parts) == 0 {
@@ -256,18 +294,120 @@ func (a *UserAnalyzer) ProcessUsers(userInfos []*fetcher.Info) (map[uint64]*type
 		return &result, nil
 	})
 	if err != nil {
-		a.logger.Error("Error processing Gemini response", zap.Error(err))
-		return nil, nil, fmt.Errorf("%w: %w", ErrModelResponse, err)
+		return nil, fmt.Errorf("%w: %w", ErrModelResponse, err)
 	}
 
-	a.logger.Info("Received AI response",
-		zap.Int("totalUsers", len(userInfos)),
-		zap.Int("flaggedUsers", len(flaggedUsers.Users)))
+	return flaggedUsers, nil
+}
 
-	// Validate AI responses against translated content but use original descriptions for storage
-	validatedUsers, failedValidationIDs := a.validateFlaggedUsers(flaggedUsers, translatedInfos, originalInfos)
+// buildUserPrompt converts user infos into the minified JSON prompt sent to the model.
+// It performs no translation or API calls so the output depends only on its inputs.
+func buildUserPrompt(m *minify.M, userInfos []*fetcher.Info) (string, error) {
+	summaries := make([]userSummary, 0, len(userInfos))
+	for _, userInfo := range userInfos {
+		summary := userSummary{
+			Name: userInfo.Name,
+		}
 
-	return validatedUsers, failedValidationIDs, nil
+		// Only include display name if it's different from the username
+		if userInfo.DisplayName != userInfo.Name {
+			summary.DisplayName = userInfo.DisplayName
+		}
+
+		// Replace empty descriptions with placeholder
+		description := userInfo.Description
+		if description == "" {
+			description = "[Empty profile]"
+		}
+		summary.Description = description
+		summary.Outfits = outfitNames(userInfo)
+
+		summaries = append(summaries, summary)
+	}
+
+	// Minify JSON to reduce token usage
+	userInfoJSON, err := sonic.Marshal(summaries)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrJSONProcessing, err)
+	}
+
+	userInfoJSON, err = m.Bytes("application/json", userInfoJSON)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrJSONProcessing, err)
+	}
+
+	return string(userInfoJSON), nil
+}
+
+// outfitNames returns the outfit names of a user to include in the prompt. Names are
+// trimmed, shortened and kept once, and at most MaxPromptOutfits names are returned.
+// Users whose outfits were not fetched have none.
+func outfitNames(userInfo *fetcher.Info) []string {
+	if userInfo.Outfits == nil || userInfo.Outfits.Error != nil {
+		return nil
+	}
+
+	var names []string
+	seen := make(map[string]struct{})
+	for _, outfit := range userInfo.Outfits.Data {
+		name := strings.Join(strings.Fields(outfit.Name), " ")
+		if runes := []rune(name); len(runes) > MaxOutfitNameLength {
+			name = string(runes[:MaxOutfitNameLength])
+		}
+		key := strings.ToLower(name)
+		if _, ok := seen[key]; ok || name == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		names = append(names, name)
+		if len(names) >= MaxPromptOutfits {
+			break
+		}
+	}
+	return names
+}
+
+// labelFlaggedContent marks flagged content that was only found in the outfit names
+// of the user with the outfit prefix. Any prefix added by the model is dropped first
+// so the label depends only on where the content was found.
+func labelFlaggedContent(flaggedContent []string, userInfo *fetcher.Info) []string {
+	outfits := outfitNames(userInfo)
+	labeled := make([]string, 0, len(flaggedContent))
+	for _, item := range flaggedContent {
+		content, _ := types.SplitFlaggedContent(item)
+
+		inProfile := utils.ContainsNormalized(userInfo.Name, content) ||
+			utils.ContainsNormalized(userInfo.DisplayName, content) ||
+			utils.ContainsNormalized(userInfo.Description, content)
+		if !inProfile && containsAnyNormalized(outfits, content) {
+			content = types.OutfitContentPrefix + content
+		}
+		labeled = append(labeled, content)
+	}
+	return labeled
+}
+
+// containsAnyNormalized reports whether any of the texts contains the search term.
+func containsAnyNormalized(texts []string, search string) bool {
+	for _, text := range texts {
+		if utils.ContainsNormalized(text, search) {
+			return true
+		}
+	}
+	return false
+}
+
+// orderUserInfos returns the translated infos in the same order as the original users
+// so the prompt is stable for the same input.
+func orderUserInfos(userInfos []*fetcher.Info, translatedInfos map[string]*fetcher.Info) []*fetcher.Info {
+	ordered := make([]*fetcher.Info, 0, len(translatedInfos))
+	for _, info := range userInfos {
+		if translated, ok := translatedInfos[info.Name]; ok {
+			ordered = append(ordered, translated)
+		}
+	}
+	return ordered
 }
 
 // validateFlaggedUsers validates the flagged users against the translated content
@@ -304,16 +444,19 @@ func (a *UserAnalyzer) validateFlaggedUsers(flaggedUsers *FlaggedUsers, translat
 
 		// Split all flagged content into words
 		var allFlaggedWords []string
-		for _, content := range flaggedUser.FlaggedContent {
+		for _, item := range flaggedUser.FlaggedContent {
+			content, _ := types.SplitFlaggedContent(item)
 			allFlaggedWords = append(allFlaggedWords, strings.Fields(content)...)
 		}
 
 		// Count how many flagged words are found in the translated content
+		outfits := outfitNames(translatedInfo)
 		foundWords := 0
 		for _, word := range allFlaggedWords {
 			if utils.ContainsNormalized(translatedInfo.Name, word) ||
 				(translatedInfo.DisplayName != translatedInfo.Name && utils.ContainsNormalized(translatedInfo.DisplayName, word)) ||
-				utils.ContainsNormalized(translatedInfo.Description, word) {
+				utils.ContainsNormalized(translatedInfo.Description, word) ||
+				containsAnyNormalized(outfits, word) {
 				foundWords++
 			}
 		}
@@ -323,6 +466,11 @@ func (a *UserAnalyzer) validateFlaggedUsers(flaggedUsers *FlaggedUsers, translat
 
 		// If the flagged user is correct, add it using original info
 		if isValid {
+			var outfits []apiTypes.Outfit
+			if originalInfo.Outfits != nil {
+				outfits = originalInfo.Outfits.Data
+			}
+
 			validatedUsers[originalInfo.ID] = &types.User{
 				ID:             originalInfo.ID,
 				Name:           originalInfo.Name,
@@ -330,12 +478,15 @@ func (a *UserAnalyzer) validateFlaggedUsers(flaggedUsers *FlaggedUsers, translat
 				Description:    originalInfo.Description,
 				CreatedAt:      originalInfo.CreatedAt,
 				Reason:         "AI Analysis: " + flaggedUser.Reason,
+				ReasonCategory: enum.ReasonCategoryContent,
+				FlagSource:     enum.FlagSourceAI,
 				Groups:         originalInfo.Groups.Data,
 				Friends:        originalInfo.Friends.Data,
 				Games:          originalInfo.Games.Data,
+				Outfits:        outfits,
 				FollowerCount:  originalInfo.FollowerCount,
 				FollowingCount: originalInfo.FollowingCount,
-				FlaggedContent: flaggedUser.FlaggedContent,
+				FlaggedContent: labelFlaggedContent(flaggedUser.FlaggedContent, translatedInfo),
 				Confidence:     flaggedUser.Confidence,
 				LastUpdated:    originalInfo.LastUpdated,
 				LastPurgeCheck: originalInfo.LastPurgeCheck,
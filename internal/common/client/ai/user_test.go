@@ -0,0 +1,156 @@
+package ai
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/common/client/fetcher"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tdewolff/minify/v2"
+	"github.com/tdewolff/minify/v2/json"
+)
+
+func TestBuildUserPrompt(t *testing.T) {
+	m := minify.New()
+	m.AddFunc("application/json", json.Minify)
+
+	tests := []struct {
+		name      string
+		userInfos []*fetcher.Info
+		want      string
+	}{
+		{
+			name: "display name differs from username",
+			userInfos: []*fetcher.Info{
+				{ID: 1, Name: "alice", DisplayName: "Alice W", Description: "hello there"},
+			},
+			want: `[{"name":"alice","displayName":"Alice W","description":"hello there"}]`,
+		},
+		{
+			name: "display name matches username",
+			userInfos: []*fetcher.Info{
+				{ID: 2, Name: "bob", DisplayName: "bob", Description: "just vibing"},
+			},
+			want: `[{"name":"bob","description":"just vibing"}]`,
+		},
+		{
+			name: "empty description uses placeholder",
+			userInfos: []*fetcher.Info{
+				{ID: 3, Name: "carol", DisplayName: "carol", Description: ""},
+			},
+			want: `[{"name":"carol","description":"[Empty profile]"}]`,
+		},
+		{
+			name: "multiple users keep input order",
+			userInfos: []*fetcher.Info{
+				{ID: 4, Name: "zed", DisplayName: "zed", Description: "first"},
+				{ID: 5, Name: "amy", DisplayName: "amy", Description: "second"},
+			},
+			want: `[{"name":"zed","description":"first"},{"name":"amy","description":"second"}]`,
+		},
+		{
+			name: "outfit names are included",
+			userInfos: []*fetcher.Info{
+				{ID: 6, Name: "dan", DisplayName: "dan", Description: "hi", Outfits: &fetcher.UserOutfitsFetchResult{
+					Data: []apiTypes.Outfit{{Name: "  beach   day "}, {Name: "Beach Day"}, {Name: ""}, {Name: "school"}},
+				}},
+			},
+			want: `[{"name":"dan","description":"hi","outfits":["beach day","school"]}]`,
+		},
+		{
+			name: "failed outfit fetch is omitted",
+			userInfos: []*fetcher.Info{
+				{ID: 7, Name: "eve", DisplayName: "eve", Description: "hey", Outfits: &fetcher.UserOutfitsFetchResult{
+					Data:  []apiTypes.Outfit{{Name: "school"}},
+					Error: errors.New("failed"),
+				}},
+			},
+			want: `[{"name":"eve","description":"hey"}]`,
+		},
+		{
+			name:      "no users",
+			userInfos: []*fetcher.Info{},
+			want:      `[]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildUserPrompt(m, tt.userInfos)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			// The prompt must never leak user IDs to the model
+			var summaries []map[string]interface{}
+			require.NoError(t, sonic.UnmarshalString(got, &summaries))
+			for _, summary := range summaries {
+				assert.NotContains(t, summary, "id")
+			}
+		})
+	}
+}
+
+func TestOrderUserInfos(t *testing.T) {
+	userInfos := []*fetcher.Info{
+		{ID: 1, Name: "first"},
+		{ID: 2, Name: "second"},
+		{ID: 3, Name: "third"},
+	}
+	translatedInfos := map[string]*fetcher.Info{
+		"third":  {ID: 3, Name: "third", Description: "translated third"},
+		"first":  {ID: 1, Name: "first", Description: "translated first"},
+		"second": {ID: 2, Name: "second", Description: "translated second"},
+	}
+
+	got := orderUserInfos(userInfos, translatedInfos)
+
+	require.Len(t, got, 3)
+	assert.Equal(t, "translated first", got[0].Description)
+	assert.Equal(t, "translated second", got[1].Description)
+	assert.Equal(t, "translated third", got[2].Description)
+}
+
+func TestOutfitNames(t *testing.T) {
+	t.Run("not fetched", func(t *testing.T) {
+		assert.Nil(t, outfitNames(&fetcher.Info{}))
+	})
+
+	t.Run("capped and truncated", func(t *testing.T) {
+		outfits := make([]apiTypes.Outfit, 0, MaxPromptOutfits+5)
+		outfits = append(outfits, apiTypes.Outfit{Name: strings.Repeat("é", MaxOutfitNameLength+10)})
+		for i := range MaxPromptOutfits + 4 {
+			outfits = append(outfits, apiTypes.Outfit{Name: "outfit " + strings.Repeat("x", i+1)})
+		}
+
+		got := outfitNames(&fetcher.Info{Outfits: &fetcher.UserOutfitsFetchResult{Data: outfits}})
+
+		require.Len(t, got, MaxPromptOutfits)
+		assert.Equal(t, strings.Repeat("é", MaxOutfitNameLength), got[0])
+		assert.Equal(t, "outfit x", got[1])
+	})
+}
+
+func TestLabelFlaggedContent(t *testing.T) {
+	userInfo := &fetcher.Info{
+		Name:        "frank",
+		DisplayName: "frank",
+		Description: "add me on snap",
+		Outfits: &fetcher.UserOutfitsFetchResult{
+			Data: []apiTypes.Outfit{{Name: "snap outfit"}, {Name: "condo fit"}},
+		},
+	}
+
+	got := labelFlaggedContent([]string{"add me on snap", "condo", types.OutfitContentPrefix + "snap", "missing"}, userInfo)
+
+	assert.Equal(t, []string{
+		"add me on snap",
+		types.OutfitContentPrefix + "condo",
+		"snap",
+		"missing",
+	}, got)
+}
@@ -2,6 +2,7 @@ package checker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"sync"
@@ -13,6 +14,7 @@ import (
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/robalyx/rotector/internal/common/utils"
 	"go.uber.org/zap"
 )
 
@@ -34,6 +36,24 @@ Context:
 Friend data: %s`
 )
 
+const (
+	// DefaultFriendCacheSize is used when no friend cache size is configured.
+	DefaultFriendCacheSize = 100000
+	// DefaultFriendCacheTTL is used when no friend cache TTL is configured.
+	DefaultFriendCacheTTL = 10 * time.Minute
+)
+
+const (
+	// FriendFlagThreshold is the confidence at which a user is flagged for their friends.
+	FriendFlagThreshold = 0.4
+	// CascadeDampenGeneration is the flag generation from which friends that were only
+	// flagged for their own friends count for less, so that a single flagged account
+	// cannot cascade flags through a whole community.
+	CascadeDampenGeneration = 2
+	// CascadeDampenFactor scales the weight of flagged friends at or past CascadeDampenGeneration.
+	CascadeDampenFactor = 0.25
+)
+
 // FriendAnalysis contains the result of analyzing a user's friend network.
 type FriendAnalysis struct {
 	Name     string `json:"name"`
@@ -52,14 +72,25 @@ type FriendCheckResult struct {
 type FriendChecker struct {
 	db             *database.Client
 	friendAnalyzer *ai.FriendAnalyzer
+	existingCache  *utils.LRUCache[uint64, *types.ReviewUser]
 	logger         *zap.Logger
 }
 
 // NewFriendChecker creates a FriendChecker.
-func NewFriendChecker(app *setup.App, logger *zap.Logger) *FriendChecker {
+func NewFriendChecker(app *setup.App, usage *ai.UsageTracker, logger *zap.Logger) *FriendChecker {
+	cacheSize := app.Config.Worker.FriendCache.Size
+	if cacheSize <= 0 {
+		cacheSize = DefaultFriendCacheSize
+	}
+	cacheTTL := time.Duration(app.Config.Worker.FriendCache.TTL) * time.Minute
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultFriendCacheTTL
+	}
+
 	return &FriendChecker{
 		db:             app.DB,
-		friendAnalyzer: ai.NewFriendAnalyzer(app, logger),
+		friendAnalyzer: ai.NewFriendAnalyzer(app, usage, logger),
+		existingCache:  utils.NewLRUCache[uint64, *types.ReviewUser](cacheSize, cacheTTL),
 		logger:         logger,
 	}
 }
@@ -81,10 +112,7 @@ func (c *FriendChecker) ProcessUsers(userInfos []*fetcher.Info) map[uint64]*type
 	}
 
 	// Fetch all existing friends
-	existingFriends, err := c.db.Users().GetUsersByIDs(context.Background(), friendIDs, types.UserFields{
-		Basic:  true,
-		Reason: true,
-	})
+	existingFriends, err := c.getExistingUsers(context.Background(), friendIDs)
 	if err != nil {
 		c.logger.Error("Failed to fetch existing friends", zap.Error(err))
 		return nil
@@ -127,37 +155,90 @@ func (c *FriendChecker) ProcessUsers(userInfos []*fetcher.Info) map[uint64]*type
 	return flaggedUsers
 }
 
+// getExistingUsers returns the stored status, name and reason of the given users,
+// using cached entries where possible. Users missing from the database are cached
+// as unflagged too since most friends have never been flagged. A cached entry can
+// be up to the cache TTL out of date, so a user confirmed or cleared in the bot is
+// only seen with their new status once their entry expires.
+func (c *FriendChecker) getExistingUsers(ctx context.Context, userIDs []uint64) (map[uint64]*types.ReviewUser, error) {
+	users := make(map[uint64]*types.ReviewUser, len(userIDs))
+	missing := make([]uint64, 0, len(userIDs))
+
+	// Use cached users that have not expired
+	for _, userID := range userIDs {
+		if user, ok := c.existingCache.Get(userID); ok {
+			users[userID] = user
+			continue
+		}
+		missing = append(missing, userID)
+	}
+
+	// Fetch the remaining users from the database
+	if len(missing) > 0 {
+		fetched, err := c.db.Users().GetUsersByIDs(ctx, missing, types.UserFields{
+			Basic:  true,
+			Reason: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for userID, user := range fetched {
+			c.existingCache.Set(userID, user)
+			users[userID] = user
+		}
+	}
+
+	hits, misses := c.existingCache.Stats()
+	c.logger.Debug("Looked up existing friends",
+		zap.Int("requested", len(userIDs)),
+		zap.Int("cached", len(userIDs)-len(missing)),
+		zap.Float64("hitRate", hitRate(hits, misses)))
+
+	return users, nil
+}
+
+// hitRate returns the share of cache lookups that were hits.
+func hitRate(hits, misses uint64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
 // processUserFriends checks if a user should be flagged based on their friends.
 func (c *FriendChecker) processUserFriends(userInfo *fetcher.Info, existingFriends map[uint64]*types.ReviewUser) (*types.User, bool) {
+	// Skip users whose friends could not be fetched instead of treating them as having none
+	if userInfo.Friends.Error != nil {
+		c.logger.Debug("Skipping friend check, friends unavailable",
+			zap.Uint64("userID", userInfo.ID),
+			zap.Bool("private", errors.Is(userInfo.Friends.Error, fetcher.ErrPrivate)),
+			zap.Error(userInfo.Friends.Error))
+		return nil, false
+	}
+
 	// Skip users with very few friends to avoid false positives
 	if len(userInfo.Friends.Data) < 3 {
 		return nil, false
 	}
 
 	// Count confirmed and flagged friends
-	confirmedFriends := make(map[uint64]*types.User)
-	flaggedFriends := make(map[uint64]*types.User)
-	confirmedCount := 0
-	flaggedCount := 0
-
-	for _, friend := range userInfo.Friends.Data {
-		if reviewUser, exists := existingFriends[friend.ID]; exists {
-			switch reviewUser.Status {
-			case enum.UserTypeConfirmed:
-				confirmedCount++
-				confirmedFriends[friend.ID] = &reviewUser.User
-			case enum.UserTypeFlagged:
-				flaggedCount++
-				flaggedFriends[friend.ID] = &reviewUser.User
-			} //exhaustive:ignore
-		}
-	}
+	signal := collectFriendSignal(userInfo.Friends.Data, existingFriends)
+	confirmedFriends := signal.confirmedFriends
+	flaggedFriends := signal.flaggedFriends
+	confirmedCount := len(confirmedFriends)
+	flaggedCount := len(flaggedFriends)
+
+	// Check whether the confirmed and flagged friends are connected to each other
+	cluster := c.getNetworkCluster(userInfo.ID, confirmedFriends, flaggedFriends)
 
 	// Calculate confidence score
-	confidence := c.calculateConfidence(confirmedCount, flaggedCount, len(userInfo.Friends.Data), userInfo.CreatedAt)
+	confidence := c.calculateConfidence(
+		confirmedCount, signal.flaggedWeight, len(userInfo.Friends.Data), userInfo.CreatedAt, cluster,
+	)
 
 	// Flag user if confidence exceeds threshold
-	if confidence >= 0.4 {
+	if confidence >= FriendFlagThreshold {
 		accountAge := time.Since(userInfo.CreatedAt)
 
 		// Generate AI-based reason using friend list analysis
@@ -176,6 +257,12 @@ func (c *FriendChecker) processUserFriends(userInfo *fetcher.Info, existingFrien
 			)
 		}
 
+		// Mention friends that form a connected cluster
+		if cluster != nil && cluster.ConnectedCount > 0 {
+			reason += fmt.Sprintf(" %d of these %d friends are friends with each other.",
+				cluster.ConnectedCount, cluster.CheckedCount)
+		}
+
 		user := &types.User{
 			ID:             userInfo.ID,
 			Name:           userInfo.Name,
@@ -183,6 +270,9 @@ func (c *FriendChecker) processUserFriends(userInfo *fetcher.Info, existingFrien
 			Description:    userInfo.Description,
 			CreatedAt:      userInfo.CreatedAt,
 			Reason:         "Friend Analysis: " + reason,
+			ReasonCategory: enum.ReasonCategoryFriend,
+			FlagGeneration: signal.generation,
+			FlagSource:     enum.FlagSourceFriend,
 			Groups:         userInfo.Groups.Data,
 			Friends:        userInfo.Friends.Data,
 			Games:          userInfo.Games.Data,
@@ -197,7 +287,9 @@ func (c *FriendChecker) processUserFriends(userInfo *fetcher.Info, existingFrien
 			zap.Uint64("userID", userInfo.ID),
 			zap.Int("confirmedFriends", confirmedCount),
 			zap.Int("flaggedFriends", flaggedCount),
+			zap.Int("flagGeneration", signal.generation),
 			zap.Float64("confidence", confidence),
+			zap.Float64("clusterDensity", clusterDensity(cluster)),
 			zap.Int("accountAgeDays", int(accountAge.Hours()/24)),
 			zap.String("reason", reason))
 
@@ -207,20 +299,107 @@ func (c *FriendChecker) processUserFriends(userInfo *fetcher.Info, existingFrien
 	return nil, false
 }
 
+// friendSignal holds the inappropriate friends of a user and how much they count towards a flag.
+type friendSignal struct {
+	confirmedFriends map[uint64]*types.User
+	flaggedFriends   map[uint64]*types.User
+	flaggedWeight    float64 // Number of flagged friends with cascaded flags dampened
+	generation       int     // Generation of a flag raised from these friends
+}
+
+// collectFriendSignal sorts the friends of a user into confirmed and flagged ones.
+// A flag raised from these friends is one generation past the highest generation
+// among the flagged friends, so friends confirmed or flagged for their own content
+// lead to generation 1 and each friend-only flag in between adds one more.
+func collectFriendSignal(friends []types.ExtendedFriend, existingFriends map[uint64]*types.ReviewUser) friendSignal {
+	signal := friendSignal{
+		confirmedFriends: make(map[uint64]*types.User),
+		flaggedFriends:   make(map[uint64]*types.User),
+		generation:       1,
+	}
+
+	for _, friend := range friends {
+		reviewUser, exists := existingFriends[friend.ID]
+		if !exists {
+			continue
+		}
+
+		switch reviewUser.Status {
+		case enum.UserTypeConfirmed:
+			signal.confirmedFriends[friend.ID] = &reviewUser.User
+		case enum.UserTypeFlagged:
+			signal.flaggedFriends[friend.ID] = &reviewUser.User
+			signal.flaggedWeight += flaggedFriendWeight(&reviewUser.User)
+			signal.generation = max(signal.generation, reviewUser.FlagGeneration+1)
+		} //exhaustive:ignore
+	}
+
+	return signal
+}
+
+// flaggedFriendWeight returns how much a flagged friend counts towards flagging a user.
+// Friends that were flagged only for their own friends, a few generations away from
+// any confirmed or content-based flag, count for less to stop flags from confirming
+// each other in a loop.
+func flaggedFriendWeight(friend *types.User) float64 {
+	if friend.ReasonCategory == enum.ReasonCategoryFriend && friend.FlagGeneration >= CascadeDampenGeneration {
+		return CascadeDampenFactor
+	}
+	return 1
+}
+
+// getNetworkCluster returns how closely the confirmed and flagged friends of a user are
+// connected to each other, or nil if there are too few of them or the lookup fails.
+func (c *FriendChecker) getNetworkCluster(userID uint64, confirmedFriends, flaggedFriends map[uint64]*types.User) *types.NetworkClusterInfo {
+	if len(confirmedFriends)+len(flaggedFriends) < 2 {
+		return nil
+	}
+
+	friendIDs := make([]uint64, 0, len(confirmedFriends)+len(flaggedFriends))
+	for friendID := range confirmedFriends {
+		friendIDs = append(friendIDs, friendID)
+	}
+	for friendID := range flaggedFriends {
+		friendIDs = append(friendIDs, friendID)
+	}
+
+	cluster, err := c.db.Tracking().GetNetworkClusterInfo(context.Background(), userID, friendIDs)
+	if err != nil {
+		c.logger.Error("Failed to get network cluster info",
+			zap.Error(err),
+			zap.Uint64("userID", userID))
+		return nil
+	}
+	return cluster
+}
+
+// clusterDensity returns the density of the cluster, or 0 if there is none.
+func clusterDensity(cluster *types.NetworkClusterInfo) float64 {
+	if cluster == nil {
+		return 0
+	}
+	return cluster.Density
+}
+
 // calculateConfidence computes a weighted confidence score based on friend relationships and account age.
 // The score prioritizes absolute numbers while still considering ratios as a secondary factor.
-func (c *FriendChecker) calculateConfidence(confirmedCount, flaggedCount int, totalFriends int, createdAt time.Time) float64 {
+// Friends that are connected to each other add a bonus on top, as a cluster of inappropriate
+// users is a stronger signal than the same number of unrelated ones. The flagged weight is
+// the number of flagged friends after dampening cascaded flags.
+func (c *FriendChecker) calculateConfidence(
+	confirmedCount int, flaggedWeight float64, totalFriends int, createdAt time.Time, cluster *types.NetworkClusterInfo,
+) float64 {
 	var confidence float64
 
 	// Factor 1: Absolute number of inappropriate friends - 60% weight
-	inappropriateWeight := c.calculateInappropriateWeight(confirmedCount, flaggedCount)
+	inappropriateWeight := c.calculateInappropriateWeight(confirmedCount, flaggedWeight)
 	confidence += inappropriateWeight * 0.60
 
 	// Factor 2: Ratio of inappropriate friends - 30% weight
 	// This helps catch users with a high concentration of inappropriate friends
 	// even if they don't meet the absolute number thresholds
 	if totalFriends > 0 {
-		totalInappropriate := float64(confirmedCount) + (float64(flaggedCount) * 0.5)
+		totalInappropriate := float64(confirmedCount) + (flaggedWeight * 0.5)
 		ratioWeight := math.Min(totalInappropriate/float64(totalFriends), 1.0)
 		confidence += ratioWeight * 0.30
 	}
@@ -230,13 +409,16 @@ func (c *FriendChecker) calculateConfidence(confirmedCount, flaggedCount int, to
 	ageWeight := c.calculateAgeWeight(accountAge)
 	confidence += ageWeight * 0.10
 
-	return confidence
+	// Factor 4: Cluster density of inappropriate friends - up to 10% bonus
+	confidence += clusterDensity(cluster) * 0.10
+
+	return math.Min(confidence, 1.0)
 }
 
 // calculateInappropriateWeight returns a weight based on the total number of inappropriate friends.
 // Confirmed friends are weighted more heavily than flagged friends.
-func (c *FriendChecker) calculateInappropriateWeight(confirmedCount, flaggedCount int) float64 {
-	totalWeight := float64(confirmedCount) + (float64(flaggedCount) * 0.5)
+func (c *FriendChecker) calculateInappropriateWeight(confirmedCount int, flaggedWeight float64) float64 {
+	totalWeight := float64(confirmedCount) + (flaggedWeight * 0.5)
 
 	switch {
 	case confirmedCount >= 8 || totalWeight >= 12:
@@ -0,0 +1,172 @@
+package checker
+
+import (
+	"testing"
+	"time"
+
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newRingGraph creates a friend graph of users in a ring, each friends with the
+// given number of neighbours on either side. This models a tight-knit community
+// where most friends of a user are also friends with each other.
+func newRingGraph(size, neighbours int) map[uint64][]types.ExtendedFriend {
+	graph := make(map[uint64][]types.ExtendedFriend, size)
+	for i := range size {
+		friends := make([]types.ExtendedFriend, 0, neighbours*2)
+		for offset := 1; offset <= neighbours; offset++ {
+			for _, j := range []int{(i + offset) % size, (i - offset + size) % size} {
+				friends = append(friends, types.ExtendedFriend{Friend: apiTypes.Friend{ID: uint64(j + 1)}})
+			}
+		}
+		graph[uint64(i+1)] = friends
+	}
+	return graph
+}
+
+// simulateFriendCascade runs the friend check over a friend graph round by round, like
+// repeated worker scans, until no more users are flagged. The seeds start out confirmed.
+// Without generation tracking every flag is stored as generation 1 and so never dampened.
+func simulateFriendCascade(
+	c *FriendChecker, graph map[uint64][]types.ExtendedFriend, seeds []uint64, trackGenerations bool,
+) map[uint64]*types.ReviewUser {
+	existing := make(map[uint64]*types.ReviewUser)
+	for _, seed := range seeds {
+		existing[seed] = &types.ReviewUser{
+			User:   types.User{ID: seed, ReasonCategory: enum.ReasonCategoryContent},
+			Status: enum.UserTypeConfirmed,
+		}
+	}
+
+	createdAt := time.Now().Add(-7 * 24 * time.Hour)
+	for range len(graph) {
+		flagged := make(map[uint64]*types.ReviewUser)
+		for userID, friends := range graph {
+			if _, ok := existing[userID]; ok {
+				continue
+			}
+
+			signal := collectFriendSignal(friends, existing)
+			confidence := c.calculateConfidence(
+				len(signal.confirmedFriends), signal.flaggedWeight, len(friends), createdAt, nil,
+			)
+			if confidence < FriendFlagThreshold {
+				continue
+			}
+
+			generation := signal.generation
+			if !trackGenerations {
+				generation = 1
+			}
+			flagged[userID] = &types.ReviewUser{
+				User:   types.User{ID: userID, ReasonCategory: enum.ReasonCategoryFriend, FlagGeneration: generation},
+				Status: enum.UserTypeFlagged,
+			}
+		}
+
+		if len(flagged) == 0 {
+			break
+		}
+		for userID, user := range flagged {
+			existing[userID] = user
+		}
+	}
+
+	return existing
+}
+
+// maxFlagGeneration returns the highest generation among the flagged users.
+func maxFlagGeneration(users map[uint64]*types.ReviewUser) int {
+	highest := 0
+	for _, user := range users {
+		if user.Status == enum.UserTypeFlagged {
+			highest = max(highest, user.FlagGeneration)
+		}
+	}
+	return highest
+}
+
+func TestFriendCascadeIsBounded(t *testing.T) {
+	c := &FriendChecker{logger: zap.NewNop()}
+	graph := newRingGraph(300, 8)
+	seeds := []uint64{1, 2, 3, 4, 5}
+
+	t.Run("without generations flags spread through the whole community", func(t *testing.T) {
+		users := simulateFriendCascade(c, graph, seeds, false)
+
+		assert.Len(t, users, len(graph))
+	})
+
+	t.Run("dampened flags stop close to the seeds", func(t *testing.T) {
+		users := simulateFriendCascade(c, graph, seeds, true)
+
+		assert.Less(t, len(users), 40)
+		assert.LessOrEqual(t, maxFlagGeneration(users), CascadeDampenGeneration+1)
+
+		// Users on the far side of the ring are never flagged
+		_, ok := users[150]
+		assert.False(t, ok)
+	})
+
+	t.Run("more seeds do not deepen the cascade", func(t *testing.T) {
+		moreSeeds := []uint64{1, 2, 3, 4, 5, 100, 101, 102, 103, 104, 200, 201, 202, 203, 204}
+		users := simulateFriendCascade(c, graph, moreSeeds, true)
+
+		assert.Less(t, len(users), 120)
+		assert.LessOrEqual(t, maxFlagGeneration(users), CascadeDampenGeneration+1)
+	})
+}
+
+func TestCollectFriendSignal(t *testing.T) {
+	friends := make([]types.ExtendedFriend, 0, 6)
+	for id := uint64(1); id <= 6; id++ {
+		friends = append(friends, types.ExtendedFriend{Friend: apiTypes.Friend{ID: id}})
+	}
+
+	flagged := func(category enum.ReasonCategory, generation int) *types.ReviewUser {
+		return &types.ReviewUser{
+			User:   types.User{ReasonCategory: category, FlagGeneration: generation},
+			Status: enum.UserTypeFlagged,
+		}
+	}
+
+	t.Run("confirmed and content flags start a cascade", func(t *testing.T) {
+		signal := collectFriendSignal(friends, map[uint64]*types.ReviewUser{
+			1: {Status: enum.UserTypeConfirmed},
+			2: flagged(enum.ReasonCategoryContent, 0),
+			3: {Status: enum.UserTypeCleared},
+		})
+
+		assert.Len(t, signal.confirmedFriends, 1)
+		assert.Len(t, signal.flaggedFriends, 1)
+		assert.InDelta(t, 1.0, signal.flaggedWeight, 0.0001)
+		assert.Equal(t, 1, signal.generation)
+	})
+
+	t.Run("generation follows the deepest flagged friend", func(t *testing.T) {
+		signal := collectFriendSignal(friends, map[uint64]*types.ReviewUser{
+			1: flagged(enum.ReasonCategoryFriend, 1),
+			2: flagged(enum.ReasonCategoryFriend, 3),
+			3: flagged(enum.ReasonCategoryGroup, 0),
+		})
+
+		require.Len(t, signal.flaggedFriends, 3)
+		assert.Equal(t, 4, signal.generation)
+	})
+
+	t.Run("cascaded friend flags are dampened", func(t *testing.T) {
+		signal := collectFriendSignal(friends, map[uint64]*types.ReviewUser{
+			1: flagged(enum.ReasonCategoryFriend, 1),
+			2: flagged(enum.ReasonCategoryFriend, CascadeDampenGeneration),
+			3: flagged(enum.ReasonCategoryFriend, CascadeDampenGeneration+1),
+			4: flagged(enum.ReasonCategoryMultiple, 0),
+		})
+
+		assert.InDelta(t, 2+2*CascadeDampenFactor, signal.flaggedWeight, 0.0001)
+	})
+}
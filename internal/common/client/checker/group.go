@@ -2,7 +2,10 @@ package checker
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +17,16 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// ownedConfirmedGroupWeight is added to the confidence of a user for each confirmed
+	// group they own. Owners control the group and its members, so owning a confirmed
+	// group is enough to flag a user even without memberships in other groups.
+	ownedConfirmedGroupWeight = 0.3
+	// ownedFlaggedGroupWeight is added to the confidence of a user for each flagged
+	// group they own. It is lower since flagged groups have not been reviewed yet.
+	ownedFlaggedGroupWeight = 0.1
+)
+
 // GroupCheckResult contains the result of checking a user's groups.
 type GroupCheckResult struct {
 	UserID      uint64
@@ -167,6 +180,16 @@ func (c *GroupChecker) ProcessUsers(userInfos []*fetcher.Info) map[uint64]*types
 		return nil
 	}
 
+	// Allowlisted groups do not count towards flagging users
+	allowlisted, err := c.db.GroupAllowlist().GetAllowlistedIDs(context.Background(), groupIDs)
+	if err != nil {
+		c.logger.Error("Failed to fetch allowlisted groups", zap.Error(err))
+		return nil
+	}
+	for groupID := range allowlisted {
+		delete(existingGroups, groupID)
+	}
+
 	// Process each user concurrently
 	var wg sync.WaitGroup
 	resultsChan := make(chan GroupCheckResult, len(userInfos))
@@ -206,28 +229,54 @@ func (c *GroupChecker) ProcessUsers(userInfos []*fetcher.Info) map[uint64]*types
 
 // processUserGroups checks if a user should be flagged based on their groups.
 func (c *GroupChecker) processUserGroups(userInfo *fetcher.Info, existingGroups map[uint64]*types.ReviewGroup) (*types.User, bool) {
+	// Skip users whose groups could not be fetched instead of treating them as having none
+	if userInfo.Groups.Error != nil {
+		c.logger.Debug("Skipping group check, groups unavailable",
+			zap.Uint64("userID", userInfo.ID),
+			zap.Bool("private", errors.Is(userInfo.Groups.Error, fetcher.ErrPrivate)),
+			zap.Error(userInfo.Groups.Error))
+		return nil, false
+	}
+
 	// Skip users with very few groups to avoid false positives
 	if len(userInfo.Groups.Data) < 2 {
 		return nil, false
 	}
 
-	// Count confirmed and flagged groups
+	// Count confirmed and flagged groups, along with the ones the user owns
 	confirmedCount := 0
 	flaggedCount := 0
+	ownedConfirmedCount := 0
+	ownedFlaggedCount := 0
+	var ownedGroups []string
 
 	for _, group := range userInfo.Groups.Data {
-		if reviewGroup, exists := existingGroups[group.Group.ID]; exists {
-			switch reviewGroup.Status {
-			case enum.GroupTypeConfirmed:
-				confirmedCount++
-			case enum.GroupTypeFlagged:
-				flaggedCount++
-			} //exhaustive:ignore
+		reviewGroup, exists := existingGroups[group.Group.ID]
+		if !exists {
+			continue
 		}
+
+		owner := types.IsGroupOwner(userInfo.ID, group)
+		switch reviewGroup.Status {
+		case enum.GroupTypeConfirmed:
+			confirmedCount++
+			if owner {
+				ownedConfirmedCount++
+				ownedGroups = append(ownedGroups, fmt.Sprintf("Owns confirmed group %q.", group.Group.Name))
+			}
+		case enum.GroupTypeFlagged:
+			flaggedCount++
+			if owner {
+				ownedFlaggedCount++
+				ownedGroups = append(ownedGroups, fmt.Sprintf("Owns flagged group %q.", group.Group.Name))
+			}
+		} //exhaustive:ignore
 	}
 
-	// Calculate confidence score
+	// Calculate confidence score, raised for owned groups
 	confidence := c.calculateConfidence(confirmedCount, flaggedCount, len(userInfo.Groups.Data))
+	confidence += calculateOwnershipBoost(ownedConfirmedCount, ownedFlaggedCount)
+	confidence = math.Min(confidence, 1.0)
 
 	// Flag user if confidence exceeds threshold
 	if confidence >= 0.4 {
@@ -235,15 +284,30 @@ func (c *GroupChecker) processUserGroups(userInfo *fetcher.Info, existingGroups
 			zap.Uint64("userID", userInfo.ID),
 			zap.Int("confirmedGroups", confirmedCount),
 			zap.Int("flaggedGroups", flaggedCount),
+			zap.Int("ownedGroups", len(ownedGroups)),
 			zap.Float64("confidence", confidence))
 
+		// Mention owned groups explicitly since they weigh more than memberships
+		reason := "Group Analysis: Member of multiple inappropriate groups."
+		if len(ownedGroups) > 0 {
+			reason = "Group Analysis: " + strings.Join(ownedGroups, " ")
+			switch others := confirmedCount + flaggedCount - len(ownedGroups); {
+			case others == 1:
+				reason += " Member of 1 other inappropriate group."
+			case others > 1:
+				reason += fmt.Sprintf(" Member of %d other inappropriate groups.", others)
+			}
+		}
+
 		return &types.User{
 			ID:             userInfo.ID,
 			Name:           userInfo.Name,
 			DisplayName:    userInfo.DisplayName,
 			Description:    userInfo.Description,
 			CreatedAt:      userInfo.CreatedAt,
-			Reason:         "Group Analysis: Member of multiple inappropriate groups.",
+			Reason:         reason,
+			ReasonCategory: enum.ReasonCategoryGroup,
+			FlagSource:     enum.FlagSourceGroup,
 			Groups:         userInfo.Groups.Data,
 			Friends:        userInfo.Friends.Data,
 			Games:          userInfo.Games.Data,
@@ -276,6 +340,11 @@ func (c *GroupChecker) calculateConfidence(confirmedCount, flaggedCount, totalGr
 	return confidence
 }
 
+// calculateOwnershipBoost returns the confidence added for the confirmed and flagged groups a user owns.
+func calculateOwnershipBoost(ownedConfirmedCount, ownedFlaggedCount int) float64 {
+	return float64(ownedConfirmedCount)*ownedConfirmedGroupWeight + float64(ownedFlaggedCount)*ownedFlaggedGroupWeight
+}
+
 // calculateInappropriateWeight returns a weight based on the total number of inappropriate groups.
 func (c *GroupChecker) calculateInappropriateWeight(confirmedCount, flaggedCount int) float64 {
 	totalWeight := float64(confirmedCount) + (float64(flaggedCount) * 0.5)
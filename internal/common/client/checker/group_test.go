@@ -0,0 +1,98 @@
+package checker
+
+import (
+	"testing"
+
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/common/client/fetcher"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newGroupInfo creates user info with memberships in the given groups and the given rank in each.
+func newGroupInfo(userID uint64, ranks map[uint64]uint64) *fetcher.Info {
+	groups := make([]*apiTypes.UserGroupRoles, 0, len(ranks))
+	for groupID := uint64(1); groupID <= uint64(len(ranks)); groupID++ {
+		groups = append(groups, &apiTypes.UserGroupRoles{
+			Group: apiTypes.GroupResponse{ID: groupID, Name: "Group " + string(rune('A'+groupID-1))},
+			Role:  apiTypes.UserGroupRole{Rank: ranks[groupID]},
+		})
+	}
+
+	return &fetcher.Info{
+		ID:      userID,
+		Groups:  &fetcher.UserGroupFetchResult{Data: groups},
+		Friends: &fetcher.UserFriendFetchResult{},
+		Games:   &fetcher.UserGamesFetchResult{},
+	}
+}
+
+func TestProcessUserGroupsOwnership(t *testing.T) {
+	c := &GroupChecker{logger: zap.NewNop()}
+	existingGroups := map[uint64]*types.ReviewGroup{
+		1: {Group: types.Group{ID: 1}, Status: enum.GroupTypeConfirmed},
+		2: {Group: types.Group{ID: 2}, Status: enum.GroupTypeFlagged},
+	}
+
+	t.Run("member of one confirmed group is not flagged", func(t *testing.T) {
+		info := newGroupInfo(100, map[uint64]uint64{1: 1, 2: 1, 3: 1, 4: 1, 5: 1})
+
+		user, flagged := c.processUserGroups(info, existingGroups)
+		assert.False(t, flagged)
+		assert.Nil(t, user)
+	})
+
+	t.Run("owner of one confirmed group is flagged", func(t *testing.T) {
+		info := newGroupInfo(100, map[uint64]uint64{1: types.GroupOwnerRank, 2: 1, 3: 1, 4: 1, 5: 1})
+
+		user, flagged := c.processUserGroups(info, existingGroups)
+		require.True(t, flagged)
+		assert.Equal(t, `Group Analysis: Owns confirmed group "Group A". Member of 1 other inappropriate group.`, user.Reason)
+		assert.InDelta(t, 0.66, user.Confidence, 0.001)
+	})
+
+	t.Run("owner scores higher than member", func(t *testing.T) {
+		// Enough memberships to be flagged either way
+		confirmed := map[uint64]*types.ReviewGroup{
+			1: {Group: types.Group{ID: 1}, Status: enum.GroupTypeConfirmed},
+			2: {Group: types.Group{ID: 2}, Status: enum.GroupTypeConfirmed},
+			3: {Group: types.Group{ID: 3}, Status: enum.GroupTypeConfirmed},
+		}
+
+		member, flagged := c.processUserGroups(newGroupInfo(100, map[uint64]uint64{1: 1, 2: 1, 3: 1, 4: 1}), confirmed)
+		require.True(t, flagged)
+		assert.Equal(t, "Group Analysis: Member of multiple inappropriate groups.", member.Reason)
+
+		owner, flagged := c.processUserGroups(newGroupInfo(100, map[uint64]uint64{1: 1, 2: types.GroupOwnerRank, 3: 1, 4: 1}), confirmed)
+		require.True(t, flagged)
+		assert.Contains(t, owner.Reason, `Owns confirmed group "Group B".`)
+		assert.Greater(t, owner.Confidence, member.Confidence)
+	})
+
+	t.Run("listed owner without owner rank", func(t *testing.T) {
+		info := newGroupInfo(100, map[uint64]uint64{1: 1, 2: 1, 3: 1})
+		info.Groups.Data[0].Group.Owner = &apiTypes.GroupUser{UserID: 100}
+
+		user, flagged := c.processUserGroups(info, existingGroups)
+		require.True(t, flagged)
+		assert.Contains(t, user.Reason, `Owns confirmed group "Group A".`)
+	})
+
+	t.Run("owned flagged group weighs less", func(t *testing.T) {
+		info := newGroupInfo(100, map[uint64]uint64{1: 1, 2: types.GroupOwnerRank, 3: 1, 4: 1, 5: 1})
+
+		user, flagged := c.processUserGroups(info, existingGroups)
+		require.True(t, flagged)
+		assert.Equal(t, `Group Analysis: Owns flagged group "Group B". Member of 1 other inappropriate group.`, user.Reason)
+		assert.Less(t, user.Confidence, 0.66)
+	})
+}
+
+func TestCalculateOwnershipBoost(t *testing.T) {
+	assert.InDelta(t, 0.0, calculateOwnershipBoost(0, 0), 0.001)
+	assert.InDelta(t, ownedConfirmedGroupWeight, calculateOwnershipBoost(1, 0), 0.001)
+	assert.InDelta(t, 2*ownedConfirmedGroupWeight+ownedFlaggedGroupWeight, calculateOwnershipBoost(2, 1), 0.001)
+}
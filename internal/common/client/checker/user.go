@@ -6,9 +6,11 @@ import (
 
 	"github.com/robalyx/rotector/internal/common/client/ai"
 	"github.com/robalyx/rotector/internal/common/client/fetcher"
+	"github.com/robalyx/rotector/internal/common/progress"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"github.com/robalyx/rotector/internal/common/translator"
 	"go.uber.org/zap"
 )
@@ -18,21 +20,27 @@ import (
 type UserChecker struct {
 	app           *setup.App
 	db            *database.Client
+	bar           *progress.Bar
 	userFetcher   *fetcher.UserFetcher
 	userAnalyzer  *ai.UserAnalyzer
 	groupChecker  *GroupChecker
 	friendChecker *FriendChecker
+	flagSource    enum.FlagSource
 	logger        *zap.Logger
 }
 
 // NewUserChecker creates a UserChecker with all required dependencies.
-func NewUserChecker(app *setup.App, userFetcher *fetcher.UserFetcher, logger *zap.Logger) *UserChecker {
+// Token usage of the AI checks is recorded to the given tracker, which may be nil.
+func NewUserChecker(
+	app *setup.App, bar *progress.Bar, userFetcher *fetcher.UserFetcher, usage *ai.UsageTracker, logger *zap.Logger,
+) *UserChecker {
 	translator := translator.New(app.RoAPI.GetClient())
-	userAnalyzer := ai.NewUserAnalyzer(app, translator, logger)
+	userAnalyzer := ai.NewUserAnalyzer(app, translator, usage, logger)
 
 	return &UserChecker{
 		app:          app,
 		db:           app.DB,
+		bar:          bar,
 		userFetcher:  userFetcher,
 		userAnalyzer: userAnalyzer,
 		groupChecker: NewGroupChecker(app.DB, logger,
@@ -40,16 +48,43 @@ func NewUserChecker(app *setup.App, userFetcher *fetcher.UserFetcher, logger *za
 			app.Config.Worker.ThresholdLimits.MinFlaggedOverride,
 			app.Config.Worker.ThresholdLimits.MinFlaggedPercentage,
 		),
-		friendChecker: NewFriendChecker(app, logger),
+		friendChecker: NewFriendChecker(app, usage, logger),
 		logger:        logger,
 	}
 }
 
+// SetFlagSource records the given source on every user this checker flags instead
+// of the checker that flagged them. The queue worker uses this to attribute flags
+// of users queued by moderators to the recheck.
+func (c *UserChecker) SetFlagSource(source enum.FlagSource) {
+	c.flagSource = source
+}
+
 // ProcessUsers runs users through multiple checking stage.
-// Returns IDs of users that failed AI validation for retry.
-func (c *UserChecker) ProcessUsers(userInfos []*fetcher.Info) []uint64 {
+// Returns IDs of users whose check completed, and IDs of users that failed AI
+// validation for retry. Users are only counted as checked if the AI analyzed
+// them and any flag raised for them was saved.
+func (c *UserChecker) ProcessUsers(userInfos []*fetcher.Info) ([]uint64, []uint64) {
 	c.logger.Info("Processing users", zap.Int("userInfos", len(userInfos)))
 
+	// Skip protected accounts before running any checks
+	userInfos, err := c.filterProtectedUsers(userInfos)
+	if err != nil {
+		// Retry all users later rather than risk flagging a protected account
+		c.logger.Error("Failed to filter protected users", zap.Error(err))
+		failedIDs := make([]uint64, len(userInfos))
+		for i, info := range userInfos {
+			failedIDs[i] = info.ID
+		}
+		return nil, failedIDs
+	}
+	if len(userInfos) == 0 {
+		return nil, nil
+	}
+
+	c.bar.SetPhase("AI analysis")
+	c.bar.SetTotalItems(int64(len(userInfos)))
+
 	// Process group checker results
 	flaggedUsers := c.groupChecker.ProcessUsers(userInfos)
 
@@ -59,6 +94,8 @@ func (c *UserChecker) ProcessUsers(userInfos []*fetcher.Info) []uint64 {
 		if existingUser, ok := flaggedUsers[userID]; ok {
 			// Combine reasons and update confidence
 			existingUser.Reason = fmt.Sprintf("%s\n\n%s", existingUser.Reason, friendUser.Reason)
+			existingUser.ReasonCategory = enum.ReasonCategoryMultiple
+			existingUser.FlagGeneration = 0
 			existingUser.Confidence = 1.0
 		} else {
 			flaggedUsers[userID] = friendUser
@@ -66,12 +103,14 @@ func (c *UserChecker) ProcessUsers(userInfos []*fetcher.Info) []uint64 {
 	}
 
 	// Process AI results
-	flaggedByAI, failedIDs, err := c.userAnalyzer.ProcessUsers(userInfos)
-	if err == nil {
+	flaggedByAI, failedIDs, aiErr := c.userAnalyzer.ProcessUsers(userInfos)
+	if aiErr == nil {
 		for userID, aiUser := range flaggedByAI {
 			if existingUser, ok := flaggedUsers[userID]; ok {
 				// Combine reasons and update confidence
 				existingUser.Reason = fmt.Sprintf("%s\n\n%s", existingUser.Reason, aiUser.Reason)
+				existingUser.ReasonCategory = enum.ReasonCategoryMultiple
+				existingUser.FlagGeneration = 0
 				existingUser.Confidence = 1.0
 				existingUser.FlaggedContent = aiUser.FlaggedContent
 			} else {
@@ -79,11 +118,22 @@ func (c *UserChecker) ProcessUsers(userInfos []*fetcher.Info) []uint64 {
 			}
 		}
 	}
+	c.bar.IncrementProcessed(int64(len(userInfos)))
 
 	// Stop if no users were flagged
 	if len(flaggedUsers) == 0 {
 		c.logger.Info("No flagged users found", zap.Int("userInfos", len(userInfos)))
-		return failedIDs
+		if aiErr != nil {
+			return nil, failedIDs
+		}
+		return checkedUserIDs(userInfos, failedIDs, nil), failedIDs
+	}
+
+	// Attribute the flags to the recheck that requested them
+	if c.flagSource != enum.FlagSourceUnknown {
+		for _, user := range flaggedUsers {
+			user.FlagSource = c.flagSource
+		}
 	}
 
 	// Fetch additional user data concurrently
@@ -103,8 +153,15 @@ func (c *UserChecker) ProcessUsers(userInfos []*fetcher.Info) []uint64 {
 	}
 
 	// Save flagged users to database
+	c.bar.SetPhase("saving")
+	c.bar.SetTotalItems(int64(len(flaggedUsers)))
+	var unsavedUsers map[uint64]*types.User
 	if err := c.db.Users().SaveUsers(context.Background(), flaggedUsers); err != nil {
 		c.logger.Error("Failed to save users", zap.Error(err))
+		unsavedUsers = flaggedUsers
+	} else {
+		c.bar.IncrementProcessed(int64(len(flaggedUsers)))
+		c.recordAppealRegressions(flaggedUsers)
 	}
 
 	// Track flagged users' group memberships
@@ -114,7 +171,85 @@ func (c *UserChecker) ProcessUsers(userInfos []*fetcher.Info) []uint64 {
 		zap.Int("totalProcessed", len(userInfos)),
 		zap.Int("flaggedUsers", len(flaggedUsers)))
 
-	return failedIDs
+	if aiErr != nil {
+		return nil, failedIDs
+	}
+	return checkedUserIDs(userInfos, failedIDs, unsavedUsers), failedIDs
+}
+
+// checkedUserIDs returns the IDs of the users whose check completed, leaving out
+// users that failed validation and flagged users that could not be saved.
+func checkedUserIDs(userInfos []*fetcher.Info, failedIDs []uint64, unsavedUsers map[uint64]*types.User) []uint64 {
+	failed := make(map[uint64]struct{}, len(failedIDs))
+	for _, userID := range failedIDs {
+		failed[userID] = struct{}{}
+	}
+
+	userIDs := make([]uint64, 0, len(userInfos))
+	for _, info := range userInfos {
+		if _, ok := failed[info.ID]; ok {
+			continue
+		}
+		if _, ok := unsavedUsers[info.ID]; ok {
+			continue
+		}
+		userIDs = append(userIDs, info.ID)
+	}
+	return userIDs
+}
+
+// filterProtectedUsers removes protected accounts from the users to check.
+// The original users are returned alongside the error if the check fails.
+func (c *UserChecker) filterProtectedUsers(userInfos []*fetcher.Info) ([]*fetcher.Info, error) {
+	userIDs := make([]uint64, len(userInfos))
+	for i, info := range userInfos {
+		userIDs[i] = info.ID
+	}
+
+	protectedIDs, err := c.db.Protected().GetProtectedIDs(context.Background(), userIDs)
+	if err != nil {
+		return userInfos, err
+	}
+
+	if len(protectedIDs) == 0 {
+		return userInfos, nil
+	}
+
+	filtered := make([]*fetcher.Info, 0, len(userInfos))
+	for _, info := range userInfos {
+		if protectedIDs[info.ID] {
+			c.logger.Info("Skipped protected user",
+				zap.Uint64("userID", info.ID),
+				zap.String("username", info.Name))
+			continue
+		}
+		filtered = append(filtered, info)
+	}
+
+	return filtered, nil
+}
+
+// recordAppealRegressions records flagged users that were cleared by an accepted appeal,
+// so the rate at which accepted appeals are flagged again can be measured.
+func (c *UserChecker) recordAppealRegressions(flaggedUsers map[uint64]*types.User) {
+	userIDs := make([]uint64, 0, len(flaggedUsers))
+	for userID := range flaggedUsers {
+		userIDs = append(userIDs, userID)
+	}
+
+	regressions, err := c.db.Appeals().RecordRegressions(context.Background(), userIDs)
+	if err != nil {
+		c.logger.Error("Failed to record appeal regressions", zap.Error(err))
+		return
+	}
+
+	for _, regression := range regressions {
+		c.logger.Warn("User flagged again after accepted appeal",
+			zap.Int64("appealID", regression.AppealID),
+			zap.Uint64("userID", regression.UserID),
+			zap.Uint64("reviewerID", regression.ReviewerID),
+			zap.Int("daysToReflag", regression.DaysToReflag))
+	}
 }
 
 // trackFlaggedUsersGroups adds flagged users' group memberships to tracking.
@@ -0,0 +1,26 @@
+package checker
+
+import (
+	"testing"
+
+	"github.com/robalyx/rotector/internal/common/client/fetcher"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckedUserIDs(t *testing.T) {
+	userInfos := []*fetcher.Info{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+
+	t.Run("all users checked", func(t *testing.T) {
+		assert.Equal(t, []uint64{1, 2, 3, 4}, checkedUserIDs(userInfos, nil, nil))
+	})
+
+	t.Run("failed validation is left out", func(t *testing.T) {
+		assert.Equal(t, []uint64{1, 3, 4}, checkedUserIDs(userInfos, []uint64{2}, nil))
+	})
+
+	t.Run("flagged users that were not saved are left out", func(t *testing.T) {
+		unsaved := map[uint64]*types.User{3: {ID: 3}, 4: {ID: 4}}
+		assert.Equal(t, []uint64{1}, checkedUserIDs(userInfos, []uint64{2}, unsaved))
+	})
+}
@@ -0,0 +1,96 @@
+package fetcher
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	apiErrors "github.com/jaxron/roapi.go/pkg/api/errors"
+)
+
+// DefaultRetryAfter is how long callers should wait after a rate limited request.
+// The API client does not expose the Retry-After header, so a fixed delay is used.
+const DefaultRetryAfter = 30 * time.Second
+
+var (
+	// ErrRateLimited indicates that the request was rejected due to rate limiting.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrPrivate indicates that the requested data is hidden by the target's privacy settings.
+	ErrPrivate = errors.New("data is private")
+	// ErrDeleted indicates that the target does not exist or has been deleted.
+	ErrDeleted = errors.New("target does not exist")
+)
+
+// statusCodePattern extracts the HTTP status code from errors returned for responses
+// that had no parsable error body, such as "invalid JSON response: code 429".
+var statusCodePattern = regexp.MustCompile(`(?:code |error \()(\d{3})\b`)
+
+// RateLimitError is returned when a request was rate limited. It matches
+// ErrRateLimited with errors.Is and carries how long to wait before retrying.
+type RateLimitError struct {
+	RetryAfter time.Duration
+	Err        error
+}
+
+// Error implements the error interface.
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s (retry after %s): %s", ErrRateLimited, e.RetryAfter, e.Err)
+}
+
+// Unwrap allows matching both ErrRateLimited and the original error.
+func (e *RateLimitError) Unwrap() []error {
+	return []error{ErrRateLimited, e.Err}
+}
+
+// classifyError maps an error from the Roblox API onto ErrRateLimited, ErrPrivate
+// or ErrDeleted so callers can react to each case. The original error is kept in
+// the chain. Errors that match none of the cases are returned unchanged.
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// Check the messages returned by the API first
+	var apiErr *apiErrors.APIError
+	if errors.As(err, &apiErr) {
+		for _, data := range apiErr.Errors {
+			message := strings.ToLower(data.Message)
+			switch {
+			case strings.Contains(message, "toomanyrequests"), strings.Contains(message, "too many requests"):
+				return &RateLimitError{RetryAfter: DefaultRetryAfter, Err: err}
+			case strings.Contains(message, "permission"), strings.Contains(message, "private"):
+				return fmt.Errorf("%w: %w", ErrPrivate, err)
+			case strings.Contains(message, "does not exist"),
+				strings.Contains(message, "is invalid"),
+				strings.Contains(message, "not found"):
+				return fmt.Errorf("%w: %w", ErrDeleted, err)
+			}
+		}
+		return err
+	}
+
+	// Fall back to the status code for responses without an error body
+	if matches := statusCodePattern.FindStringSubmatch(err.Error()); matches != nil {
+		statusCode, _ := strconv.Atoi(matches[1])
+		switch statusCode {
+		case 429:
+			return &RateLimitError{RetryAfter: DefaultRetryAfter, Err: err}
+		case 404:
+			return fmt.Errorf("%w: %w", ErrDeleted, err)
+		}
+	}
+
+	return err
+}
+
+// RetryAfter returns how long to wait before retrying if the error is a rate limit error.
+func RetryAfter(err error) (time.Duration, bool) {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return rateLimitErr.RetryAfter, true
+	}
+	return 0, false
+}
@@ -0,0 +1,125 @@
+package fetcher
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	apiErrors "github.com/jaxron/roapi.go/pkg/api/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// apiError builds an error like the one returned by the API client for an error response body.
+func apiError(code int, message string) error {
+	return &apiErrors.APIError{
+		Errors: []apiErrors.APIErrorData{{Code: code, Message: message}},
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "rate limited response",
+			err:  apiError(0, "TooManyRequests"),
+			want: ErrRateLimited,
+		},
+		{
+			name: "rate limited message",
+			err:  apiError(0, "Too many requests"),
+			want: ErrRateLimited,
+		},
+		{
+			name: "rate limited without body",
+			err:  fmt.Errorf("%w: code %d", apiErrors.ErrParseJSON, 429),
+			want: ErrRateLimited,
+		},
+		{
+			name: "rate limited without message",
+			err:  fmt.Errorf("roblox API error (%d): %w", 429, apiErrors.ErrNoMessage),
+			want: ErrRateLimited,
+		},
+		{
+			name: "private inventory",
+			err:  apiError(4, "You don't have permissions to view the specified user's inventory."),
+			want: ErrPrivate,
+		},
+		{
+			name: "invalid user",
+			err:  apiError(3, "The user id is invalid."),
+			want: ErrDeleted,
+		},
+		{
+			name: "friends of missing user",
+			err:  apiError(1, "The target user is invalid or does not exist."),
+			want: ErrDeleted,
+		},
+		{
+			name: "missing group",
+			err:  apiError(1, "Group is invalid or does not exist."),
+			want: ErrDeleted,
+		},
+		{
+			name: "missing outfits user",
+			err:  apiError(1, "The specified user does not exist!"),
+			want: ErrDeleted,
+		},
+		{
+			name: "not found without body",
+			err:  fmt.Errorf("%w: code %d", apiErrors.ErrReadBody, 404),
+			want: ErrDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyError(tt.err)
+			assert.ErrorIs(t, got, tt.want)
+			assert.ErrorIs(t, got, tt.err, "the original error must stay in the chain")
+		})
+	}
+}
+
+func TestClassifyErrorUnchanged(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "unknown api error",
+			err:  apiError(0, "InternalServerError"),
+		},
+		{
+			name: "server error without body",
+			err:  fmt.Errorf("%w: code %d", apiErrors.ErrParseJSON, 500),
+		},
+		{
+			name: "network error",
+			err:  errors.New("connection reset by peer"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyError(tt.err)
+			assert.Equal(t, tt.err, got)
+		})
+	}
+
+	assert.NoError(t, classifyError(nil))
+}
+
+func TestRetryAfter(t *testing.T) {
+	retryAfter, ok := RetryAfter(classifyError(apiError(0, "TooManyRequests")))
+	assert.True(t, ok)
+	assert.Equal(t, DefaultRetryAfter, retryAfter)
+
+	_, ok = RetryAfter(classifyError(apiError(3, "The user id is invalid.")))
+	assert.False(t, ok)
+
+	_, ok = RetryAfter(fmt.Errorf("wrapped: %w", classifyError(apiError(0, "TooManyRequests"))))
+	assert.True(t, ok)
+}
@@ -50,11 +50,18 @@ func (f *FollowFetcher) AddFollowCounts(users map[uint64]*types.User) map[uint64
 			followerCount, followerErr := f.roAPI.Friends().GetFollowerCount(context.Background(), u.ID)
 			followingCount, followingErr := f.roAPI.Friends().GetFollowingCount(context.Background(), u.ID)
 
-			err := errors.Join(followerErr, followingErr)
+			err := errors.Join(classifyError(followerErr), classifyError(followingErr))
 			if err != nil {
 				f.logger.Error("Failed to fetch follow counts",
 					zap.Error(err),
 					zap.Uint64("userID", u.ID))
+
+				mu.Lock()
+				results[u.ID] = &FollowFetchResult{
+					ID:    u.ID,
+					Error: err,
+				}
+				mu.Unlock()
 				return
 			}
 
@@ -72,7 +79,7 @@ func (f *FollowFetcher) AddFollowCounts(users map[uint64]*types.User) map[uint64
 
 	f.logger.Debug("Finished fetching follow counts",
 		zap.Int("totalUsers", len(users)),
-		zap.Int("successfulFetches", len(results)))
+		zap.Int("fetchedResults", len(results)))
 
 	return results
 }
@@ -54,7 +54,7 @@ func (f *FriendFetcher) GetFriends(ctx context.Context, userID uint64) ([]uint64
 		// Fetch page of friends
 		response, err := f.roAPI.Friends().FindFriends(ctx, builder.Build())
 		if err != nil {
-			return nil, err
+			return nil, classifyError(err)
 		}
 
 		// Add friend IDs to slice
@@ -105,7 +105,7 @@ func (f *FriendFetcher) GetFriendsWithDetails(ctx context.Context, userID uint64
 			userDetails, err := f.roAPI.Users().GetUsersByIDs(ctx, builder.Build())
 			if err != nil {
 				f.logger.Error("Failed to fetch user details",
-					zap.Error(err),
+					zap.Error(classifyError(err)),
 					zap.Int("batchStart", start),
 					zap.Int("batchEnd", end))
 				return
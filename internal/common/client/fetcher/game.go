@@ -49,7 +49,7 @@ func (g *GameFetcher) FetchGamesForUser(userID uint64) ([]*types.Game, error) {
 		// Fetch page of games
 		response, err := g.roAPI.Games().GetUserGames(context.Background(), builder.Build())
 		if err != nil {
-			return nil, err
+			return nil, classifyError(err)
 		}
 
 		// Append games from this page
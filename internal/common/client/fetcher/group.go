@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/jaxron/roapi.go/pkg/api"
 	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
@@ -14,16 +15,25 @@ import (
 // ErrGroupLocked indicates that the group is locked.
 var ErrGroupLocked = errors.New("group is locked")
 
+// ShoutRecorder records the shouts seen on groups so that shouts swapped out
+// before a reviewer sees the group are kept.
+type ShoutRecorder interface {
+	AddShout(ctx context.Context, groupID uint64, shout string, postedAt time.Time) (bool, error)
+}
+
 // GroupFetcher handles concurrent retrieval of group information from the Roblox API.
 type GroupFetcher struct {
 	roAPI  *api.API
+	shouts ShoutRecorder
 	logger *zap.Logger
 }
 
 // NewGroupFetcher creates a GroupFetcher with the provided API client and logger.
-func NewGroupFetcher(roAPI *api.API, logger *zap.Logger) *GroupFetcher {
+// The shouts of fetched groups are recorded if shouts is not nil.
+func NewGroupFetcher(roAPI *api.API, shouts ShoutRecorder, logger *zap.Logger) *GroupFetcher {
 	return &GroupFetcher{
 		roAPI:  roAPI,
+		shouts: shouts,
 		logger: logger,
 	}
 }
@@ -54,7 +64,7 @@ func (g *GroupFetcher) FetchGroupInfos(groupIDs []uint64) []*apiTypes.GroupRespo
 			if err != nil {
 				g.logger.Error("Error fetching group info",
 					zap.Uint64("groupID", id),
-					zap.Error(err))
+					zap.Error(classifyError(err)))
 				return
 			}
 
@@ -63,6 +73,8 @@ func (g *GroupFetcher) FetchGroupInfos(groupIDs []uint64) []*apiTypes.GroupRespo
 				return
 			}
 
+			g.recordShout(groupInfo)
+
 			mu.Lock()
 			validGroups = append(validGroups, groupInfo)
 			mu.Unlock()
@@ -78,7 +90,22 @@ func (g *GroupFetcher) FetchGroupInfos(groupIDs []uint64) []*apiTypes.GroupRespo
 	return validGroups
 }
 
+// recordShout records the current shout of a group if shout recording is enabled.
+func (g *GroupFetcher) recordShout(groupInfo *apiTypes.GroupResponse) {
+	if g.shouts == nil || groupInfo.Shout == nil || groupInfo.Shout.Body == "" {
+		return
+	}
+
+	_, err := g.shouts.AddShout(context.Background(), groupInfo.ID, groupInfo.Shout.Body, groupInfo.Shout.Updated)
+	if err != nil {
+		g.logger.Error("Failed to record group shout",
+			zap.Error(err),
+			zap.Uint64("groupID", groupInfo.ID))
+	}
+}
+
 // FetchLockedGroups checks which groups from a batch of IDs are currently locked.
+// Groups that no longer exist are included as well.
 // Returns a slice of locked group IDs.
 func (g *GroupFetcher) FetchLockedGroups(groupIDs []uint64) ([]uint64, error) {
 	var (
@@ -94,6 +121,16 @@ func (g *GroupFetcher) FetchLockedGroups(groupIDs []uint64) ([]uint64, error) {
 
 			groupInfo, err := g.roAPI.Groups().GetGroupInfo(context.Background(), id)
 			if err != nil {
+				err = classifyError(err)
+
+				// Deleted groups are handled the same way as locked ones
+				if errors.Is(err, ErrDeleted) {
+					mu.Lock()
+					results = append(results, id)
+					mu.Unlock()
+					return
+				}
+
 				g.logger.Error("Error fetching group info",
 					zap.Uint64("groupID", id),
 					zap.Error(err))
@@ -122,7 +159,7 @@ func (g *GroupFetcher) GetUserGroups(ctx context.Context, userID uint64) ([]*api
 	builder := groups.NewUserGroupRolesBuilder(userID)
 	fetchedGroups, err := g.roAPI.Groups().GetUserGroupRoles(ctx, builder.Build())
 	if err != nil {
-		return nil, err
+		return nil, classifyError(err)
 	}
 
 	groups := make([]*apiTypes.UserGroupRoles, 0, len(fetchedGroups.Data))
@@ -136,3 +173,30 @@ func (g *GroupFetcher) GetUserGroups(ctx context.Context, userID uint64) ([]*api
 
 	return groups, nil
 }
+
+// GetGroupsByOwner retrieves the groups owned by a user.
+func (g *GroupFetcher) GetGroupsByOwner(ctx context.Context, userID uint64) ([]*apiTypes.GroupResponse, error) {
+	userGroups, err := g.GetUserGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	owned := ownedGroups(userGroups, userID)
+
+	g.logger.Debug("Finished fetching owned groups",
+		zap.Uint64("userID", userID),
+		zap.Int("totalGroups", len(owned)))
+
+	return owned, nil
+}
+
+// ownedGroups returns the groups in which the user holds the owner role.
+func ownedGroups(userGroups []*apiTypes.UserGroupRoles, userID uint64) []*apiTypes.GroupResponse {
+	owned := make([]*apiTypes.GroupResponse, 0)
+	for _, userGroup := range userGroups {
+		if userGroup.Group.Owner != nil && userGroup.Group.Owner.UserID == userID {
+			owned = append(owned, &userGroup.Group)
+		}
+	}
+	return owned
+}
@@ -0,0 +1,26 @@
+package fetcher
+
+import (
+	"testing"
+
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnedGroups(t *testing.T) {
+	userGroups := []*apiTypes.UserGroupRoles{
+		{Group: apiTypes.GroupResponse{ID: 1, Owner: &apiTypes.GroupUser{UserID: 100}}},
+		{Group: apiTypes.GroupResponse{ID: 2, Owner: &apiTypes.GroupUser{UserID: 200}}},
+		{Group: apiTypes.GroupResponse{ID: 3}},
+		{Group: apiTypes.GroupResponse{ID: 4, Owner: &apiTypes.GroupUser{UserID: 100}}},
+	}
+
+	owned := ownedGroups(userGroups, 100)
+
+	ids := make([]uint64, 0, len(owned))
+	for _, group := range owned {
+		ids = append(ids, group.ID)
+	}
+	assert.Equal(t, []uint64{1, 4}, ids)
+	assert.Empty(t, ownedGroups(userGroups, 300))
+}
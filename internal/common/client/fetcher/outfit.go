@@ -20,8 +20,10 @@ type OutfitFetchResult struct {
 
 // OutfitFetcher handles retrieval of user outfit information from the Roblox API.
 type OutfitFetcher struct {
-	roAPI  *api.API
-	logger *zap.Logger
+	roAPI   *api.API
+	logger  *zap.Logger
+	retry   RetryPolicy
+	breaker *CircuitBreaker
 }
 
 // NewOutfitFetcher creates an OutfitFetcher with the provided API client and logger.
@@ -46,12 +48,21 @@ func (o *OutfitFetcher) AddOutfits(users map[uint64]*types.User) map[uint64]*Out
 		go func(u *types.User) {
 			defer wg.Done()
 
-			builder := avatar.NewUserOutfitsBuilder(u.ID).WithItemsPerPage(1000).WithIsEditable(true)
-			outfits, err := o.roAPI.Avatar().GetUserOutfits(context.Background(), builder.Build())
+			outfits, err := callWithRetry(context.Background(), o.retry, o.breaker,
+				func(ctx context.Context) (*apiTypes.OutfitResponse, error) {
+					return o.GetUserOutfits(ctx, u.ID)
+				})
 			if err != nil {
 				o.logger.Error("Failed to fetch user outfits",
 					zap.Error(err),
 					zap.Uint64("userID", u.ID))
+
+				mu.Lock()
+				results[u.ID] = &OutfitFetchResult{
+					ID:    u.ID,
+					Error: err,
+				}
+				mu.Unlock()
 				return
 			}
 
@@ -68,7 +79,14 @@ func (o *OutfitFetcher) AddOutfits(users map[uint64]*types.User) map[uint64]*Out
 
 	o.logger.Debug("Finished fetching user outfits",
 		zap.Int("totalUsers", len(users)),
-		zap.Int("successfulFetches", len(results)))
+		zap.Int("fetchedResults", len(results)))
 
 	return results
 }
+
+// GetUserOutfits fetches the outfits a user created.
+func (o *OutfitFetcher) GetUserOutfits(ctx context.Context, userID uint64) (*apiTypes.OutfitResponse, error) {
+	builder := avatar.NewUserOutfitsBuilder(userID).WithItemsPerPage(1000).WithIsEditable(true)
+	outfits, err := o.roAPI.Avatar().GetUserOutfits(ctx, builder.Build())
+	return outfits, classifyError(err)
+}
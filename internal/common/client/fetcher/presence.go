@@ -49,7 +49,7 @@ func (p *PresenceFetcher) FetchPresences(userIDs []uint64) []*types.UserPresence
 			presences, err := p.roAPI.Presence().GetUserPresences(context.Background(), params)
 			if err != nil {
 				p.logger.Error("Error fetching user presences",
-					zap.Error(err),
+					zap.Error(classifyError(err)),
 					zap.Int("batchStart", start))
 				return
 			}
@@ -0,0 +1,198 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/setup/config"
+)
+
+const (
+	// DefaultConcurrency is used when no user fetch concurrency is configured.
+	DefaultConcurrency = 20
+	// DefaultMaxAttempts is used when no maximum number of attempts is configured.
+	DefaultMaxAttempts = 3
+	// DefaultInitialBackoff is used when no initial backoff is configured.
+	DefaultInitialBackoff = 500 * time.Millisecond
+	// DefaultMaxBackoff is used when no maximum backoff is configured.
+	DefaultMaxBackoff = 5 * time.Second
+	// DefaultBreakerWindow is used when no error rate window is configured.
+	DefaultBreakerWindow = 30 * time.Second
+	// DefaultBreakerThreshold is used when no error rate threshold is configured.
+	DefaultBreakerThreshold = 0.5
+	// DefaultBreakerMinRequests is used when no minimum request count is configured.
+	DefaultBreakerMinRequests = 20
+	// DefaultBreakerCooldown is used when no cool-down period is configured.
+	DefaultBreakerCooldown = 30 * time.Second
+)
+
+// ErrCircuitOpen indicates that a request was not sent because the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// RetryPolicy controls how often a failed API call is retried and how long to wait between attempts.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// NewRetryPolicy creates a RetryPolicy from the configuration, using the defaults for unset values.
+func NewRetryPolicy(cfg config.UserFetch) RetryPolicy {
+	policy := RetryPolicy{
+		MaxAttempts:    cfg.MaxAttempts,
+		InitialBackoff: time.Duration(cfg.InitialBackoff) * time.Millisecond,
+		MaxBackoff:     time.Duration(cfg.MaxBackoff) * time.Millisecond,
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = DefaultMaxAttempts
+	}
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = DefaultInitialBackoff
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = DefaultMaxBackoff
+	}
+	return policy
+}
+
+// Backoff returns how long to wait after the given failed attempt, starting at 1.
+// The delay doubles with every attempt and is capped at MaxBackoff.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxBackoff)
+}
+
+// CircuitBreaker stops new requests for a cool-down period once the error rate
+// of the requests made within the current window exceeds the threshold.
+type CircuitBreaker struct {
+	window      time.Duration
+	threshold   float64
+	minRequests int
+	cooldown    time.Duration
+	now         func() time.Time
+
+	mu          sync.Mutex
+	windowStart time.Time
+	requests    int
+	failures    int
+	openUntil   time.Time
+}
+
+// NewCircuitBreaker creates a CircuitBreaker from the configuration, using the defaults for unset values.
+func NewCircuitBreaker(cfg config.UserFetch) *CircuitBreaker {
+	b := &CircuitBreaker{
+		window:      time.Duration(cfg.BreakerWindow) * time.Millisecond,
+		threshold:   cfg.BreakerThreshold,
+		minRequests: cfg.BreakerMinRequests,
+		cooldown:    time.Duration(cfg.BreakerCooldown) * time.Millisecond,
+		now:         time.Now,
+	}
+	if b.window <= 0 {
+		b.window = DefaultBreakerWindow
+	}
+	if b.threshold <= 0 {
+		b.threshold = DefaultBreakerThreshold
+	}
+	if b.minRequests <= 0 {
+		b.minRequests = DefaultBreakerMinRequests
+	}
+	if b.cooldown <= 0 {
+		b.cooldown = DefaultBreakerCooldown
+	}
+	return b
+}
+
+// Allow reports whether a new request may be sent. A nil breaker always allows requests.
+func (b *CircuitBreaker) Allow() bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !b.now().Before(b.openUntil)
+}
+
+// Record adds the outcome of a request to the current window and opens the
+// circuit if the error rate has reached the threshold. A nil breaker ignores outcomes.
+func (b *CircuitBreaker) Record(failed bool) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if now.Sub(b.windowStart) >= b.window {
+		b.windowStart = now
+		b.requests = 0
+		b.failures = 0
+	}
+
+	b.requests++
+	if failed {
+		b.failures++
+	}
+
+	if b.requests >= b.minRequests && float64(b.failures)/float64(b.requests) >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+		b.windowStart = b.openUntil
+		b.requests = 0
+		b.failures = 0
+	}
+}
+
+// isRetryable reports whether a failed call may succeed if it is sent again.
+// Missing or private data will not change, and rate limited requests are left
+// for the caller to retry after the rate limit has passed.
+func isRetryable(err error) bool {
+	return !errors.Is(err, ErrDeleted) &&
+		!errors.Is(err, ErrPrivate) &&
+		!errors.Is(err, ErrRateLimited) &&
+		!errors.Is(err, ErrCircuitOpen) &&
+		!errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded)
+}
+
+// isAPIFailure reports whether an error counts towards the circuit breaker's
+// error rate. Deleted and private targets are valid responses from the API.
+func isAPIFailure(err error) bool {
+	return err != nil && !errors.Is(err, ErrDeleted) && !errors.Is(err, ErrPrivate)
+}
+
+// callWithRetry calls the function until it succeeds, returns an error that is
+// not worth retrying, or the policy runs out of attempts. The function must
+// return classified errors. ErrCircuitOpen is returned without calling the
+// function if the breaker is open. A zero policy makes a single attempt.
+func callWithRetry[T any](
+	ctx context.Context, policy RetryPolicy, breaker *CircuitBreaker, call func(context.Context) (T, error),
+) (T, error) {
+	var zero T
+	for attempt := 1; ; attempt++ {
+		if !breaker.Allow() {
+			return zero, ErrCircuitOpen
+		}
+
+		result, err := call(ctx)
+		breaker.Record(isAPIFailure(err))
+		if err == nil {
+			return result, nil
+		}
+
+		if !isRetryable(err) || attempt >= policy.MaxAttempts {
+			return zero, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-time.After(policy.Backoff(attempt)):
+		}
+	}
+}
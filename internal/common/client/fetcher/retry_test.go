@@ -0,0 +1,195 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPI fails the first failures calls with err and succeeds afterwards.
+type fakeAPI struct {
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *fakeAPI) call(_ context.Context) (string, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return "", f.err
+	}
+	return "ok", nil
+}
+
+// testPolicy retries quickly so the tests do not wait on real backoff delays.
+var testPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     2 * time.Millisecond,
+}
+
+func TestCallWithRetry(t *testing.T) {
+	errTransient := errors.New("connection reset")
+
+	tests := []struct {
+		name      string
+		failures  int
+		err       error
+		wantErr   error
+		wantCalls int
+	}{
+		{
+			name:      "succeeds first time",
+			failures:  0,
+			err:       errTransient,
+			wantCalls: 1,
+		},
+		{
+			name:      "succeeds after transient failures",
+			failures:  2,
+			err:       errTransient,
+			wantCalls: 3,
+		},
+		{
+			name:      "gives up after max attempts",
+			failures:  5,
+			err:       errTransient,
+			wantErr:   errTransient,
+			wantCalls: 3,
+		},
+		{
+			name:      "does not retry deleted targets",
+			failures:  5,
+			err:       classifyError(apiError(3, "The user id is invalid.")),
+			wantErr:   ErrDeleted,
+			wantCalls: 1,
+		},
+		{
+			name:      "does not retry rate limits",
+			failures:  5,
+			err:       classifyError(apiError(0, "TooManyRequests")),
+			wantErr:   ErrRateLimited,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeAPI{failures: tt.failures, err: tt.err}
+
+			got, err := callWithRetry(context.Background(), testPolicy, nil, api.call)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, got)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "ok", got)
+			}
+			assert.Equal(t, tt.wantCalls, api.calls)
+		})
+	}
+}
+
+func TestCallWithRetryCircuitOpen(t *testing.T) {
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	breaker := &CircuitBreaker{
+		window:      time.Minute,
+		threshold:   0.5,
+		minRequests: 2,
+		cooldown:    30 * time.Second,
+		now:         func() time.Time { return now },
+	}
+
+	// Two failed calls open the circuit
+	failing := &fakeAPI{failures: 10, err: errors.New("connection reset")}
+	_, err := callWithRetry(context.Background(), RetryPolicy{MaxAttempts: 2}, breaker, failing.call)
+	require.Error(t, err)
+	assert.Equal(t, 2, failing.calls)
+
+	// New requests are skipped while the circuit is open
+	api := &fakeAPI{}
+	_, err = callWithRetry(context.Background(), testPolicy, breaker, api.call)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, 0, api.calls)
+
+	// Requests resume after the cool-down
+	now = now.Add(30 * time.Second)
+	got, err := callWithRetry(context.Background(), testPolicy, breaker, api.call)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", got)
+	assert.Equal(t, 1, api.calls)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	start := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		outcomes []bool
+		elapsed  []time.Duration
+		wantOpen bool
+	}{
+		{
+			name:     "stays closed below minimum requests",
+			outcomes: []bool{true, true, true},
+			elapsed:  []time.Duration{0, 0, 0},
+			wantOpen: false,
+		},
+		{
+			name:     "stays closed below threshold",
+			outcomes: []bool{true, false, false, false, false},
+			elapsed:  []time.Duration{0, 0, 0, 0, 0},
+			wantOpen: false,
+		},
+		{
+			name:     "opens at threshold",
+			outcomes: []bool{true, false, true, false},
+			elapsed:  []time.Duration{0, 0, 0, 0},
+			wantOpen: true,
+		},
+		{
+			name:     "forgets failures from previous window",
+			outcomes: []bool{true, true, true, false, false, false, false},
+			elapsed:  []time.Duration{0, 0, 0, time.Minute, time.Minute, time.Minute, time.Minute},
+			wantOpen: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := start
+			breaker := &CircuitBreaker{
+				window:      time.Minute,
+				threshold:   0.5,
+				minRequests: 4,
+				cooldown:    30 * time.Second,
+				now:         func() time.Time { return now },
+			}
+
+			for i, failed := range tt.outcomes {
+				now = start.Add(tt.elapsed[i])
+				breaker.Record(failed)
+			}
+
+			assert.Equal(t, !tt.wantOpen, breaker.Allow())
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     3 * time.Second,
+	}
+
+	assert.Equal(t, 500*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, time.Second, policy.Backoff(2))
+	assert.Equal(t, 2*time.Second, policy.Backoff(3))
+	assert.Equal(t, 3*time.Second, policy.Backoff(4))
+	assert.Equal(t, 3*time.Second, policy.Backoff(10))
+}
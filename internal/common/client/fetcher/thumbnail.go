@@ -119,7 +119,7 @@ func (t *ThumbnailFetcher) ProcessBatchThumbnails(requests *thumbnails.BatchThum
 			thumbnailResponses, err := t.roAPI.Thumbnails().GetBatchThumbnails(context.Background(), batchRequests.Build())
 			if err != nil {
 				t.logger.Error("Error fetching batch thumbnails",
-					zap.Error(err),
+					zap.Error(classifyError(err)),
 					zap.Int("batchStart", start))
 				return
 			}
@@ -8,9 +8,11 @@ import (
 
 	"github.com/jaxron/roapi.go/pkg/api"
 	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/common/progress"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 // ErrUserBanned indicates that the user is banned from Roblox.
@@ -23,6 +25,18 @@ type UserFetchResult struct {
 	Error error
 }
 
+// FetchInfosResult contains the outcome of fetching a batch of users.
+type FetchInfosResult struct {
+	// Infos holds the users that were fetched.
+	Infos []*Info
+	// Failed holds the reason each user could not be fetched. Banned users are
+	// reported with ErrUserBanned and API failures keep their classified error.
+	Failed map[uint64]error
+	// Skipped holds the users that were not fetched because the circuit breaker
+	// was open or the context was canceled. They should be requeued rather than dropped.
+	Skipped []uint64
+}
+
 // UserGroupFetchResult contains the result of fetching a user's groups.
 type UserGroupFetchResult struct {
 	Data  []*apiTypes.UserGroupRoles
@@ -41,20 +55,27 @@ type UserGamesFetchResult struct {
 	Error error
 }
 
+// UserOutfitsFetchResult contains the result of fetching a user's outfits.
+type UserOutfitsFetchResult struct {
+	Data  []apiTypes.Outfit
+	Error error
+}
+
 // Info combines user profile data with their group memberships and friend list.
 type Info struct {
-	ID             uint64                 `json:"id"`
-	Name           string                 `json:"name"`
-	DisplayName    string                 `json:"displayName"`
-	Description    string                 `json:"description"`
-	CreatedAt      time.Time              `json:"createdAt"`
-	Groups         *UserGroupFetchResult  `json:"groupIds"`
-	Friends        *UserFriendFetchResult `json:"friends"`
-	Games          *UserGamesFetchResult  `json:"games"`
-	FollowerCount  uint64                 `json:"followerCount"`
-	FollowingCount uint64                 `json:"followingCount"`
-	LastUpdated    time.Time              `json:"lastUpdated"`
-	LastPurgeCheck time.Time              `json:"lastPurgeCheck"`
+	ID             uint64                  `json:"id"`
+	Name           string                  `json:"name"`
+	DisplayName    string                  `json:"displayName"`
+	Description    string                  `json:"description"`
+	CreatedAt      time.Time               `json:"createdAt"`
+	Groups         *UserGroupFetchResult   `json:"groupIds"`
+	Friends        *UserFriendFetchResult  `json:"friends"`
+	Games          *UserGamesFetchResult   `json:"games"`
+	Outfits        *UserOutfitsFetchResult `json:"outfits"` // Only fetched if outfit analysis is enabled
+	FollowerCount  uint64                  `json:"followerCount"`
+	FollowingCount uint64                  `json:"followingCount"`
+	LastUpdated    time.Time               `json:"lastUpdated"`
+	LastPurgeCheck time.Time               `json:"lastPurgeCheck"`
 }
 
 // UserFetcher handles concurrent retrieval of user information from the Roblox API.
@@ -67,52 +88,114 @@ type UserFetcher struct {
 	outfitFetcher    *OutfitFetcher
 	thumbnailFetcher *ThumbnailFetcher
 	followFetcher    *FollowFetcher
+	retry            RetryPolicy
+	breaker          *CircuitBreaker
+	concurrency      int
+	fetchOutfits     bool
 }
 
 // NewUserFetcher creates a UserFetcher with the provided API client and logger.
+// User lookups and the per-user fetches share one retry policy and circuit breaker,
+// and at most the configured number of users are fetched at the same time.
 func NewUserFetcher(app *setup.App, logger *zap.Logger) *UserFetcher {
+	retry := NewRetryPolicy(app.Config.Common.UserFetch)
+	breaker := NewCircuitBreaker(app.Config.Common.UserFetch)
+
+	concurrency := app.Config.Common.UserFetch.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	outfitFetcher := NewOutfitFetcher(app.RoAPI, logger)
+	outfitFetcher.retry = retry
+	outfitFetcher.breaker = breaker
+
 	return &UserFetcher{
 		roAPI:            app.RoAPI,
 		logger:           logger,
-		groupFetcher:     NewGroupFetcher(app.RoAPI, logger),
+		groupFetcher:     NewGroupFetcher(app.RoAPI, nil, logger),
 		gameFetcher:      NewGameFetcher(app.RoAPI, logger),
 		friendFetcher:    NewFriendFetcher(app.RoAPI, logger),
-		outfitFetcher:    NewOutfitFetcher(app.RoAPI, logger),
+		outfitFetcher:    outfitFetcher,
 		thumbnailFetcher: NewThumbnailFetcher(app.RoAPI, logger),
 		followFetcher:    NewFollowFetcher(app.RoAPI, logger),
+		retry:            retry,
+		breaker:          breaker,
+		concurrency:      concurrency,
+		fetchOutfits:     app.Config.Common.GeminiAI.AnalyzeOutfits,
 	}
 }
 
 // FetchInfos retrieves complete user information for a batch of user IDs.
-func (u *UserFetcher) FetchInfos(userIDs []uint64) []*Info {
+func (u *UserFetcher) FetchInfos(ctx context.Context, userIDs []uint64) []*Info {
+	return u.FetchInfosWithErrors(ctx, userIDs).Infos
+}
+
+// FetchInfosWithErrors retrieves complete user information for a batch of user IDs,
+// along with the reason each missing user could not be fetched. Failed requests are
+// retried with backoff, and users that could not be requested because the circuit
+// breaker was open are reported separately so callers can requeue them.
+func (u *UserFetcher) FetchInfosWithErrors(ctx context.Context, userIDs []uint64) *FetchInfosResult {
 	var (
-		validUsers = make([]*Info, 0, len(userIDs))
-		mu         sync.Mutex
-		wg         sync.WaitGroup
+		result = &FetchInfosResult{
+			Infos:  make([]*Info, 0, len(userIDs)),
+			Failed: make(map[uint64]error),
+		}
+		mu sync.Mutex
 	)
 
-	// Process each user concurrently
+	// Process users concurrently up to the concurrency limit
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(u.concurrency)
 	for _, userID := range userIDs {
-		wg.Add(1)
-		go func(id uint64) {
-			defer wg.Done()
+		g.Go(func() error {
+			defer progress.IncrementProcessed(ctx, 1)
+
+			// Skip remaining users once the context is canceled
+			if err := ctx.Err(); err != nil {
+				mu.Lock()
+				u.addFailure(result, userID, err)
+				mu.Unlock()
+				return nil
+			}
 
 			// Fetch the user info
-			userInfo, err := u.roAPI.Users().GetUserByID(context.Background(), id)
+			userInfo, err := callWithRetry(ctx, u.retry, u.breaker,
+				func(ctx context.Context) (*apiTypes.UserByIDResponse, error) {
+					userInfo, err := u.roAPI.Users().GetUserByID(ctx, userID)
+					return userInfo, classifyError(err)
+				})
 			if err != nil {
-				u.logger.Error("Error fetching user info",
-					zap.Uint64("userID", id),
-					zap.Error(err))
-				return
+				mu.Lock()
+				u.addFailure(result, userID, err)
+				mu.Unlock()
+				return nil
 			}
 
 			// Skip banned users
 			if userInfo.IsBanned {
-				return
+				mu.Lock()
+				result.Failed[userID] = ErrUserBanned
+				mu.Unlock()
+				return nil
 			}
 
-			// Fetch groups, friends, and games concurrently
-			groups, friends, games := u.fetchUserData(id)
+			// Fetch groups, friends, games and outfits concurrently
+			groups, friends, games, outfits := u.fetchUserData(ctx, userID)
+
+			// Requeue users whose data was cut short by the circuit breaker or cancellation
+			errs := []error{groups.Error, friends.Error, games.Error}
+			if outfits != nil {
+				errs = append(errs, outfits.Error)
+			}
+			for _, err := range errs {
+				if isSkipped(err) {
+					mu.Lock()
+					u.addFailure(result, userID, err)
+					mu.Unlock()
+					return nil
+				}
+			}
 
 			// Add the user info to valid users
 			now := time.Now()
@@ -125,40 +208,89 @@ func (u *UserFetcher) FetchInfos(userIDs []uint64) []*Info {
 				Groups:         groups,
 				Friends:        friends,
 				Games:          games,
+				Outfits:        outfits,
 				LastUpdated:    now,
 				LastPurgeCheck: now,
 			}
 
 			mu.Lock()
-			validUsers = append(validUsers, info)
+			result.Infos = append(result.Infos, info)
 			mu.Unlock()
-		}(userID)
+			return nil
+		})
 	}
 
-	wg.Wait()
+	_ = g.Wait()
 
 	u.logger.Debug("Finished fetching user information",
 		zap.Int("totalRequested", len(userIDs)),
-		zap.Int("successfulFetches", len(validUsers)))
+		zap.Int("successfulFetches", len(result.Infos)),
+		zap.Int("failedFetches", len(result.Failed)),
+		zap.Int("skippedFetches", len(result.Skipped)))
+
+	return result
+}
+
+// addFailure records a user that could not be fetched in the result.
+// The caller must hold the lock guarding the result.
+func (u *UserFetcher) addFailure(result *FetchInfosResult, userID uint64, err error) {
+	if isSkipped(err) {
+		result.Skipped = append(result.Skipped, userID)
+		return
+	}
 
-	return validUsers
+	u.logger.Error("Error fetching user info",
+		zap.Uint64("userID", userID),
+		zap.Error(err))
+	result.Failed[userID] = err
+}
+
+// isSkipped reports whether a fetch was not completed because the circuit breaker
+// was open or the context was canceled, rather than because the request failed.
+func isSkipped(err error) bool {
+	return errors.Is(err, ErrCircuitOpen) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded)
 }
 
 // fetchUserData retrieves a user's group memberships, friend list, and games concurrently.
-func (u *UserFetcher) fetchUserData(userID uint64) (*UserGroupFetchResult, *UserFriendFetchResult, *UserGamesFetchResult) {
+// Outfits are fetched along with them if outfit analysis is enabled, and are nil otherwise.
+func (u *UserFetcher) fetchUserData(ctx context.Context, userID uint64) (
+	*UserGroupFetchResult, *UserFriendFetchResult, *UserGamesFetchResult, *UserOutfitsFetchResult,
+) {
 	var (
 		groupResult  *UserGroupFetchResult
 		friendResult *UserFriendFetchResult
 		gameResult   *UserGamesFetchResult
+		outfitResult *UserOutfitsFetchResult
 		wg           sync.WaitGroup
 	)
 
 	wg.Add(3)
 
+	// Fetch user's outfits
+	if u.fetchOutfits {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			outfits, err := callWithRetry(ctx, u.retry, u.breaker,
+				func(ctx context.Context) (*apiTypes.OutfitResponse, error) {
+					return u.outfitFetcher.GetUserOutfits(ctx, userID)
+				})
+			outfitResult = &UserOutfitsFetchResult{Error: err}
+			if err == nil {
+				outfitResult.Data = outfits.Data
+			}
+		}()
+	}
+
 	// Fetch user's groups
 	go func() {
 		defer wg.Done()
-		groups, err := u.groupFetcher.GetUserGroups(context.Background(), userID)
+		groups, err := callWithRetry(ctx, u.retry, u.breaker,
+			func(ctx context.Context) ([]*apiTypes.UserGroupRoles, error) {
+				return u.groupFetcher.GetUserGroups(ctx, userID)
+			})
 		groupResult = &UserGroupFetchResult{
 			Data:  groups,
 			Error: err,
@@ -168,7 +300,10 @@ func (u *UserFetcher) fetchUserData(userID uint64) (*UserGroupFetchResult, *User
 	// Fetch user's friends
 	go func() {
 		defer wg.Done()
-		fetchedFriends, err := u.friendFetcher.GetFriendsWithDetails(context.Background(), userID)
+		fetchedFriends, err := callWithRetry(ctx, u.retry, u.breaker,
+			func(ctx context.Context) ([]types.ExtendedFriend, error) {
+				return u.friendFetcher.GetFriendsWithDetails(ctx, userID)
+			})
 		friendResult = &UserFriendFetchResult{
 			Data:  fetchedFriends,
 			Error: err,
@@ -178,7 +313,10 @@ func (u *UserFetcher) fetchUserData(userID uint64) (*UserGroupFetchResult, *User
 	// Fetch user's games
 	go func() {
 		defer wg.Done()
-		games, err := u.gameFetcher.FetchGamesForUser(userID)
+		games, err := callWithRetry(ctx, u.retry, u.breaker,
+			func(_ context.Context) ([]*apiTypes.Game, error) {
+				return u.gameFetcher.FetchGamesForUser(userID)
+			})
 		gameResult = &UserGamesFetchResult{
 			Data:  games,
 			Error: err,
@@ -186,46 +324,208 @@ func (u *UserFetcher) fetchUserData(userID uint64) (*UserGroupFetchResult, *User
 	}()
 
 	wg.Wait()
-	return groupResult, friendResult, gameResult
+	return groupResult, friendResult, gameResult, outfitResult
+}
+
+// UserCheckResult contains the outcome of checking a batch of users.
+type UserCheckResult struct {
+	BannedIDs []uint64          // Users that are banned or whose accounts no longer exist
+	Names     map[uint64]string // Current usernames of the users that are not banned
 }
 
-// FetchBannedUsers checks which users from a batch of IDs are currently banned.
-// Returns a slice of banned user IDs.
-func (u *UserFetcher) FetchBannedUsers(userIDs []uint64) ([]uint64, error) {
+// CheckUsers checks which users from a batch of IDs are currently banned.
+// Users whose accounts no longer exist are included as well. The current
+// usernames of the other users are returned so renamed users can be updated.
+// Returns the context error if the check was canceled before every user was checked.
+func (u *UserFetcher) CheckUsers(ctx context.Context, userIDs []uint64) (*UserCheckResult, error) {
 	var (
-		results = make([]uint64, 0, len(userIDs))
-		mu      sync.Mutex
-		wg      sync.WaitGroup
+		result = &UserCheckResult{
+			BannedIDs: make([]uint64, 0, len(userIDs)),
+			Names:     make(map[uint64]string, len(userIDs)),
+		}
+		mu sync.Mutex
 	)
 
+	// Check users concurrently up to the concurrency limit
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(u.concurrency)
 	for _, userID := range userIDs {
-		wg.Add(1)
-		go func(id uint64) {
-			defer wg.Done()
+		g.Go(func() error {
+			defer progress.IncrementProcessed(gctx, 1)
+
+			// Skip remaining users once the context is canceled
+			if gctx.Err() != nil {
+				return nil
+			}
 
-			userInfo, err := u.roAPI.Users().GetUserByID(context.Background(), id)
+			userInfo, err := u.roAPI.Users().GetUserByID(gctx, userID)
 			if err != nil {
+				err = classifyError(err)
+
+				// Deleted accounts are handled the same way as banned ones
+				if errors.Is(err, ErrDeleted) {
+					mu.Lock()
+					result.BannedIDs = append(result.BannedIDs, userID)
+					mu.Unlock()
+					return nil
+				}
+
 				u.logger.Warn("Error fetching user info",
-					zap.Uint64("userID", id),
+					zap.Uint64("userID", userID),
 					zap.Error(err))
-				return
+				return nil
 			}
 
+			mu.Lock()
 			if userInfo.IsBanned {
-				mu.Lock()
-				results = append(results, userInfo.ID)
-				mu.Unlock()
+				result.BannedIDs = append(result.BannedIDs, userInfo.ID)
+			} else {
+				result.Names[userInfo.ID] = userInfo.Name
 			}
-		}(userID)
+			mu.Unlock()
+			return nil
+		})
 	}
 
-	wg.Wait()
+	_ = g.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
-	u.logger.Debug("Finished checking banned users",
+	u.logger.Debug("Finished checking users",
 		zap.Int("totalChecked", len(userIDs)),
-		zap.Int("bannedUsers", len(results)))
+		zap.Int("bannedUsers", len(result.BannedIDs)))
+
+	return result, nil
+}
+
+// UserRefreshResult contains the outcome of refreshing the counts of a batch of users.
+type UserRefreshResult struct {
+	Counts    map[uint64]*types.UserFollowCounts // Current counts of the users that are not banned
+	BannedIDs []uint64                           // Users that are banned or whose accounts no longer exist
+	Failed    map[uint64]error                   // Reason the counts of each remaining user could not be fetched
+}
+
+// RefreshCounts fetches the current follower and following counts and games of
+// a batch of users without rescanning them. Banned users and users whose accounts
+// no longer exist are reported in BannedIDs so they can be removed.
+// Returns the context error if the refresh was canceled before every user was fetched.
+func (u *UserFetcher) RefreshCounts(ctx context.Context, userIDs []uint64) (*UserRefreshResult, error) {
+	var (
+		result = &UserRefreshResult{
+			Counts:    make(map[uint64]*types.UserFollowCounts, len(userIDs)),
+			BannedIDs: make([]uint64, 0),
+			Failed:    make(map[uint64]error),
+		}
+		mu sync.Mutex
+	)
+
+	// Refresh users concurrently up to the concurrency limit
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(u.concurrency)
+	for _, userID := range userIDs {
+		g.Go(func() error {
+			// Skip remaining users once the context is canceled
+			if gctx.Err() != nil {
+				return nil
+			}
+
+			counts, err := u.fetchCounts(gctx, userID)
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case errors.Is(err, ErrUserBanned) || errors.Is(err, ErrDeleted):
+				result.BannedIDs = append(result.BannedIDs, userID)
+			case err != nil:
+				u.logger.Warn("Error refreshing user counts",
+					zap.Uint64("userID", userID),
+					zap.Error(err))
+				result.Failed[userID] = err
+			default:
+				result.Counts[userID] = counts
+			}
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	u.logger.Debug("Finished refreshing user counts",
+		zap.Int("totalUsers", len(userIDs)),
+		zap.Int("refreshedUsers", len(result.Counts)),
+		zap.Int("bannedUsers", len(result.BannedIDs)))
+
+	return result, nil
+}
+
+// fetchCounts fetches the follow counts and games of a single user after
+// checking that the user is not banned.
+func (u *UserFetcher) fetchCounts(ctx context.Context, userID uint64) (*types.UserFollowCounts, error) {
+	userInfo, err := callWithRetry(ctx, u.retry, u.breaker,
+		func(ctx context.Context) (*apiTypes.UserByIDResponse, error) {
+			userInfo, err := u.roAPI.Users().GetUserByID(ctx, userID)
+			return userInfo, classifyError(err)
+		})
+	if err != nil {
+		return nil, err
+	}
+	if userInfo.IsBanned {
+		return nil, ErrUserBanned
+	}
+
+	followerCount, err := callWithRetry(ctx, u.retry, u.breaker,
+		func(ctx context.Context) (uint64, error) {
+			count, err := u.roAPI.Friends().GetFollowerCount(ctx, userID)
+			return count, classifyError(err)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	followingCount, err := callWithRetry(ctx, u.retry, u.breaker,
+		func(ctx context.Context) (uint64, error) {
+			count, err := u.roAPI.Friends().GetFollowingCount(ctx, userID)
+			return count, classifyError(err)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := callWithRetry(ctx, u.retry, u.breaker,
+		func(_ context.Context) ([]*apiTypes.Game, error) {
+			return u.gameFetcher.FetchGamesForUser(userID)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UserFollowCounts{
+		FollowerCount:  followerCount,
+		FollowingCount: followingCount,
+		Games:          games,
+	}, nil
+}
+
+// FetchProfile retrieves the basic profile of a single user without any of the
+// additional data fetched by FetchInfos. Banned users are reported with ErrUserBanned,
+// and API failures are classified so deleted accounts can be told apart.
+func (u *UserFetcher) FetchProfile(ctx context.Context, userID uint64) (*apiTypes.UserByIDResponse, error) {
+	userInfo, err := u.roAPI.Users().GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, classifyError(err)
+	}
+
+	if userInfo.IsBanned {
+		return nil, ErrUserBanned
+	}
 
-	return results, nil
+	return userInfo, nil
 }
 
 // FetchAdditionalUserData concurrently fetches thumbnails, outfits, and follow counts for users.
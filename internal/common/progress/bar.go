@@ -7,19 +7,38 @@ import (
 	"time"
 )
 
+const (
+	// rateWindow is how far back processed item samples are kept for the rolling rate.
+	rateWindow = 30 * time.Second
+	// maxRateSamples caps the number of samples kept within the rate window.
+	maxRateSamples = 100
+)
+
+// rateSample is the number of items processed in the current phase at a point in time.
+type rateSample struct {
+	at        time.Time
+	processed int64
+}
+
 // Bar creates a visual progress indicator with percentage, step messages,
-// and estimated completion time. It uses mutex locking to handle concurrent updates.
+// the current phase with its item counts, and estimated completion time.
+// It uses mutex locking to handle concurrent updates.
 type Bar struct {
 	total            int64
 	current          int64
 	width            int
 	mu               sync.Mutex
 	lastUpdate       time.Time
+	lastLine         string
 	message          string
 	stepMessage      string
 	stepStart        time.Time
 	overallStart     time.Time
 	overallDurations []time.Duration
+	phase            string
+	totalItems       int64
+	processedItems   int64
+	samples          []rateSample
 }
 
 // NewBar creates a progress bar with a total value to track progress against,
@@ -90,36 +109,124 @@ func (b *Bar) SetStepMessage(message string, progress int64) {
 	}
 }
 
+// SetPhase updates the label of the phase the worker is in, such as "fetching users".
+// The item counts of the previous phase are cleared.
+func (b *Bar) SetPhase(phase string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.phase = phase
+	b.totalItems = 0
+	b.processedItems = 0
+	b.samples = nil
+}
+
+// SetTotalItems sets the number of items the current phase will process
+// and restarts the processed count and rolling rate.
+func (b *Bar) SetTotalItems(total int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.totalItems = total
+	b.processedItems = 0
+	b.samples = []rateSample{{at: time.Now()}}
+}
+
+// IncrementProcessed adds to the number of items processed in the current phase,
+// capping at the total items, and records a sample for the rolling rate.
+func (b *Bar) IncrementProcessed(n int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.processedItems += n
+	if b.totalItems > 0 && b.processedItems > b.totalItems {
+		b.processedItems = b.totalItems
+	}
+
+	// Drop samples outside the window, keeping two so a rate can still be measured
+	now := time.Now()
+	b.samples = append(b.samples, rateSample{at: now, processed: b.processedItems})
+	cutoff := now.Add(-rateWindow)
+	for len(b.samples) > 2 && (b.samples[0].at.Before(cutoff) || len(b.samples) > maxRateSamples) {
+		b.samples = b.samples[1:]
+	}
+}
+
 // String generates the visual progress bar with percentage complete,
-// current step message and duration, overall duration and ETA.
+// current step message and duration, phase item counts, overall duration and ETA.
 // Updates are rate-limited to 100ms to prevent screen flicker.
 func (b *Bar) String() string {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	// Rate limit updates to 100ms
-	if time.Since(b.lastUpdate) < 100*time.Millisecond {
-		return ""
+	// Rate limit updates to 100ms, repeating the last line in between
+	if time.Since(b.lastUpdate) < 100*time.Millisecond && b.lastLine != "" {
+		return b.lastLine
 	}
 	b.lastUpdate = time.Now()
+	b.lastLine = "\r" + b.line(b.lastUpdate)
+
+	return b.lastLine
+}
+
+// plainLine formats the progress bar as it is right now, without rate limiting.
+func (b *Bar) plainLine() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 
+	return b.line(time.Now())
+}
+
+// line formats the progress bar as a single line without any terminal control codes.
+// The caller must hold the lock.
+func (b *Bar) line(now time.Time) string {
 	// Calculate progress percentage and bar fill
-	percent := float64(b.current) / float64(b.total)
+	var percent float64
+	if b.total > 0 {
+		percent = float64(b.current) / float64(b.total)
+	}
 	filled := int(percent * float64(b.width))
 	bar := strings.Repeat("=", filled) + strings.Repeat("-", b.width-filled)
 
 	// Format durations
-	stepDuration := time.Since(b.stepStart).Round(time.Second)
-	overallDuration := time.Since(b.overallStart).Round(time.Second)
+	stepDuration := now.Sub(b.stepStart).Round(time.Second)
+	overallDuration := now.Sub(b.overallStart).Round(time.Second)
+
+	// Format the phase with its item counts
+	var phase string
+	switch {
+	case b.phase != "" && b.totalItems > 0:
+		phase = fmt.Sprintf(" | %s %d/%d", b.phase, b.processedItems, b.totalItems)
+	case b.totalItems > 0:
+		phase = fmt.Sprintf(" | %d/%d", b.processedItems, b.totalItems)
+	case b.phase != "":
+		phase = " | " + b.phase
+	}
 
-	return fmt.Sprintf("\r%s [%s] %.1f%% | %s (%s) | Overall: %s (ETA: %s)",
-		b.message, bar, percent*100, b.stepMessage, stepDuration,
-		overallDuration, b.calculateETA())
+	return fmt.Sprintf("%s [%s] %.1f%% | %s (%s)%s | Overall: %s (ETA: %s)",
+		b.message, bar, percent*100, b.stepMessage, stepDuration, phase,
+		overallDuration, b.calculateETA(now))
 }
 
-// calculateETA estimates completion time based on previous operation durations.
-// Returns "0s" if no duration history is available.
-func (b *Bar) calculateETA() string {
+// calculateETA estimates the remaining time of the current phase from the rolling
+// rate of processed items. Without item counts, it falls back to the average of
+// previous operation durations, returning "0s" if no duration history is available.
+func (b *Bar) calculateETA(now time.Time) string {
+	if b.totalItems > 0 {
+		remaining := b.totalItems - b.processedItems
+		if remaining <= 0 {
+			return "0s"
+		}
+
+		rate := rollingRate(b.samples, now)
+		if rate <= 0 {
+			return "?"
+		}
+
+		eta := time.Duration(float64(remaining) / rate * float64(time.Second))
+		return eta.Round(time.Second).String()
+	}
+
 	if len(b.overallDurations) == 0 {
 		return "0s"
 	}
@@ -134,6 +241,22 @@ func (b *Bar) calculateETA() string {
 	return eta.Round(time.Second).String()
 }
 
+// rollingRate returns the items processed per second between the oldest sample
+// and now, or 0 if there are not enough samples to measure a rate.
+func rollingRate(samples []rateSample, now time.Time) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+
+	first, last := samples[0], samples[len(samples)-1]
+	elapsed := now.Sub(first.at).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return float64(last.processed-first.processed) / elapsed
+}
+
 // Reset prepares the bar for a new operation by storing the previous operation's duration
 // and resetting progress counters and timers. It maintains a rolling window of past durations
 // for ETA calculation.
@@ -149,10 +272,15 @@ func (b *Bar) Reset() {
 	// Store current operation's duration
 	b.overallDurations = append(b.overallDurations, time.Since(b.overallStart))
 
-	// Reset counters and timers
+	// Reset counters, phase and timers
 	b.current = 0
 	b.lastUpdate = time.Now()
+	b.lastLine = ""
 	b.stepMessage = ""
+	b.phase = ""
+	b.totalItems = 0
+	b.processedItems = 0
+	b.samples = nil
 	b.stepStart = time.Now()
 	b.overallStart = time.Now()
 }
@@ -0,0 +1,143 @@
+package progress
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollingRate(t *testing.T) {
+	start := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		samples []rateSample
+		now     time.Time
+		want    float64
+	}{
+		{
+			name:    "no samples",
+			samples: nil,
+			now:     start,
+			want:    0,
+		},
+		{
+			name:    "single sample",
+			samples: []rateSample{{at: start}},
+			now:     start.Add(time.Second),
+			want:    0,
+		},
+		{
+			name: "steady rate",
+			samples: []rateSample{
+				{at: start},
+				{at: start.Add(5 * time.Second), processed: 25},
+				{at: start.Add(10 * time.Second), processed: 50},
+			},
+			now:  start.Add(10 * time.Second),
+			want: 5,
+		},
+		{
+			name: "rate drops while stalled",
+			samples: []rateSample{
+				{at: start},
+				{at: start.Add(10 * time.Second), processed: 50},
+			},
+			now:  start.Add(20 * time.Second),
+			want: 2.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, rollingRate(tt.samples, tt.now), 0.001)
+		})
+	}
+}
+
+func TestBarETA(t *testing.T) {
+	now := time.Now()
+
+	t.Run("from item rate", func(t *testing.T) {
+		b := NewBar(100, 10, "Worker 0")
+		b.SetPhase("fetching users")
+		b.SetTotalItems(100)
+		b.processedItems = 40
+		b.samples = []rateSample{{at: now.Add(-10 * time.Second)}, {at: now, processed: 40}}
+
+		assert.Equal(t, "15s", b.calculateETA(now))
+	})
+
+	t.Run("unknown rate", func(t *testing.T) {
+		b := NewBar(100, 10, "Worker 0")
+		b.SetTotalItems(100)
+
+		assert.Equal(t, "?", b.calculateETA(now))
+	})
+
+	t.Run("falls back to previous durations", func(t *testing.T) {
+		b := NewBar(100, 10, "Worker 0")
+		b.overallDurations = []time.Duration{10 * time.Second, 20 * time.Second}
+
+		assert.Equal(t, "15s", b.calculateETA(now))
+	})
+}
+
+func TestBarLine(t *testing.T) {
+	b := NewBar(100, 10, "Worker 0")
+	b.SetStepMessage("Fetching user info", 50)
+	b.SetPhase("fetching users")
+	b.SetTotalItems(200)
+	b.IncrementProcessed(20)
+
+	line := b.plainLine()
+	assert.Contains(t, line, "Worker 0 [=====-----] 50.0% | Fetching user info (0s) | fetching users 20/200 |")
+	assert.NotContains(t, line, "\r")
+
+	// Changing phase clears the item counts
+	b.SetPhase("saving")
+	assert.Contains(t, b.plainLine(), "| saving | Overall:")
+
+	// Resetting clears the phase
+	b.Reset()
+	assert.NotContains(t, b.plainLine(), "saving")
+}
+
+func TestIncrementProcessedFromContext(t *testing.T) {
+	b := NewBar(100, 10, "Worker 0")
+	b.SetTotalItems(3)
+
+	ctx := WithBar(context.Background(), b)
+	IncrementProcessed(ctx, 2)
+	IncrementProcessed(ctx, 5)
+	IncrementProcessed(context.Background(), 1)
+
+	assert.Equal(t, int64(3), b.processedItems, "processed items are capped at the total")
+}
+
+func TestRendererPlainOutput(t *testing.T) {
+	b := NewBar(100, 10, "Worker 0")
+	b.SetStepMessage("Processing users", 60)
+
+	var out bytes.Buffer
+	r := &Renderer{bars: []*Bar{b}, output: &out, width: 40, done: make(chan struct{})}
+	r.draw()
+	r.Stop()
+	r.draw()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], "Worker 0 [======----] 60.0% | Processing users")
+	assert.NotContains(t, lines[0], "\033")
+}
+
+func TestFitWidth(t *testing.T) {
+	assert.Equal(t, "short", fitWidth("short", 10))
+	assert.Equal(t, "abcd", fitWidth("abcdefgh", 5))
+	assert.Equal(t, "abcdefgh", fitWidth("abcdefgh", 0))
+}
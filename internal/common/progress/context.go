@@ -0,0 +1,20 @@
+package progress
+
+import "context"
+
+// barKey is the context key of the bar that processed items are reported to.
+type barKey struct{}
+
+// WithBar returns a context carrying the bar, so that code processing items
+// on behalf of a worker can report them without taking the bar as a parameter.
+func WithBar(ctx context.Context, bar *Bar) context.Context {
+	return context.WithValue(ctx, barKey{}, bar)
+}
+
+// IncrementProcessed adds to the processed items of the bar carried by the
+// context. It does nothing if the context carries no bar.
+func IncrementProcessed(ctx context.Context, n int64) {
+	if bar, ok := ctx.Value(barKey{}).(*Bar); ok && bar != nil {
+		bar.IncrementProcessed(n)
+	}
+}
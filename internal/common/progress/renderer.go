@@ -4,15 +4,30 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 )
 
+const (
+	// renderInterval is how often the bars are redrawn on a terminal.
+	renderInterval = 100 * time.Millisecond
+	// logInterval is how often the bars are written as log lines when the output is not a terminal.
+	logInterval = 30 * time.Second
+	// defaultLineWidth is the line width used when the terminal width is unknown.
+	defaultLineWidth = 120
+)
+
 // Renderer manages multiple progress bars by updating them concurrently
-// and handling terminal output synchronization.
+// and handling terminal output synchronization. When the output is not a
+// terminal, the bars are written as plain log lines instead.
 type Renderer struct {
 	bars   []*Bar
 	output io.Writer
+	tty    bool
+	width  int
+	drawn  bool
+	done   chan struct{}
 	mu     sync.Mutex
 }
 
@@ -22,40 +37,118 @@ func NewRenderer(bars []*Bar) *Renderer {
 	return &Renderer{
 		bars:   bars,
 		output: os.Stdout,
+		tty:    isTerminal(os.Stdout),
+		width:  terminalWidth(),
+		done:   make(chan struct{}),
 	}
 }
 
 // Render starts the rendering loop that updates all progress bars.
-// It clears previous lines and redraws bars every 100ms to show progress.
+// On a terminal, it clears previous lines and redraws bars every 100ms.
+// Otherwise, it writes the bars as log lines every 30 seconds.
 // The loop continues until Stop is called.
 func (r *Renderer) Render() {
-	for {
-		r.mu.Lock()
-
-		// Clear previous lines using ANSI escape codes
-		for range r.bars {
-			_, _ = fmt.Fprint(r.output, "\033[1A\033[K")
-		}
+	interval := renderInterval
+	if !r.tty {
+		interval = logInterval
+	}
 
-		// Draw updated progress bars
-		for _, bar := range r.bars {
-			_, _ = fmt.Fprintln(r.output, bar.String())
-		}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-		r.mu.Unlock()
+	for {
+		r.draw()
 
-		time.Sleep(100 * time.Millisecond)
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		}
 	}
 }
 
-// Stop cleans up the display by clearing the progress bars from the screen.
+// Stop ends the rendering loop and clears the progress bars from the screen.
 // This prevents leftover progress bars from cluttering the terminal.
 func (r *Renderer) Stop() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	select {
+	case <-r.done:
+		return
+	default:
+		close(r.done)
+	}
+
 	// Clear all progress bar lines one last time
+	if r.tty && r.drawn {
+		r.clear()
+	}
+}
+
+// draw writes the current state of every bar to the output.
+func (r *Renderer) draw() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	select {
+	case <-r.done:
+		return
+	default:
+	}
+
+	// Write plain log lines without any control codes
+	if !r.tty {
+		timestamp := time.Now().Format("2006/01/02 15:04:05")
+		for _, bar := range r.bars {
+			_, _ = fmt.Fprintf(r.output, "%s %s\n", timestamp, bar.plainLine())
+		}
+		return
+	}
+
+	// Clear previous lines using ANSI escape codes
+	if r.drawn {
+		r.clear()
+	}
+
+	// Draw updated progress bars, cut to the terminal width so lines never wrap
+	for _, bar := range r.bars {
+		_, _ = fmt.Fprintf(r.output, "\033[K%s\n", fitWidth(bar.plainLine(), r.width))
+	}
+	r.drawn = true
+}
+
+// clear moves the cursor up over the drawn bars, clearing each line.
+// The caller must hold the lock.
+func (r *Renderer) clear() {
 	for range r.bars {
 		_, _ = fmt.Fprint(r.output, "\033[1A\033[K")
 	}
 }
+
+// fitWidth cuts the line so it fits within the width without wrapping.
+func fitWidth(line string, width int) string {
+	runes := []rune(line)
+	if width <= 0 || len(runes) < width {
+		return line
+	}
+	return string(runes[:width-1])
+}
+
+// isTerminal reports whether the file is a character device such as a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// terminalWidth returns the terminal width from the COLUMNS environment variable,
+// falling back to a default width if it is not set.
+func terminalWidth() int {
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	return defaultLineWidth
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bytedance/sonic"
@@ -51,8 +52,26 @@ const (
 	// QueuePriorityPrefix namespaces Redis keys mapping items to priority levels.
 	// Keys are formatted as "queue_priority:{userID}".
 	QueuePriorityPrefix = "queue_priority:"
+	// QueueProcessedPrefix namespaces Redis sorted sets recording finished items per priority.
+	// Keys are formatted as "queue_processed:{priority}".
+	QueueProcessedPrefix = "queue_processed:"
+
+	// StatsWindow controls how far back finished items are counted in the queue stats.
+	StatsWindow = 1 * time.Hour
 )
 
+// Priorities lists the priority levels in the order they are processed.
+var Priorities = []string{HighPriority, NormalPriority, LowPriority}
+
+// PriorityStats summarizes the state of a single priority queue.
+type PriorityStats struct {
+	Priority          string        `json:"priority"`          // Priority level of the queue
+	Waiting           int           `json:"waiting"`           // Items currently in the queue
+	ProcessedLastHour int           `json:"processedLastHour"` // Items finished within the stats window
+	AvgWait           time.Duration `json:"avgWait"`           // Average wait of finished items with a known wait
+	KnownWaits        int           `json:"knownWaits"`        // Finished items whose wait is known
+}
+
 // Item encapsulates all metadata needed to process a queued task.
 type Item struct {
 	UserID      uint64    `json:"userId"`      // Target user for the queued operation
@@ -113,7 +132,7 @@ func (m *Manager) AddToQueue(ctx context.Context, item *Item) error {
 	}
 
 	// Log the activity
-	go m.db.Activity().Log(ctx, &types.ActivityLog{
+	m.db.Activity().Log(ctx, &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			UserID: item.UserID,
 		},
@@ -126,6 +145,49 @@ func (m *Manager) AddToQueue(ctx context.Context, item *Item) error {
 	return nil
 }
 
+// AddBatchToQueue adds multiple items to the queue without logging an activity entry
+// per item, leaving it to the caller to record a single composite entry. Items for
+// users that are already pending or processing are skipped. Returns the number of
+// items that were actually queued.
+func (m *Manager) AddBatchToQueue(ctx context.Context, items []*Item) (int, error) {
+	queued := 0
+	for _, item := range items {
+		// Skip users that already have an active queue entry
+		status, _, _, err := m.GetQueueInfo(ctx, item.UserID)
+		if err == nil && (status == StatusPending || status == StatusProcessing) {
+			continue
+		}
+
+		// Serialize item to JSON
+		itemJSON, err := sonic.Marshal(item)
+		if err != nil {
+			m.logger.Error("Failed to marshal queue item", zap.Error(err))
+			return queued, err
+		}
+
+		// Add to sorted set with score as timestamp
+		key := fmt.Sprintf("queue:%s_priority", item.Priority)
+		err = m.client.Do(ctx,
+			m.client.B().Zadd().Key(key).ScoreMember().ScoreMember(float64(item.AddedAt.Unix()), string(itemJSON)).Build(),
+		).Error()
+		if err != nil {
+			m.logger.Error("Failed to add item to queue", zap.Error(err))
+			return queued, err
+		}
+
+		// Store queue position information for status display
+		err = m.SetQueueInfo(ctx, item.UserID, StatusPending, item.Priority, m.GetQueueLength(ctx, item.Priority))
+		if err != nil {
+			m.logger.Error("Failed to update queue info", zap.Error(err))
+			return queued, err
+		}
+
+		queued++
+	}
+
+	return queued, nil
+}
+
 // GetQueueItems gets items from a queue with the given key and batch size.
 func (m *Manager) GetQueueItems(ctx context.Context, key string, batchSize int) ([]string, error) {
 	result, err := m.client.Do(ctx,
@@ -175,6 +237,88 @@ func (m *Manager) UpdateQueueItem(ctx context.Context, key string, score float64
 	return nil
 }
 
+// MarkProcessed records that an item left its queue so it is counted in the queue stats.
+// Items queued before they carried an added timestamp are recorded with an unknown wait.
+func (m *Manager) MarkProcessed(ctx context.Context, item *Item, processedAt time.Time) error {
+	key := QueueProcessedPrefix + item.Priority
+	cutoff := processedAt.Add(-StatsWindow).Unix()
+
+	cmds := rueidis.Commands{
+		m.client.B().Zadd().Key(key).ScoreMember().
+			ScoreMember(float64(processedAt.Unix()), processedMember(item, processedAt)).Build(),
+		m.client.B().Zremrangebyscore().Key(key).Min("-inf").Max("(" + strconv.FormatInt(cutoff, 10)).Build(),
+		m.client.B().Expire().Key(key).Seconds(int64(StatsWindow.Seconds())).Build(),
+	}
+	for _, resp := range m.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("failed to record processed item: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetStats returns the stats of each priority queue in processing order.
+func (m *Manager) GetStats(ctx context.Context) ([]*PriorityStats, error) {
+	since := strconv.FormatInt(time.Now().Add(-StatsWindow).Unix(), 10)
+
+	stats := make([]*PriorityStats, 0, len(Priorities))
+	for _, priority := range Priorities {
+		members, err := m.client.Do(ctx, m.client.B().Zrange().Key(QueueProcessedPrefix+priority).
+			Min(since).Max("+inf").Byscore().Build(),
+		).AsStrSlice()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get processed items: %w", err)
+		}
+
+		avgWait, knownWaits := averageWait(members)
+		stats = append(stats, &PriorityStats{
+			Priority:          priority,
+			Waiting:           m.GetQueueLength(ctx, priority),
+			ProcessedLastHour: len(members),
+			AvgWait:           avgWait,
+			KnownWaits:        knownWaits,
+		})
+	}
+
+	return stats, nil
+}
+
+// processedMember encodes a finished item as "{userID}:{processedAt}:{waitMillis}".
+// The processed time keeps members unique when a user is processed more than once,
+// and a wait of -1 marks an item without an added timestamp.
+func processedMember(item *Item, processedAt time.Time) string {
+	wait := int64(-1)
+	if !item.AddedAt.IsZero() && !processedAt.Before(item.AddedAt) {
+		wait = processedAt.Sub(item.AddedAt).Milliseconds()
+	}
+	return fmt.Sprintf("%d:%d:%d", item.UserID, processedAt.UnixNano(), wait)
+}
+
+// averageWait returns the average wait of the given processed members along with
+// the number of members whose wait is known. Unknown and malformed waits are skipped.
+func averageWait(members []string) (time.Duration, int) {
+	var total int64
+	known := 0
+	for _, member := range members {
+		idx := strings.LastIndexByte(member, ':')
+		if idx == -1 {
+			continue
+		}
+		wait, err := strconv.ParseInt(member[idx+1:], 10, 64)
+		if err != nil || wait < 0 {
+			continue
+		}
+		total += wait
+		known++
+	}
+
+	if known == 0 {
+		return 0, 0
+	}
+	return time.Duration(total/int64(known)) * time.Millisecond, known
+}
+
 // GetQueueInfo returns the queue status, position, and priority for a user.
 func (m *Manager) GetQueueInfo(ctx context.Context, userID uint64) (status, priority string, position int, err error) {
 	// Get status
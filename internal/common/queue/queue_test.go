@@ -0,0 +1,36 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessedMember(t *testing.T) {
+	processedAt := time.Unix(1700000000, 0)
+
+	item := &Item{UserID: 42, AddedAt: processedAt.Add(-90 * time.Second)}
+	assert.Equal(t, "42:1700000000000000000:90000", processedMember(item, processedAt))
+
+	// Items queued without a timestamp have an unknown wait
+	assert.Equal(t, "42:1700000000000000000:-1", processedMember(&Item{UserID: 42}, processedAt))
+
+	// Timestamps in the future are treated as unknown rather than negative
+	future := &Item{UserID: 42, AddedAt: processedAt.Add(time.Minute)}
+	assert.Equal(t, "42:1700000000000000000:-1", processedMember(future, processedAt))
+}
+
+func TestAverageWait(t *testing.T) {
+	avg, known := averageWait(nil)
+	assert.Zero(t, avg)
+	assert.Zero(t, known)
+
+	avg, known = averageWait([]string{"1:100:-1", "2:200:-1"})
+	assert.Zero(t, avg)
+	assert.Zero(t, known)
+
+	avg, known = averageWait([]string{"1:100:60000", "2:200:-1", "3:300:180000", "malformed"})
+	assert.Equal(t, 2*time.Minute, avg)
+	assert.Equal(t, 2, known)
+}
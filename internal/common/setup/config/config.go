@@ -23,7 +23,7 @@ const RepositoryVersion = "v1.0.0-beta.1"
 const (
 	CurrentCommonVersion = 1
 	CurrentBotVersion    = 1
-	CurrentWorkerVersion = 1
+	CurrentWorkerVersion = 2
 	CurrentAPIVersion    = 1
 )
 
@@ -41,6 +41,7 @@ type CommonConfig struct {
 	Debug          Debug          `koanf:"debug"`
 	CircuitBreaker CircuitBreaker `koanf:"circuit_breaker"`
 	Retry          Retry          `koanf:"retry"`
+	UserFetch      UserFetch      `koanf:"user_fetch"`
 	PostgreSQL     PostgreSQL     `koanf:"postgresql"`
 	Redis          Redis          `koanf:"redis"`
 	GeminiAI       GeminiAI       `koanf:"gemini_ai"`
@@ -50,8 +51,11 @@ type CommonConfig struct {
 
 // BotConfig contains Discord bot specific configuration.
 type BotConfig struct {
-	Version int     `koanf:"version"`
-	Discord Discord `koanf:"discord"`
+	Version  int      `koanf:"version"`
+	ReadOnly bool     `koanf:"read_only"` // Refuse all writes, e.g. during database maintenance
+	Discord  Discord  `koanf:"discord"`
+	Presence Presence `koanf:"presence"`
+	Appeals  Appeals  `koanf:"appeals"`
 }
 
 // WorkerConfig contains worker specific configuration.
@@ -59,6 +63,11 @@ type WorkerConfig struct {
 	Version         int             `koanf:"version"`
 	BatchSizes      BatchSizes      `koanf:"batch_sizes"`
 	ThresholdLimits ThresholdLimits `koanf:"threshold_limits"`
+	Stats           StatsConfig     `koanf:"stats"`
+	FriendCache     FriendCache     `koanf:"friend_cache"`
+	AIUsage         AIUsage         `koanf:"ai_usage"`
+	Retention       Retention       `koanf:"retention"`
+	RecentScans     RecentScans     `koanf:"recent_scans"`
 }
 
 // APIConfig contains RPC server specific configuration.
@@ -92,6 +101,18 @@ type Retry struct {
 	MaxDelay   int    `koanf:"max_delay"`   // Maximum retry delay in milliseconds
 }
 
+// UserFetch contains the concurrency, retry and circuit breaker configuration for user lookups.
+type UserFetch struct {
+	Concurrency        int     `koanf:"concurrency"`          // Maximum users fetched at the same time
+	MaxAttempts        int     `koanf:"max_attempts"`         // Maximum attempts per request
+	InitialBackoff     int     `koanf:"initial_backoff"`      // Delay before the first retry in milliseconds
+	MaxBackoff         int     `koanf:"max_backoff"`          // Maximum delay between retries in milliseconds
+	BreakerWindow      int     `koanf:"breaker_window"`       // Window the error rate is measured over in milliseconds
+	BreakerThreshold   float64 `koanf:"breaker_threshold"`    // Error rate (0-1) that opens the circuit
+	BreakerMinRequests int     `koanf:"breaker_min_requests"` // Minimum requests in the window before the circuit can open
+	BreakerCooldown    int     `koanf:"breaker_cooldown"`     // Time the circuit stays open in milliseconds
+}
+
 // PostgreSQL contains database connection configuration.
 type PostgreSQL struct {
 	Host         string `koanf:"host"`           // Database hostname
@@ -115,8 +136,11 @@ type Redis struct {
 
 // GeminiAI contains GeminiAI API configuration.
 type GeminiAI struct {
-	APIKey string `koanf:"api_key"` // API key for authentication
-	Model  string `koanf:"model"`   // Model version to use
+	APIKey         string  `koanf:"api_key"`         // API key for authentication
+	Model          string  `koanf:"model"`           // Model version to use
+	InputPrice     float64 `koanf:"input_price"`     // Estimated USD cost per million prompt tokens
+	OutputPrice    float64 `koanf:"output_price"`    // Estimated USD cost per million completion tokens
+	AnalyzeOutfits bool    `koanf:"analyze_outfits"` // Send outfit names for analysis, which adds a request per user and enlarges prompts
 }
 
 // Discord contains Discord bot configuration.
@@ -133,6 +157,20 @@ type ShardingConfig struct {
 	ShardIDs   string `koanf:"shard_ids"`   // Comma-separated list of shard IDs to manage (empty for all)
 }
 
+// Presence contains configuration for the bot's Discord activity status.
+type Presence struct {
+	Enabled  bool   `koanf:"enabled"`  // Show review queue stats as the bot's activity
+	Interval int    `koanf:"interval"` // Update interval in minutes
+	Template string `koanf:"template"` // Activity text with count placeholders
+}
+
+// Appeals contains configuration for appeal tickets.
+type Appeals struct {
+	UserMessageInterval      *int `koanf:"user_message_interval"`      // Minimum seconds between messages from appealing users (nil for the default)
+	ModeratorMessageInterval *int `koanf:"moderator_message_interval"` // Minimum seconds between messages from moderators (nil for the default)
+	MaxAttachmentSize        int  `koanf:"max_attachment_size"`        // Maximum size in megabytes of images attached to messages
+}
+
 // BatchSizes configures how many items to process in each batch.
 type BatchSizes struct {
 	FriendUsers     int `koanf:"friend_users"`     // Number of friends to process in one batch
@@ -153,6 +191,39 @@ type ThresholdLimits struct {
 	MinFlaggedOverride     int     `koanf:"min_flagged_override"`           // Flag group if flagged users count exceeds this value
 	MinFollowersForPopular uint64  `koanf:"min_followers_for_popular_user"` // Minimum follower count to consider a user "popular"
 	MaxGroupMembersTrack   uint64  `koanf:"max_group_members_track"`        // Maximum group members before skipping tracking
+	ThumbnailStaleDays     int     `koanf:"thumbnail_stale_days"`           // Days before a thumbnail is refreshed
+}
+
+// StatsConfig configures the statistics worker.
+type StatsConfig struct {
+	Interval         int         `koanf:"interval"`           // Snapshot interval in minutes
+	MaxBackfillHours int         `koanf:"max_backfill_hours"` // Longest gap in hourly stats to backfill on startup
+	TrendDays        int         `koanf:"trend_days"`         // Days of flagged content compared with the days before for trending terms
+	Server           StatsServer `koanf:"server"`             // Public statistics endpoint
+}
+
+// StatsServer configures the public statistics HTTP endpoint served by the stats worker.
+type StatsServer struct {
+	Enabled        bool   `koanf:"enabled"`         // Serve the public statistics endpoint
+	Address        string `koanf:"address"`         // Address to listen on, such as "0.0.0.0:8090"
+	Token          string `koanf:"token"`           // Bearer token required from clients
+	AllowAnonymous bool   `koanf:"allow_anonymous"` // Allow requests without the bearer token
+}
+
+// FriendCache configures the cache of existing users used by the friend checker.
+type FriendCache struct {
+	Size int `koanf:"size"` // Maximum number of cached users
+	TTL  int `koanf:"ttl"`  // Minutes a cached user is kept, which bounds how long status changes go unseen
+}
+
+// RecentScans configures how long workers skip users that were recently analyzed.
+type RecentScans struct {
+	TTL int `koanf:"ttl"` // Hours an analyzed user is skipped, or 0 to analyze users again right away
+}
+
+// AIUsage configures the reporting of AI token usage by workers.
+type AIUsage struct {
+	LogInterval int `koanf:"log_interval"` // Batches between usage summaries in the logs
 }
 
 // APIServer contains server configuration options.
@@ -280,3 +351,14 @@ func checkConfigVersion(name string, current, expected int) error { //nolint:unp
 	}
 	return nil
 }
+
+// Retention configures how many days each class of data is kept before it is purged.
+type Retention struct {
+	ClearedUsers    int `koanf:"cleared_users"`    // Days cleared users are kept, unless pinned
+	ClearedGroups   int `koanf:"cleared_groups"`   // Days cleared groups are kept
+	BannedUsers     int `koanf:"banned_users"`     // Days banned users are kept after being purged from the platform
+	DeletedUsers    int `koanf:"deleted_users"`    // Days users deleted by an admin can still be restored
+	ActivityLogs    int `koanf:"activity_logs"`    // Days activity logs are kept
+	HourlyStats     int `koanf:"hourly_stats"`     // Days hourly statistics are kept
+	ResolvedAppeals int `koanf:"resolved_appeals"` // Days resolved appeals and their messages are kept
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"github.com/robalyx/rotector/internal/common/storage/database/migrations"
 	"github.com/robalyx/rotector/internal/common/storage/redis"
+	"github.com/robalyx/rotector/internal/common/translator"
 	"github.com/uptrace/bun/migrate"
 	"go.uber.org/zap"
 	"google.golang.org/api/option"
@@ -27,19 +28,20 @@ import (
 // App bundles all core dependencies and services needed by the application.
 // Each field represents a major subsystem that needs initialization and cleanup.
 type App struct {
-	Config       *config.Config   // Application configuration
-	Logger       *zap.Logger      // Main application logger
-	DBLogger     *zap.Logger      // Database-specific logger
-	DB           *database.Client // Database connection pool
-	GenAIClient  *genai.Client    // Generative AI client
-	GenAIModel   string           // Generative AI model
-	RoAPI        *api.API         // RoAPI HTTP client
-	Queue        *queue.Manager   // Background job queue
-	RedisManager *redis.Manager   // Redis connection manager
-	StatusClient rueidis.Client   // Redis client for worker status reporting
-	LogManager   *logger.Manager  // Log management system
-	pprofServer  *pprofServer     // Debug HTTP server for pprof
-	proxies      *proxy.Proxies   // Proxy middleware
+	Config       *config.Config    // Application configuration
+	Logger       *zap.Logger       // Main application logger
+	DBLogger     *zap.Logger       // Database-specific logger
+	DB           *database.Client  // Database connection pool
+	GenAIClient  *genai.Client     // Generative AI client
+	GenAIModel   string            // Generative AI model
+	RoAPI        *api.API          // RoAPI HTTP client
+	Translations *translator.Cache // Shared cache of translated descriptions
+	Queue        *queue.Manager    // Background job queue
+	RedisManager *redis.Manager    // Redis connection manager
+	StatusClient rueidis.Client    // Redis client for worker status reporting
+	LogManager   *logger.Manager   // Log management system
+	pprofServer  *pprofServer      // Debug HTTP server for pprof
+	proxies      *proxy.Proxies    // Proxy middleware
 }
 
 // InitializeApp bootstraps all application dependencies in the correct order,
@@ -94,6 +96,11 @@ func InitializeApp(ctx context.Context, logDir string) (*App, error) {
 		return nil, err
 	}
 
+	// Translations are cached once so every menu shares the same entries
+	translations := translator.NewCache(
+		translator.New(roAPI.GetClient()), translator.DefaultCacheSize, translator.DefaultCacheTTL, logger,
+	)
+
 	// Queue manager creates its own Redis database for job storage
 	queueClient, err := redisManager.GetClient(redis.QueueDBIndex)
 	if err != nil {
@@ -128,6 +135,7 @@ func InitializeApp(ctx context.Context, logDir string) (*App, error) {
 		GenAIClient:  genAIClient,
 		GenAIModel:   cfg.Common.GeminiAI.Model,
 		RoAPI:        roAPI,
+		Translations: translations,
 		Queue:        queueManager,
 		RedisManager: redisManager,
 		StatusClient: statusClient,
@@ -11,6 +11,7 @@ import (
 	"github.com/robalyx/rotector/internal/common/setup/config"
 	"github.com/robalyx/rotector/internal/common/storage/database/migrations"
 	"github.com/robalyx/rotector/internal/common/storage/database/models"
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
@@ -22,6 +23,19 @@ import (
 // PartitionCount is the number of partitions for user and group tables.
 const PartitionCount = 8
 
+// These type assertions ensure that the models implement the store
+// interfaces used by the bot at compile time.
+var (
+	_ store.UserStore          = (*models.UserModel)(nil)
+	_ store.GroupStore         = (*models.GroupModel)(nil)
+	_ store.AppealStore        = (*models.AppealModel)(nil)
+	_ store.ActivityStore      = (*models.ActivityModel)(nil)
+	_ store.ActivityStore      = (*models.ActivityLogger)(nil)
+	_ store.SettingStore       = (*models.SettingModel)(nil)
+	_ store.NoteStore          = (*models.NoteModel)(nil)
+	_ store.SecondOpinionStore = (*models.SecondOpinionModel)(nil)
+)
+
 // sonicProvider is a JSON provider that uses Sonic for encoding and decoding.
 type sonicProvider struct{}
 
@@ -50,13 +64,20 @@ type Client struct {
 	groups     *models.GroupModel
 	stats      *models.StatsModel
 	settings   *models.SettingModel
-	activity   *models.ActivityModel
+	activity   *models.ActivityLogger
 	tracking   *models.TrackingModel
 	appeals    *models.AppealModel
 	bans       *models.BanModel
 	reputation *models.ReputationModel
 	votes      *models.VoteModel
 	views      *models.MaterializedViewModel
+	protected  *models.ProtectedModel
+	allowlist  *models.GroupAllowlistModel
+	trends     *models.TrendModel
+	shouts     *models.ShoutModel
+	aiUsage    *models.AIUsageModel
+	notes      *models.NoteModel
+	opinions   *models.SecondOpinionModel
 }
 
 // NewConnection establishes a new database connection and returns a Client instance.
@@ -106,28 +127,39 @@ func NewConnection(ctx context.Context, config *config.PostgreSQL, logger *zap.L
 	views := models.NewMaterializedView(db, logger)
 	votes := models.NewVote(db, activity, views, logger)
 	reputation := models.NewReputation(db, votes, logger)
+	protected := models.NewProtected(db, logger)
+	allowlist := models.NewGroupAllowlist(db, logger)
 	client := &Client{
 		db:         db,
 		logger:     logger,
-		users:      models.NewUser(db, tracking, activity, reputation, votes, logger),
-		groups:     models.NewGroup(db, activity, reputation, votes, logger),
+		users:      models.NewUser(db, tracking, activity, reputation, votes, protected, logger),
+		groups:     models.NewGroup(db, tracking, activity, reputation, votes, allowlist, logger),
 		stats:      models.NewStats(db, logger),
 		settings:   models.NewSetting(db, logger),
-		activity:   activity,
+		activity:   models.NewActivityLogger(activity, logger),
 		tracking:   tracking,
 		appeals:    models.NewAppeal(db, logger),
 		bans:       models.NewBan(db, logger),
 		reputation: reputation,
 		votes:      votes,
 		views:      views,
+		protected:  protected,
+		allowlist:  allowlist,
+		trends:     models.NewTrend(db, logger),
+		shouts:     models.NewShout(db, logger),
+		aiUsage:    models.NewAIUsage(db, logger),
+		notes:      models.NewNote(db, logger),
+		opinions:   models.NewSecondOpinion(db, logger),
 	}
 
 	logger.Info("Database connection established")
 	return client, nil
 }
 
-// Close gracefully shuts down the database connection.
+// Close writes the remaining activity logs and gracefully shuts down the database connection.
 func (c *Client) Close() error {
+	c.activity.Close()
+
 	err := c.db.Close()
 	if err != nil {
 		c.logger.Error("Failed to close database connection", zap.Error(err))
@@ -163,7 +195,8 @@ func (c *Client) Tracking() *models.TrackingModel {
 }
 
 // Activity returns the repository for logging user actions.
-func (c *Client) Activity() *models.ActivityModel {
+// Logged actions are buffered and written in batches.
+func (c *Client) Activity() *models.ActivityLogger {
 	return c.activity
 }
 
@@ -192,6 +225,41 @@ func (c *Client) Views() *models.MaterializedViewModel {
 	return c.views
 }
 
+// Protected returns the repository for protected account operations.
+func (c *Client) Protected() *models.ProtectedModel {
+	return c.protected
+}
+
+// GroupAllowlist returns the repository for allowlisted group operations.
+func (c *Client) GroupAllowlist() *models.GroupAllowlistModel {
+	return c.allowlist
+}
+
+// Trends returns the repository for trending term operations.
+func (c *Client) Trends() *models.TrendModel {
+	return c.trends
+}
+
+// Shouts returns the repository for group shout history operations.
+func (c *Client) Shouts() *models.ShoutModel {
+	return c.shouts
+}
+
+// AIUsage returns the repository for AI token usage operations.
+func (c *Client) AIUsage() *models.AIUsageModel {
+	return c.aiUsage
+}
+
+// Notes returns the repository for reviewer note operations.
+func (c *Client) Notes() *models.NoteModel {
+	return c.notes
+}
+
+// SecondOpinions returns the repository for second opinion request operations.
+func (c *Client) SecondOpinions() *models.SecondOpinionModel {
+	return c.opinions
+}
+
 // DB returns the underlying bun.DB instance.
 func (c *Client) DB() *bun.DB {
 	return c.db
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add reason presets column to user settings
+		_, err := db.NewRaw(`
+			ALTER TABLE user_settings
+			ADD COLUMN IF NOT EXISTS reason_presets jsonb NOT NULL DEFAULT '[]';
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add reason presets column: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Remove reason presets column from user settings
+		_, err := db.NewRaw(`
+			ALTER TABLE user_settings
+			DROP COLUMN IF EXISTS reason_presets;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop reason presets column: %w", err)
+		}
+
+		return nil
+	})
+}
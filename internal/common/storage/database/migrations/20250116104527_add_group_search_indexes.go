@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	tables := []string{"flagged_groups", "confirmed_groups", "cleared_groups", "locked_groups"}
+
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Enable trigram matching for substring searches
+		_, err := db.NewRaw(`CREATE EXTENSION IF NOT EXISTS pg_trgm;`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create pg_trgm extension: %w", err)
+		}
+
+		// Create trigram indexes on the searchable text of each group table
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				CREATE INDEX IF NOT EXISTS idx_%s_search_trgm
+				ON %s USING gin ((name || ' ' || description || ' ' || reason) gin_trgm_ops);
+			`, table, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create search index for %s: %w", table, err)
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop trigram search indexes
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`DROP INDEX IF EXISTS idx_%s_search_trgm;`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to drop search index for %s: %w", table, err)
+			}
+		}
+
+		return nil
+	})
+}
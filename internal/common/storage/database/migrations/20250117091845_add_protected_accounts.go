@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create protected accounts table
+		_, err := db.NewCreateTable().
+			Model((*types.ProtectedAccount)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create protected accounts table: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop protected accounts table
+		_, err := db.NewDropTable().
+			Model((*types.ProtectedAccount)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop protected accounts table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create trending terms table
+		_, err := db.NewCreateTable().
+			Model((*types.TrendingTerm)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create trending terms table: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop trending terms table
+		_, err := db.NewDropTable().
+			Model((*types.TrendingTerm)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop trending terms table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+// reviewOrderTables lists the tables the review queue selects from.
+var reviewOrderTables = []string{ //nolint:gochecknoglobals
+	"flagged_users", "confirmed_users", "cleared_users", "banned_users",
+	"flagged_groups", "confirmed_groups", "cleared_groups", "locked_groups",
+}
+
+// reviewOrderIndexes holds the index name suffix and columns matching
+// each deterministic review sort order, including its tie-breakers.
+var reviewOrderIndexes = []struct { //nolint:gochecknoglobals
+	suffix  string
+	columns string
+}{
+	{"confidence_order", "confidence DESC, last_updated ASC, id ASC"},
+	{"updated_order", "last_updated ASC, id ASC"},
+}
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create composite indexes covering the review sort orders
+		for _, table := range reviewOrderTables {
+			for _, index := range reviewOrderIndexes {
+				_, err := db.NewRaw(fmt.Sprintf(`
+					CREATE INDEX IF NOT EXISTS idx_%s_%s
+					ON %s (%s);
+				`, table, index.suffix, table, index.columns)).Exec(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to create %s index for %s: %w", index.suffix, table, err)
+				}
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop review order indexes
+		for _, table := range reviewOrderTables {
+			for _, index := range reviewOrderIndexes {
+				_, err := db.NewRaw(fmt.Sprintf(`DROP INDEX IF EXISTS idx_%s_%s;`, table, index.suffix)).Exec(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to drop %s index for %s: %w", index.suffix, table, err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	tables := []string{"flagged_users", "confirmed_users", "cleared_users", "banned_users"}
+
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add decisive content marks alongside the flagged content of each user table
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s ADD COLUMN IF NOT EXISTS decisive_content jsonb;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to add decisive_content to %s: %w", table, err)
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop decisive content columns
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s DROP COLUMN IF EXISTS decisive_content;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to drop decisive_content from %s: %w", table, err)
+			}
+		}
+
+		return nil
+	})
+}
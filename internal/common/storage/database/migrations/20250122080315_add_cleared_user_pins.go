@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add pinned flag to cleared users so reference cases survive the purge
+		_, err := db.NewRaw(`
+			ALTER TABLE cleared_users
+			ADD COLUMN IF NOT EXISTS pinned boolean NOT NULL DEFAULT false;
+
+			CREATE INDEX IF NOT EXISTS idx_cleared_users_pinned_cleared_at
+			ON cleared_users (pinned, cleared_at);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add pinned column to cleared users: %w", err)
+		}
+
+		// Add expiry digest channel and pin cap to bot settings
+		_, err = db.NewRaw(`
+			ALTER TABLE bot_settings
+			ADD COLUMN IF NOT EXISTS notification_channel_id bigint NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS max_pinned_users bigint NOT NULL DEFAULT 25;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add notification settings columns: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Remove expiry digest channel and pin cap from bot settings
+		_, err := db.NewRaw(`
+			ALTER TABLE bot_settings
+			DROP COLUMN IF EXISTS notification_channel_id,
+			DROP COLUMN IF EXISTS max_pinned_users;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop notification settings columns: %w", err)
+		}
+
+		// Remove pinned flag from cleared users
+		_, err = db.NewRaw(`
+			DROP INDEX IF EXISTS idx_cleared_users_pinned_cleared_at;
+
+			ALTER TABLE cleared_users
+			DROP COLUMN IF EXISTS pinned;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop pinned column from cleared users: %w", err)
+		}
+
+		return nil
+	})
+}
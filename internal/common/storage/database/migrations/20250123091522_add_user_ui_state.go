@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add persisted UI state column to user settings
+		_, err := db.NewRaw(`
+			ALTER TABLE user_settings
+			ADD COLUMN IF NOT EXISTS ui_state jsonb;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add UI state column: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Remove persisted UI state column from user settings
+		_, err := db.NewRaw(`
+			ALTER TABLE user_settings
+			DROP COLUMN IF EXISTS ui_state;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop UI state column: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add appeal cooldown days column to bot settings
+		_, err := db.NewRaw(`
+			ALTER TABLE bot_settings
+			ADD COLUMN IF NOT EXISTS appeal_cooldown_days bigint NOT NULL DEFAULT 7;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add appeal cooldown days column: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Remove appeal cooldown days column from bot settings
+		_, err := db.NewRaw(`
+			ALTER TABLE bot_settings
+			DROP COLUMN IF EXISTS appeal_cooldown_days;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop appeal cooldown days column: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add backfilled flag to hourly stats
+		_, err := db.NewRaw(`
+			ALTER TABLE hourly_stats
+			ADD COLUMN IF NOT EXISTS backfilled boolean NOT NULL DEFAULT false;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add backfilled column: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Remove backfilled flag from hourly stats
+		_, err := db.NewRaw(`
+			ALTER TABLE hourly_stats
+			DROP COLUMN IF EXISTS backfilled;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop backfilled column: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	tables := []string{"flagged_users", "confirmed_users", "cleared_users", "banned_users"}
+
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add reason category to each user table
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s ADD COLUMN IF NOT EXISTS reason_category bigint NOT NULL DEFAULT 0;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to add reason_category to %s: %w", table, err)
+			}
+
+			// Classify existing reasons from the markers the checkers add
+			_, err = db.NewRaw(fmt.Sprintf(`
+				UPDATE %s SET reason_category = CASE
+					WHEN (reason LIKE '%%Friend Analysis:%%')::int
+						+ (reason LIKE '%%Group Analysis:%%')::int
+						+ (reason LIKE '%%AI Analysis:%%')::int > 1 THEN 4
+					WHEN reason LIKE '%%Friend Analysis:%%' THEN 1
+					WHEN reason LIKE '%%Group Analysis:%%' THEN 2
+					WHEN reason LIKE '%%AI Analysis:%%' THEN 3
+					ELSE 0
+				END;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to classify reasons in %s: %w", table, err)
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop reason category columns
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s DROP COLUMN IF EXISTS reason_category;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to drop reason_category from %s: %w", table, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create group allowlist table
+		_, err := db.NewCreateTable().
+			Model((*types.AllowlistedGroup)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create group allowlist table: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop group allowlist table
+		_, err := db.NewDropTable().
+			Model((*types.AllowlistedGroup)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop group allowlist table: %w", err)
+		}
+
+		return nil
+	})
+}
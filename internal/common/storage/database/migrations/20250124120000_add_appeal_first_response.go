@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add first response time to appeals
+		_, err := db.NewRaw(`
+			ALTER TABLE appeals ADD COLUMN IF NOT EXISTS first_response_at TIMESTAMPTZ;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add first_response_at to appeals: %w", err)
+		}
+
+		// Backfill from the first moderator message or the moderator's decision
+		_, err = db.NewRaw(`
+			UPDATE appeals AS a SET first_response_at = LEAST(
+				(SELECT MIN(m.created_at) FROM appeal_messages AS m
+				 WHERE m.appeal_id = a.id AND m.role = ?),
+				CASE WHEN a.reviewer_id IS NOT NULL AND a.reviewer_id <> a.requester_id
+					THEN a.reviewed_at END
+			)
+			WHERE a.first_response_at IS NULL;
+		`, enum.MessageRoleModerator).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to backfill first_response_at: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop first response time
+		_, err := db.NewRaw(`
+			ALTER TABLE appeals DROP COLUMN IF EXISTS first_response_at;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop first_response_at from appeals: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add purge counts to hourly stats
+		_, err := db.NewRaw(`
+			ALTER TABLE hourly_stats
+			ADD COLUMN IF NOT EXISTS users_purged_cleared bigint NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS groups_purged_cleared bigint NOT NULL DEFAULT 0;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add purge count columns: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Remove purge counts from hourly stats
+		_, err := db.NewRaw(`
+			ALTER TABLE hourly_stats
+			DROP COLUMN IF EXISTS users_purged_cleared,
+			DROP COLUMN IF EXISTS groups_purged_cleared;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop purge count columns: %w", err)
+		}
+
+		return nil
+	})
+}
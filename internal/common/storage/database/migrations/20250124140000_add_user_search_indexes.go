@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	tables := []string{"flagged_users", "confirmed_users", "cleared_users", "banned_users"}
+	columns := []string{"name", "display_name"}
+
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Enable trigram matching for substring searches
+		_, err := db.NewRaw(`CREATE EXTENSION IF NOT EXISTS pg_trgm;`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create pg_trgm extension: %w", err)
+		}
+
+		// Create trigram indexes on the names of each user table
+		for _, table := range tables {
+			for _, column := range columns {
+				_, err := db.NewRaw(fmt.Sprintf(`
+					CREATE INDEX IF NOT EXISTS idx_%s_%s_trgm
+					ON %s USING gin (%s gin_trgm_ops);
+				`, table, column, table, column)).Exec(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to create %s search index for %s: %w", column, table, err)
+				}
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop trigram search indexes
+		for _, table := range tables {
+			for _, column := range columns {
+				_, err := db.NewRaw(fmt.Sprintf(`DROP INDEX IF EXISTS idx_%s_%s_trgm;`, table, column)).Exec(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to drop %s search index for %s: %w", column, table, err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,61 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	tables := []string{"user_reputations", "group_reputations"}
+	voteTables := []string{"user_votes", "group_votes"}
+
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		for _, table := range tables {
+			// Add decayed score, starting from the exact score until the next refresh
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s ADD COLUMN IF NOT EXISTS decayed_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to add decayed_score column to %s: %w", table, err)
+			}
+
+			_, err = db.NewRaw(fmt.Sprintf(`
+				UPDATE %s SET decayed_score = score WHERE decayed_score = 0;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to backfill decayed_score for %s: %w", table, err)
+			}
+
+		}
+
+		// Find the votes passing the decay age since the last refresh
+		for _, table := range voteTables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				CREATE INDEX IF NOT EXISTS idx_%s_voted_at ON %s (voted_at);
+			`, table, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create voted_at index for %s: %w", table, err)
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		for _, table := range voteTables {
+			_, err := db.NewRaw(fmt.Sprintf(`DROP INDEX IF EXISTS idx_%s_voted_at;`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to drop voted_at index for %s: %w", table, err)
+			}
+		}
+
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`ALTER TABLE %s DROP COLUMN IF EXISTS decayed_score;`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to drop decayed_score column from %s: %w", table, err)
+			}
+		}
+
+		return nil
+	})
+}
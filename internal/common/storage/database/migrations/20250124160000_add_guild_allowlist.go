@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add guild allowlist columns to bot settings
+		_, err := db.NewRaw(`
+			ALTER TABLE bot_settings
+			ADD COLUMN IF NOT EXISTS allowed_guild_ids bigint[],
+			ADD COLUMN IF NOT EXISTS allow_dms boolean NOT NULL DEFAULT true;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add guild allowlist columns: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Remove guild allowlist columns from bot settings
+		_, err := db.NewRaw(`
+			ALTER TABLE bot_settings
+			DROP COLUMN IF EXISTS allowed_guild_ids,
+			DROP COLUMN IF EXISTS allow_dms;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop guild allowlist columns: %w", err)
+		}
+
+		return nil
+	})
+}
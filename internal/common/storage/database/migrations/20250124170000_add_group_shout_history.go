@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create group shout history table, where the unique group and post time
+		// both deduplicates shouts and serves lookups of the latest shouts
+		_, err := db.NewCreateTable().
+			Model((*types.GroupShoutHistory)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create group shout history table: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop group shout history table
+		_, err := db.NewDropTable().
+			Model((*types.GroupShoutHistory)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop group shout history table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add review confidence threshold column to user settings
+		_, err := db.NewRaw(`
+			ALTER TABLE user_settings
+			ADD COLUMN IF NOT EXISTS review_confidence_threshold double precision NOT NULL DEFAULT 0;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add review confidence threshold column: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Remove review confidence threshold column from user settings
+		_, err := db.NewRaw(`
+			ALTER TABLE user_settings
+			DROP COLUMN IF EXISTS review_confidence_threshold;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop review confidence threshold column: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create AI usage statistics table
+		_, err := db.NewCreateTable().
+			Model((*types.AIUsageStats)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create AI usage stats table: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop AI usage statistics table
+		_, err := db.NewDropTable().
+			Model((*types.AIUsageStats)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop AI usage stats table: %w", err)
+		}
+
+		return nil
+	})
+}
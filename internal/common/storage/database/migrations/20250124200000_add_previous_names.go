@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	tables := []string{"flagged_users", "confirmed_users", "cleared_users", "banned_users"}
+
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add the earlier usernames of renamed users to each user table
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s ADD COLUMN IF NOT EXISTS previous_names text[];
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to add previous_names to %s: %w", table, err)
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop previous names columns
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s DROP COLUMN IF EXISTS previous_names;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to drop previous_names from %s: %w", table, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create appeal regressions table
+		_, err := db.NewCreateTable().
+			Model((*types.AppealRegression)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create appeal regressions table: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop appeal regressions table
+		_, err := db.NewDropTable().
+			Model((*types.AppealRegression)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop appeal regressions table: %w", err)
+		}
+
+		return nil
+	})
+}
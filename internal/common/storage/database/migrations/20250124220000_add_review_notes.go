@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create review notes table
+		_, err := db.NewCreateTable().
+			Model((*types.ReviewNote)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create review notes table: %w", err)
+		}
+
+		// Create index for looking up the latest notes of a target
+		_, err = db.NewRaw(`
+			CREATE INDEX IF NOT EXISTS idx_review_notes_target_created
+			ON review_notes (target_type, target_id, created_at DESC, id DESC);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create review notes index: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop review notes table
+		_, err := db.NewDropTable().
+			Model((*types.ReviewNote)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop review notes table: %w", err)
+		}
+
+		return nil
+	})
+}
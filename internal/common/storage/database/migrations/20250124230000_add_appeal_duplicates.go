@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Mark appeals for users that were appealed by several requesters
+		_, err := db.NewRaw(`
+			ALTER TABLE appeals ADD COLUMN IF NOT EXISTS has_duplicates BOOLEAN NOT NULL DEFAULT FALSE;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add has_duplicates to appeals: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop duplicate marker
+		_, err := db.NewRaw(`
+			ALTER TABLE appeals DROP COLUMN IF EXISTS has_duplicates;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop has_duplicates from appeals: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create second opinion requests table
+		_, err := db.NewCreateTable().
+			Model((*types.SecondOpinion)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create user second opinions table: %w", err)
+		}
+
+		// Create index for excluding the requester's users from their review queue
+		_, err = db.NewRaw(`
+			CREATE INDEX IF NOT EXISTS idx_user_second_opinions_requested_by
+			ON user_second_opinions (requested_by, user_id);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create user second opinions index: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop second opinion requests table
+		_, err := db.NewDropTable().
+			Model((*types.SecondOpinion)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop user second opinions table: %w", err)
+		}
+
+		return nil
+	})
+}
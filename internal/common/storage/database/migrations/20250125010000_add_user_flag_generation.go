@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	tables := []string{"flagged_users", "confirmed_users", "cleared_users", "banned_users"}
+
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add flag generation to each user table
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s ADD COLUMN IF NOT EXISTS flag_generation bigint NOT NULL DEFAULT 0;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to add flag_generation to %s: %w", table, err)
+			}
+
+			// Existing friend flags have an unknown origin, so treat them as first generation
+			_, err = db.NewRaw(fmt.Sprintf(`
+				UPDATE %s SET flag_generation = 1 WHERE reason_category = 1;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to set flag_generation in %s: %w", table, err)
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop flag generation columns
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s DROP COLUMN IF EXISTS flag_generation;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to drop flag_generation from %s: %w", table, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Mark messages that have an image attached
+		_, err := db.NewRaw(`
+			ALTER TABLE appeal_messages ADD COLUMN IF NOT EXISTS has_attachment boolean NOT NULL DEFAULT false;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add has_attachment to appeal_messages: %w", err)
+		}
+
+		// Create appeal attachments table
+		_, err = db.NewCreateTable().
+			Model((*types.AppealAttachment)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create appeal attachments table: %w", err)
+		}
+
+		// Attachments are purged together with their appeal
+		_, err = db.NewRaw(`
+			CREATE INDEX IF NOT EXISTS idx_appeal_attachments_appeal_id
+			ON appeal_attachments (appeal_id);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create appeal attachments index: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop appeal attachments table
+		_, err := db.NewDropTable().
+			Model((*types.AppealAttachment)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop appeal attachments table: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			ALTER TABLE appeal_messages DROP COLUMN IF EXISTS has_attachment;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop has_attachment from appeal_messages: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add leaderboard anonymity column to user settings
+		_, err := db.NewRaw(`
+			ALTER TABLE user_settings
+			ADD COLUMN IF NOT EXISTS leaderboard_anonymous boolean NOT NULL DEFAULT false;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add leaderboard anonymous column: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Remove leaderboard anonymity column from user settings
+		_, err := db.NewRaw(`
+			ALTER TABLE user_settings
+			DROP COLUMN IF EXISTS leaderboard_anonymous;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop leaderboard anonymous column: %w", err)
+		}
+
+		return nil
+	})
+}
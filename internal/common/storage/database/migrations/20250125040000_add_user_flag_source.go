@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	tables := []string{"flagged_users", "confirmed_users", "cleared_users", "banned_users"}
+
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Add flag source to each user table. Existing users keep the unknown source
+		// since the checker that flagged them was not recorded.
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s ADD COLUMN IF NOT EXISTS flag_source bigint NOT NULL DEFAULT 0;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to add flag_source to %s: %w", table, err)
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop flag source columns
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				ALTER TABLE %s DROP COLUMN IF EXISTS flag_source;
+			`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to drop flag_source from %s: %w", table, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create the archive of users deleted by an admin
+		_, err := db.NewCreateTable().
+			Model((*types.DeletedUser)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create deleted users table: %w", err)
+		}
+
+		// Deleted users are purged by age
+		_, err = db.NewRaw(`
+			CREATE INDEX IF NOT EXISTS idx_deleted_users_deleted_at
+			ON deleted_users (deleted_at);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create deleted users index: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop deleted users table
+		_, err := db.NewDropTable().
+			Model((*types.DeletedUser)(nil)).
+			IfExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop deleted users table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	tables := []string{"flagged_users", "confirmed_users", "cleared_users", "banned_users"}
+
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Join previous names with a separator no username contains, so a search
+		// cannot match across two names. array_to_string is only stable, so it is
+		// wrapped in an immutable function that indexes can use.
+		_, err := db.NewRaw(`
+			CREATE OR REPLACE FUNCTION previous_names_text(names text[]) RETURNS text
+			LANGUAGE sql IMMUTABLE PARALLEL SAFE
+			AS $$ SELECT array_to_string(names, E'\n') $$;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create previous_names_text function: %w", err)
+		}
+
+		// Create trigram indexes on the previous names of each user table
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`
+				CREATE INDEX IF NOT EXISTS idx_%s_previous_names_trgm
+				ON %s USING gin (previous_names_text(previous_names) gin_trgm_ops);
+			`, table, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create previous names search index for %s: %w", table, err)
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		// Drop previous names search indexes
+		for _, table := range tables {
+			_, err := db.NewRaw(fmt.Sprintf(`DROP INDEX IF EXISTS idx_%s_previous_names_trgm;`, table)).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to drop previous names search index for %s: %w", table, err)
+			}
+		}
+
+		_, err := db.NewRaw(`DROP FUNCTION IF EXISTS previous_names_text(text[]);`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop previous_names_text function: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/migrate"
+)
+
+// testDSNEnv names the environment variable holding the DSN of a disposable
+// TimescaleDB database used by the migration tests.
+const testDSNEnv = "ROTECTOR_TEST_POSTGRES_DSN"
+
+// openTestDB connects to the database named by testDSNEnv and applies every
+// migration, skipping the test if it is not set.
+func openTestDB(t *testing.T) *bun.DB {
+	t.Helper()
+
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+
+	ctx := context.Background()
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn))), pgdialect.New())
+	t.Cleanup(func() { _ = db.Close() })
+
+	migrator := migrate.NewMigrator(db, Migrations)
+	require.NoError(t, migrator.Init(ctx))
+	_, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestMigrationsAreIdempotent(t *testing.T) {
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+
+	ctx := context.Background()
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn))), pgdialect.New())
+	t.Cleanup(func() { _ = db.Close() })
+
+	// Apply all migrations through the migrator as a fresh install would
+	migrator := migrate.NewMigrator(db, Migrations)
+	require.NoError(t, migrator.Init(ctx))
+
+	group, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+	require.False(t, group.IsZero(), "migrations should be applied to an empty database")
+
+	// A second run finds nothing left to apply
+	group, err = migrator.Migrate(ctx)
+	require.NoError(t, err)
+	require.True(t, group.IsZero(), "no migrations should be pending after migrating")
+
+	// Running every migration again must not fail on the existing schema
+	for _, migration := range Migrations.Sorted() {
+		require.NoError(t, migration.Up(ctx, db), "migration %s is not idempotent", migration.Name)
+	}
+}
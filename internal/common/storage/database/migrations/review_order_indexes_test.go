@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewOrderIndexes(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+
+	tables := []string{
+		"flagged_users", "confirmed_users", "cleared_users", "banned_users",
+		"flagged_groups", "confirmed_groups", "cleared_groups", "locked_groups",
+	}
+
+	for _, table := range tables {
+		t.Run(table, func(t *testing.T) {
+			var defs []string
+			err := db.NewRaw(
+				"SELECT indexdef FROM pg_indexes WHERE schemaname = current_schema() AND tablename = ?", table,
+			).Scan(ctx, &defs)
+			require.NoError(t, err)
+
+			// Each deterministic review sort must be covered by an index with its tie-breakers
+			assert.True(t, hasIndexOn(defs, "(confidence DESC, last_updated, id)"),
+				"no index for the confidence order in %v", defs)
+			assert.True(t, hasIndexOn(defs, "(last_updated, id)"),
+				"no index for the last updated order in %v", defs)
+		})
+	}
+}
+
+// hasIndexOn reports whether one of the index definitions is a btree index on
+// exactly the given columns.
+func hasIndexOn(defs []string, columns string) bool {
+	for _, def := range defs {
+		if strings.HasSuffix(def, "USING btree "+columns) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
@@ -49,6 +50,20 @@ func (r *ActivityModel) Log(ctx context.Context, log *types.ActivityLog) {
 		zap.String("activityType", log.ActivityType.String()))
 }
 
+// LogBatch stores several moderator actions in a single insert.
+func (r *ActivityModel) LogBatch(ctx context.Context, logs []*types.ActivityLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	if _, err := r.db.NewInsert().Model(&logs).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to log activities: %w (count=%d)", err, len(logs))
+	}
+
+	r.logger.Debug("Logged activities", zap.Int("count", len(logs)))
+	return nil
+}
+
 // GetLogs retrieves activity logs based on filter criteria.
 func (r *ActivityModel) GetLogs(ctx context.Context, filter types.ActivityFilter, cursor *types.LogCursor, limit int) ([]*types.ActivityLog, *types.LogCursor, error) {
 	var logs []*types.ActivityLog
@@ -135,3 +150,134 @@ func (r *ActivityModel) GetRecentlyReviewedIDs(ctx context.Context, reviewerID u
 
 	return ids, nil
 }
+
+// CountReviewerActivities counts the activities of the given types that a reviewer performed since the given time.
+func (r *ActivityModel) CountReviewerActivities(
+	ctx context.Context, reviewerID uint64, activityTypes []enum.ActivityType, since time.Time,
+) (int, error) {
+	count, err := r.db.NewSelect().
+		Model((*types.ActivityLog)(nil)).
+		Where("reviewer_id = ?", reviewerID).
+		Where("activity_type IN (?)", bun.In(activityTypes)).
+		Where("activity_timestamp >= ?", since).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count reviewer activities: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetReviewerSummary counts the activities a reviewer performed between start and end,
+// grouped by activity type. Types the reviewer did not perform are left out.
+func (r *ActivityModel) GetReviewerSummary(
+	ctx context.Context, reviewerID uint64, start, end time.Time,
+) ([]*types.ActivityTypeCount, error) {
+	var counts []*types.ActivityTypeCount
+	err := reviewerSummaryQuery(r.db, reviewerID, start, end).Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer summary: %w (reviewerID=%d)", err, reviewerID)
+	}
+
+	return counts, nil
+}
+
+// reviewerSummaryQuery builds the query counting a reviewer's activities per type
+// within the half-open range [start, end), most frequent first.
+func reviewerSummaryQuery(db bun.IDB, reviewerID uint64, start, end time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.ActivityLog)(nil)).
+		Column("activity_type").
+		ColumnExpr("COUNT(*) AS count").
+		Where("reviewer_id = ?", reviewerID).
+		Where("activity_timestamp >= ?", start).
+		Where("activity_timestamp < ?", end).
+		Group("activity_type").
+		OrderExpr("count DESC, activity_type ASC")
+}
+
+// GetDecisionDurations calculates the median and 90th percentile review times of
+// confirm, clear and skip decisions made since the given time. Results are grouped
+// by reason category and by confidence decile. Durations above the maximum are
+// excluded since they are most likely abandoned sessions.
+func (r *ActivityModel) GetDecisionDurations(ctx context.Context, since time.Time) (*types.DecisionDurations, error) {
+	activityTypes := bun.In([]enum.ActivityType{
+		enum.ActivityTypeUserConfirmed,
+		enum.ActivityTypeUserConfirmedCustom,
+		enum.ActivityTypeUserCleared,
+		enum.ActivityTypeUserSkipped,
+		enum.ActivityTypeGroupConfirmed,
+		enum.ActivityTypeGroupConfirmedCustom,
+		enum.ActivityTypeGroupCleared,
+		enum.ActivityTypeGroupSkipped,
+	})
+	maxSeconds := types.MaxDecisionDuration.Seconds()
+
+	// Select the decisions that have review time recorded
+	decisions := r.db.NewSelect().
+		TableExpr("activity_logs").
+		ColumnExpr("(details->>?)::float AS seconds", types.DetailKeyReviewSeconds).
+		ColumnExpr("COALESCE(details->>?, 'Unknown') AS category", types.DetailKeyReasonCategory).
+		ColumnExpr("LEAST(FLOOR(COALESCE((details->>?)::float, 0) * 10), 9)::int AS decile", types.DetailKeyConfidence).
+		Where("activity_timestamp >= ?", since).
+		Where("activity_type IN (?)", activityTypes).
+		Where("details->>? IS NOT NULL", types.DetailKeyReviewSeconds).
+		Where("(details->>?)::float <= ?", types.DetailKeyReviewSeconds, maxSeconds)
+
+	var result types.DecisionDurations
+
+	// Aggregate by reason category
+	err := r.db.NewSelect().
+		With("decisions", decisions).
+		TableExpr("decisions").
+		ColumnExpr("category AS label").
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds) AS median_seconds").
+		ColumnExpr("percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds) AS p90_seconds").
+		Group("category").
+		Order("category").
+		Scan(ctx, &result.ByCategory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get decision durations by category: %w", err)
+	}
+
+	// Aggregate by confidence decile
+	err = r.db.NewSelect().
+		With("decisions", decisions).
+		TableExpr("decisions").
+		ColumnExpr("to_char(decile / 10.0, 'FM0.0') || '-' || to_char((decile + 1) / 10.0, 'FM0.0') AS label").
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds) AS median_seconds").
+		ColumnExpr("percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds) AS p90_seconds").
+		Group("decile").
+		Order("decile").
+		Scan(ctx, &result.ByConfidence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get decision durations by confidence: %w", err)
+	}
+
+	return &result, nil
+}
+
+// PurgeOldLogs drops the chunks of the activity_logs hypertable that only hold logs
+// older than the cutoff date. Dropping whole chunks is much cheaper than deleting rows,
+// so logs in a chunk that straddles the cutoff are kept until the whole chunk expires.
+// Returns the number of chunks dropped.
+func (r *ActivityModel) PurgeOldLogs(ctx context.Context, cutoffDate time.Time) (int, error) {
+	var chunks []string
+	if err := dropActivityChunksQuery(r.db, cutoffDate).Scan(ctx, &chunks); err != nil {
+		return 0, fmt.Errorf("failed to drop activity log chunks: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
+	}
+
+	r.logger.Debug("Dropped old activity log chunks",
+		zap.Int("chunks", len(chunks)),
+		zap.Time("cutoffDate", cutoffDate))
+
+	return len(chunks), nil
+}
+
+// dropActivityChunksQuery builds the query dropping the activity log chunks older
+// than the cutoff date, returning the name of each dropped chunk.
+func dropActivityChunksQuery(db bun.IDB, cutoffDate time.Time) *bun.RawQuery {
+	return db.NewRaw("SELECT drop_chunks('activity_logs', older_than => ?::timestamptz)", cutoffDate)
+}
@@ -0,0 +1,220 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.uber.org/zap"
+)
+
+const (
+	// ActivityBatchSize is the most activities written in a single insert.
+	ActivityBatchSize = 50
+	// ActivityFlushInterval is the longest an activity waits in the buffer before it is written.
+	ActivityFlushInterval = 2 * time.Second
+	// ActivityBufferSize is the number of activities that can wait to be written
+	// before Log blocks.
+	ActivityBufferSize = 1000
+
+	// activityWriteAttempts is the number of times a batch is written before it is dropped.
+	activityWriteAttempts = 3
+	// activityRetryDelay is the delay before the first retry, doubled for each retry after.
+	activityRetryDelay = 250 * time.Millisecond
+	// activityWriteTimeout is how long a single batch insert may take.
+	activityWriteTimeout = 10 * time.Second
+)
+
+// activityBatchWriter writes activities to the database.
+type activityBatchWriter interface {
+	LogBatch(ctx context.Context, logs []*types.ActivityLog) error
+}
+
+// ActivityLogger buffers moderator actions and writes them in batches from a
+// background goroutine, so that logging an action costs a channel send instead
+// of an insert. All other activity operations go to the embedded ActivityModel.
+type ActivityLogger struct {
+	*ActivityModel
+
+	writer     activityBatchWriter
+	entries    chan *types.ActivityLog
+	flushes    chan chan struct{}
+	stop       chan struct{}
+	done       chan struct{}
+	mu         sync.RWMutex
+	closed     bool
+	batchSize  int
+	interval   time.Duration
+	retryDelay time.Duration
+	logger     *zap.Logger
+}
+
+// NewActivityLogger creates an ActivityLogger writing through the given model
+// and starts its background flusher. Close must be called to write the
+// remaining activities on shutdown.
+func NewActivityLogger(model *ActivityModel, logger *zap.Logger) *ActivityLogger {
+	return newActivityLogger(model, model, ActivityBatchSize, ActivityFlushInterval, activityRetryDelay, logger)
+}
+
+// newActivityLogger creates an ActivityLogger with the given writer and batching settings.
+func newActivityLogger(
+	model *ActivityModel, writer activityBatchWriter, batchSize int, interval, retryDelay time.Duration, logger *zap.Logger,
+) *ActivityLogger {
+	l := &ActivityLogger{
+		ActivityModel: model,
+		writer:        writer,
+		entries:       make(chan *types.ActivityLog, ActivityBufferSize),
+		flushes:       make(chan chan struct{}),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+		batchSize:     batchSize,
+		interval:      interval,
+		retryDelay:    retryDelay,
+		logger:        logger.Named("activity_logger"),
+	}
+	go l.run()
+	return l
+}
+
+// Log queues a moderator action to be written with the next batch. It only
+// blocks if the buffer is full. Activities logged after Close are written directly.
+func (l *ActivityLogger) Log(_ context.Context, log *types.ActivityLog) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.closed {
+		l.write([]*types.ActivityLog{log})
+		return
+	}
+	l.entries <- log
+}
+
+// Flush writes all queued activities and waits until they are written.
+func (l *ActivityLogger) Flush() {
+	ack := make(chan struct{})
+	select {
+	case l.flushes <- ack:
+		<-ack
+	case <-l.done:
+	}
+}
+
+// Close writes all queued activities and stops the background flusher.
+func (l *ActivityLogger) Close() {
+	// Wait for pending sends so that no activity is left in the buffer
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return
+	}
+	l.closed = true
+	l.mu.Unlock()
+
+	close(l.stop)
+	<-l.done
+}
+
+// run collects queued activities and writes them once a batch is full, the
+// flush interval passes, or a flush is requested.
+func (l *ActivityLogger) run() {
+	defer close(l.done)
+
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	batch := make([]*types.ActivityLog, 0, l.batchSize)
+	for {
+		select {
+		case log := <-l.entries:
+			batch = append(batch, log)
+			if len(batch) >= l.batchSize {
+				batch = l.writeAndReset(batch)
+			}
+		case <-ticker.C:
+			batch = l.writeAndReset(batch)
+		case ack := <-l.flushes:
+			batch = l.writeAndReset(l.drain(batch))
+			close(ack)
+		case <-l.stop:
+			l.writeAndReset(l.drain(batch))
+			return
+		}
+	}
+}
+
+// drain moves all queued activities into the batch, writing each batch that fills up.
+func (l *ActivityLogger) drain(batch []*types.ActivityLog) []*types.ActivityLog {
+	for {
+		select {
+		case log := <-l.entries:
+			batch = append(batch, log)
+			if len(batch) >= l.batchSize {
+				batch = l.writeAndReset(batch)
+			}
+		default:
+			return batch
+		}
+	}
+}
+
+// writeAndReset writes the batch if it is not empty and returns a new empty batch.
+func (l *ActivityLogger) writeAndReset(batch []*types.ActivityLog) []*types.ActivityLog {
+	if len(batch) == 0 {
+		return batch
+	}
+	l.write(batch)
+	return make([]*types.ActivityLog, 0, l.batchSize)
+}
+
+// write inserts the batch, retrying transient failures. If the batch is rejected
+// by the database, its activities are written one by one so that a single bad
+// activity does not drop the others.
+func (l *ActivityLogger) write(batch []*types.ActivityLog) {
+	var err error
+	for attempt := range activityWriteAttempts {
+		if attempt > 0 {
+			time.Sleep(l.retryDelay << (attempt - 1))
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), activityWriteTimeout)
+		err = l.writer.LogBatch(ctx, batch)
+		cancel()
+		if err == nil {
+			return
+		}
+		if isRejectedActivity(err) {
+			break
+		}
+	}
+
+	if isRejectedActivity(err) && len(batch) > 1 {
+		for _, log := range batch {
+			l.write([]*types.ActivityLog{log})
+		}
+		return
+	}
+
+	l.logger.Error("Failed to log activities", zap.Error(err), zap.Int("count", len(batch)))
+	for _, log := range batch {
+		l.logger.Debug("Dropped activity",
+			zap.Uint64("userID", log.ActivityTarget.UserID),
+			zap.Uint64("groupID", log.ActivityTarget.GroupID),
+			zap.Uint64("reviewerID", log.ReviewerID),
+			zap.String("activityType", log.ActivityType.String()))
+	}
+}
+
+// isRejectedActivity checks if the database rejected the data itself, which
+// retrying cannot fix, such as invalid values or constraint violations.
+func isRejectedActivity(err error) bool {
+	var pgErr pgdriver.Error
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	code := pgErr.Field('C')
+	return strings.HasPrefix(code, "22") || strings.HasPrefix(code, "23")
+}
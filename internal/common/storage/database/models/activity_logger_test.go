@@ -0,0 +1,171 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.uber.org/zap"
+)
+
+// fakeBatchWriter records the batches written by an ActivityLogger.
+type fakeBatchWriter struct {
+	mu       sync.Mutex
+	batches  [][]*types.ActivityLog
+	failures []error // Returned by the next calls to LogBatch in order
+}
+
+func (w *fakeBatchWriter) LogBatch(_ context.Context, logs []*types.ActivityLog) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.failures) > 0 {
+		err := w.failures[0]
+		w.failures = w.failures[1:]
+		if err != nil {
+			return err
+		}
+	}
+
+	w.batches = append(w.batches, logs)
+	return nil
+}
+
+// sizes returns the size of each written batch in order.
+func (w *fakeBatchWriter) sizes() []int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	sizes := make([]int, 0, len(w.batches))
+	for _, batch := range w.batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+// written returns the user IDs of all written activities in order.
+func (w *fakeBatchWriter) written() []uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var ids []uint64
+	for _, batch := range w.batches {
+		for _, log := range batch {
+			ids = append(ids, log.ActivityTarget.UserID)
+		}
+	}
+	return ids
+}
+
+// newTestActivityLogger creates an ActivityLogger that only flushes on its own after an hour.
+func newTestActivityLogger(writer *fakeBatchWriter, batchSize int) *ActivityLogger {
+	return newActivityLogger(nil, writer, batchSize, time.Hour, time.Millisecond, zap.NewNop())
+}
+
+func logActivities(l *ActivityLogger, count int) []uint64 {
+	ids := make([]uint64, 0, count)
+	for i := range count {
+		id := uint64(i + 1)
+		l.Log(context.Background(), &types.ActivityLog{ActivityTarget: types.ActivityTarget{UserID: id}})
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestActivityLoggerSplitsBatches(t *testing.T) {
+	writer := &fakeBatchWriter{}
+	l := newTestActivityLogger(writer, 50)
+	defer l.Close()
+
+	ids := logActivities(l, 120)
+	l.Flush()
+
+	assert.Equal(t, []int{50, 50, 20}, writer.sizes())
+	assert.Equal(t, ids, writer.written())
+}
+
+func TestActivityLoggerFlushesOnClose(t *testing.T) {
+	writer := &fakeBatchWriter{}
+	l := newTestActivityLogger(writer, 50)
+
+	ids := logActivities(l, 7)
+	l.Close()
+
+	assert.Equal(t, []int{7}, writer.sizes())
+	assert.Equal(t, ids, writer.written())
+
+	// Activities logged after closing are written straight away
+	l.Log(context.Background(), &types.ActivityLog{ActivityTarget: types.ActivityTarget{UserID: 99}})
+	assert.Equal(t, []int{7, 1}, writer.sizes())
+
+	// Closing and flushing again are no-ops
+	l.Close()
+	l.Flush()
+}
+
+func TestActivityLoggerFlushesOnInterval(t *testing.T) {
+	writer := &fakeBatchWriter{}
+	l := newActivityLogger(nil, writer, 50, 10*time.Millisecond, time.Millisecond, zap.NewNop())
+	defer l.Close()
+
+	logActivities(l, 3)
+
+	assert.Eventually(t, func() bool {
+		return len(writer.written()) == 3
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestActivityLoggerConcurrentLogs(t *testing.T) {
+	writer := &fakeBatchWriter{}
+	l := newTestActivityLogger(writer, 50)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logActivities(l, 100)
+		}()
+	}
+	wg.Wait()
+	l.Close()
+
+	assert.Len(t, writer.written(), 1000)
+	for _, size := range writer.sizes() {
+		assert.LessOrEqual(t, size, 50)
+	}
+}
+
+func TestActivityLoggerRetries(t *testing.T) {
+	t.Run("transient failure is retried", func(t *testing.T) {
+		writer := &fakeBatchWriter{failures: []error{errors.New("connection reset"), nil}}
+		l := newTestActivityLogger(writer, 50)
+
+		ids := logActivities(l, 3)
+		l.Close()
+
+		assert.Equal(t, ids, writer.written())
+	})
+
+	t.Run("batch is dropped after the last attempt", func(t *testing.T) {
+		failure := errors.New("connection reset")
+		writer := &fakeBatchWriter{failures: []error{failure, failure, failure}}
+		l := newTestActivityLogger(writer, 50)
+
+		logActivities(l, 3)
+		l.Close()
+
+		assert.Empty(t, writer.written())
+	})
+}
+
+func TestIsRejectedActivity(t *testing.T) {
+	assert.False(t, isRejectedActivity(errors.New("connection reset")))
+	assert.False(t, isRejectedActivity(context.DeadlineExceeded))
+	assert.False(t, isRejectedActivity(pgdriver.Error{}), "errors without a code can be retried")
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestReviewerSummaryQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+
+	query := reviewerSummaryQuery(db, 123, start, end).String()
+
+	assert.Contains(t, query, `FROM "activity_logs"`)
+	assert.Contains(t, query, "COUNT(*) AS count")
+	assert.Contains(t, query, "reviewer_id = 123")
+	assert.Contains(t, query, `activity_timestamp >= '2025-01-01 00:00:00+00:00'`)
+	assert.Contains(t, query, `activity_timestamp < '2025-01-02 00:00:00+00:00'`)
+	assert.Contains(t, query, `GROUP BY "activity_type"`)
+}
+
+func TestDropActivityChunksQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := dropActivityChunksQuery(db, cutoff).String()
+
+	assert.Equal(t,
+		`SELECT drop_chunks('activity_logs', older_than => '2025-01-01 00:00:00+00:00'::timestamptz)`, query)
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// GroupAllowlistModel handles database operations for groups that must never be flagged.
+type GroupAllowlistModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewGroupAllowlist creates a new GroupAllowlistModel instance.
+func NewGroupAllowlist(db *bun.DB, logger *zap.Logger) *GroupAllowlistModel {
+	return &GroupAllowlistModel{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Add creates or updates an allowlisted group record.
+func (m *GroupAllowlistModel) Add(ctx context.Context, group *types.AllowlistedGroup) error {
+	_, err := m.db.NewInsert().
+		Model(group).
+		On("CONFLICT (id) DO UPDATE").
+		Set("reason = EXCLUDED.reason").
+		Set("added_by = EXCLUDED.added_by").
+		Set("added_at = EXCLUDED.added_at").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to add allowlisted group: %w", err)
+	}
+	return nil
+}
+
+// Remove removes an allowlisted group record.
+// Returns true if a group was removed, false if the group wasn't allowlisted.
+func (m *GroupAllowlistModel) Remove(ctx context.Context, groupID uint64) (bool, error) {
+	result, err := m.db.NewDelete().
+		Model((*types.AllowlistedGroup)(nil)).
+		Where("id = ?", groupID).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove allowlisted group: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+// List retrieves all allowlisted groups ordered by when they were added.
+func (m *GroupAllowlistModel) List(ctx context.Context) ([]*types.AllowlistedGroup, error) {
+	var groups []*types.AllowlistedGroup
+	err := m.db.NewSelect().
+		Model(&groups).
+		Order("added_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get allowlisted groups: %w", err)
+	}
+	return groups, nil
+}
+
+// IsAllowlisted checks if a group ID is allowlisted.
+func (m *GroupAllowlistModel) IsAllowlisted(ctx context.Context, groupID uint64) (bool, error) {
+	exists, err := m.db.NewSelect().
+		Model((*types.AllowlistedGroup)(nil)).
+		Where("id = ?", groupID).
+		Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check allowlisted group: %w", err)
+	}
+	return exists, nil
+}
+
+// GetAllowlistedIDs returns which of the given group IDs are allowlisted.
+func (m *GroupAllowlistModel) GetAllowlistedIDs(ctx context.Context, groupIDs []uint64) (map[uint64]bool, error) {
+	allowlisted := make(map[uint64]bool)
+	if len(groupIDs) == 0 {
+		return allowlisted, nil
+	}
+
+	var ids []uint64
+	err := m.db.NewSelect().
+		Model((*types.AllowlistedGroup)(nil)).
+		Column("id").
+		Where("id IN (?)", bun.In(groupIDs)).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get allowlisted group IDs: %w", err)
+	}
+
+	for _, id := range ids {
+		allowlisted[id] = true
+	}
+	return allowlisted, nil
+}
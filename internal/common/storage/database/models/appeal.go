@@ -2,6 +2,8 @@ package models
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -19,8 +21,17 @@ type appealResult struct {
 		LastViewed   time.Time `bun:",notnull"`
 		LastActivity time.Time `bun:",notnull"`
 	} `bun:"embed:"`
+	ClaimActivity time.Time `bun:",nullzero"`
 }
 
+// claimActivityExpr selects when a claimed appeal last received a moderator message
+// or was claimed, whichever is later, so a fresh claim is not released because of
+// replies made before it.
+const claimActivityExpr = `GREATEST((
+	SELECT MAX(m.created_at) FROM appeal_messages AS m
+	WHERE m.appeal_id = appeal.id AND m.role = ?
+), appeal.claimed_at)`
+
 // AppealModel handles database operations for appeal records.
 type AppealModel struct {
 	db     *bun.DB
@@ -93,6 +104,7 @@ func (r *AppealModel) AcceptAppeal(ctx context.Context, appealID int64, reviewer
 			Set("reviewer_id = ?", reviewerID).
 			Set("reviewed_at = ?", now).
 			Set("review_reason = ?", reason).
+			Set("first_response_at = COALESCE(first_response_at, ?)", now).
 			Where("id = ?", appealID).
 			Where("status = ?", enum.AppealStatusPending).
 			Exec(ctx)
@@ -122,15 +134,7 @@ func (r *AppealModel) RejectAppeal(ctx context.Context, appealID int64, reviewer
 	now := time.Now()
 	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		// Update appeal status
-		_, err := tx.NewUpdate().
-			Model((*types.Appeal)(nil)).
-			Set("status = ?", enum.AppealStatusRejected).
-			Set("reviewer_id = ?", reviewerID).
-			Set("reviewed_at = ?", now).
-			Set("review_reason = ?", reason).
-			Where("id = ?", appealID).
-			Where("status = ?", enum.AppealStatusPending).
-			Exec(ctx)
+		_, err := rejectAppealQuery(tx, appealID, reviewerID, reason, now).Exec(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to reject appeal: %w (appealID=%d)", err, appealID)
 		}
@@ -152,6 +156,28 @@ func (r *AppealModel) RejectAppeal(ctx context.Context, appealID int64, reviewer
 	})
 }
 
+// rejectAppealQuery builds the update that rejects a pending appeal. A reviewer ID
+// of 0 marks an automatic rejection by the system. The rejection only counts as
+// the first moderator response if it was not made by the system or by the
+// requester closing their own appeal.
+func rejectAppealQuery(db bun.IDB, appealID int64, reviewerID uint64, reason string, now time.Time) *bun.UpdateQuery {
+	query := db.NewUpdate().
+		Model((*types.Appeal)(nil)).
+		Set("status = ?", enum.AppealStatusRejected).
+		Set("reviewer_id = ?", reviewerID).
+		Set("reviewed_at = ?", now).
+		Set("review_reason = ?", reason).
+		Where("id = ?", appealID).
+		Where("status = ?", enum.AppealStatusPending)
+
+	if reviewerID != 0 {
+		query.Set("first_response_at = CASE WHEN requester_id <> ? THEN COALESCE(first_response_at, ?) "+
+			"ELSE first_response_at END", reviewerID, now)
+	}
+
+	return query
+}
+
 // HasPendingAppealByRequester checks if a requester already has any pending appeals.
 func (r *AppealModel) HasPendingAppealByRequester(ctx context.Context, requesterID uint64) (bool, error) {
 	exists, err := r.db.NewSelect().
@@ -165,32 +191,107 @@ func (r *AppealModel) HasPendingAppealByRequester(ctx context.Context, requester
 	return exists, nil
 }
 
-// HasPreviousRejection checks if a user ID has any rejected appeals within the last 7 days.
-func (r *AppealModel) HasPreviousRejection(ctx context.Context, userID uint64) (bool, error) {
-	exists, err := r.db.NewSelect().
-		Model((*types.Appeal)(nil)).
-		Where("user_id = ?", userID).
-		Where("status = ?", enum.AppealStatusRejected).
-		Where("reviewed_at > ?", time.Now().AddDate(0, 0, -7)).
-		Exists(ctx)
+// HasRecentRejection checks if a user ID had an appeal rejected within the given window.
+// The most recent rejection is used regardless of which reviewer handled it, and the
+// returned time is when the window after that rejection ends.
+func (r *AppealModel) HasRecentRejection(ctx context.Context, userID uint64, window time.Duration) (bool, time.Time, error) {
+	var appeal types.Appeal
+	err := recentRejectionQuery(r.db, &appeal, userID, time.Now().Add(-window)).Scan(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to check previous rejections: %w (userID=%d)", err, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, time.Time{}, nil
+		}
+		return false, time.Time{}, fmt.Errorf("failed to check recent rejections: %w (userID=%d)", err, userID)
 	}
 
-	return exists, nil
+	return true, appeal.ReviewedAt.Add(window), nil
 }
 
-// HasPendingAppealByUserID checks if a user ID already has any pending appeals.
-func (r *AppealModel) HasPendingAppealByUserID(ctx context.Context, userID uint64) (bool, error) {
-	exists, err := r.db.NewSelect().
-		Model((*types.Appeal)(nil)).
+// recentRejectionQuery builds the query for the most recent rejected appeal
+// of a user ID that was reviewed after the given time.
+func recentRejectionQuery(db bun.IDB, appeal *types.Appeal, userID uint64, since time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(appeal).
+		Column("reviewed_at").
 		Where("user_id = ?", userID).
-		Where("status = ?", enum.AppealStatusPending).
-		Exists(ctx)
+		Where("status = ?", enum.AppealStatusRejected).
+		Where("reviewed_at > ?", since).
+		Order("reviewed_at DESC").
+		Limit(1)
+}
+
+// GetAppealsByUserID gets the appeals for a user ID, newest first. Accepted and
+// rejected appeals are only included if includeResolved is set.
+func (r *AppealModel) GetAppealsByUserID(ctx context.Context, userID uint64, includeResolved bool) ([]*types.Appeal, error) {
+	var appeals []*types.Appeal
+	err := appealsByUserIDQuery(r.db, &appeals, userID, includeResolved).Scan(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to check pending appeals: %w (userID=%d)", err, userID)
+		return nil, fmt.Errorf("failed to get appeals by user ID: %w (userID=%d)", err, userID)
 	}
-	return exists, nil
+	return appeals, nil
+}
+
+// appealsByUserIDQuery builds the query for the appeals of a user ID, newest first.
+func appealsByUserIDQuery(db bun.IDB, appeals *[]*types.Appeal, userID uint64, includeResolved bool) *bun.SelectQuery {
+	query := db.NewSelect().
+		Model(appeals).
+		Where("user_id = ?", userID).
+		Order("id DESC")
+
+	if !includeResolved {
+		query.Where("status = ?", enum.AppealStatusPending)
+	}
+
+	return query
+}
+
+// AnnotateDuplicateAppeal marks an appeal and the other appeals for the same user as
+// duplicates. A system message listing the other appeals is added to the appeal, and
+// a message pointing back to it is added to each of the other appeals.
+func (r *AppealModel) AnnotateDuplicateAppeal(ctx context.Context, appeal *types.Appeal, duplicates []*types.Appeal) error {
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	appealIDs := []int64{appeal.ID}
+	messages := []*types.AppealMessage{{
+		AppealID:  appeal.ID,
+		Role:      enum.MessageRoleSystem,
+		Content:   types.DuplicateAppealNote(duplicates),
+		CreatedAt: now,
+	}}
+	for _, duplicate := range duplicates {
+		appealIDs = append(appealIDs, duplicate.ID)
+		messages = append(messages, &types.AppealMessage{
+			AppealID:  duplicate.ID,
+			Role:      enum.MessageRoleSystem,
+			Content:   types.DuplicateAppealBacklink(appeal),
+			CreatedAt: now,
+		})
+	}
+
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewUpdate().
+			Model((*types.Appeal)(nil)).
+			Set("has_duplicates = TRUE").
+			Where("id IN (?)", bun.In(appealIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to mark duplicate appeals: %w (appealID=%d)", err, appeal.ID)
+		}
+
+		_, err = tx.NewInsert().Model(&messages).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add duplicate appeal messages: %w (appealID=%d)", err, appeal.ID)
+		}
+
+		appeal.HasDuplicates = true
+		r.logger.Debug("Annotated duplicate appeal",
+			zap.Int64("appealID", appeal.ID),
+			zap.Int("duplicates", len(duplicates)))
+		return nil
+	})
 }
 
 // GetAppealsToReview gets a list of appeals based on sort criteria.
@@ -208,7 +309,8 @@ func (r *AppealModel) GetAppealsToReview(
 		Model((*types.Appeal)(nil)).
 		Join("JOIN appeal_timelines AS t ON t.id = appeal.id").
 		ColumnExpr("appeal.*").
-		ColumnExpr("t.timestamp, t.last_viewed, t.last_activity")
+		ColumnExpr("t.timestamp, t.last_viewed, t.last_activity").
+		ColumnExpr(claimActivityExpr+" AS claim_activity", enum.MessageRoleModerator)
 
 	// Apply status filter if not showing all
 	query.Where("status = ?", statusFilter)
@@ -227,6 +329,13 @@ func (r *AppealModel) GetAppealsToReview(
 			query.Where("(t.last_activity, appeal.id) < (?, ?)", cursor.LastActivity, cursor.ID)
 		}
 		query.Order("t.last_activity DESC", "appeal.id DESC")
+	case enum.AppealSortByUnclaimed:
+		query.Where("status = ?", enum.AppealStatusPending) // Only show pending appeals for unclaimed view
+		query.Where("claimed_by IS NULL")
+		if cursor != nil {
+			query.Where("(t.last_activity, appeal.id) < (?, ?)", cursor.LastActivity, cursor.ID)
+		}
+		query.Order("t.last_activity DESC", "appeal.id DESC")
 	case enum.AppealSortByNewest:
 		if cursor != nil {
 			query.Where("(t.timestamp, appeal.id) < (?, ?)", cursor.Timestamp, cursor.ID)
@@ -307,23 +416,95 @@ func (r *AppealModel) GetAppealMessages(ctx context.Context, appealID int64) ([]
 	return messages, nil
 }
 
+// GetAppealAttachments gets the images attached to the given messages, keyed by message ID.
+func (r *AppealModel) GetAppealAttachments(ctx context.Context, messageIDs []int64) (map[int64]*types.AppealAttachment, error) {
+	if len(messageIDs) == 0 {
+		return map[int64]*types.AppealAttachment{}, nil
+	}
+
+	var attachments []*types.AppealAttachment
+	if err := appealAttachmentsQuery(r.db, &attachments, messageIDs).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get appeal attachments: %w (messageIDs=%v)", err, messageIDs)
+	}
+
+	result := make(map[int64]*types.AppealAttachment, len(attachments))
+	for _, attachment := range attachments {
+		result[attachment.MessageID] = attachment
+	}
+
+	return result, nil
+}
+
+// appealAttachmentsQuery builds the query selecting the images attached to the given messages.
+func appealAttachmentsQuery(db bun.IDB, attachments *[]*types.AppealAttachment, messageIDs []int64) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(attachments).
+		Where("message_id IN (?)", bun.In(messageIDs))
+}
+
 // AddAppealMessage adds a new message to an appeal and updates the appeal's last activity.
+// An image set as the message's attachment is saved together with the message.
 // If the message is from a moderator and the appeal isn't claimed, it will also claim the appeal.
-func (r *AppealModel) AddAppealMessage(ctx context.Context, message *types.AppealMessage, appeal *types.Appeal) error {
+// Returns a RateLimitError if the sender's previous message to the appeal was sent less than
+// minInterval before this one.
+func (r *AppealModel) AddAppealMessage(
+	ctx context.Context, message *types.AppealMessage, appeal *types.Appeal, minInterval time.Duration,
+) error {
 	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Lock the appeal so concurrent messages from the same sender are rate limited in order
+		var lockedID int64
+		err := tx.NewSelect().
+			Model((*types.Appeal)(nil)).
+			Column("id").
+			Where("id = ?", appeal.ID).
+			For("UPDATE").
+			Scan(ctx, &lockedID)
+		if err != nil {
+			return notFoundError(
+				fmt.Errorf("failed to lock appeal: %w (appealID=%d)", err, appeal.ID), types.ErrAppealNotFound,
+			)
+		}
+
+		// Enforce the minimum interval since the sender's latest message
+		if minInterval > 0 {
+			var lastSent time.Time
+			err := latestAppealMessageQuery(tx, appeal.ID, message.UserID).Scan(ctx, &lastSent)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("failed to get latest appeal message: %w (appealID=%d)", err, appeal.ID)
+			}
+			if err == nil {
+				if remaining := minInterval - message.CreatedAt.Sub(lastSent); remaining > 0 {
+					return &types.RateLimitError{RetryAfter: remaining}
+				}
+			}
+		}
+
 		// Insert the new message
+		message.HasAttachment = message.Attachment != nil
 		if _, err := tx.NewInsert().Model(message).Exec(ctx); err != nil {
 			return fmt.Errorf("failed to insert appeal message: %w (appealID=%d)", err, appeal.ID)
 		}
 
+		// Insert the attached image
+		if attachment := message.Attachment; attachment != nil {
+			attachment.MessageID = message.ID
+			attachment.AppealID = appeal.ID
+			attachment.CreatedAt = message.CreatedAt
+			if _, err := tx.NewInsert().Model(attachment).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to insert appeal attachment: %w (appealID=%d)", err, appeal.ID)
+			}
+		}
+
 		now := time.Now()
 
-		// Auto-claim appeal for moderator messages if not already claimed
-		if message.Role == enum.MessageRoleModerator && appeal.ClaimedBy == 0 {
+		if message.Role == enum.MessageRoleModerator {
+			// Auto-claim appeal if not already claimed, checked in the update
+			// itself so a claim made by another reviewer is never overwritten
 			_, err := tx.NewUpdate().
-				Model(appeal).
-				Set("claimed_by = ?", message.UserID).
-				Set("claimed_at = ?", now).
+				Model((*types.Appeal)(nil)).
+				Set("first_response_at = COALESCE(first_response_at, ?)", now).
+				Set("claimed_at = CASE WHEN claimed_by IS NULL THEN ? ELSE claimed_at END", now).
+				Set("claimed_by = COALESCE(claimed_by, ?)", message.UserID).
 				Where("id = ?", appeal.ID).
 				Exec(ctx)
 			if err != nil {
@@ -332,7 +513,7 @@ func (r *AppealModel) AddAppealMessage(ctx context.Context, message *types.Appea
 		}
 
 		// Update the appeal's last activity timestamp
-		_, err := tx.NewUpdate().
+		_, err = tx.NewUpdate().
 			Model((*types.AppealTimeline)(nil)).
 			Set("last_activity = ?", now).
 			Where("id = ?", appeal.ID).
@@ -345,6 +526,333 @@ func (r *AppealModel) AddAppealMessage(ctx context.Context, message *types.Appea
 	})
 }
 
+// latestAppealMessageQuery builds the query selecting when the user last sent a message to the appeal.
+func latestAppealMessageQuery(db bun.IDB, appealID int64, userID uint64) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.AppealMessage)(nil)).
+		Column("created_at").
+		Where("appeal_id = ?", appealID).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(1)
+}
+
+// ClaimAppeal assigns a pending appeal to the reviewer. Claiming an appeal the
+// reviewer already holds refreshes the claim. Returns ErrAppealAlreadyClaimed if
+// another reviewer holds the claim and ErrInvalidAppealStatus if the appeal is closed.
+func (r *AppealModel) ClaimAppeal(ctx context.Context, appealID int64, reviewerID uint64) error {
+	result, err := claimAppealQuery(r.db, appealID, reviewerID, time.Now()).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to claim appeal: %w (appealID=%d)", err, appealID)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w (appealID=%d)", err, appealID)
+	}
+
+	if affected == 0 {
+		// Find out why the appeal could not be claimed
+		var appeal types.Appeal
+		err := r.db.NewSelect().
+			Model(&appeal).
+			Column("status", "claimed_by").
+			Where("id = ?", appealID).
+			Scan(ctx)
+		if err != nil {
+			return notFoundError(
+				fmt.Errorf("failed to get appeal: %w (appealID=%d)", err, appealID), types.ErrAppealNotFound,
+			)
+		}
+
+		if appeal.Status != enum.AppealStatusPending {
+			return types.ErrInvalidAppealStatus
+		}
+		return types.ErrAppealAlreadyClaimed
+	}
+
+	r.logger.Debug("Claimed appeal",
+		zap.Int64("appealID", appealID),
+		zap.Uint64("reviewerID", reviewerID))
+	return nil
+}
+
+// claimAppealQuery builds the update that claims a pending appeal for the reviewer
+// as long as no other reviewer holds the claim.
+func claimAppealQuery(db bun.IDB, appealID int64, reviewerID uint64, now time.Time) *bun.UpdateQuery {
+	return db.NewUpdate().
+		Model((*types.Appeal)(nil)).
+		Set("claimed_by = ?", reviewerID).
+		Set("claimed_at = ?", now).
+		Where("id = ?", appealID).
+		Where("status = ?", enum.AppealStatusPending).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.Where("claimed_by IS NULL").WhereOr("claimed_by = ?", reviewerID)
+		})
+}
+
+// UnclaimAppeal releases the reviewer's claim on an appeal so other reviewers can
+// pick it up. Returns ErrAppealNotClaimed if the reviewer does not hold the claim.
+func (r *AppealModel) UnclaimAppeal(ctx context.Context, appealID int64, reviewerID uint64) error {
+	result, err := r.db.NewUpdate().
+		Model((*types.Appeal)(nil)).
+		Set("claimed_by = NULL").
+		Set("claimed_at = NULL").
+		Where("id = ?", appealID).
+		Where("claimed_by = ?", reviewerID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to unclaim appeal: %w (appealID=%d)", err, appealID)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w (appealID=%d)", err, appealID)
+	}
+
+	if affected == 0 {
+		return types.ErrAppealNotClaimed
+	}
+
+	r.logger.Debug("Unclaimed appeal",
+		zap.Int64("appealID", appealID),
+		zap.Uint64("reviewerID", reviewerID))
+	return nil
+}
+
+// ReleaseStaleClaims unclaims pending appeals whose claimer has not sent a message since the cutoff.
+// Released appeals have their last activity bumped so they surface at the top of the unclaimed view.
+// The returned appeals still hold the original claimer for notification purposes.
+func (r *AppealModel) ReleaseStaleClaims(ctx context.Context, cutoff time.Time) ([]*types.Appeal, error) {
+	var appeals []*types.Appeal
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Find claimed appeals without recent moderator activity
+		err := tx.NewSelect().
+			Model(&appeals).
+			Where("status = ?", enum.AppealStatusPending).
+			Where("claimed_by IS NOT NULL").
+			Where(claimActivityExpr+" < ?", enum.MessageRoleModerator, cutoff).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get stale claimed appeals: %w", err)
+		}
+
+		if len(appeals) == 0 {
+			return nil
+		}
+
+		appealIDs := make([]int64, len(appeals))
+		for i, appeal := range appeals {
+			appealIDs[i] = appeal.ID
+		}
+
+		// Remove the claims
+		_, err = tx.NewUpdate().
+			Model((*types.Appeal)(nil)).
+			Set("claimed_by = NULL").
+			Set("claimed_at = NULL").
+			Where("id IN (?)", bun.In(appealIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to release stale claims: %w", err)
+		}
+
+		// Bump the appeals to the top of the unclaimed view
+		_, err = tx.NewUpdate().
+			Model((*types.AppealTimeline)(nil)).
+			Set("last_activity = ?", time.Now()).
+			Where("id IN (?)", bun.In(appealIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update appeal timelines: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return appeals, nil
+}
+
+// PurgeOldResolvedAppeals removes accepted and rejected appeals that were reviewed
+// before the cutoff date, along with their messages, attachments and timeline entries. Pending
+// appeals are never removed. Returns the number of appeals removed.
+func (r *AppealModel) PurgeOldResolvedAppeals(ctx context.Context, cutoffDate time.Time) (int, error) {
+	var appealIDs []int64
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := purgeResolvedAppealsQuery(tx, cutoffDate).Scan(ctx, &appealIDs)
+		if err != nil {
+			return fmt.Errorf("failed to purge old appeals: %w", err)
+		}
+		if len(appealIDs) == 0 {
+			return nil
+		}
+
+		_, err = tx.NewDelete().
+			Model((*types.AppealAttachment)(nil)).
+			Where("appeal_id IN (?)", bun.In(appealIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge old appeal attachments: %w", err)
+		}
+
+		_, err = tx.NewDelete().
+			Model((*types.AppealMessage)(nil)).
+			Where("appeal_id IN (?)", bun.In(appealIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge old appeal messages: %w", err)
+		}
+
+		_, err = tx.NewDelete().
+			Model((*types.AppealTimeline)(nil)).
+			Where("id IN (?)", bun.In(appealIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge old appeal timelines: %w", err)
+		}
+
+		_, err = tx.NewDelete().
+			Model((*types.AppealRegression)(nil)).
+			Where("appeal_id IN (?)", bun.In(appealIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge old appeal regressions: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
+	}
+
+	r.logger.Debug("Purged old resolved appeals",
+		zap.Int("count", len(appealIDs)),
+		zap.Time("cutoffDate", cutoffDate))
+
+	return len(appealIDs), nil
+}
+
+// purgeResolvedAppealsQuery builds the query deleting appeals that were accepted or
+// rejected before the cutoff date, returning the IDs of the deleted appeals.
+func purgeResolvedAppealsQuery(db bun.IDB, cutoffDate time.Time) *bun.DeleteQuery {
+	return db.NewDelete().
+		Model((*types.Appeal)(nil)).
+		Where("status IN (?)", bun.In([]enum.AppealStatus{enum.AppealStatusAccepted, enum.AppealStatusRejected})).
+		Where("reviewed_at < ?", cutoffDate).
+		Returning("id")
+}
+
+// GetResponseTimeStats calculates how long appeals submitted since the given time
+// waited for a first moderator response and for a decision. Appeals rejected
+// automatically by the system or closed by their requester are not resolved by
+// a moderator and are excluded from the resolution times.
+func (r *AppealModel) GetResponseTimeStats(ctx context.Context, since time.Time) (*types.AppealResponseTimeStats, error) {
+	var result types.AppealResponseTimeStats
+
+	err := appealTimingQuery(r.db, since, "appeal.first_response_at").Scan(ctx, &result.FirstResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appeal first response times: %w", err)
+	}
+
+	err = appealTimingQuery(r.db, since, "appeal.reviewed_at").
+		Where("appeal.status <> ?", enum.AppealStatusPending).
+		Where("appeal.reviewer_id IS NOT NULL").
+		Where("appeal.reviewer_id <> appeal.requester_id").
+		Scan(ctx, &result.Resolution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appeal resolution times: %w", err)
+	}
+
+	return &result, nil
+}
+
+// appealTimingQuery builds the query that aggregates the time between submitting
+// an appeal and the given timestamp column for appeals submitted since the given time.
+func appealTimingQuery(db bun.IDB, since time.Time, column string) *bun.SelectQuery {
+	seconds := "EXTRACT(EPOCH FROM (" + column + " - t.timestamp))"
+
+	return db.NewSelect().
+		Model((*types.Appeal)(nil)).
+		Join("JOIN appeal_timelines AS t ON t.id = appeal.id").
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("COALESCE(AVG("+seconds+"), 0) AS avg_seconds").
+		ColumnExpr("COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY "+seconds+"), 0) AS median_seconds").
+		ColumnExpr("COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY "+seconds+"), 0) AS p95_seconds").
+		Where("t.timestamp >= ?", since).
+		Where(column + " IS NOT NULL")
+}
+
+// RecordRegressions records the given users that are flagged again while they are
+// cleared because of an accepted appeal. Each appeal is only recorded the first time
+// its user is flagged again. Returns the regressions that were newly recorded.
+func (r *AppealModel) RecordRegressions(ctx context.Context, userIDs []uint64) ([]*types.AppealRegression, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	var regressions []*types.AppealRegression
+	err := recordRegressionsQuery(r.db, userIDs, time.Now()).Scan(ctx, &regressions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record appeal regressions: %w", err)
+	}
+
+	r.logger.Debug("Recorded appeal regressions",
+		zap.Int("checkedUsers", len(userIDs)),
+		zap.Int("regressions", len(regressions)))
+
+	return regressions, nil
+}
+
+// recordRegressionsQuery builds the query inserting a regression for each of the
+// given users that is cleared and has an accepted appeal reviewed since being
+// cleared. Accepting an appeal clears the user just before the appeal is marked
+// as accepted, so users cleared by a reviewer without an appeal do not match.
+func recordRegressionsQuery(db bun.IDB, userIDs []uint64, now time.Time) *bun.RawQuery {
+	return db.NewRaw(`
+		INSERT INTO appeal_regressions (appeal_id, user_id, reviewer_id, days_to_reflag, reflagged_at)
+		SELECT DISTINCT ON (appeal.user_id)
+			appeal.id, appeal.user_id, appeal.reviewer_id,
+			FLOOR(EXTRACT(EPOCH FROM (?::timestamptz - appeal.reviewed_at)) / 86400)::int,
+			?::timestamptz
+		FROM appeals AS appeal
+		JOIN cleared_users AS cleared ON cleared.id = appeal.user_id
+		WHERE appeal.user_id IN (?)
+		AND appeal.status = ?
+		AND appeal.reviewed_at >= cleared.cleared_at
+		ORDER BY appeal.user_id, appeal.reviewed_at DESC
+		ON CONFLICT (appeal_id) DO NOTHING
+		RETURNING *
+	`, now, now, bun.In(userIDs), enum.AppealStatusAccepted)
+}
+
+// GetRegressionStats calculates how many appeals accepted since the given time were
+// followed by their user being flagged again. Appeals accepted by returning the
+// user to review count as accepted but can never regress.
+func (r *AppealModel) GetRegressionStats(ctx context.Context, since time.Time) (*types.AppealRegressionStats, error) {
+	var stats types.AppealRegressionStats
+	if err := regressionStatsQuery(r.db, since).Scan(ctx, &stats); err != nil {
+		return nil, fmt.Errorf("failed to get appeal regression stats: %w", err)
+	}
+	return &stats, nil
+}
+
+// regressionStatsQuery builds the query counting the appeals accepted since the
+// given time and the regressions recorded for them.
+func regressionStatsQuery(db bun.IDB, since time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.Appeal)(nil)).
+		Join("LEFT JOIN appeal_regressions AS r ON r.appeal_id = appeal.id").
+		ColumnExpr("COUNT(*) AS accepted_count").
+		ColumnExpr("COUNT(r.appeal_id) AS regressed_count").
+		ColumnExpr("COALESCE(AVG(r.days_to_reflag), 0) AS avg_days_to_reflag").
+		Where("appeal.status = ?", enum.AppealStatusAccepted).
+		Where("appeal.reviewed_at >= ?", since)
+}
+
 // processAppealResults handles pagination and data transformation for appeal results.
 func processAppealResults(results []appealResult, limit int) ([]*types.Appeal, *types.AppealTimeline, *types.AppealTimeline) {
 	var appeals []*types.Appeal
@@ -370,6 +878,7 @@ func processAppealResults(results []appealResult, limit int) ([]*types.Appeal, *
 		appeals[i].Timestamp = result.Timeline.Timestamp
 		appeals[i].LastViewed = result.Timeline.LastViewed
 		appeals[i].LastActivity = result.Timeline.LastActivity
+		appeals[i].ClaimActivity = result.ClaimActivity
 	}
 
 	if len(results) > 0 {
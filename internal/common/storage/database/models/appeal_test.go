@@ -0,0 +1,173 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRecentRejectionQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := recentRejectionQuery(db, &types.Appeal{}, 123, since).String()
+
+	assert.Contains(t, query, `FROM "appeals"`)
+	assert.Contains(t, query, "(user_id = 123)")
+	assert.Contains(t, query, "reviewed_at > '2025-01-01 00:00:00+00:00'")
+	assert.Contains(t, query, `ORDER BY "reviewed_at" DESC`)
+	assert.Contains(t, query, "LIMIT 1")
+	assert.NotContains(t, query, "reviewer_id", "the most recent rejection counts regardless of reviewer")
+}
+
+func TestAppealsByUserIDQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	t.Run("pending only", func(t *testing.T) {
+		var appeals []*types.Appeal
+		query := appealsByUserIDQuery(db, &appeals, 123, false).String()
+
+		assert.Contains(t, query, "(user_id = 123)")
+		assert.Contains(t, query, "(status = 0)")
+		assert.Contains(t, query, `ORDER BY "id" DESC`)
+	})
+
+	t.Run("including resolved", func(t *testing.T) {
+		var appeals []*types.Appeal
+		query := appealsByUserIDQuery(db, &appeals, 123, true).String()
+
+		assert.Contains(t, query, "(user_id = 123)")
+		assert.NotContains(t, query, "status =")
+	})
+}
+
+func TestRejectAppealQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		reviewerID        uint64
+		wantFirstResponse bool
+	}{
+		{
+			name:              "moderator rejection records first response",
+			reviewerID:        456,
+			wantFirstResponse: true,
+		},
+		{
+			name:              "system rejection leaves first response unset",
+			reviewerID:        0,
+			wantFirstResponse: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := rejectAppealQuery(db, 1, tt.reviewerID, "reason", now).String()
+
+			assert.Contains(t, query, `UPDATE "appeals"`)
+			assert.Contains(t, query, "(id = 1)")
+			if tt.wantFirstResponse {
+				assert.Contains(t, query, "first_response_at = CASE WHEN requester_id <> 456")
+			} else {
+				assert.NotContains(t, query, "first_response_at")
+			}
+		})
+	}
+}
+
+func TestAppealTimingQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := appealTimingQuery(db, since, "appeal.first_response_at").String()
+
+	assert.Contains(t, query, "JOIN appeal_timelines AS t ON t.id = appeal.id")
+	assert.Contains(t, query, "EXTRACT(EPOCH FROM (appeal.first_response_at - t.timestamp))")
+	assert.Contains(t, query, "percentile_cont(0.95)")
+	assert.Contains(t, query, "t.timestamp >= '2025-01-01 00:00:00+00:00'")
+	assert.Contains(t, query, "(appeal.first_response_at IS NOT NULL)")
+}
+
+func TestClaimAppealQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	query := claimAppealQuery(db, 1, 456, now).String()
+
+	assert.Contains(t, query, `UPDATE "appeals"`)
+	assert.Contains(t, query, "claimed_by = 456")
+	assert.Contains(t, query, "claimed_at = '2025-01-24 12:00:00+00:00'")
+	assert.Contains(t, query, "(id = 1)")
+	assert.Contains(t, query, "(status = 0)")
+	assert.Contains(t, query, "((claimed_by IS NULL) OR (claimed_by = 456))",
+		"only unclaimed appeals or the reviewer's own claim can be claimed")
+}
+
+func TestLatestAppealMessageQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := latestAppealMessageQuery(db, 7, 123).String()
+
+	assert.Contains(t, query, `SELECT "appeal_message"."created_at" FROM "appeal_messages"`)
+	assert.Contains(t, query, "(appeal_id = 7) AND (user_id = 123)")
+	assert.Contains(t, query, `ORDER BY "created_at" DESC`)
+	assert.Contains(t, query, "LIMIT 1")
+	assert.NotContains(t, query, "role", "the interval applies to the sender regardless of role")
+}
+
+func TestAppealAttachmentsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	var attachments []*types.AppealAttachment
+	query := appealAttachmentsQuery(db, &attachments, []int64{3, 5}).String()
+
+	assert.Contains(t, query, `FROM "appeal_attachments"`)
+	assert.Contains(t, query, "message_id IN (3, 5)")
+}
+
+func TestPurgeResolvedAppealsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := purgeResolvedAppealsQuery(db, cutoff).String()
+
+	assert.Contains(t, query, `DELETE FROM "appeals"`)
+	assert.Contains(t, query, "status IN (1, 2)")
+	assert.Contains(t, query, `reviewed_at < '2025-01-01 00:00:00+00:00'`)
+	assert.Contains(t, query, "RETURNING id")
+}
+
+func TestRecordRegressionsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	query := recordRegressionsQuery(db, []uint64{1, 2}, now).String()
+
+	assert.Contains(t, query, "INSERT INTO appeal_regressions")
+	assert.Contains(t, query, "JOIN cleared_users AS cleared ON cleared.id = appeal.user_id")
+	assert.Contains(t, query, "appeal.user_id IN (1, 2)")
+	assert.Contains(t, query, "appeal.status = 1")
+	assert.Contains(t, query, "appeal.reviewed_at >= cleared.cleared_at",
+		"only appeals accepted since the user was cleared count")
+	assert.Contains(t, query, "'2025-01-24 12:00:00+00:00'::timestamptz - appeal.reviewed_at")
+	assert.Contains(t, query, "ON CONFLICT (appeal_id) DO NOTHING", "each appeal is recorded once")
+}
+
+func TestRegressionStatsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := regressionStatsQuery(db, since).String()
+
+	assert.Contains(t, query, "LEFT JOIN appeal_regressions AS r ON r.appeal_id = appeal.id")
+	assert.Contains(t, query, "COUNT(r.appeal_id) AS regressed_count")
+	assert.Contains(t, query, "(appeal.status = 1)")
+	assert.Contains(t, query, "appeal.reviewed_at >= '2025-01-01 00:00:00+00:00'")
+}
@@ -0,0 +1,126 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestPurgeClearedUsersQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := purgeClearedUsersQuery(db, cutoff).String()
+
+	assert.Contains(t, query, `DELETE FROM "cleared_users"`)
+	assert.Contains(t, query, `cleared_at < '2025-01-01 00:00:00+00:00'`)
+	assert.Contains(t, query, "pinned = false", "pinned users must be excluded from the purge")
+}
+
+func TestPurgeBannedUsersQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := purgeBannedUsersQuery(db, cutoff).String()
+
+	assert.Contains(t, query, `DELETE FROM "banned_users"`)
+	assert.Contains(t, query, `purged_at < '2025-01-01 00:00:00+00:00'`)
+}
+
+func TestOldClearedUsersQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := oldClearedUsersQuery(db, cutoff).String()
+
+	assert.Contains(t, query, `SELECT "cleared_user"."id" FROM "cleared_users"`)
+	assert.Contains(t, query, `cleared_at < '2025-01-01 00:00:00+00:00'`)
+	assert.Contains(t, query, "pinned = false", "the dry run must match the purge conditions")
+}
+
+func TestExpiringClearedUsersQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	purgeCutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	warnCutoff := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	query := expiringClearedUsersQuery(db, purgeCutoff, warnCutoff).String()
+
+	assert.Contains(t, query, `cleared_at >= '2025-01-01 00:00:00+00:00'`)
+	assert.Contains(t, query, `cleared_at < '2025-01-08 00:00:00+00:00'`)
+	assert.Contains(t, query, "pinned = false", "pinned users must be excluded from the digest")
+}
+
+func TestPurgeDeletedUsersQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := purgeDeletedUsersQuery(db, cutoff).String()
+
+	assert.Contains(t, query, `DELETE FROM "deleted_users"`)
+	assert.Contains(t, query, `deleted_at < '2025-01-01 00:00:00+00:00'`)
+}
+
+func TestSetClearedUserPinnedEnforcesCapConcurrently(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	ids := []uint64{9_000_000_351, 9_000_000_352, 9_000_000_353, 9_000_000_354, 9_000_000_355, 9_000_000_356}
+	for _, id := range ids {
+		user := types.User{ID: id, UUID: uuid.New(), Name: "pin_" + strconv.FormatUint(id, 10)}
+		_, err := db.NewInsert().Model(&types.ClearedUser{User: user, ClearedAt: time.Now()}).Exec(ctx)
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.ClearedUser)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+	})
+
+	// Leave room for two more pins on top of any already in the database
+	existing, err := db.NewSelect().Model((*types.ClearedUser)(nil)).Where("pinned = true").Count(ctx)
+	require.NoError(t, err)
+	maxPinned := existing + 2
+
+	// Pin every user at once
+	var wg sync.WaitGroup
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = users.SetClearedUserPinned(ctx, id, true, maxPinned)
+		}()
+	}
+	wg.Wait()
+
+	var pinnedCount, limitCount int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			pinnedCount++
+		case errors.Is(err, types.ErrPinLimitReached):
+			limitCount++
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	assert.Equal(t, 2, pinnedCount)
+	assert.Equal(t, len(ids)-2, limitCount)
+
+	pinned, err := db.NewSelect().Model((*types.ClearedUser)(nil)).
+		Where("id IN (?)", bun.In(ids)).
+		Where("pinned = true").
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pinned, "concurrent pins must not exceed the cap")
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+)
+
+// notFoundError converts a missing row error into the given sentinel so callers can
+// check for it with errors.Is without depending on database/sql. Other errors are
+// returned unchanged.
+func notFoundError(err error, sentinel error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return sentinel
+	}
+	return err
+}
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNotFoundError(t *testing.T) {
+	errConnection := errors.New("connection refused")
+
+	tests := []struct {
+		name     string
+		err      error
+		sentinel error
+		want     error
+	}{
+		{
+			name:     "missing user",
+			err:      sql.ErrNoRows,
+			sentinel: types.ErrUserNotFound,
+			want:     types.ErrUserNotFound,
+		},
+		{
+			name:     "wrapped missing appeal",
+			err:      fmt.Errorf("failed to lock appeal: %w", sql.ErrNoRows),
+			sentinel: types.ErrAppealNotFound,
+			want:     types.ErrAppealNotFound,
+		},
+		{
+			name:     "other errors are kept",
+			err:      errConnection,
+			sentinel: types.ErrGroupNotFound,
+			want:     errConnection,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := notFoundError(tt.err, tt.sentinel)
+			assert.ErrorIs(t, err, tt.want)
+			assert.NotErrorIs(t, err, sql.ErrNoRows)
+		})
+	}
+}
+
+func TestModelsReturnNotFoundSentinels(t *testing.T) {
+	// The database has no rows, so every lookup misses
+	db, _ := newFakeDB(t)
+	ctx := context.Background()
+	users := NewUser(db, nil, nil, nil, nil, nil, zap.NewNop())
+	groups := NewGroup(db, nil, nil, nil, nil, nil, zap.NewNop())
+	appeals := NewAppeal(db, zap.NewNop())
+
+	tests := []struct {
+		name string
+		call func() error
+		want error
+	}{
+		{
+			name: "UserModel.GetUserByID",
+			call: func() error {
+				_, err := users.GetUserByID(ctx, "1", types.UserFields{Basic: true})
+				return err
+			},
+			want: types.ErrUserNotFound,
+		},
+		{
+			name: "UserModel.GetUserToScan",
+			call: func() error {
+				_, err := users.GetUserToScan(ctx)
+				return err
+			},
+			want: types.ErrNoUsersToScan,
+		},
+		{
+			name: "GroupModel.GetGroupByID",
+			call: func() error {
+				_, err := groups.GetGroupByID(ctx, "1", types.GroupFields{Basic: true})
+				return err
+			},
+			want: types.ErrGroupNotFound,
+		},
+		{
+			name: "GroupModel.GetGroupToScan",
+			call: func() error {
+				_, err := groups.GetGroupToScan(ctx)
+				return err
+			},
+			want: types.ErrNoGroupsToScan,
+		},
+		{
+			name: "AppealModel.ClaimAppeal",
+			call: func() error {
+				return appeals.ClaimAppeal(ctx, 1, 2)
+			},
+			want: types.ErrAppealNotFound,
+		},
+		{
+			name: "AppealModel.AddAppealMessage",
+			call: func() error {
+				return appeals.AddAppealMessage(ctx, &types.AppealMessage{AppealID: 1}, &types.Appeal{ID: 1}, time.Minute)
+			},
+			want: types.ErrAppealNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			require.ErrorIs(t, err, tt.want)
+			assert.NotErrorIs(t, err, sql.ErrNoRows)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// fakeDB is a database that answers every SELECT with one row per configured ID
+// and records the queries it was sent, so the number of round trips a method
+// makes can be checked without a database server.
+type fakeDB struct {
+	ids     []int64
+	mu      sync.Mutex
+	queries []string
+}
+
+// newFakeDB creates a bun database backed by a fakeDB returning the given IDs.
+func newFakeDB(t *testing.T, ids ...int64) (*bun.DB, *fakeDB) {
+	t.Helper()
+
+	fake := &fakeDB{ids: ids}
+	db := bun.NewDB(sql.OpenDB(fake), pgdialect.New())
+	db.AddQueryHook(fake)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db, fake
+}
+
+// Count returns how many recorded queries start with the given statement.
+func (f *fakeDB) Count(statement string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	count := 0
+	for _, query := range f.queries {
+		if strings.HasPrefix(query, statement) {
+			count++
+		}
+	}
+	return count
+}
+
+// Queries returns the recorded queries starting with the given statement.
+func (f *fakeDB) Queries(statement string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var queries []string
+	for _, query := range f.queries {
+		if strings.HasPrefix(query, statement) {
+			queries = append(queries, query)
+		}
+	}
+	return queries
+}
+
+// BeforeQuery implements bun.QueryHook.
+func (f *fakeDB) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.queries = append(f.queries, event.Query)
+	return ctx
+}
+
+// AfterQuery implements bun.QueryHook.
+func (f *fakeDB) AfterQuery(context.Context, *bun.QueryEvent) {}
+
+// Connect implements driver.Connector.
+func (f *fakeDB) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{db: f}, nil
+}
+
+// Driver implements driver.Connector.
+func (f *fakeDB) Driver() driver.Driver {
+	return nil
+}
+
+// fakeConn is a connection to a fakeDB.
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return c, nil }
+func (c *fakeConn) Commit() error                       { return nil }
+func (c *fakeConn) Rollback() error                     { return nil }
+
+func (c *fakeConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(len(c.db.ids)), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if !strings.HasPrefix(query, "SELECT") {
+		return &fakeRows{}, nil
+	}
+	return &fakeRows{ids: c.db.ids}, nil
+}
+
+// fakeRows returns one row with only an id column per ID.
+type fakeRows struct {
+	ids []int64
+	pos int
+}
+
+func (r *fakeRows) Columns() []string { return []string{"id"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.ids) {
+		return io.EOF
+	}
+	dest[0] = r.ids[r.pos]
+	r.pos++
+	return nil
+}
@@ -5,8 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
@@ -18,21 +21,30 @@ import (
 // GroupModel handles database operations for group records.
 type GroupModel struct {
 	db         *bun.DB
+	tracking   *TrackingModel
 	activity   *ActivityModel
 	reputation *ReputationModel
 	votes      *VoteModel
+	allowlist  *GroupAllowlistModel
 	logger     *zap.Logger
+	now        func() time.Time
 }
 
 // NewGroup creates a GroupModel with database access for
 // storing and retrieving group information.
-func NewGroup(db *bun.DB, activity *ActivityModel, reputation *ReputationModel, votes *VoteModel, logger *zap.Logger) *GroupModel {
+func NewGroup(
+	db *bun.DB, tracking *TrackingModel, activity *ActivityModel, reputation *ReputationModel, votes *VoteModel,
+	allowlist *GroupAllowlistModel, logger *zap.Logger,
+) *GroupModel {
 	return &GroupModel{
 		db:         db,
+		tracking:   tracking,
 		activity:   activity,
 		reputation: reputation,
 		votes:      votes,
+		allowlist:  allowlist,
 		logger:     logger,
+		now:        time.Now,
 	}
 }
 
@@ -53,6 +65,12 @@ func (r *GroupModel) SaveGroups(ctx context.Context, groups map[uint64]*types.Gr
 		return fmt.Errorf("failed to get existing groups: %w", err)
 	}
 
+	// Get allowlisted groups which must never be flagged
+	allowlisted, err := r.allowlist.GetAllowlistedIDs(ctx, groupIDs)
+	if err != nil {
+		return err
+	}
+
 	// Initialize slices for each table
 	flaggedGroups := make([]*types.FlaggedGroup, 0)
 	confirmedGroups := make([]*types.ConfirmedGroup, 0)
@@ -77,6 +95,12 @@ func (r *GroupModel) SaveGroups(ctx context.Context, groups map[uint64]*types.Gr
 			status = enum.GroupTypeFlagged
 		}
 
+		// Skip allowlisted groups that would be flagged
+		if status == enum.GroupTypeFlagged && allowlisted[id] {
+			r.logger.Debug("Skipping allowlisted group", zap.Uint64("groupID", id))
+			continue
+		}
+
 		switch status {
 		case enum.GroupTypeConfirmed:
 			confirmedGroups = append(confirmedGroups, &types.ConfirmedGroup{
@@ -259,6 +283,11 @@ func (r *GroupModel) ClearGroup(ctx context.Context, group *types.ReviewGroup) e
 			return fmt.Errorf("failed to delete group from locked_groups: %w", err)
 		}
 
+		// Stop tracking the group's members so they no longer count towards related users
+		if _, err := r.tracking.deleteGroupTracking(ctx, tx, group.ID); err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -274,20 +303,15 @@ func (r *GroupModel) ClearGroup(ctx context.Context, group *types.ReviewGroup) e
 	return nil
 }
 
-// GetGroupByID retrieves a group by either their numeric ID or UUID.
+// GetGroupByID retrieves a group by either their numeric ID or UUID. A group found
+// in several tables is returned with the status that comes first in groupStatusPrecedence.
 func (r *GroupModel) GetGroupByID(ctx context.Context, groupID string, fields types.GroupFields) (*types.ReviewGroup, error) {
 	var result types.ReviewGroup
 
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		// Try each model in order until we find a group
-		models := []interface{}{
-			&types.FlaggedGroup{},
-			&types.ConfirmedGroup{},
-			&types.ClearedGroup{},
-			&types.LockedGroup{},
-		}
-
-		for _, model := range models {
+		// Try each table in order of precedence until we find the group
+		for _, status := range groupStatusPrecedence {
+			model := newGroupModel(status)
 			query := tx.NewSelect().
 				Model(model).
 				Column(fields.Columns()...).
@@ -334,9 +358,10 @@ func (r *GroupModel) GetGroupByID(ctx context.Context, groupID string, fields ty
 				result.Reputation = reputation
 
 				// Update last_viewed if requested
+				result.LastViewed = r.now()
 				_, err = tx.NewUpdate().
 					Model(model).
-					Set("last_viewed = ?", time.Now()).
+					Set("last_viewed = ?", result.LastViewed).
 					Where("id = ?", result.ID).
 					Exec(ctx)
 				if err != nil {
@@ -360,7 +385,8 @@ func (r *GroupModel) GetGroupByID(ctx context.Context, groupID string, fields ty
 }
 
 // GetGroupsByIDs retrieves specified group information for a list of group IDs.
-// Returns a map of group IDs to review groups.
+// Returns a map of group IDs to review groups. A group found in several tables
+// gets the status that comes first in groupStatusPrecedence.
 func (r *GroupModel) GetGroupsByIDs(ctx context.Context, groupIDs []uint64, fields types.GroupFields) (map[uint64]*types.ReviewGroup, error) {
 	groups := make(map[uint64]*types.ReviewGroup)
 
@@ -379,11 +405,11 @@ func (r *GroupModel) GetGroupsByIDs(ctx context.Context, groupIDs []uint64, fiel
 			return fmt.Errorf("failed to get confirmed groups: %w", err)
 		}
 		for _, group := range confirmedGroups {
-			groups[group.ID] = &types.ReviewGroup{
+			mergeGroup(groups, &types.ReviewGroup{
 				Group:      group.Group,
 				VerifiedAt: group.VerifiedAt,
 				Status:     enum.GroupTypeConfirmed,
-			}
+			})
 		}
 
 		// Query flagged groups
@@ -397,10 +423,10 @@ func (r *GroupModel) GetGroupsByIDs(ctx context.Context, groupIDs []uint64, fiel
 			return fmt.Errorf("failed to get flagged groups: %w", err)
 		}
 		for _, group := range flaggedGroups {
-			groups[group.ID] = &types.ReviewGroup{
+			mergeGroup(groups, &types.ReviewGroup{
 				Group:  group.Group,
 				Status: enum.GroupTypeFlagged,
-			}
+			})
 		}
 
 		// Query cleared groups
@@ -414,11 +440,11 @@ func (r *GroupModel) GetGroupsByIDs(ctx context.Context, groupIDs []uint64, fiel
 			return fmt.Errorf("failed to get cleared groups: %w", err)
 		}
 		for _, group := range clearedGroups {
-			groups[group.ID] = &types.ReviewGroup{
+			mergeGroup(groups, &types.ReviewGroup{
 				Group:     group.Group,
 				ClearedAt: group.ClearedAt,
 				Status:    enum.GroupTypeCleared,
-			}
+			})
 		}
 
 		// Query locked groups
@@ -432,11 +458,11 @@ func (r *GroupModel) GetGroupsByIDs(ctx context.Context, groupIDs []uint64, fiel
 			return fmt.Errorf("failed to get locked groups: %w", err)
 		}
 		for _, group := range lockedGroups {
-			groups[group.ID] = &types.ReviewGroup{
+			mergeGroup(groups, &types.ReviewGroup{
 				Group:    group.Group,
 				LockedAt: group.LockedAt,
 				Status:   enum.GroupTypeLocked,
-			}
+			})
 		}
 
 		// Mark remaining IDs as unflagged
@@ -462,47 +488,65 @@ func (r *GroupModel) GetGroupsByIDs(ctx context.Context, groupIDs []uint64, fiel
 	return groups, nil
 }
 
+// groupStatusPrecedence lists the group statuses from highest to lowest precedence,
+// deciding which status wins when a group is found in several tables at once.
+// Locked comes first as it reflects the state of the group on Roblox, followed
+// by confirmed as a final review decision, with cleared groups last.
+var groupStatusPrecedence = []enum.GroupType{
+	enum.GroupTypeLocked,
+	enum.GroupTypeConfirmed,
+	enum.GroupTypeFlagged,
+	enum.GroupTypeCleared,
+}
+
+// groupStatusRank returns the position of a status in groupStatusPrecedence,
+// where a lower rank takes precedence. Unknown statuses rank last.
+func groupStatusRank(status enum.GroupType) int {
+	for i, s := range groupStatusPrecedence {
+		if s == status {
+			return i
+		}
+	}
+	return len(groupStatusPrecedence)
+}
+
+// newGroupModel returns an empty model of the table storing groups with the given status.
+func newGroupModel(status enum.GroupType) interface{} {
+	switch status {
+	case enum.GroupTypeConfirmed:
+		return &types.ConfirmedGroup{}
+	case enum.GroupTypeCleared:
+		return &types.ClearedGroup{}
+	case enum.GroupTypeLocked:
+		return &types.LockedGroup{}
+	case enum.GroupTypeFlagged, enum.GroupTypeUnflagged:
+	}
+	return &types.FlaggedGroup{}
+}
+
+// mergeGroup adds a group to the results unless the group was already found
+// in a table whose status takes precedence.
+func mergeGroup(groups map[uint64]*types.ReviewGroup, group *types.ReviewGroup) {
+	if existing, ok := groups[group.ID]; ok && groupStatusRank(existing.Status) <= groupStatusRank(group.Status) {
+		return
+	}
+	groups[group.ID] = group
+}
+
 // GetGroupsToCheck finds groups that haven't been checked for locked status recently.
 func (r *GroupModel) GetGroupsToCheck(ctx context.Context, limit int) ([]uint64, error) {
+	now := r.now()
 	var groupIDs []uint64
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		// Get and update confirmed groups
-		err := tx.NewRaw(`
-			WITH updated AS (
-				UPDATE confirmed_groups
-				SET last_purge_check = NOW()
-				WHERE id IN (
-					SELECT id FROM confirmed_groups
-					WHERE last_purge_check < NOW() - INTERVAL '1 day'
-					ORDER BY last_purge_check ASC
-					LIMIT ?
-					FOR UPDATE SKIP LOCKED
-				)
-				RETURNING id
-			)
-			SELECT * FROM updated
-		`, limit/2).Scan(ctx, &groupIDs)
+		err := purgeCheckBatchQuery(tx, "confirmed_groups", limit/2, now).Scan(ctx, &groupIDs)
 		if err != nil {
 			return fmt.Errorf("failed to get and update confirmed groups: %w", err)
 		}
 
 		// Get and update flagged groups
 		var flaggedIDs []uint64
-		err = tx.NewRaw(`
-			WITH updated AS (
-				UPDATE flagged_groups
-				SET last_purge_check = NOW()
-				WHERE id IN (
-					SELECT id FROM flagged_groups
-					WHERE last_purge_check < NOW() - INTERVAL '1 day'
-					ORDER BY last_purge_check ASC
-					LIMIT ?
-					FOR UPDATE SKIP LOCKED
-				)
-				RETURNING id
-			)
-			SELECT * FROM updated
-		`, limit/2).Scan(ctx, &flaggedIDs)
+		err = purgeCheckBatchQuery(tx, "flagged_groups", limit/2, now).Scan(ctx, &flaggedIDs)
 		if err != nil {
 			return fmt.Errorf("failed to get and update flagged groups: %w", err)
 		}
@@ -541,8 +585,10 @@ func (r *GroupModel) PurgeOldClearedGroups(ctx context.Context, cutoffDate time.
 }
 
 // RemoveLockedGroups moves groups from confirmed_groups and flagged_groups to locked_groups.
-// This happens when groups are found to be locked by Roblox.
+// This happens when groups are found to be locked by Roblox. The groups of each table
+// are inserted in a single query, and all of them share the same lock time.
 func (r *GroupModel) RemoveLockedGroups(ctx context.Context, groupIDs []uint64) error {
+	lockedAt := r.now()
 	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		// Move confirmed groups to locked_groups
 		var confirmedGroups []types.ConfirmedGroup
@@ -553,18 +599,15 @@ func (r *GroupModel) RemoveLockedGroups(ctx context.Context, groupIDs []uint64)
 			return fmt.Errorf("failed to select confirmed groups for locking: %w", err)
 		}
 
+		lockedGroups := make([]types.LockedGroup, 0, len(confirmedGroups))
 		for _, group := range confirmedGroups {
-			lockedGroup := &types.LockedGroup{
-				Group:    group.Group,
-				LockedAt: time.Now(),
-			}
-			_, err = tx.NewInsert().Model(lockedGroup).
-				On("CONFLICT (id) DO UPDATE").
-				Exec(ctx)
-			if err != nil {
+			lockedGroups = append(lockedGroups, types.LockedGroup{Group: group.Group, LockedAt: lockedAt})
+		}
+		if len(lockedGroups) > 0 {
+			if _, err := lockedGroupsInsertQuery(tx, &lockedGroups).Exec(ctx); err != nil {
 				return fmt.Errorf(
-					"failed to insert locked group from confirmed_groups: %w (groupID=%d)",
-					err, group.ID,
+					"failed to insert locked groups from confirmed_groups: %w (groupCount=%d)",
+					err, len(lockedGroups),
 				)
 			}
 		}
@@ -578,18 +621,15 @@ func (r *GroupModel) RemoveLockedGroups(ctx context.Context, groupIDs []uint64)
 			return fmt.Errorf("failed to select flagged groups for locking: %w", err)
 		}
 
+		lockedGroups = make([]types.LockedGroup, 0, len(flaggedGroups))
 		for _, group := range flaggedGroups {
-			lockedGroup := &types.LockedGroup{
-				Group:    group.Group,
-				LockedAt: time.Now(),
-			}
-			_, err = tx.NewInsert().Model(lockedGroup).
-				On("CONFLICT (id) DO UPDATE").
-				Exec(ctx)
-			if err != nil {
+			lockedGroups = append(lockedGroups, types.LockedGroup{Group: group.Group, LockedAt: lockedAt})
+		}
+		if len(lockedGroups) > 0 {
+			if _, err := lockedGroupsInsertQuery(tx, &lockedGroups).Exec(ctx); err != nil {
 				return fmt.Errorf(
-					"failed to insert locked group from flagged_groups: %w (groupID=%d)",
-					err, group.ID,
+					"failed to insert locked groups from flagged_groups: %w (groupCount=%d)",
+					err, len(lockedGroups),
 				)
 			}
 		}
@@ -621,38 +661,42 @@ func (r *GroupModel) RemoveLockedGroups(ctx context.Context, groupIDs []uint64)
 	})
 }
 
-// GetGroupsForThumbnailUpdate retrieves groups that need thumbnail updates.
-func (r *GroupModel) GetGroupsForThumbnailUpdate(ctx context.Context, limit int) (map[uint64]*types.Group, error) {
-	groups := make(map[uint64]*types.Group)
+// lockedGroupsInsertQuery builds the query inserting the given groups into locked_groups
+// in a single statement, replacing the stored copy of groups that are already locked.
+func lockedGroupsInsertQuery(db bun.IDB, groups *[]types.LockedGroup) *bun.InsertQuery {
+	return db.NewInsert().
+		Model(groups).
+		On("CONFLICT (id) DO UPDATE")
+}
 
-	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		// Query groups from each table that need thumbnail updates
-		for _, model := range []interface{}{
-			(*types.FlaggedGroup)(nil),
-			(*types.ConfirmedGroup)(nil),
-			(*types.ClearedGroup)(nil),
-			(*types.LockedGroup)(nil),
-		} {
-			var reviewGroups []types.ReviewGroup
-			err := tx.NewSelect().
-				Model(model).
-				Where("last_thumbnail_update < NOW() - INTERVAL '7 days'").
-				OrderExpr("last_thumbnail_update ASC").
-				Limit(limit).
-				Scan(ctx, &reviewGroups)
+// GetGroupsForThumbnailUpdate retrieves up to limit groups whose thumbnails were
+// last updated before staleBefore. Groups viewed after viewedAfter come first.
+func (r *GroupModel) GetGroupsForThumbnailUpdate(
+	ctx context.Context, staleBefore, viewedAfter time.Time, limit int,
+) (map[uint64]*types.Group, error) {
+	table := r.db.Dialect().Tables().Get(reflect.TypeOf(types.Group{}))
+	columns := make([]string, 0, len(table.Fields))
+	for _, field := range table.Fields {
+		columns = append(columns, field.Name)
+	}
 
-			if err != nil && !errors.Is(err, sql.ErrNoRows) {
-				return fmt.Errorf("failed to query groups for thumbnail update: %w", err)
-			}
+	var rows []*types.Group
+	err := staleThumbnailsQuery(r.db, []interface{}{
+		(*types.FlaggedGroup)(nil),
+		(*types.ConfirmedGroup)(nil),
+		(*types.ClearedGroup)(nil),
+		(*types.LockedGroup)(nil),
+	}, columns, staleBefore, viewedAfter, limit).Scan(ctx, &rows)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query groups for thumbnail update: %w", err)
+	}
 
-			for _, group := range reviewGroups {
-				groups[group.ID] = &group.Group
-			}
-		}
-		return nil
-	})
+	groups := make(map[uint64]*types.Group, len(rows))
+	for _, group := range rows {
+		groups[group.ID] = group
+	}
 
-	return groups, err
+	return groups, nil
 }
 
 // DeleteGroup removes a group and all associated data from the database.
@@ -712,20 +756,16 @@ func (r *GroupModel) DeleteGroup(ctx context.Context, groupID uint64) (bool, err
 // GetGroupToScan finds the next group to scan from confirmed_groups, falling back to flagged_groups
 // if no confirmed groups are available.
 func (r *GroupModel) GetGroupToScan(ctx context.Context) (*types.Group, error) {
+	now := r.now()
 	var group *types.Group
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		// First try confirmed groups
 		var confirmedGroup types.ConfirmedGroup
-		err := tx.NewSelect().Model(&confirmedGroup).
-			Where("last_scanned < NOW() - INTERVAL '1 day'").
-			Order("last_scanned ASC").
-			Limit(1).
-			For("UPDATE SKIP LOCKED").
-			Scan(ctx)
+		err := scanCandidateQuery(tx, &confirmedGroup, now).Scan(ctx)
 		if err == nil {
 			// Update last_scanned
 			_, err = tx.NewUpdate().Model(&confirmedGroup).
-				Set("last_scanned = ?", time.Now()).
+				Set("last_scanned = ?", now).
 				Where("id = ?", confirmedGroup.ID).
 				Exec(ctx)
 			if err != nil {
@@ -738,21 +778,20 @@ func (r *GroupModel) GetGroupToScan(ctx context.Context) (*types.Group, error) {
 			return nil
 		}
 
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to query confirmed groups: %w", err)
+		}
+
 		// If no confirmed groups, try flagged groups
 		var flaggedGroup types.FlaggedGroup
-		err = tx.NewSelect().Model(&flaggedGroup).
-			Where("last_scanned < NOW() - INTERVAL '1 day'").
-			Order("last_scanned ASC").
-			Limit(1).
-			For("UPDATE SKIP LOCKED").
-			Scan(ctx)
+		err = scanCandidateQuery(tx, &flaggedGroup, now).Scan(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to get group to scan: %w", err)
+			return notFoundError(fmt.Errorf("failed to query flagged groups: %w", err), types.ErrNoGroupsToScan)
 		}
 
 		// Update last_scanned
 		_, err = tx.NewUpdate().Model(&flaggedGroup).
-			Set("last_scanned = ?", time.Now()).
+			Set("last_scanned = ?", now).
 			Where("id = ?", flaggedGroup.ID).
 			Exec(ctx)
 		if err != nil {
@@ -771,6 +810,17 @@ func (r *GroupModel) GetGroupToScan(ctx context.Context) (*types.Group, error) {
 	return group, nil
 }
 
+// prioritizeGroupScansQuery builds the query that resets last_scanned of the given
+// groups that are confirmed, so GetGroupToScan returns them before any other group.
+// The IDs of the updated groups are returned.
+func prioritizeGroupScansQuery(db bun.IDB, groupIDs []uint64) *bun.UpdateQuery {
+	return db.NewUpdate().
+		Model((*types.ConfirmedGroup)(nil)).
+		Set("last_scanned = ?", time.Unix(0, 0).UTC()).
+		Where("id IN (?)", bun.In(groupIDs)).
+		Returning("id")
+}
+
 // CheckConfirmedGroups checks which groups from a list of IDs exist in any group table.
 // Returns a map of group IDs to their status (confirmed, flagged, cleared, locked).
 func (r *GroupModel) CheckConfirmedGroups(ctx context.Context, groupIDs []uint64) ([]uint64, error) {
@@ -793,8 +843,208 @@ func (r *GroupModel) CheckConfirmedGroups(ctx context.Context, groupIDs []uint64
 	return confirmedGroupIDs, err
 }
 
+// CheckExistingGroups checks which groups from a list of IDs exist in any group table.
+// Returns a map of the existing group IDs to their status. Groups that are not in
+// the database are left out.
+func (r *GroupModel) CheckExistingGroups(ctx context.Context, groupIDs []uint64) (map[uint64]enum.GroupType, error) {
+	statuses := make(map[uint64]enum.GroupType)
+	if len(groupIDs) == 0 {
+		return statuses, nil
+	}
+
+	// Check the tables in order of precedence so a group in several tables
+	// always gets the same status
+	for _, status := range groupStatusPrecedence {
+		var existingIDs []uint64
+		if err := existingGroupsQuery(r.db, newGroupModel(status), groupIDs).Scan(ctx, &existingIDs); err != nil {
+			return nil, fmt.Errorf("failed to check existing %s groups: %w", status, err)
+		}
+		for _, id := range existingIDs {
+			if _, ok := statuses[id]; !ok {
+				statuses[id] = status
+			}
+		}
+	}
+
+	return statuses, nil
+}
+
+// existingGroupsQuery builds the query selecting which of the given groups are in the model's table.
+func existingGroupsQuery(db bun.IDB, model interface{}, groupIDs []uint64) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(model).
+		Column("id").
+		Where("id IN (?)", bun.In(groupIDs))
+}
+
+// SearchGroups finds groups whose name, description or reason contains the query
+// across the tables of the given statuses. Results are ordered by group ID and
+// paginated using the returned cursor, which is nil when there are no more results.
+func (r *GroupModel) SearchGroups(
+	ctx context.Context, query string, statuses []enum.GroupType, cursor *types.GroupSearchCursor, limit int,
+) ([]*types.GroupSearchResult, *types.GroupSearchCursor, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < types.MinSearchQueryLength {
+		return nil, nil, types.ErrSearchQueryTooShort
+	}
+
+	// Escape LIKE wildcards so the query is matched literally
+	escaper := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	pattern := "%" + escaper.Replace(query) + "%"
+
+	// Build a subquery for each requested status table
+	var union *bun.SelectQuery
+	for _, status := range statuses {
+		var table string
+		switch status {
+		case enum.GroupTypeFlagged:
+			table = "flagged_groups"
+		case enum.GroupTypeConfirmed:
+			table = "confirmed_groups"
+		case enum.GroupTypeCleared:
+			table = "cleared_groups"
+		case enum.GroupTypeLocked:
+			table = "locked_groups"
+		case enum.GroupTypeUnflagged:
+			continue
+		}
+
+		subq := r.db.NewSelect().
+			TableExpr("? AS g", bun.Ident(table)).
+			ColumnExpr("g.id, g.name, g.reason, g.confidence").
+			ColumnExpr("? AS status", status).
+			ColumnExpr("COALESCE(array_length(t.flagged_users, 1), 0) AS flagged_members").
+			Join("LEFT JOIN group_member_trackings AS t ON t.id = g.id").
+			Where("(g.name || ' ' || g.description || ' ' || g.reason) ILIKE ?", pattern)
+
+		if union == nil {
+			union = subq
+		} else {
+			union = union.UnionAll(subq)
+		}
+	}
+
+	if union == nil {
+		return []*types.GroupSearchResult{}, nil, nil
+	}
+
+	// Page through the combined results by ID and status, since a group can be
+	// in more than one table while it moves between statuses
+	combined := r.db.NewSelect().
+		TableExpr("(?) AS results", union).
+		ColumnExpr("*").
+		OrderExpr("id ASC, status ASC")
+
+	if cursor != nil {
+		combined.Where("(id, status) > (?, ?)", cursor.ID, cursor.Status)
+	}
+
+	// Get one extra to determine if there are more results
+	var results []*types.GroupSearchResult
+	err := combined.Limit(limit+1).Scan(ctx, &results)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to search groups: %w (query=%q)", err, query)
+	}
+
+	var nextCursor *types.GroupSearchCursor
+	if len(results) > limit {
+		results = results[:limit]
+		last := results[limit-1]
+		nextCursor = &types.GroupSearchCursor{ID: last.ID, Status: last.Status}
+	}
+
+	r.logger.Debug("Searched groups",
+		zap.String("query", query),
+		zap.Int("statusCount", len(statuses)),
+		zap.Int("resultCount", len(results)))
+
+	return results, nextCursor, nil
+}
+
+// GetGroupFlaggedMemberCounts counts how many of the group's tracked members are
+// confirmed or flagged in a single query. Groups without a tracking record have
+// no tracked members and return zero counts.
+func (r *GroupModel) GetGroupFlaggedMemberCounts(ctx context.Context, groupID uint64) (*types.GroupMemberCounts, error) {
+	var counts types.GroupMemberCounts
+	err := groupMemberCountsQuery(r.db, groupID).Scan(ctx, &counts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &counts, nil
+		}
+		return nil, fmt.Errorf("failed to get group member counts: %w (groupID=%d)", err, groupID)
+	}
+
+	return &counts, nil
+}
+
+// groupMemberCountsQuery builds the query counting the tracked members of a group
+// along with how many of them are in the confirmed and flagged user tables.
+func groupMemberCountsQuery(db bun.IDB, groupID uint64) *bun.SelectQuery {
+	countMembers := func(model interface{}) *bun.SelectQuery {
+		return db.NewSelect().
+			Model(model).
+			ColumnExpr("count(*)").
+			Where("?TableAlias.id = ANY(group_member_tracking.flagged_users)")
+	}
+
+	return db.NewSelect().
+		Model((*types.GroupMemberTracking)(nil)).
+		ColumnExpr("COALESCE(cardinality(?TableAlias.flagged_users), 0) AS tracked").
+		ColumnExpr("(?) AS confirmed", countMembers((*types.ConfirmedUser)(nil))).
+		ColumnExpr("(?) AS flagged", countMembers((*types.FlaggedUser)(nil))).
+		Where("?TableAlias.id = ?", groupID)
+}
+
+// GetCurrentlyViewedCounts returns how many groups of each status are currently held
+// by a reviewer, meaning they were opened within the review hold window.
+func (r *GroupModel) GetCurrentlyViewedCounts(ctx context.Context) (map[enum.GroupType]int, error) {
+	now := r.now()
+	counts := make(map[enum.GroupType]int)
+	for status, model := range map[enum.GroupType]interface{}{
+		enum.GroupTypeFlagged:   (*types.FlaggedGroup)(nil),
+		enum.GroupTypeConfirmed: (*types.ConfirmedGroup)(nil),
+		enum.GroupTypeCleared:   (*types.ClearedGroup)(nil),
+		enum.GroupTypeLocked:    (*types.LockedGroup)(nil),
+	} {
+		count, err := viewedCountQuery(r.db, model, now).Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count viewed %s groups: %w", status, err)
+		}
+		counts[status] = count
+	}
+	return counts, nil
+}
+
+// ReleaseReview resets the last_viewed timestamp of groups held by a reviewer so they
+// return to the review queue immediately instead of after the hold window.
+func (r *GroupModel) ReleaseReview(ctx context.Context, groupIDs ...uint64) error {
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	now := r.now()
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, model := range []interface{}{
+			(*types.FlaggedGroup)(nil),
+			(*types.ConfirmedGroup)(nil),
+			(*types.ClearedGroup)(nil),
+			(*types.LockedGroup)(nil),
+		} {
+			if _, err := releaseReviewQuery(tx, model, groupIDs, now).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to release groups: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
 // GetGroupToReview finds a group to review based on the sort method and target mode.
-func (r *GroupModel) GetGroupToReview(ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, reviewerID uint64) (*types.ReviewGroup, error) {
+// Groups in lockedIDs are skipped since another reviewer has them open.
+// In read-only mode the group is fetched without a row lock and last_viewed is left untouched.
+func (r *GroupModel) GetGroupToReview(
+	ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, reviewerID uint64,
+	lockedIDs []uint64, readOnly bool,
+) (*types.ReviewGroup, error) {
 	// Get recently reviewed group IDs
 	recentIDs, err := r.activity.GetRecentlyReviewedIDs(ctx, reviewerID, true, 100)
 	if err != nil {
@@ -802,6 +1052,7 @@ func (r *GroupModel) GetGroupToReview(ctx context.Context, sortBy enum.ReviewSor
 		// Continue without filtering if there's an error
 		recentIDs = []uint64{}
 	}
+	recentIDs = append(recentIDs, lockedIDs...)
 
 	// Define models in priority order based on target mode
 	var models []interface{}
@@ -838,7 +1089,7 @@ func (r *GroupModel) GetGroupToReview(ctx context.Context, sortBy enum.ReviewSor
 
 	// Try each model in order until we find a group
 	for _, model := range models {
-		result, err := r.getNextToReview(ctx, model, sortBy, recentIDs)
+		result, err := r.getNextToReview(ctx, model, sortBy, recentIDs, readOnly)
 		if err == nil {
 			return result, nil
 		}
@@ -851,7 +1102,9 @@ func (r *GroupModel) GetGroupToReview(ctx context.Context, sortBy enum.ReviewSor
 }
 
 // getNextToReview handles the common logic for getting the next item to review.
-func (r *GroupModel) getNextToReview(ctx context.Context, model interface{}, sortBy enum.ReviewSortBy, recentIDs []uint64) (*types.ReviewGroup, error) {
+func (r *GroupModel) getNextToReview(
+	ctx context.Context, model interface{}, sortBy enum.ReviewSortBy, recentIDs []uint64, readOnly bool,
+) (*types.ReviewGroup, error) {
 	var result types.ReviewGroup
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		// Build subquery to get ID
@@ -861,31 +1114,23 @@ func (r *GroupModel) getNextToReview(ctx context.Context, model interface{}, sor
 
 		// Exclude recently reviewed IDs if any exist
 		if len(recentIDs) > 0 {
-			subq.Where("id NOT IN (?)", bun.In(recentIDs))
+			subq.Where("?TableAlias.id NOT IN (?)", bun.In(recentIDs))
 		}
 
-		// Apply sort order to subquery
-		switch sortBy {
-		case enum.ReviewSortByConfidence:
-			subq.Order("confidence DESC")
-		case enum.ReviewSortByLastUpdated:
-			subq.Order("last_updated ASC")
-		case enum.ReviewSortByReputation:
-			subq.Join("LEFT JOIN group_reputations ON group_reputations.id = ?TableAlias.id").
-				OrderExpr("COALESCE(group_reputations.score, 0) ASC")
-		case enum.ReviewSortByRandom:
-			subq.OrderExpr("RANDOM()")
-		}
+		// Skip groups held by another reviewer and apply sort order to subquery
+		applyViewHold(subq, r.now())
+		applyReviewSort(subq, sortBy, "group_reputations")
 
 		subq.Limit(1)
 
-		// Main query to get the full record with FOR UPDATE
-		err := tx.NewSelect().
+		// Main query to get the full record, locking it unless in read-only mode
+		query := tx.NewSelect().
 			Model(model).
-			Where("id = (?)", subq).
-			For("UPDATE").
-			Scan(ctx)
-		if err != nil {
+			Where("id = (?)", subq)
+		if !readOnly {
+			query.For("UPDATE")
+		}
+		if err := query.Scan(ctx); err != nil {
 			return err
 		}
 
@@ -917,10 +1162,16 @@ func (r *GroupModel) getNextToReview(ctx context.Context, model interface{}, sor
 		}
 		result.Reputation = reputation
 
+		// Skip the last_viewed update in read-only mode
+		if readOnly {
+			return nil
+		}
+
 		// Update last_viewed
+		result.LastViewed = r.now()
 		_, err = tx.NewUpdate().
 			Model(model).
-			Set("last_viewed = ?", time.Now()).
+			Set("last_viewed = ?", result.LastViewed).
 			Where("id = ?", result.ID).
 			Exec(ctx)
 		if err != nil {
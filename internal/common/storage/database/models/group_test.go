@@ -0,0 +1,181 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+func TestGroupMemberCountsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := groupMemberCountsQuery(db, 12345).String()
+
+	assert.Contains(t, query, `COALESCE(cardinality("group_member_tracking".flagged_users), 0) AS tracked`)
+	assert.Contains(t, query, `(SELECT count(*) FROM "confirmed_users" AS "confirmed_user" `+
+		`WHERE ("confirmed_user".id = ANY(group_member_tracking.flagged_users))) AS confirmed`)
+	assert.Contains(t, query, `(SELECT count(*) FROM "flagged_users" AS "flagged_user" `+
+		`WHERE ("flagged_user".id = ANY(group_member_tracking.flagged_users))) AS flagged`)
+	assert.Contains(t, query, `FROM "group_member_trackings" AS "group_member_tracking"`)
+	assert.Contains(t, query, `"group_member_tracking".id = 12345`)
+}
+
+func TestPrioritizeGroupScansQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := prioritizeGroupScansQuery(db, []uint64{10, 20}).String()
+
+	assert.Contains(t, query, `UPDATE "confirmed_groups"`)
+	assert.Contains(t, query, `last_scanned = '1970-01-01 00:00:00+00:00'`)
+	assert.Contains(t, query, "id IN (10, 20)")
+	assert.Contains(t, query, `RETURNING id`)
+}
+
+func TestExistingGroupsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := existingGroupsQuery(db, (*types.LockedGroup)(nil), []uint64{10, 20}).String()
+
+	assert.Contains(t, query, `SELECT "locked_group"."id" FROM "locked_groups"`)
+	assert.Contains(t, query, "id IN (10, 20)")
+}
+
+func TestCheckExistingGroupsStatusIsStable(t *testing.T) {
+	// Every table answers with both groups, as if they were in all of them
+	db, _ := newFakeDB(t, 10, 20)
+	model := NewGroup(db, nil, nil, nil, nil, nil, zap.NewNop())
+
+	for range 10 {
+		statuses, err := model.CheckExistingGroups(context.Background(), []uint64{10, 20})
+		require.NoError(t, err)
+		assert.Equal(t, map[uint64]enum.GroupType{
+			10: enum.GroupTypeLocked,
+			20: enum.GroupTypeLocked,
+		}, statuses)
+	}
+}
+
+func TestRemoveLockedGroupsBatchesInserts(t *testing.T) {
+	db, fake := newFakeDB(t, 10, 20)
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	model := NewGroup(db, nil, nil, nil, nil, nil, zap.NewNop())
+	model.now = func() time.Time { return now }
+
+	require.NoError(t, model.RemoveLockedGroups(context.Background(), []uint64{10, 20}))
+
+	// One insert per source table regardless of how many groups are moved
+	inserts := fake.Queries("INSERT")
+	require.Len(t, inserts, 2)
+	for _, query := range inserts {
+		assert.Contains(t, query, `INSERT INTO "locked_groups"`)
+		assert.Contains(t, query, "ON CONFLICT (id) DO UPDATE")
+		assert.Equal(t, 2, strings.Count(query, "'2025-01-24 12:00:00+00:00'"),
+			"every group in the batch shares the same lock time")
+	}
+	assert.Equal(t, 2, fake.Count("DELETE"))
+}
+
+func TestMergeGroup(t *testing.T) {
+	lockedAt := time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+	clearedAt := lockedAt.Add(time.Hour)
+
+	// Tables are queried confirmed, flagged, cleared, then locked
+	groups := make(map[uint64]*types.ReviewGroup)
+	mergeGroup(groups, &types.ReviewGroup{Group: types.Group{ID: 1}, Status: enum.GroupTypeConfirmed})
+	mergeGroup(groups, &types.ReviewGroup{Group: types.Group{ID: 2}, Status: enum.GroupTypeFlagged})
+	mergeGroup(groups, &types.ReviewGroup{Group: types.Group{ID: 1}, ClearedAt: clearedAt, Status: enum.GroupTypeCleared})
+	mergeGroup(groups, &types.ReviewGroup{Group: types.Group{ID: 2}, ClearedAt: clearedAt, Status: enum.GroupTypeCleared})
+	mergeGroup(groups, &types.ReviewGroup{Group: types.Group{ID: 2}, LockedAt: lockedAt, Status: enum.GroupTypeLocked})
+
+	assert.Equal(t, map[uint64]*types.ReviewGroup{
+		1: {Group: types.Group{ID: 1}, Status: enum.GroupTypeConfirmed},
+		2: {Group: types.Group{ID: 2}, LockedAt: lockedAt, Status: enum.GroupTypeLocked},
+	}, groups)
+}
+
+func TestGroupStatusPrecedence(t *testing.T) {
+	assert.Equal(t, []enum.GroupType{
+		enum.GroupTypeLocked, enum.GroupTypeConfirmed, enum.GroupTypeFlagged, enum.GroupTypeCleared,
+	}, groupStatusPrecedence)
+	assert.Equal(t, len(groupStatusPrecedence), groupStatusRank(enum.GroupTypeUnflagged))
+
+	// Every status in the precedence is looked up in its own table
+	assert.IsType(t, &types.LockedGroup{}, newGroupModel(enum.GroupTypeLocked))
+	assert.IsType(t, &types.ConfirmedGroup{}, newGroupModel(enum.GroupTypeConfirmed))
+	assert.IsType(t, &types.FlaggedGroup{}, newGroupModel(enum.GroupTypeFlagged))
+	assert.IsType(t, &types.ClearedGroup{}, newGroupModel(enum.GroupTypeCleared))
+}
+
+func TestSearchGroupsCursorAppliesToCombinedResults(t *testing.T) {
+	db, fake := newFakeDB(t)
+	model := NewGroup(db, nil, nil, nil, nil, nil, zap.NewNop())
+
+	cursor := &types.GroupSearchCursor{ID: 10, Status: enum.GroupTypeFlagged}
+	_, _, err := model.SearchGroups(context.Background(), "search",
+		[]enum.GroupType{enum.GroupTypeFlagged, enum.GroupTypeConfirmed}, cursor, 5)
+	require.NoError(t, err)
+
+	queries := fake.Queries("SELECT")
+	require.Len(t, queries, 1)
+	assert.Equal(t, 1, strings.Count(queries[0], "(id, status) > (10, 1)"),
+		"the cursor is applied once to the combined results")
+	assert.True(t, strings.HasSuffix(queries[0], "ORDER BY id ASC, status ASC LIMIT 6"))
+}
+
+func TestSearchGroupsPagesThroughGroupsInSeveralTables(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	groups := NewGroup(db, nil, nil, nil, nil, nil, zap.NewNop())
+
+	newGroup := func(id uint64) types.Group {
+		return types.Group{ID: id, UUID: uuid.New(), Name: "cursorsearch_" + strconv.FormatUint(id, 10)}
+	}
+	ids := []uint64{9_000_000_741, 9_000_000_742}
+
+	// The first group is in two tables while it moves between statuses
+	_, err := db.NewInsert().Model(&types.FlaggedGroup{Group: newGroup(ids[0])}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.ConfirmedGroup{Group: newGroup(ids[0])}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.FlaggedGroup{Group: newGroup(ids[1])}).Exec(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.FlaggedGroup)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.ConfirmedGroup)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+	})
+
+	// Every row is returned exactly once when paging one result at a time
+	statuses := []enum.GroupType{enum.GroupTypeFlagged, enum.GroupTypeConfirmed}
+	var found []types.GroupSearchCursor
+	var cursor *types.GroupSearchCursor
+	for range 5 {
+		results, nextCursor, err := groups.SearchGroups(ctx, "cursorsearch_", statuses, cursor, 1)
+		require.NoError(t, err)
+		for _, result := range results {
+			found = append(found, types.GroupSearchCursor{ID: result.ID, Status: result.Status})
+		}
+		if nextCursor == nil {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	assert.Equal(t, []types.GroupSearchCursor{
+		{ID: ids[0], Status: enum.GroupTypeConfirmed},
+		{ID: ids[0], Status: enum.GroupTypeFlagged},
+		{ID: ids[1], Status: enum.GroupTypeFlagged},
+	}, found)
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Time windows used when selecting users and groups. Cutoffs are computed in Go
+// from the model's clock instead of the database's NOW(), so every window is
+// defined once here and can be tested with a fixed time.
+const (
+	// ReviewLockWindow is how long an item stays held by the reviewer who last opened
+	// it. It matches the session timeout so a hold lapses together with an idle session.
+	ReviewLockWindow = 10 * time.Minute
+
+	// ScanInterval is how long a scanned user or group waits before it is due to be
+	// scanned again.
+	ScanInterval = 24 * time.Hour
+
+	// PurgeCheckInterval is how long a user or group waits after a ban or lock check
+	// before it is due to be checked again.
+	PurgeCheckInterval = 24 * time.Hour
+
+	// RecentlyProcessedWindow is how long after its last update a user counts as
+	// recently processed and is not processed again.
+	RecentlyProcessedWindow = 7 * 24 * time.Hour
+)
+
+// purgeCheckBatchQuery builds the query claiming the rows of a table that are due
+// for a ban or lock check, oldest check first, by setting their last_purge_check
+// to now. Rows locked by another worker are skipped.
+func purgeCheckBatchQuery(db bun.IDB, table string, limit int, now time.Time) *bun.RawQuery {
+	return db.NewRaw(`
+		WITH updated AS (
+			UPDATE ?
+			SET last_purge_check = ?
+			WHERE id IN (
+				SELECT id FROM ?
+				WHERE last_purge_check < ?
+				ORDER BY last_purge_check ASC
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id
+		)
+		SELECT * FROM updated
+	`, bun.Ident(table), now, bun.Ident(table), now.Add(-PurgeCheckInterval), limit)
+}
+
+// scanCandidateQuery builds the query locking the row of the model that is most
+// overdue for a scan. Rows locked by another worker are skipped.
+func scanCandidateQuery(db bun.IDB, model interface{}, now time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(model).
+		Where("last_scanned < ?", now.Add(-ScanInterval)).
+		Order("last_scanned ASC").
+		Limit(1).
+		For("UPDATE SKIP LOCKED")
+}
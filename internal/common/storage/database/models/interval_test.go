@@ -0,0 +1,246 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+func TestPurgeCheckBatchQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	for _, table := range []string{"confirmed_users", "flagged_users", "confirmed_groups", "flagged_groups"} {
+		t.Run(table, func(t *testing.T) {
+			query := purgeCheckBatchQuery(db, table, 50, now).String()
+
+			assert.Contains(t, query, `UPDATE "`+table+`"`)
+			assert.Contains(t, query, `SELECT id FROM "`+table+`"`)
+			assert.Contains(t, query, "SET last_purge_check = '2025-01-24 12:00:00+00:00'")
+			assert.Contains(t, query, "WHERE last_purge_check < '2025-01-23 12:00:00+00:00'")
+			assert.Contains(t, query, "LIMIT 50")
+			assert.Contains(t, query, "FOR UPDATE SKIP LOCKED")
+			assert.NotContains(t, query, "NOW()", "the cutoff comes from the model's clock")
+		})
+	}
+}
+
+func TestScanCandidateQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		model interface{}
+		table string
+	}{
+		{name: "confirmed users", model: &types.ConfirmedUser{}, table: `"confirmed_users"`},
+		{name: "flagged users", model: &types.FlaggedUser{}, table: `"flagged_users"`},
+		{name: "confirmed groups", model: &types.ConfirmedGroup{}, table: `"confirmed_groups"`},
+		{name: "flagged groups", model: &types.FlaggedGroup{}, table: `"flagged_groups"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := scanCandidateQuery(db, tt.model, now).String()
+
+			assert.Contains(t, query, "FROM "+tt.table)
+			assert.Contains(t, query, "WHERE (last_scanned < '2025-01-23 12:00:00+00:00')")
+			assert.Contains(t, query, `ORDER BY "last_scanned" ASC LIMIT 1 FOR UPDATE SKIP LOCKED`)
+		})
+	}
+}
+
+// intervalTestNow is the fixed time of the model clock in the interval tests. It is
+// far from the real time so the seeded rows are the only ones near the cutoffs.
+var intervalTestNow = time.Date(2001, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// newIntervalTestUser returns a user with the given ID whose timestamps are all set
+// relative to intervalTestNow.
+func newIntervalTestUser(id uint64, lastScanned, lastPurgeCheck, lastViewed time.Duration) types.User {
+	return types.User{
+		ID:             id,
+		UUID:           uuid.New(),
+		Name:           "interval_" + strconv.FormatUint(id, 10),
+		LastUpdated:    intervalTestNow.Add(-48 * time.Hour),
+		LastScanned:    intervalTestNow.Add(-lastScanned),
+		LastPurgeCheck: intervalTestNow.Add(-lastPurgeCheck),
+		LastViewed:     intervalTestNow.Add(-lastViewed),
+	}
+}
+
+// seedIntervalUsers inserts confirmed and flagged users into the test database and
+// deletes them when the test ends.
+func seedIntervalUsers(t *testing.T, db *bun.DB, confirmed, flagged []types.User) {
+	t.Helper()
+
+	ctx := context.Background()
+	var ids []uint64
+	for _, user := range confirmed {
+		_, err := db.NewInsert().Model(&types.ConfirmedUser{User: user, VerifiedAt: intervalTestNow}).Exec(ctx)
+		require.NoError(t, err)
+		ids = append(ids, user.ID)
+	}
+	for _, user := range flagged {
+		_, err := db.NewInsert().Model(&types.FlaggedUser{User: user}).Exec(ctx)
+		require.NoError(t, err)
+		ids = append(ids, user.ID)
+	}
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.ConfirmedUser)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.FlaggedUser)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+	})
+}
+
+func TestGetUserToScanUsesModelClockWithoutDatabase(t *testing.T) {
+	db, fake := newFakeDB(t, 7)
+	model := NewUser(db, nil, nil, nil, nil, nil, zap.NewNop())
+	model.now = func() time.Time { return intervalTestNow }
+
+	user, err := model.GetUserToScan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), user.ID)
+
+	// The cutoff and the new scan time both come from the model's clock
+	selects := fake.Queries("SELECT")
+	require.Len(t, selects, 1)
+	assert.Contains(t, selects[0], "last_scanned < '2000-12-31 12:00:00+00:00'")
+	updates := fake.Queries("UPDATE")
+	require.Len(t, updates, 1)
+	assert.Contains(t, updates[0], "last_scanned = '2001-01-01 12:00:00+00:00'")
+}
+
+func TestGetUserToScanUsesModelClock(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+	users.now = func() time.Time { return intervalTestNow }
+
+	// One confirmed user overdue for a scan and one flagged user scanned an hour ago
+	seedIntervalUsers(t, db,
+		[]types.User{newIntervalTestUser(9_000_000_701, 2*ScanInterval, 0, time.Hour)},
+		[]types.User{newIntervalTestUser(9_000_000_702, time.Hour, 0, time.Hour)},
+	)
+
+	user, err := users.GetUserToScan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9_000_000_701), user.ID)
+
+	// Nothing else is due until the clock moves past the scan interval
+	_, err = users.GetUserToScan(ctx)
+	require.ErrorIs(t, err, types.ErrNoUsersToScan)
+
+	users.now = func() time.Time { return intervalTestNow.Add(ScanInterval) }
+	user, err = users.GetUserToScan(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9_000_000_702), user.ID)
+}
+
+func TestGetUsersToCheckUsesModelClock(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+	users.now = func() time.Time { return intervalTestNow }
+
+	seedIntervalUsers(t, db,
+		[]types.User{
+			newIntervalTestUser(9_000_000_711, 0, 2*PurgeCheckInterval, time.Hour),
+			newIntervalTestUser(9_000_000_712, 0, time.Hour, time.Hour),
+		},
+		[]types.User{
+			newIntervalTestUser(9_000_000_713, 0, PurgeCheckInterval+time.Minute, time.Hour),
+			newIntervalTestUser(9_000_000_714, 0, PurgeCheckInterval-time.Minute, time.Hour),
+		},
+	)
+
+	// Only users checked longer ago than the purge check interval are claimed
+	userIDs, err := users.GetUsersToCheck(ctx, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{9_000_000_711, 9_000_000_713}, userIDs)
+
+	// Claimed users are not returned again at the same time
+	userIDs, err = users.GetUsersToCheck(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, userIDs)
+
+	// The flagged user checked just inside the interval is due two minutes later
+	users.now = func() time.Time { return intervalTestNow.Add(2 * time.Minute) }
+	userIDs, err = users.GetUsersToCheck(ctx, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{9_000_000_714}, userIDs)
+}
+
+func TestGetGroupsToCheckUsesModelClock(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	groups := NewGroup(db, nil, nil, nil, nil, nil, zap.NewNop())
+	groups.now = func() time.Time { return intervalTestNow }
+
+	newGroup := func(id uint64, lastPurgeCheck time.Duration) types.Group {
+		return types.Group{
+			ID:             id,
+			UUID:           uuid.New(),
+			Name:           "interval_" + strconv.FormatUint(id, 10),
+			LastPurgeCheck: intervalTestNow.Add(-lastPurgeCheck),
+		}
+	}
+	ids := []uint64{9_000_000_721, 9_000_000_722, 9_000_000_723}
+	_, err := db.NewInsert().Model(&types.ConfirmedGroup{Group: newGroup(ids[0], 2*PurgeCheckInterval), VerifiedAt: intervalTestNow}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.FlaggedGroup{Group: newGroup(ids[1], PurgeCheckInterval+time.Minute)}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.FlaggedGroup{Group: newGroup(ids[2], time.Hour)}).Exec(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.ConfirmedGroup)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.FlaggedGroup)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+	})
+
+	groupIDs, err := groups.GetGroupsToCheck(ctx, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{ids[0], ids[1]}, groupIDs)
+
+	// Once the interval has passed since the first check every group is due again
+	groups.now = func() time.Time { return intervalTestNow.Add(PurgeCheckInterval + time.Second) }
+	groupIDs, err = groups.GetGroupsToCheck(ctx, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ids, groupIDs)
+}
+
+func TestGetNextToReviewUsesModelClock(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+	users.now = func() time.Time { return intervalTestNow }
+
+	// One user opened by a reviewer within the hold and one whose hold has lapsed
+	held := newIntervalTestUser(9_000_000_731, 0, 0, ReviewLockWindow/2)
+	lapsed := newIntervalTestUser(9_000_000_732, 0, 0, 2*ReviewLockWindow)
+	lapsed.LastUpdated = held.LastUpdated.Add(time.Minute)
+	seedIntervalUsers(t, db, nil, []types.User{held, lapsed})
+
+	user, err := users.getNextToReview(ctx, &types.FlaggedUser{}, enum.ReviewSortByLastUpdated, 0, nil, 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, lapsed.ID, user.ID)
+	assert.Equal(t, intervalTestNow, user.LastViewed)
+
+	// Both users are held now, until the clock moves past the hold window
+	_, err = users.getNextToReview(ctx, &types.FlaggedUser{}, enum.ReviewSortByLastUpdated, 0, nil, 1, false)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	users.now = func() time.Time { return intervalTestNow.Add(ReviewLockWindow) }
+	user, err = users.getNextToReview(ctx, &types.FlaggedUser{}, enum.ReviewSortByLastUpdated, 0, nil, 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, held.ID, user.ID)
+}
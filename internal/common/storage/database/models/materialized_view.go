@@ -11,6 +11,7 @@ import (
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"github.com/uptrace/bun"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 // MaterializedViewModel handles refresh tracking for materialized views.
@@ -28,49 +29,123 @@ func NewMaterializedView(db *bun.DB, logger *zap.Logger) *MaterializedViewModel
 }
 
 // RefreshIfStale refreshes a materialized view if it hasn't been refreshed in the given duration.
+// The refresh is skipped if the view is already being refreshed elsewhere.
 func (m *MaterializedViewModel) RefreshIfStale(ctx context.Context, period enum.LeaderboardPeriod) error {
-	viewName := fmt.Sprintf("vote_leaderboard_stats_%s", period)
+	viewName := leaderboardViewName(period)
 	staleDuration := m.getStaleDuration(period)
 
 	return m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		locked, err := lockView(ctx, tx, viewName)
+		if err != nil || !locked {
+			return err
+		}
+
 		// Get last refresh time
 		var refresh types.MaterializedViewRefresh
-		err := tx.NewSelect().
+		err = tx.NewSelect().
 			Model(&refresh).
 			Where("view_name = ?", viewName).
-			For("UPDATE SKIP LOCKED").
 			Scan(ctx)
 
 		if err != nil || time.Since(refresh.LastRefresh) > staleDuration {
-			// Refresh the materialized view
-			_, err = tx.NewRaw(fmt.Sprintf(`
-				REFRESH MATERIALIZED VIEW CONCURRENTLY %s
-			`, viewName)).Exec(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to refresh view %s: %w", viewName, err)
-			}
-
-			// Update last refresh time
-			_, err = tx.NewInsert().
-				Model(&types.MaterializedViewRefresh{
-					ViewName:    viewName,
-					LastRefresh: time.Now(),
-				}).
-				On("CONFLICT (view_name) DO UPDATE").
-				Set("last_refresh = EXCLUDED.last_refresh").
-				Exec(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to update refresh time: %w", err)
-			}
+			return refreshView(ctx, tx, viewName)
 		}
 
 		return nil
 	})
 }
 
+// ForceRefreshAll refreshes every leaderboard view at once regardless of when it was
+// last refreshed. The reviewer stats read their accuracy from these views so they are
+// refreshed too. Views already being refreshed elsewhere are skipped instead of being
+// refreshed twice. The results are returned in the order of the leaderboard periods.
+func (m *MaterializedViewModel) ForceRefreshAll(ctx context.Context) ([]*types.ViewRefreshResult, error) {
+	periods := enum.LeaderboardPeriodValues()
+	results := make([]*types.ViewRefreshResult, len(periods))
+
+	g, ctx := errgroup.WithContext(ctx)
+	for i, period := range periods {
+		g.Go(func() error {
+			viewName := leaderboardViewName(period)
+			result := &types.ViewRefreshResult{ViewName: viewName, Period: period}
+			start := time.Now()
+
+			err := m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+				locked, err := lockView(ctx, tx, viewName)
+				if err != nil {
+					return err
+				}
+				if !locked {
+					result.Skipped = true
+					return nil
+				}
+				return refreshView(ctx, tx, viewName)
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Duration = time.Since(start)
+			results[i] = result
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("Force refreshed materialized views", zap.Int("viewCount", len(results)))
+	return results, nil
+}
+
+// lockView takes a transaction-level advisory lock for the view so that only one
+// refresh of it runs at a time. It returns false if the lock is held elsewhere.
+func lockView(ctx context.Context, tx bun.Tx, viewName string) (bool, error) {
+	var locked bool
+	if err := viewLockQuery(tx, viewName).Scan(ctx, &locked); err != nil {
+		return false, fmt.Errorf("failed to lock view %s: %w", viewName, err)
+	}
+	return locked, nil
+}
+
+// viewLockQuery builds the query trying to take the advisory lock of a view.
+func viewLockQuery(db bun.IDB, viewName string) *bun.SelectQuery {
+	return db.NewSelect().ColumnExpr("pg_try_advisory_xact_lock(hashtext(?))", viewName)
+}
+
+// refreshView refreshes the materialized view and records the refresh time.
+func refreshView(ctx context.Context, tx bun.Tx, viewName string) error {
+	_, err := tx.NewRaw(fmt.Sprintf(`
+		REFRESH MATERIALIZED VIEW CONCURRENTLY %s
+	`, viewName)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to refresh view %s: %w", viewName, err)
+	}
+
+	_, err = tx.NewInsert().
+		Model(&types.MaterializedViewRefresh{
+			ViewName:    viewName,
+			LastRefresh: time.Now(),
+		}).
+		On("CONFLICT (view_name) DO UPDATE").
+		Set("last_refresh = EXCLUDED.last_refresh").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update refresh time: %w", err)
+	}
+
+	return nil
+}
+
+// leaderboardViewName returns the name of the leaderboard view of a period.
+func leaderboardViewName(period enum.LeaderboardPeriod) string {
+	return fmt.Sprintf("vote_leaderboard_stats_%s", period)
+}
+
 // GetRefreshInfo returns the last refresh time and next scheduled refresh for a view.
 func (m *MaterializedViewModel) GetRefreshInfo(ctx context.Context, period enum.LeaderboardPeriod) (lastRefresh, nextRefresh time.Time, err error) {
-	viewName := fmt.Sprintf("vote_leaderboard_stats_%s", period)
+	viewName := leaderboardViewName(period)
 	staleDuration := m.getStaleDuration(period)
 
 	var refresh types.MaterializedViewRefresh
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestViewLockQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := viewLockQuery(db, "vote_leaderboard_stats_Daily").String()
+
+	assert.Equal(t, "SELECT pg_try_advisory_xact_lock(hashtext('vote_leaderboard_stats_Daily'))", query)
+}
+
+func TestLeaderboardViewName(t *testing.T) {
+	assert.Equal(t, "vote_leaderboard_stats_Weekly", leaderboardViewName(enum.LeaderboardPeriodWeekly))
+	assert.Equal(t, "vote_leaderboard_stats_AllTime", leaderboardViewName(enum.LeaderboardPeriodAllTime))
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// NoteModel handles database operations for reviewer notes on users and groups.
+type NoteModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewNote creates a new NoteModel instance.
+func NewNote(db *bun.DB, logger *zap.Logger) *NoteModel {
+	return &NoteModel{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// AddNote saves a new note and sets its ID.
+func (m *NoteModel) AddNote(ctx context.Context, note *types.ReviewNote) error {
+	_, err := m.db.NewInsert().
+		Model(note).
+		Returning("id").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to add note: %w (targetType=%s, targetID=%d)",
+			err, note.TargetType, note.TargetID)
+	}
+
+	m.logger.Debug("Added note",
+		zap.String("targetType", note.TargetType.String()),
+		zap.Uint64("targetID", note.TargetID),
+		zap.Int64("noteID", note.ID))
+	return nil
+}
+
+// GetNotes retrieves the latest notes of a user or group, newest first.
+func (m *NoteModel) GetNotes(
+	ctx context.Context, targetType enum.NoteTargetType, targetID uint64, limit int,
+) ([]*types.ReviewNote, error) {
+	var notes []*types.ReviewNote
+	err := notesQuery(m.db, targetType, targetID, limit).Scan(ctx, &notes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notes: %w (targetType=%s, targetID=%d)", err, targetType, targetID)
+	}
+	return notes, nil
+}
+
+// DeleteNote deletes a note of a user or group and returns the deleted note.
+// Only the author of the note can delete it unless isAdmin is set.
+// Returns types.ErrNoteNotFound if the target has no such note and
+// types.ErrNotNoteAuthor if the note belongs to another reviewer.
+func (m *NoteModel) DeleteNote(
+	ctx context.Context, targetType enum.NoteTargetType, targetID uint64, noteID int64, reviewerID uint64, isAdmin bool,
+) (*types.ReviewNote, error) {
+	var note types.ReviewNote
+	err := m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&note).
+			Where("id = ?", noteID).
+			Where("target_type = ?", targetType).
+			Where("target_id = ?", targetID).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return types.ErrNoteNotFound
+			}
+			return fmt.Errorf("failed to get note: %w", err)
+		}
+
+		if note.AuthorID != reviewerID && !isAdmin {
+			return types.ErrNotNoteAuthor
+		}
+
+		_, err = tx.NewDelete().
+			Model((*types.ReviewNote)(nil)).
+			Where("id = ?", noteID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete note: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete note: %w (noteID=%d)", err, noteID)
+	}
+
+	m.logger.Debug("Deleted note",
+		zap.String("targetType", targetType.String()),
+		zap.Uint64("targetID", targetID),
+		zap.Int64("noteID", noteID))
+	return &note, nil
+}
+
+// notesQuery builds the query selecting the latest notes of a user or group.
+func notesQuery(db bun.IDB, targetType enum.NoteTargetType, targetID uint64, limit int) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.ReviewNote)(nil)).
+		Where("target_type = ?", targetType).
+		Where("target_id = ?", targetID).
+		Order("created_at DESC", "id DESC").
+		Limit(limit)
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestNotesQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := notesQuery(db, enum.NoteTargetTypeGroup, 42, 3).String()
+
+	assert.Contains(t, query, `FROM "review_notes" AS "review_note"`)
+	assert.Contains(t, query, `WHERE (target_type = 1) AND (target_id = 42)`)
+	assert.Contains(t, query, `ORDER BY "created_at" DESC, "id" DESC LIMIT 3`)
+}
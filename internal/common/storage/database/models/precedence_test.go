@@ -0,0 +1,101 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robalyx/rotector/internal/common/storage/database/migrations"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/migrate"
+	"go.uber.org/zap"
+)
+
+// testDSNEnv names the environment variable holding the DSN of a disposable
+// TimescaleDB database used by the database-backed model tests.
+const testDSNEnv = "ROTECTOR_TEST_POSTGRES_DSN"
+
+// openTestDB connects to the test database and applies all migrations.
+// The test is skipped if no test database is configured.
+func openTestDB(t *testing.T) *bun.DB {
+	t.Helper()
+
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+
+	ctx := context.Background()
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn))), pgdialect.New())
+	t.Cleanup(func() { _ = db.Close() })
+
+	migrator := migrate.NewMigrator(db, migrations.Migrations)
+	require.NoError(t, migrator.Init(ctx))
+	_, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+
+	return db
+}
+
+// newTestUserModel creates a UserModel backed by the test database.
+func newTestUserModel(db *bun.DB) *UserModel {
+	logger := zap.NewNop()
+	activity := NewActivity(db, logger)
+	votes := NewVote(db, activity, NewMaterializedView(db, logger), logger)
+	return NewUser(db, NewTracking(db, logger), activity, NewReputation(db, votes, logger), votes, NewProtected(db, logger), logger)
+}
+
+// seedFlaggedUsers inserts flagged users with the given IDs and confidence into the
+// test database and deletes them when the test ends.
+func seedFlaggedUsers(t *testing.T, db *bun.DB, confidence float64, ids ...uint64) {
+	t.Helper()
+
+	ctx := context.Background()
+	for _, id := range ids {
+		user := types.User{ID: id, UUID: uuid.New(), Name: "seed_" + strconv.FormatUint(id, 10), Confidence: confidence}
+		_, err := db.NewInsert().Model(&types.FlaggedUser{User: user}).Exec(ctx)
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.FlaggedUser)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+	})
+}
+
+func TestGetUserByIDStatusIsStable(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	// Seed a user into both the cleared and banned tables as can happen during races
+	now := time.Now()
+	user := types.User{ID: 9_000_000_001, UUID: uuid.New(), Name: "precedence_test"}
+	_, err := db.NewInsert().Model(&types.ClearedUser{User: user, ClearedAt: now}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.BannedUser{User: user, PurgedAt: now}).Exec(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.ClearedUser)(nil)).Where("id = ?", user.ID).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.BannedUser)(nil)).Where("id = ?", user.ID).Exec(ctx)
+	})
+
+	for range 5 {
+		result, err := users.GetUserByID(ctx, strconv.FormatUint(user.ID, 10), types.UserFields{})
+		require.NoError(t, err)
+		assert.Equal(t, enum.UserTypeBanned, result.Status)
+	}
+
+	// Batch lookups agree with single lookups
+	results, err := users.GetUsersByIDs(ctx, []uint64{user.ID}, types.UserFields{})
+	require.NoError(t, err)
+	assert.Equal(t, enum.UserTypeBanned, results[user.ID].Status)
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// ProtectedModel handles database operations for accounts that must never be flagged.
+type ProtectedModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewProtected creates a new ProtectedModel instance.
+func NewProtected(db *bun.DB, logger *zap.Logger) *ProtectedModel {
+	return &ProtectedModel{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// AddProtectedAccount creates or updates a protected account record.
+func (m *ProtectedModel) AddProtectedAccount(ctx context.Context, account *types.ProtectedAccount) error {
+	_, err := m.db.NewInsert().
+		Model(account).
+		On("CONFLICT (id) DO UPDATE").
+		Set("note = EXCLUDED.note").
+		Set("added_by = EXCLUDED.added_by").
+		Set("added_at = EXCLUDED.added_at").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to add protected account: %w", err)
+	}
+	return nil
+}
+
+// RemoveProtectedAccount removes a protected account record.
+// Returns true if an account was removed, false if the account wasn't protected.
+func (m *ProtectedModel) RemoveProtectedAccount(ctx context.Context, userID uint64) (bool, error) {
+	result, err := m.db.NewDelete().
+		Model((*types.ProtectedAccount)(nil)).
+		Where("id = ?", userID).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove protected account: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+// GetProtectedAccounts retrieves all protected accounts ordered by when they were added.
+func (m *ProtectedModel) GetProtectedAccounts(ctx context.Context) ([]*types.ProtectedAccount, error) {
+	var accounts []*types.ProtectedAccount
+	err := m.db.NewSelect().
+		Model(&accounts).
+		Order("added_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get protected accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// GetProtectedIDs returns which of the given user IDs are protected.
+func (m *ProtectedModel) GetProtectedIDs(ctx context.Context, userIDs []uint64) (map[uint64]bool, error) {
+	protected := make(map[uint64]bool)
+	if len(userIDs) == 0 {
+		return protected, nil
+	}
+
+	var ids []uint64
+	err := m.db.NewSelect().
+		Model((*types.ProtectedAccount)(nil)).
+		Column("id").
+		Where("id IN (?)", bun.In(userIDs)).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get protected IDs: %w", err)
+	}
+
+	for _, id := range ids {
+		protected[id] = true
+	}
+	return protected, nil
+}
+
+// IsProtected checks if a user ID is protected.
+func (m *ProtectedModel) IsProtected(ctx context.Context, userID uint64) (bool, error) {
+	exists, err := protectedAccountQuery(m.db, userID).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check protected account: %w", err)
+	}
+	return exists, nil
+}
+
+// protectedAccountQuery builds the query selecting the protected account with the given user ID.
+func protectedAccountQuery(db bun.IDB, userID uint64) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.ProtectedAccount)(nil)).
+		Where("id = ?", userID)
+}
@@ -30,8 +30,15 @@ func NewReputation(db *bun.DB, votes *VoteModel, logger *zap.Logger) *Reputation
 }
 
 // UpdateUserVotes updates the upvotes or downvotes count for a user in training mode.
+// The vote and the count are saved together, and types.ErrTargetDecided is returned
+// if the user has already been confirmed or cleared.
 func (r *ReputationModel) UpdateUserVotes(ctx context.Context, userID uint64, discordUserID uint64, isUpvote bool) error {
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Save the vote first, which locks the user so it cannot be decided meanwhile
+		if err := saveVoteTx(ctx, tx, userID, discordUserID, isUpvote, enum.VoteTypeUser); err != nil {
+			return err
+		}
+
 		var reputation types.UserReputation
 		err := tx.NewSelect().
 			Model(&reputation).
@@ -42,11 +49,13 @@ func (r *ReputationModel) UpdateUserVotes(ctx context.Context, userID uint64, di
 			return fmt.Errorf("failed to get reputation: %w", err)
 		}
 
-		// Update vote counts
+		// Update vote counts, where a new vote always counts at full weight
 		if isUpvote {
 			reputation.Upvotes++
+			reputation.DecayedScore++
 		} else {
 			reputation.Downvotes++
+			reputation.DecayedScore--
 		}
 
 		// Update reputation
@@ -59,6 +68,7 @@ func (r *ReputationModel) UpdateUserVotes(ctx context.Context, userID uint64, di
 			Set("upvotes = EXCLUDED.upvotes").
 			Set("downvotes = EXCLUDED.downvotes").
 			Set("score = EXCLUDED.score").
+			Set("decayed_score = EXCLUDED.decayed_score").
 			Set("updated_at = EXCLUDED.updated_at").
 			Exec(ctx)
 		if err != nil {
@@ -68,20 +78,25 @@ func (r *ReputationModel) UpdateUserVotes(ctx context.Context, userID uint64, di
 		return nil
 	})
 	if err != nil {
+		if errors.Is(err, types.ErrTargetDecided) {
+			r.votes.VerifyDecidedVotes(ctx, userID, enum.VoteTypeUser)
+		}
 		return err
 	}
 
-	// Save the vote
-	if err := r.votes.SaveVote(ctx, userID, discordUserID, isUpvote, enum.VoteTypeUser); err != nil {
-		return fmt.Errorf("failed to save vote: %w", err)
-	}
-
 	return nil
 }
 
 // UpdateGroupVotes updates the upvotes or downvotes count for a group in training mode.
+// The vote and the count are saved together, and types.ErrTargetDecided is returned
+// if the group has already been confirmed or cleared.
 func (r *ReputationModel) UpdateGroupVotes(ctx context.Context, groupID uint64, discordUserID uint64, isUpvote bool) error {
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Save the vote first, which locks the group so it cannot be decided meanwhile
+		if err := saveVoteTx(ctx, tx, groupID, discordUserID, isUpvote, enum.VoteTypeGroup); err != nil {
+			return err
+		}
+
 		var reputation types.GroupReputation
 		err := tx.NewSelect().
 			Model(&reputation).
@@ -92,11 +107,13 @@ func (r *ReputationModel) UpdateGroupVotes(ctx context.Context, groupID uint64,
 			return fmt.Errorf("failed to get reputation: %w", err)
 		}
 
-		// Update vote counts
+		// Update vote counts, where a new vote always counts at full weight
 		if isUpvote {
 			reputation.Upvotes++
+			reputation.DecayedScore++
 		} else {
 			reputation.Downvotes++
+			reputation.DecayedScore--
 		}
 
 		// Update reputation
@@ -109,6 +126,7 @@ func (r *ReputationModel) UpdateGroupVotes(ctx context.Context, groupID uint64,
 			Set("upvotes = EXCLUDED.upvotes").
 			Set("downvotes = EXCLUDED.downvotes").
 			Set("score = EXCLUDED.score").
+			Set("decayed_score = EXCLUDED.decayed_score").
 			Set("updated_at = EXCLUDED.updated_at").
 			Exec(ctx)
 		if err != nil {
@@ -118,14 +136,12 @@ func (r *ReputationModel) UpdateGroupVotes(ctx context.Context, groupID uint64,
 		return nil
 	})
 	if err != nil {
+		if errors.Is(err, types.ErrTargetDecided) {
+			r.votes.VerifyDecidedVotes(ctx, groupID, enum.VoteTypeGroup)
+		}
 		return err
 	}
 
-	// Save the vote
-	if err := r.votes.SaveVote(ctx, groupID, discordUserID, isUpvote, enum.VoteTypeGroup); err != nil {
-		return fmt.Errorf("failed to save vote: %w", err)
-	}
-
 	return nil
 }
 
@@ -160,3 +176,101 @@ func (r *ReputationModel) GetGroupReputation(ctx context.Context, groupID uint64
 	}
 	return &reputation.Reputation, nil
 }
+
+// RefreshDecayedScores recalculates the decayed reputation scores of users and groups
+// from their votes, counting votes older than VoteDecayAge at reduced weight. New
+// votes already count at full weight, so only the targets with a vote that passed
+// the decay age since the previous refresh are recalculated. A zero since time
+// recalculates every target. Returns the number of reputation records updated.
+func (r *ReputationModel) RefreshDecayedScores(ctx context.Context, since, now time.Time) (int, error) {
+	cutoff := now.Add(-types.VoteDecayAge)
+	var totalUpdated int
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, tables := range []struct {
+			votes      interface{}
+			reputation interface{}
+		}{
+			{votes: (*types.UserVote)(nil), reputation: (*types.UserReputation)(nil)},
+			{votes: (*types.GroupVote)(nil), reputation: (*types.GroupReputation)(nil)},
+		} {
+			var tallies []*types.VoteTally
+			err := voteTalliesQuery(tx, tables.votes, since.Add(-types.VoteDecayAge), cutoff, since.IsZero()).
+				Scan(ctx, &tallies)
+			if err != nil {
+				return fmt.Errorf("failed to get vote tallies: %w", err)
+			}
+
+			if len(tallies) == 0 {
+				continue
+			}
+
+			scores := make([]*decayedScore, 0, len(tallies))
+			for _, tally := range tallies {
+				scores = append(scores, &decayedScore{ID: tally.ID, DecayedScore: tally.DecayedScore()})
+			}
+
+			result, err := decayedScoresUpdateQuery(tx, tables.reputation, scores).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update decayed scores: %w", err)
+			}
+
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("failed to get rows affected: %w", err)
+			}
+			totalUpdated += int(affected)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	r.logger.Debug("Refreshed decayed reputation scores",
+		zap.Time("cutoff", cutoff),
+		zap.Int("updatedCount", totalUpdated))
+
+	return totalUpdated, nil
+}
+
+// decayedScore is the recalculated decayed score of a single target.
+type decayedScore struct {
+	ID           uint64  `bun:"id"`
+	DecayedScore float64 `bun:"decayed_score"`
+}
+
+// voteTalliesQuery builds the query summing the votes on each target, split
+// by whether they were cast before the cutoff. Unless all targets are wanted,
+// only the targets with a vote cast between the previous cutoff and the cutoff
+// are summed.
+func voteTalliesQuery(db bun.IDB, model interface{}, previousCutoff, cutoff time.Time, all bool) *bun.SelectQuery {
+	query := db.NewSelect().
+		Model(model).
+		Column("id").
+		ColumnExpr("COALESCE(SUM(CASE WHEN is_upvote THEN 1 ELSE -1 END) FILTER (WHERE voted_at >= ?), 0) AS recent_score", cutoff).
+		ColumnExpr("COALESCE(SUM(CASE WHEN is_upvote THEN 1 ELSE -1 END) FILTER (WHERE voted_at < ?), 0) AS old_score", cutoff).
+		Group("id")
+
+	if !all {
+		query.Where("id IN (?)", db.NewSelect().
+			Model(model).
+			Column("id").
+			Where("voted_at >= ?", previousCutoff).
+			Where("voted_at < ?", cutoff))
+	}
+
+	return query
+}
+
+// decayedScoresUpdateQuery builds a bulk update setting the decayed scores of
+// the given targets. Targets without a reputation record are left alone.
+func decayedScoresUpdateQuery(db bun.IDB, model interface{}, scores []*decayedScore) *bun.UpdateQuery {
+	return db.NewUpdate().
+		With("_data", db.NewValues(&scores)).
+		Model(model).
+		TableExpr("_data").
+		Set("decayed_score = _data.decayed_score").
+		Where("?TableAlias.id = _data.id")
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+func TestVoteTalliesQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	previousCutoff := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := voteTalliesQuery(db, (*types.UserVote)(nil), previousCutoff, cutoff, true).String()
+
+	assert.Contains(t, query, `FROM "user_votes"`)
+	assert.Contains(t, query, `COALESCE(SUM(CASE WHEN is_upvote THEN 1 ELSE -1 END) `+
+		`FILTER (WHERE voted_at >= '2025-01-01 00:00:00+00:00'), 0) AS recent_score`)
+	assert.Contains(t, query, `COALESCE(SUM(CASE WHEN is_upvote THEN 1 ELSE -1 END) `+
+		`FILTER (WHERE voted_at < '2025-01-01 00:00:00+00:00'), 0) AS old_score`)
+	assert.Contains(t, query, `GROUP BY "id"`)
+	assert.NotContains(t, query, "WHERE (id IN")
+
+	// An incremental refresh only sums the targets with a vote that just decayed
+	query = voteTalliesQuery(db, (*types.UserVote)(nil), previousCutoff, cutoff, false).String()
+	assert.Contains(t, query, `WHERE (id IN (SELECT "user_vote"."id" FROM "user_votes" AS "user_vote" `+
+		`WHERE (voted_at >= '2024-12-31 00:00:00+00:00') AND (voted_at < '2025-01-01 00:00:00+00:00')))`)
+}
+
+func TestDecayedScoresUpdateQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := decayedScoresUpdateQuery(db, (*types.GroupReputation)(nil), []*decayedScore{
+		{ID: 10, DecayedScore: -5},
+		{ID: 20, DecayedScore: 1.5},
+	}).String()
+
+	assert.Contains(t, query, `WITH "_data" ("id", "decayed_score") AS (VALUES`)
+	assert.Contains(t, query, `UPDATE "group_reputations"`)
+	assert.Contains(t, query, `SET decayed_score = _data.decayed_score`)
+	assert.Contains(t, query, `WHERE ("group_reputation".id = _data.id)`)
+}
+
+func TestRefreshDecayedScores(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	reputations := NewReputation(db, nil, zap.NewNop())
+
+	// A user heavily downvoted long ago, a user moderately downvoted recently and a
+	// user whose downvotes pass the decay age during the next refresh
+	now := time.Date(2001, 6, 1, 12, 0, 0, 0, time.UTC)
+	oldID, recentID, decayingID := uint64(9_000_000_901), uint64(9_000_000_902), uint64(9_000_000_903)
+	ids := []uint64{oldID, recentID, decayingID}
+	seedDownvotes := func(id uint64, count int, votedAt time.Time) {
+		for i := range count {
+			_, err := db.NewInsert().Model(&types.UserVote{Vote: types.Vote{
+				ID: id, DiscordUserID: uint64(i + 1), VotedAt: votedAt,
+			}}).Exec(ctx)
+			require.NoError(t, err)
+		}
+		_, err := db.NewInsert().Model(&types.UserReputation{Reputation: types.Reputation{
+			ID: id, Downvotes: int32(count), Score: -int32(count), DecayedScore: -float64(count), UpdatedAt: votedAt,
+		}}).Exec(ctx)
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.UserVote)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.UserReputation)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+	})
+	seedDownvotes(oldID, 10, now.Add(-types.VoteDecayAge-10*24*time.Hour))
+	seedDownvotes(recentID, 6, now.Add(-10*24*time.Hour))
+	seedDownvotes(decayingID, 4, now.Add(-types.VoteDecayAge-time.Hour))
+
+	decayedScores := func() map[uint64]float64 {
+		var reps []types.UserReputation
+		require.NoError(t, db.NewSelect().Model(&reps).Where("id IN (?)", bun.In(ids)).Scan(ctx))
+		scores := make(map[uint64]float64, len(reps))
+		for _, rep := range reps {
+			scores[rep.ID] = rep.DecayedScore
+		}
+		return scores
+	}
+
+	// Refreshing a day after the previous refresh only recalculates the user
+	// whose votes decayed in between
+	updated, err := reputations.RefreshDecayedScores(ctx, now.Add(-24*time.Hour), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	assert.Equal(t, map[uint64]float64{oldID: -10, recentID: -6, decayingID: -2}, decayedScores())
+
+	// A full refresh lets the old heavily downvoted user fall behind the recent one
+	_, err = reputations.RefreshDecayedScores(ctx, time.Time{}, now)
+	require.NoError(t, err)
+	scores := decayedScores()
+	assert.Equal(t, map[uint64]float64{oldID: -5, recentID: -6, decayingID: -2}, scores)
+	assert.Greater(t, scores[oldID], scores[recentID])
+
+	// The exact scores are left alone
+	var rep types.UserReputation
+	require.NoError(t, db.NewSelect().Model(&rep).Where("id = ?", oldID).Scan(ctx))
+	assert.Equal(t, int32(-10), rep.Score)
+}
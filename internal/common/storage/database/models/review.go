@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/uptrace/bun"
+)
+
+// applyReviewSort orders a review subquery by the given sort method. Deterministic
+// sorts fall back to last_updated and then id so items sharing the same primary
+// value (such as a confidence of 1.00) are always returned in the same order.
+// The reputationTable is joined when sorting by reputation, which uses the decayed
+// score so that old votes weigh less than recent ones.
+func applyReviewSort(subq *bun.SelectQuery, sortBy enum.ReviewSortBy, reputationTable string) {
+	switch sortBy {
+	case enum.ReviewSortByConfidence:
+		subq.OrderExpr("?TableAlias.confidence DESC, ?TableAlias.last_updated ASC, ?TableAlias.id ASC")
+	case enum.ReviewSortByLastUpdated:
+		subq.OrderExpr("?TableAlias.last_updated ASC, ?TableAlias.id ASC")
+	case enum.ReviewSortByReputation:
+		subq.Join("LEFT JOIN ? ON ?.id = ?TableAlias.id", bun.Ident(reputationTable), bun.Ident(reputationTable)).
+			OrderExpr("COALESCE(?.decayed_score, 0) ASC, ?TableAlias.last_updated ASC, ?TableAlias.id ASC", bun.Ident(reputationTable))
+	case enum.ReviewSortByRandom:
+		// A random order has no meaningful ties to break
+		subq.OrderExpr("RANDOM()")
+	}
+}
+
+// applyConfidenceThreshold limits a review subquery to items with at least the given
+// confidence. No limit is applied when the threshold is zero or when sorting by
+// reputation, since that sort surfaces items by community votes rather than confidence.
+func applyConfidenceThreshold(subq *bun.SelectQuery, sortBy enum.ReviewSortBy, minConfidence float64) {
+	if minConfidence <= 0 || sortBy == enum.ReviewSortByReputation {
+		return
+	}
+	subq.Where("?TableAlias.confidence >= ?", minConfidence)
+}
+
+// applyViewHold excludes items that a reviewer opened within the hold window so two
+// reviewers are not shown the same item at the same time.
+func applyViewHold(subq *bun.SelectQuery, now time.Time) {
+	subq.Where("?TableAlias.last_viewed < ?", now.Add(-ReviewLockWindow))
+}
+
+// viewedCountQuery builds the query counting the items of a model that are
+// currently held by a reviewer.
+func viewedCountQuery(db bun.IDB, model interface{}, now time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(model).
+		Where("last_viewed >= ?", now.Add(-ReviewLockWindow))
+}
+
+// releaseReviewQuery builds the query resetting the last_viewed timestamp of held
+// items so they return to the review queue straight away.
+func releaseReviewQuery(db bun.IDB, model interface{}, ids []uint64, now time.Time) *bun.UpdateQuery {
+	return db.NewUpdate().
+		Model(model).
+		Set("last_viewed = ?", time.Time{}).
+		Where("id IN (?)", bun.In(ids)).
+		Where("last_viewed >= ?", now.Add(-ReviewLockWindow))
+}
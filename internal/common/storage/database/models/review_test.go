@@ -0,0 +1,159 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestApplyReviewSort(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	tests := []struct {
+		name            string
+		model           interface{}
+		sortBy          enum.ReviewSortBy
+		reputationTable string
+		wantOrder       string
+	}{
+		{
+			name:            "users by confidence",
+			model:           (*types.FlaggedUser)(nil),
+			sortBy:          enum.ReviewSortByConfidence,
+			reputationTable: "user_reputations",
+			wantOrder: `ORDER BY "flagged_user".confidence DESC, "flagged_user".last_updated ASC, ` +
+				`"flagged_user".id ASC`,
+		},
+		{
+			name:            "users by last updated",
+			model:           (*types.FlaggedUser)(nil),
+			sortBy:          enum.ReviewSortByLastUpdated,
+			reputationTable: "user_reputations",
+			wantOrder:       `ORDER BY "flagged_user".last_updated ASC, "flagged_user".id ASC`,
+		},
+		{
+			name:            "users by reputation",
+			model:           (*types.FlaggedUser)(nil),
+			sortBy:          enum.ReviewSortByReputation,
+			reputationTable: "user_reputations",
+			wantOrder: `ORDER BY COALESCE("user_reputations".decayed_score, 0) ASC, "flagged_user".last_updated ASC, ` +
+				`"flagged_user".id ASC`,
+		},
+		{
+			name:            "groups by confidence",
+			model:           (*types.FlaggedGroup)(nil),
+			sortBy:          enum.ReviewSortByConfidence,
+			reputationTable: "group_reputations",
+			wantOrder: `ORDER BY "flagged_group".confidence DESC, "flagged_group".last_updated ASC, ` +
+				`"flagged_group".id ASC`,
+		},
+		{
+			name:            "groups by reputation",
+			model:           (*types.FlaggedGroup)(nil),
+			sortBy:          enum.ReviewSortByReputation,
+			reputationTable: "group_reputations",
+			wantOrder: `ORDER BY COALESCE("group_reputations".decayed_score, 0) ASC, "flagged_group".last_updated ASC, ` +
+				`"flagged_group".id ASC`,
+		},
+		{
+			name:            "random has no tie break",
+			model:           (*types.FlaggedUser)(nil),
+			sortBy:          enum.ReviewSortByRandom,
+			reputationTable: "user_reputations",
+			wantOrder:       `ORDER BY RANDOM()`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subq := db.NewSelect().Model(tt.model).Column("id")
+			applyReviewSort(subq, tt.sortBy, tt.reputationTable)
+			assert.Contains(t, subq.String(), tt.wantOrder)
+		})
+	}
+}
+
+func TestApplyConfidenceThreshold(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	tests := []struct {
+		name          string
+		sortBy        enum.ReviewSortBy
+		minConfidence float64
+		wantWhere     string
+	}{
+		{
+			name:          "no threshold",
+			sortBy:        enum.ReviewSortByConfidence,
+			minConfidence: 0,
+			wantWhere:     "",
+		},
+		{
+			name:          "threshold with confidence sort",
+			sortBy:        enum.ReviewSortByConfidence,
+			minConfidence: 0.8,
+			wantWhere:     `WHERE ("flagged_user".confidence >= 0.8)`,
+		},
+		{
+			name:          "threshold with random sort",
+			sortBy:        enum.ReviewSortByRandom,
+			minConfidence: 0.5,
+			wantWhere:     `WHERE ("flagged_user".confidence >= 0.5)`,
+		},
+		{
+			name:          "threshold ignored with reputation sort",
+			sortBy:        enum.ReviewSortByReputation,
+			minConfidence: 0.8,
+			wantWhere:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subq := db.NewSelect().Model((*types.FlaggedUser)(nil)).Column("id")
+			applyConfidenceThreshold(subq, tt.sortBy, tt.minConfidence)
+			if tt.wantWhere == "" {
+				assert.NotContains(t, subq.String(), "WHERE")
+			} else {
+				assert.Contains(t, subq.String(), tt.wantWhere)
+			}
+		})
+	}
+}
+
+func TestApplyViewHold(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	subq := db.NewSelect().Model((*types.FlaggedGroup)(nil)).Column("id")
+	applyViewHold(subq, now)
+
+	assert.Contains(t, subq.String(), `WHERE ("flagged_group".last_viewed < '2025-01-24 11:50:00+00:00')`)
+}
+
+func TestViewedCountQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	query := viewedCountQuery(db, (*types.ConfirmedUser)(nil), now).String()
+
+	assert.Contains(t, query, `FROM "confirmed_users" AS "confirmed_user"`)
+	assert.Contains(t, query, `WHERE (last_viewed >= '2025-01-24 11:50:00+00:00')`)
+}
+
+func TestReleaseReviewQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	query := releaseReviewQuery(db, (*types.FlaggedUser)(nil), []uint64{1, 2}, now).String()
+
+	assert.Contains(t, query, `UPDATE "flagged_users" AS "flagged_user"`)
+	assert.Contains(t, query, `SET last_viewed = '0001-01-01 00:00:00+00:00'`)
+	assert.Contains(t, query, `WHERE (id IN (1, 2)) AND (last_viewed >= '2025-01-24 11:50:00+00:00')`)
+}
@@ -0,0 +1,117 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// SecondOpinionModel handles database operations for second opinion requests on users.
+type SecondOpinionModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewSecondOpinion creates a new SecondOpinionModel instance.
+func NewSecondOpinion(db *bun.DB, logger *zap.Logger) *SecondOpinionModel {
+	return &SecondOpinionModel{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// RequestSecondOpinion saves a request for another reviewer to decide on a user and
+// releases the requester's hold on the user so it can be served to someone else
+// straight away. A reviewer asking again only updates the note. Returns
+// types.ErrSecondOpinionRequested if another reviewer already asked.
+func (m *SecondOpinionModel) RequestSecondOpinion(ctx context.Context, opinion *types.SecondOpinion) error {
+	err := m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		result, err := requestSecondOpinionQuery(tx, opinion).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save second opinion request: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if affected == 0 {
+			return types.ErrSecondOpinionRequested
+		}
+
+		// Release the requester's hold on the user
+		now := time.Now()
+		for _, model := range []interface{}{
+			(*types.FlaggedUser)(nil),
+			(*types.ConfirmedUser)(nil),
+			(*types.ClearedUser)(nil),
+			(*types.BannedUser)(nil),
+		} {
+			if _, err := releaseReviewQuery(tx, model, []uint64{opinion.UserID}, now).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to release user: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to request second opinion: %w (userID=%d)", err, opinion.UserID)
+	}
+
+	m.logger.Debug("Requested second opinion",
+		zap.Uint64("userID", opinion.UserID),
+		zap.Uint64("requestedBy", opinion.RequestedBy))
+	return nil
+}
+
+// GetSecondOpinion retrieves the pending second opinion request on a user.
+// Returns nil if no second opinion was requested.
+func (m *SecondOpinionModel) GetSecondOpinion(ctx context.Context, userID uint64) (*types.SecondOpinion, error) {
+	var opinion types.SecondOpinion
+	err := m.db.NewSelect().
+		Model(&opinion).
+		Where("user_id = ?", userID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get second opinion: %w (userID=%d)", err, userID)
+	}
+	return &opinion, nil
+}
+
+// requestSecondOpinionQuery builds the query saving a second opinion request. An
+// existing request is only updated when it was made by the same reviewer.
+func requestSecondOpinionQuery(db bun.IDB, opinion *types.SecondOpinion) *bun.InsertQuery {
+	return db.NewInsert().
+		Model(opinion).
+		On("CONFLICT (user_id) DO UPDATE").
+		Set("note = EXCLUDED.note").
+		Set("requested_at = EXCLUDED.requested_at").
+		Where("?TableAlias.requested_by = EXCLUDED.requested_by")
+}
+
+// deleteSecondOpinionsQuery builds the query removing the second opinion requests
+// on the given users. Requests are removed as part of the move that decides on a
+// user, so they cannot outlive the decision whichever path made it.
+func deleteSecondOpinionsQuery(db bun.IDB, userIDs []uint64) *bun.DeleteQuery {
+	return db.NewDelete().
+		Model((*types.SecondOpinion)(nil)).
+		Where("user_id IN (?)", bun.In(userIDs))
+}
+
+// applySecondOpinions keeps users a reviewer asked a second opinion on from being
+// served back to them, and serves users awaiting a second opinion to other
+// reviewers first. It must be applied before the review sort.
+func applySecondOpinions(subq *bun.SelectQuery, reviewerID uint64) {
+	subq.Where("NOT EXISTS (SELECT 1 FROM user_second_opinions AS so "+
+		"WHERE so.user_id = ?TableAlias.id AND so.requested_by = ?)", reviewerID).
+		OrderExpr("EXISTS (SELECT 1 FROM user_second_opinions AS so WHERE so.user_id = ?TableAlias.id) DESC")
+}
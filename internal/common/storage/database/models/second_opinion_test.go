@@ -0,0 +1,122 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+func TestRequestSecondOpinionQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	opinion := &types.SecondOpinion{
+		UserID:      1,
+		RequestedBy: 42,
+		Note:        "not sure about the outfits",
+		RequestedAt: time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC),
+	}
+
+	query := requestSecondOpinionQuery(db, opinion).String()
+
+	assert.Contains(t, query, `INSERT INTO "user_second_opinions" AS "second_opinion"`)
+	assert.Contains(t, query, `ON CONFLICT (user_id) DO UPDATE SET note = EXCLUDED.note, requested_at = EXCLUDED.requested_at`)
+	assert.Contains(t, query, `WHERE ("second_opinion".requested_by = EXCLUDED.requested_by)`)
+}
+
+func TestApplySecondOpinions(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	subq := db.NewSelect().Model((*types.FlaggedUser)(nil)).Column("id")
+
+	applySecondOpinions(subq, 42)
+	query := subq.OrderExpr("?TableAlias.confidence DESC").String()
+
+	assert.Contains(t, query, `WHERE (NOT EXISTS (SELECT 1 FROM user_second_opinions AS so `+
+		`WHERE so.user_id = "flagged_user".id AND so.requested_by = 42))`)
+	assert.Contains(t, query, `ORDER BY EXISTS (SELECT 1 FROM user_second_opinions AS so `+
+		`WHERE so.user_id = "flagged_user".id) DESC, "flagged_user".confidence DESC`)
+}
+
+func TestSecondOpinionRoundTrip(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	opinions := NewSecondOpinion(db, zap.NewNop())
+
+	userID := uint64(9_000_000_301)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.SecondOpinion)(nil)).Where("user_id = ?", userID).Exec(ctx)
+	})
+
+	opinion, err := opinions.GetSecondOpinion(ctx, userID)
+	require.NoError(t, err)
+	assert.Nil(t, opinion)
+
+	require.NoError(t, opinions.RequestSecondOpinion(ctx, &types.SecondOpinion{
+		UserID: userID, RequestedBy: 1, Note: "first", RequestedAt: time.Now(),
+	}))
+
+	// The requester can update their note but another reviewer cannot take over the request
+	require.NoError(t, opinions.RequestSecondOpinion(ctx, &types.SecondOpinion{
+		UserID: userID, RequestedBy: 1, Note: "updated", RequestedAt: time.Now(),
+	}))
+	err = opinions.RequestSecondOpinion(ctx, &types.SecondOpinion{
+		UserID: userID, RequestedBy: 2, Note: "mine", RequestedAt: time.Now(),
+	})
+	require.ErrorIs(t, err, types.ErrSecondOpinionRequested)
+
+	opinion, err = opinions.GetSecondOpinion(ctx, userID)
+	require.NoError(t, err)
+	require.NotNil(t, opinion)
+	assert.Equal(t, uint64(1), opinion.RequestedBy)
+	assert.Equal(t, "updated", opinion.Note)
+}
+
+func TestDecisionsResolveSecondOpinion(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+	opinions := NewSecondOpinion(db, zap.NewNop())
+
+	confirmedID, clearedID, deletedID, bannedID := uint64(9_000_000_311), uint64(9_000_000_312),
+		uint64(9_000_000_313), uint64(9_000_000_314)
+	ids := []uint64{confirmedID, clearedID, deletedID, bannedID}
+	seedFlaggedUsers(t, db, 0.8, ids...)
+	t.Cleanup(func() {
+		for _, model := range []interface{}{
+			(*types.ConfirmedUser)(nil),
+			(*types.ClearedUser)(nil),
+			(*types.BannedUser)(nil),
+			(*types.DeletedUser)(nil),
+		} {
+			_, _ = db.NewDelete().Model(model).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+		}
+		_, _ = db.NewDelete().Model((*types.SecondOpinion)(nil)).Where("user_id IN (?)", bun.In(ids)).Exec(ctx)
+	})
+
+	for _, id := range ids {
+		require.NoError(t, opinions.RequestSecondOpinion(ctx, &types.SecondOpinion{
+			UserID: id, RequestedBy: 1, Note: "unsure", RequestedAt: time.Now(),
+		}))
+	}
+
+	// Every path that decides on a user resolves the request, not only the review menu
+	require.NoError(t, users.ConfirmUser(ctx, &types.ReviewUser{User: types.User{ID: confirmedID, UUID: uuid.New()}}))
+	require.NoError(t, users.ClearUser(ctx, &types.ReviewUser{User: types.User{ID: clearedID, UUID: uuid.New()}}))
+	found, err := users.DeleteUser(ctx, deletedID, 42)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.NoError(t, users.RemoveBannedUsers(ctx, []uint64{bannedID}))
+
+	for _, id := range ids {
+		opinion, err := opinions.GetSecondOpinion(ctx, id)
+		require.NoError(t, err)
+		assert.Nil(t, opinion, "second opinion on %d outlived the decision", id)
+	}
+}
@@ -32,15 +32,16 @@ func NewSetting(db *bun.DB, logger *zap.Logger) *SettingModel {
 // GetUserSettings retrieves settings for a specific user.
 func (r *SettingModel) GetUserSettings(ctx context.Context, userID snowflake.ID) (*types.UserSetting, error) {
 	settings := &types.UserSetting{
-		UserID:             userID,
-		StreamerMode:       false,
-		UserDefaultSort:    enum.ReviewSortByRandom,
-		GroupDefaultSort:   enum.ReviewSortByRandom,
-		AppealDefaultSort:  enum.AppealSortByNewest,
-		AppealStatusFilter: enum.AppealStatusPending,
-		ChatModel:          enum.ChatModelGeminiPro,
-		ReviewMode:         enum.ReviewModeStandard,
-		ReviewTargetMode:   enum.ReviewTargetModeFlagged,
+		UserID:                    userID,
+		StreamerMode:              false,
+		UserDefaultSort:           enum.ReviewSortByRandom,
+		GroupDefaultSort:          enum.ReviewSortByRandom,
+		AppealDefaultSort:         enum.AppealSortByNewest,
+		AppealStatusFilter:        enum.AppealStatusPending,
+		ChatModel:                 enum.ChatModelGeminiPro,
+		ReviewMode:                enum.ReviewModeStandard,
+		ReviewTargetMode:          enum.ReviewTargetModeFlagged,
+		ReviewConfidenceThreshold: 0,
 		ChatMessageUsage: types.ChatMessageUsage{
 			FirstMessageTime: time.Unix(0, 0),
 			MessageCount:     0,
@@ -53,6 +54,7 @@ func (r *SettingModel) GetUserSettings(ctx context.Context, userID snowflake.ID)
 			ReviewCount: 0,
 		},
 		LeaderboardPeriod: enum.LeaderboardPeriodAllTime,
+		ReasonPresets:     []types.ReasonPreset{},
 	}
 
 	err := r.db.NewSelect().Model(settings).
@@ -85,12 +87,16 @@ func (r *SettingModel) SaveUserSettings(ctx context.Context, settings *types.Use
 		Set("chat_model = EXCLUDED.chat_model").
 		Set("review_mode = EXCLUDED.review_mode").
 		Set("review_target_mode = EXCLUDED.review_target_mode").
+		Set("review_confidence_threshold = EXCLUDED.review_confidence_threshold").
 		Set("first_message_time = EXCLUDED.first_message_time").
 		Set("message_count = EXCLUDED.message_count").
 		Set("last_skip_time = EXCLUDED.last_skip_time").
 		Set("consecutive_skips = EXCLUDED.consecutive_skips").
 		Set("review_count = EXCLUDED.review_count").
 		Set("leaderboard_period = EXCLUDED.leaderboard_period").
+		Set("leaderboard_anonymous = EXCLUDED.leaderboard_anonymous").
+		Set("reason_presets = EXCLUDED.reason_presets").
+		Set("ui_state = EXCLUDED.ui_state").
 		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to save user settings: %w (userID=%d)", err, settings.UserID)
@@ -99,6 +105,35 @@ func (r *SettingModel) SaveUserSettings(ctx context.Context, settings *types.Use
 	return nil
 }
 
+// GetAnonymousLeaderboardUsers returns which of the given users chose to appear
+// anonymously on the leaderboard.
+func (r *SettingModel) GetAnonymousLeaderboardUsers(ctx context.Context, userIDs []uint64) (map[uint64]bool, error) {
+	anonymous := make(map[uint64]bool)
+	if len(userIDs) == 0 {
+		return anonymous, nil
+	}
+
+	var ids []uint64
+	if err := anonymousLeaderboardUsersQuery(r.db, userIDs).Scan(ctx, &ids); err != nil {
+		return nil, fmt.Errorf("failed to get anonymous leaderboard users: %w", err)
+	}
+
+	for _, id := range ids {
+		anonymous[id] = true
+	}
+	return anonymous, nil
+}
+
+// anonymousLeaderboardUsersQuery builds the query selecting which of the given
+// users appear anonymously on the leaderboard.
+func anonymousLeaderboardUsersQuery(db bun.IDB, userIDs []uint64) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.UserSetting)(nil)).
+		Column("user_id").
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Where("leaderboard_anonymous = true")
+}
+
 // GetBotSettings retrieves the bot settings.
 func (r *SettingModel) GetBotSettings(ctx context.Context) (*types.BotSetting, error) {
 	// Return cached settings if they exist and are fresh
@@ -107,16 +142,22 @@ func (r *SettingModel) GetBotSettings(ctx context.Context) (*types.BotSetting, e
 	}
 
 	settings := &types.BotSetting{
-		ID:             1,
-		ReviewerIDs:    []uint64{},
-		AdminIDs:       []uint64{},
-		SessionLimit:   0,
-		WelcomeMessage: "",
+		ID:                    1,
+		ReviewerIDs:           []uint64{},
+		AdminIDs:              []uint64{},
+		SessionLimit:          0,
+		AppealStaleDays:       7,
+		AppealCooldownDays:    7,
+		NotificationChannelID: 0,
+		MaxPinnedUsers:        25,
+		WelcomeMessage:        "",
 		Announcement: types.Announcement{
 			Type:    enum.AnnouncementTypeNone,
 			Message: "",
 		},
-		APIKeys: []types.APIKeyInfo{},
+		APIKeys:         []types.APIKeyInfo{},
+		AllowedGuildIDs: []uint64{},
+		AllowDMs:        true,
 	}
 
 	err := r.db.NewSelect().Model(settings).
@@ -148,10 +189,16 @@ func (r *SettingModel) SaveBotSettings(ctx context.Context, settings *types.BotS
 		Set("reviewer_ids = EXCLUDED.reviewer_ids").
 		Set("admin_ids = EXCLUDED.admin_ids").
 		Set("session_limit = EXCLUDED.session_limit").
+		Set("appeal_stale_days = EXCLUDED.appeal_stale_days").
+		Set("appeal_cooldown_days = EXCLUDED.appeal_cooldown_days").
+		Set("notification_channel_id = EXCLUDED.notification_channel_id").
+		Set("max_pinned_users = EXCLUDED.max_pinned_users").
 		Set("welcome_message = EXCLUDED.welcome_message").
 		Set("announcement_type = EXCLUDED.announcement_type").
 		Set("announcement_message = EXCLUDED.announcement_message").
 		Set("api_keys = EXCLUDED.api_keys").
+		Set("allowed_guild_ids = EXCLUDED.allowed_guild_ids").
+		Set("allow_dms = EXCLUDED.allow_dms").
 		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to save bot settings: %w", err)
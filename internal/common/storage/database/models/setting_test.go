@@ -0,0 +1,19 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestAnonymousLeaderboardUsersQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := anonymousLeaderboardUsersQuery(db, []uint64{10, 20}).String()
+
+	assert.Contains(t, query, `SELECT "user_setting"."user_id" FROM "user_settings"`)
+	assert.Contains(t, query, "(user_id IN (10, 20)) AND (leaderboard_anonymous = true)")
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// ShoutModel handles database operations for the shout history of groups.
+type ShoutModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewShout creates a new ShoutModel instance.
+func NewShout(db *bun.DB, logger *zap.Logger) *ShoutModel {
+	return &ShoutModel{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// AddShout records a shout for a group unless the same shout was already recorded.
+// Only the most recent MaxShoutHistory shouts are kept for each group.
+// Returns true if the shout was recorded.
+func (m *ShoutModel) AddShout(ctx context.Context, groupID uint64, shout string, postedAt time.Time) (bool, error) {
+	added := false
+	err := m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		result, err := addShoutQuery(tx, &types.GroupShoutHistory{
+			GroupID:    groupID,
+			Content:    shout,
+			PostedAt:   postedAt,
+			RecordedAt: time.Now(),
+		}).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert shout: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if affected == 0 {
+			return nil // Skip shouts that were already recorded
+		}
+
+		// Remove shouts beyond the retention limit
+		_, err = trimShoutHistoryQuery(tx, groupID, types.MaxShoutHistory).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to trim shout history: %w", err)
+		}
+
+		added = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to add shout: %w (groupID=%d)", err, groupID)
+	}
+
+	if added {
+		m.logger.Debug("Recorded group shout", zap.Uint64("groupID", groupID))
+	}
+	return added, nil
+}
+
+// GetShoutHistory retrieves the recorded shouts of a group, newest first.
+func (m *ShoutModel) GetShoutHistory(ctx context.Context, groupID uint64) ([]*types.GroupShoutHistory, error) {
+	var shouts []*types.GroupShoutHistory
+	err := m.db.NewSelect().
+		Model(&shouts).
+		Where("group_id = ?", groupID).
+		Order("posted_at DESC", "id DESC").
+		Limit(types.MaxShoutHistory).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shout history: %w (groupID=%d)", err, groupID)
+	}
+	return shouts, nil
+}
+
+// addShoutQuery builds the query inserting a shout, doing nothing if the group
+// already has a shout posted at the same time.
+func addShoutQuery(db bun.IDB, shout *types.GroupShoutHistory) *bun.InsertQuery {
+	return db.NewInsert().
+		Model(shout).
+		On("CONFLICT (group_id, posted_at) DO NOTHING")
+}
+
+// trimShoutHistoryQuery builds the query deleting all but the latest keep shouts of a group.
+func trimShoutHistoryQuery(db bun.IDB, groupID uint64, keep int) *bun.DeleteQuery {
+	latest := db.NewSelect().
+		Model((*types.GroupShoutHistory)(nil)).
+		Column("id").
+		Where("group_id = ?", groupID).
+		Order("posted_at DESC", "id DESC").
+		Limit(keep)
+
+	return db.NewDelete().
+		Model((*types.GroupShoutHistory)(nil)).
+		Where("group_id = ?", groupID).
+		Where("id NOT IN (?)", latest)
+}
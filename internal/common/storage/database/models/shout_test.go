@@ -0,0 +1,72 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+func TestAddShoutQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := addShoutQuery(db, &types.GroupShoutHistory{GroupID: 42, Content: "join us"}).String()
+
+	assert.Contains(t, query, `INSERT INTO "group_shout_history"`)
+	assert.Contains(t, query, `ON CONFLICT (group_id, posted_at) DO NOTHING`)
+}
+
+func TestTrimShoutHistoryQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := trimShoutHistoryQuery(db, 42, 20).String()
+
+	assert.Contains(t, query, `DELETE FROM "group_shout_history" AS "group_shout_history"`)
+	assert.Contains(t, query, `WHERE (group_id = 42) AND (id NOT IN (SELECT "group_shout_history"."id" `+
+		`FROM "group_shout_history" WHERE (group_id = 42) `+
+		`ORDER BY "posted_at" DESC, "id" DESC LIMIT 20))`)
+}
+
+func TestAddShout(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	shouts := NewShout(db, zap.NewNop())
+
+	const groupID = 9_000_000_451
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.GroupShoutHistory)(nil)).Where("group_id = ?", groupID).Exec(ctx)
+	})
+
+	postedAt := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	added, err := shouts.AddShout(ctx, groupID, "join us", postedAt)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// Fetching the same shout again does not record it twice
+	added, err = shouts.AddShout(ctx, groupID, "join us", postedAt)
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	// Only the latest shouts are kept
+	for i := range types.MaxShoutHistory {
+		added, err = shouts.AddShout(ctx, groupID, "shout", postedAt.Add(time.Duration(i+1)*time.Minute))
+		require.NoError(t, err)
+		assert.True(t, added)
+	}
+
+	history, err := shouts.GetShoutHistory(ctx, groupID)
+	require.NoError(t, err)
+	require.Len(t, history, types.MaxShoutHistory)
+	assert.Equal(t, postedAt.Add(time.Duration(types.MaxShoutHistory)*time.Minute), history[0].PostedAt.UTC())
+
+	count, err := db.NewSelect().Model((*types.GroupShoutHistory)(nil)).Where("group_id = ?", groupID).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.MaxShoutHistory, count)
+}
@@ -3,17 +3,31 @@ package models
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"github.com/uptrace/bun"
 	"go.uber.org/zap"
 )
 
+// countsCacheDuration controls how long current counts are reused before counting again.
+// It matches the default bot presence refresh so each refresh counts the tables once.
+const countsCacheDuration = 5 * time.Minute
+
 // StatsModel handles database operations for statistics.
 type StatsModel struct {
-	db     *bun.DB
-	logger *zap.Logger
+	db          *bun.DB
+	logger      *zap.Logger
+	countsMu    sync.Mutex
+	userCounts  *types.UserCounts
+	groupCounts *types.GroupCounts
+	countedAt   time.Time
+
+	reasonMu        sync.Mutex
+	reasonCounts    []*types.ReasonCategoryCount
+	reasonCountedAt time.Time
 }
 
 // NewStats creates a new StatsModel.
@@ -101,7 +115,8 @@ func (r *StatsModel) GetCurrentStats(ctx context.Context) (*types.HourlyStats, e
 	return &stats, nil
 }
 
-// SaveHourlyStats saves the current statistics snapshot.
+// SaveHourlyStats saves the current statistics snapshot. Purge counts already
+// recorded for the hour are kept.
 func (r *StatsModel) SaveHourlyStats(ctx context.Context, stats *types.HourlyStats) error {
 	_, err := r.db.NewInsert().
 		Model(stats).
@@ -114,6 +129,7 @@ func (r *StatsModel) SaveHourlyStats(ctx context.Context, stats *types.HourlySta
 		Set("groups_flagged = EXCLUDED.groups_flagged").
 		Set("groups_cleared = EXCLUDED.groups_cleared").
 		Set("groups_locked = EXCLUDED.groups_locked").
+		Set("backfilled = EXCLUDED.backfilled").
 		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to save hourly stats: %w", err)
@@ -122,6 +138,64 @@ func (r *StatsModel) SaveHourlyStats(ctx context.Context, stats *types.HourlySta
 	return nil
 }
 
+// IncrementPurgeCounts adds the number of purged cleared users and groups to the
+// stats of the current hour. If the hour has no snapshot yet, one is created from
+// the current counts so the row is not mistaken for an empty snapshot.
+func (r *StatsModel) IncrementPurgeCounts(ctx context.Context, users, groups int) error {
+	if users == 0 && groups == 0 {
+		return nil
+	}
+
+	// Pick the bucket once so counts recorded at an hour boundary stay in the new hour
+	hour := purgeStatsHour(time.Now())
+
+	// Add to the existing snapshot of the hour
+	result, err := r.db.NewUpdate().
+		Model((*types.HourlyStats)(nil)).
+		Set("users_purged_cleared = users_purged_cleared + ?", users).
+		Set("groups_purged_cleared = groups_purged_cleared + ?", groups).
+		Where("timestamp = ?", hour).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to increment purge counts: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	// Create the snapshot of the hour with the purge counts
+	stats, err := r.GetCurrentStats(ctx)
+	if err != nil {
+		return err
+	}
+	stats.Timestamp = hour
+	stats.UsersPurgedCleared = int64(users)
+	stats.GroupsPurgedCleared = int64(groups)
+
+	_, err = r.db.NewInsert().
+		Model(stats).
+		On("CONFLICT (timestamp) DO UPDATE").
+		Set("users_purged_cleared = hourly_stats.users_purged_cleared + EXCLUDED.users_purged_cleared").
+		Set("groups_purged_cleared = hourly_stats.groups_purged_cleared + EXCLUDED.groups_purged_cleared").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save purge counts: %w", err)
+	}
+
+	return nil
+}
+
+// purgeStatsHour returns the hourly stats bucket for a purge at the given time.
+// A purge exactly at an hour boundary belongs to the hour that starts there.
+func purgeStatsHour(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Hour)
+}
+
 // GetHourlyStats retrieves hourly statistics for the last 24 hours.
 func (r *StatsModel) GetHourlyStats(ctx context.Context) ([]*types.HourlyStats, error) {
 	var stats []*types.HourlyStats
@@ -140,6 +214,27 @@ func (r *StatsModel) GetHourlyStats(ctx context.Context) ([]*types.HourlyStats,
 	return stats, nil
 }
 
+// GetDailyAggregates retrieves the hourly statistics of the last given number of
+// UTC days, including today, rolled up into one entry per day.
+func (r *StatsModel) GetDailyAggregates(ctx context.Context, days int) ([]*types.DailyStats, error) {
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1-days)
+
+	var stats []*types.HourlyStats
+	if err := hourlyStatsSinceQuery(r.db, &stats, since).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get daily aggregates: %w (days=%d)", err, days)
+	}
+
+	return types.RollupDailyStats(stats), nil
+}
+
+// hourlyStatsSinceQuery builds the query for the hourly statistics since the given time, oldest first.
+func hourlyStatsSinceQuery(db bun.IDB, stats *[]*types.HourlyStats, since time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(stats).
+		Where("timestamp >= ?", since).
+		Order("timestamp ASC")
+}
+
 // HasStatsForHour checks if statistics exist for a specific hour.
 func (r *StatsModel) HasStatsForHour(ctx context.Context, hour time.Time) (bool, error) {
 	exists, err := r.db.NewSelect().
@@ -152,25 +247,285 @@ func (r *StatsModel) HasStatsForHour(ctx context.Context, hour time.Time) (bool,
 	return exists, nil
 }
 
+// GetUserCountsByReasonCategory counts the flagged and confirmed users in each reason category.
+func (r *StatsModel) GetUserCountsByReasonCategory(ctx context.Context) ([]*types.ReasonCategoryCount, error) {
+	var counts []*types.ReasonCategoryCount
+	err := userCountsByReasonCategoryQuery(r.db).Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user counts by reason category: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetCachedUserCountsByReasonCategory returns the flagged and confirmed users in each
+// reason category, reusing the last result if it is recent like GetCachedCounts.
+func (r *StatsModel) GetCachedUserCountsByReasonCategory(ctx context.Context) ([]*types.ReasonCategoryCount, error) {
+	r.reasonMu.Lock()
+	defer r.reasonMu.Unlock()
+
+	if r.reasonCountedAt.IsZero() || time.Since(r.reasonCountedAt) >= countsCacheDuration {
+		counts, err := r.GetUserCountsByReasonCategory(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		r.reasonCounts = counts
+		r.reasonCountedAt = time.Now()
+	}
+
+	// Return copies so callers cannot modify the cached counts
+	counts := make([]*types.ReasonCategoryCount, 0, len(r.reasonCounts))
+	for _, count := range r.reasonCounts {
+		c := *count
+		counts = append(counts, &c)
+	}
+	return counts, nil
+}
+
+// userCountsByReasonCategoryQuery builds the query counting the flagged and
+// confirmed users grouped by their reason category.
+func userCountsByReasonCategoryQuery(db bun.IDB) *bun.SelectQuery {
+	users := db.NewSelect().
+		Model((*types.FlaggedUser)(nil)).
+		Column("reason_category").
+		UnionAll(
+			db.NewSelect().
+				Model((*types.ConfirmedUser)(nil)).
+				Column("reason_category"),
+		)
+
+	return db.NewSelect().
+		TableExpr("(?) AS users", users).
+		Column("reason_category").
+		ColumnExpr("COUNT(*) AS count").
+		Group("reason_category").
+		Order("reason_category ASC")
+}
+
+// GetFlaggedUserCountsByGeneration counts the flagged users at each flag generation,
+// showing how far friend flags have cascaded from confirmed and content-based flags.
+func (r *StatsModel) GetFlaggedUserCountsByGeneration(ctx context.Context) ([]*types.FlagGenerationCount, error) {
+	var counts []*types.FlagGenerationCount
+	err := flaggedUserCountsByGenerationQuery(r.db).Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flagged user counts by generation: %w", err)
+	}
+
+	return counts, nil
+}
+
+// flaggedUserCountsByGenerationQuery builds the query counting the flagged users
+// grouped by their flag generation.
+func flaggedUserCountsByGenerationQuery(db bun.IDB) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.FlaggedUser)(nil)).
+		Column("flag_generation").
+		ColumnExpr("COUNT(*) AS count").
+		Group("flag_generation").
+		Order("flag_generation ASC")
+}
+
+// GetCheckerPrecision counts the users confirmed and cleared by reviewers since the
+// given time, grouped by the checker that flagged them. Users flagged before flag
+// sources were stored are counted under the unknown source.
+func (r *StatsModel) GetCheckerPrecision(ctx context.Context, since time.Time) ([]*types.CheckerPrecision, error) {
+	var precision []*types.CheckerPrecision
+	err := checkerPrecisionQuery(r.db, since).Scan(ctx, &precision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checker precision: %w (since=%s)", err, since.Format(time.RFC3339))
+	}
+
+	return precision, nil
+}
+
+// checkerPrecisionQuery builds the query joining the latest confirm or clear decision
+// of each user since the given time with the flag source stored on the user. Only the
+// latest decision counts so a user confirmed and later cleared is not counted twice.
+// System actions are excluded.
+func checkerPrecisionQuery(db bun.IDB, since time.Time) *bun.SelectQuery {
+	confirms := []enum.ActivityType{enum.ActivityTypeUserConfirmed, enum.ActivityTypeUserConfirmedCustom}
+	clears := []enum.ActivityType{enum.ActivityTypeUserCleared}
+	decisionTypes := append(append([]enum.ActivityType{}, confirms...), clears...)
+
+	decisions := db.NewSelect().
+		TableExpr("activity_logs").
+		DistinctOn("user_id").
+		ColumnExpr("user_id, activity_type").
+		Where("activity_timestamp >= ?", since).
+		Where("activity_type IN (?)", bun.In(decisionTypes)).
+		Where("reviewer_id != 0").
+		OrderExpr("user_id, activity_timestamp DESC")
+
+	// Decided users are confirmed, cleared, banned after being confirmed or flagged
+	// again after being cleared. A user in more than one table is joined only once.
+	users := db.NewSelect().
+		Model((*types.ConfirmedUser)(nil)).
+		Column("id", "flag_source").
+		UnionAll(db.NewSelect().Model((*types.ClearedUser)(nil)).Column("id", "flag_source")).
+		UnionAll(db.NewSelect().Model((*types.BannedUser)(nil)).Column("id", "flag_source")).
+		UnionAll(db.NewSelect().Model((*types.FlaggedUser)(nil)).Column("id", "flag_source"))
+	decided := db.NewSelect().
+		TableExpr("(?) AS all_users", users).
+		DistinctOn("id").
+		ColumnExpr("id, flag_source").
+		OrderExpr("id")
+
+	return db.NewSelect().
+		With("decisions", decisions).
+		TableExpr("decisions AS d").
+		Join("LEFT JOIN (?) AS u ON u.id = d.user_id", decided).
+		ColumnExpr("COALESCE(u.flag_source, ?) AS flag_source", enum.FlagSourceUnknown).
+		ColumnExpr("COUNT(*) FILTER (WHERE d.activity_type IN (?)) AS confirmed", bun.In(confirms)).
+		ColumnExpr("COUNT(*) FILTER (WHERE d.activity_type IN (?)) AS cleared", bun.In(clears)).
+		GroupExpr("1").
+		OrderExpr("1 ASC")
+}
+
+// BackfillMissingHours fills gaps in the hourly statistics since the given time by
+// interpolating between the snapshots on either side of each gap. The current counts
+// are used as the end of a gap leading up to the current hour. Gaps longer than
+// maxGap are skipped. Returns the number of backfilled hours.
+func (r *StatsModel) BackfillMissingHours(ctx context.Context, since time.Time, maxGap time.Duration) (int, error) {
+	var stats []*types.HourlyStats
+	err := r.db.NewSelect().
+		Model(&stats).
+		Where("timestamp >= ?", since.UTC().Truncate(time.Hour)).
+		Order("timestamp ASC").
+		Scan(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get hourly stats for backfill: %w", err)
+	}
+
+	// Nothing to interpolate from without an earlier snapshot
+	if len(stats) == 0 {
+		return 0, nil
+	}
+
+	// Use the current counts as the end of any gap up to the current hour
+	current, err := r.GetCurrentStats(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if stats[len(stats)-1].Timestamp.Before(current.Timestamp) {
+		stats = append(stats, current)
+	}
+
+	// Interpolate the missing hours of each gap
+	var backfill []*types.HourlyStats
+	for i := 1; i < len(stats); i++ {
+		before, after := stats[i-1], stats[i]
+		gap := after.Timestamp.Sub(before.Timestamp)
+		if gap <= time.Hour {
+			continue
+		}
+
+		if gap > maxGap {
+			r.logger.Warn("Skipping backfill of long gap in hourly stats",
+				zap.Time("from", before.Timestamp),
+				zap.Time("to", after.Timestamp),
+				zap.Duration("maxGap", maxGap))
+			continue
+		}
+
+		backfill = append(backfill, interpolateHourlyStats(before, after)...)
+	}
+
+	if len(backfill) == 0 {
+		return 0, nil
+	}
+
+	// Keep any snapshot saved in the meantime
+	_, err = r.db.NewInsert().
+		Model(&backfill).
+		On("CONFLICT (timestamp) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to save backfilled stats: %w", err)
+	}
+
+	r.logger.Info("Backfilled missing hourly stats",
+		zap.Int("hours", len(backfill)),
+		zap.Time("since", since))
+
+	return len(backfill), nil
+}
+
+// interpolateHourlyStats returns a backfilled snapshot for every hour strictly
+// between the two snapshots, with counts linearly interpolated between them.
+func interpolateHourlyStats(before, after *types.HourlyStats) []*types.HourlyStats {
+	steps := int64(after.Timestamp.Sub(before.Timestamp) / time.Hour)
+	if steps <= 1 {
+		return nil
+	}
+
+	lerp := func(from, to, step int64) int64 {
+		return from + (to-from)*step/steps
+	}
+
+	result := make([]*types.HourlyStats, 0, steps-1)
+	for step := int64(1); step < steps; step++ {
+		result = append(result, &types.HourlyStats{
+			Timestamp:       before.Timestamp.Add(time.Duration(step) * time.Hour),
+			UsersConfirmed:  lerp(before.UsersConfirmed, after.UsersConfirmed, step),
+			UsersFlagged:    lerp(before.UsersFlagged, after.UsersFlagged, step),
+			UsersCleared:    lerp(before.UsersCleared, after.UsersCleared, step),
+			UsersBanned:     lerp(before.UsersBanned, after.UsersBanned, step),
+			GroupsConfirmed: lerp(before.GroupsConfirmed, after.GroupsConfirmed, step),
+			GroupsFlagged:   lerp(before.GroupsFlagged, after.GroupsFlagged, step),
+			GroupsCleared:   lerp(before.GroupsCleared, after.GroupsCleared, step),
+			GroupsLocked:    lerp(before.GroupsLocked, after.GroupsLocked, step),
+			Backfilled:      true,
+		})
+	}
+
+	return result
+}
+
 // PurgeOldStats removes statistics older than the cutoff date.
-func (r *StatsModel) PurgeOldStats(ctx context.Context, cutoffDate time.Time) error {
+// Returns the number of hourly snapshots removed.
+func (r *StatsModel) PurgeOldStats(ctx context.Context, cutoffDate time.Time) (int, error) {
 	result, err := r.db.NewDelete().
 		Model((*types.HourlyStats)(nil)).
 		Where("timestamp < ?", cutoffDate).
 		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to purge old stats: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
+		return 0, fmt.Errorf("failed to purge old stats: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
+		return 0, fmt.Errorf("failed to get rows affected: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
 	}
 
 	r.logger.Debug("Purged old stats",
 		zap.Int64("rowsAffected", rowsAffected),
 		zap.Time("cutoffDate", cutoffDate))
-	return nil
+	return int(rowsAffected), nil
+}
+
+// GetCachedCounts returns the current user and group counts, reusing the last result
+// if it is recent. This keeps frequent readers like the dashboard and the bot presence
+// from counting every table on each call.
+func (r *StatsModel) GetCachedCounts(ctx context.Context) (*types.UserCounts, *types.GroupCounts, error) {
+	r.countsMu.Lock()
+	defer r.countsMu.Unlock()
+
+	if r.userCounts == nil || time.Since(r.countedAt) >= countsCacheDuration {
+		userCounts, groupCounts, err := r.GetCurrentCounts(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r.userCounts = userCounts
+		r.groupCounts = groupCounts
+		r.countedAt = time.Now()
+	}
+
+	// Return copies so callers cannot modify the cached counts
+	userCounts := *r.userCounts
+	groupCounts := *r.groupCounts
+	return &userCounts, &groupCounts, nil
 }
 
 // GetCurrentCounts retrieves all current user and group counts in a single transaction.
@@ -0,0 +1,216 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+func TestInterpolateHourlyStats(t *testing.T) {
+	start := time.Date(2025, 1, 20, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		before *types.HourlyStats
+		after  *types.HourlyStats
+		want   []*types.HourlyStats
+	}{
+		{
+			name:   "consecutive hours have no gap",
+			before: &types.HourlyStats{Timestamp: start, UsersFlagged: 10},
+			after:  &types.HourlyStats{Timestamp: start.Add(time.Hour), UsersFlagged: 20},
+			want:   nil,
+		},
+		{
+			name:   "fills each missing hour",
+			before: &types.HourlyStats{Timestamp: start, UsersFlagged: 10, GroupsLocked: 6},
+			after:  &types.HourlyStats{Timestamp: start.Add(3 * time.Hour), UsersFlagged: 40, GroupsLocked: 0},
+			want: []*types.HourlyStats{
+				{Timestamp: start.Add(time.Hour), UsersFlagged: 20, GroupsLocked: 4, Backfilled: true},
+				{Timestamp: start.Add(2 * time.Hour), UsersFlagged: 30, GroupsLocked: 2, Backfilled: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, interpolateHourlyStats(tt.before, tt.after))
+		})
+	}
+}
+
+func TestUserCountsByReasonCategoryQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := userCountsByReasonCategoryQuery(db).String()
+
+	assert.Contains(t, query, `FROM "flagged_users"`)
+	assert.Contains(t, query, `FROM "confirmed_users"`)
+	assert.Contains(t, query, "UNION ALL")
+	assert.NotContains(t, query, "cleared_users")
+	assert.Contains(t, query, "COUNT(*) AS count")
+	assert.Contains(t, query, `GROUP BY "reason_category"`)
+}
+
+func TestGetCachedUserCountsByReasonCategory(t *testing.T) {
+	db, fake := newFakeDB(t)
+	stats := NewStats(db, zap.NewNop())
+
+	// Repeated dashboard loads count the reason categories once
+	for range 3 {
+		_, err := stats.GetCachedUserCountsByReasonCategory(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, fake.Count("SELECT"))
+
+	// The counts are taken again once the cached result expires
+	stats.reasonCountedAt = time.Now().Add(-countsCacheDuration)
+	_, err := stats.GetCachedUserCountsByReasonCategory(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.Count("SELECT"))
+}
+
+func TestFlaggedUserCountsByGenerationQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := flaggedUserCountsByGenerationQuery(db).String()
+
+	assert.Contains(t, query, `FROM "flagged_users"`)
+	assert.NotContains(t, query, "confirmed_users")
+	assert.Contains(t, query, "COUNT(*) AS count")
+	assert.Contains(t, query, `GROUP BY "flag_generation"`)
+	assert.Contains(t, query, `ORDER BY "flag_generation" ASC`)
+}
+
+func TestCheckerPrecisionQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := checkerPrecisionQuery(db, since).String()
+
+	assert.Contains(t, query, "SELECT DISTINCT ON (user_id) user_id, activity_type FROM activity_logs")
+	assert.Contains(t, query, "activity_timestamp >= '2025-01-01 00:00:00+00:00'")
+	assert.Contains(t, query, "(reviewer_id != 0)", "system actions are not reviewer decisions")
+	assert.Contains(t, query, "ORDER BY user_id, activity_timestamp DESC", "only the latest decision of a user counts")
+	assert.Contains(t, query, `FROM "confirmed_users"`)
+	assert.Contains(t, query, `FROM "cleared_users"`)
+	assert.Contains(t, query, `FROM "banned_users"`)
+	assert.Contains(t, query, `FROM "flagged_users"`, "users flagged again keep their decision")
+	assert.Contains(t, query, "SELECT DISTINCT ON (id) id, flag_source FROM", "each user is joined once")
+	assert.Contains(t, query, "LEFT JOIN")
+	assert.Contains(t, query, "COALESCE(u.flag_source, 0) AS flag_source",
+		"users without a stored source are counted as unknown")
+	assert.Contains(t, query, "GROUP BY 1")
+}
+
+func TestPurgeStatsHour(t *testing.T) {
+	hour := time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want time.Time
+	}{
+		{
+			name: "within the hour",
+			at:   hour.Add(30 * time.Minute),
+			want: hour,
+		},
+		{
+			name: "exactly at the boundary belongs to the new hour",
+			at:   hour,
+			want: hour,
+		},
+		{
+			name: "just before the boundary belongs to the previous hour",
+			at:   hour.Add(-time.Nanosecond),
+			want: hour.Add(-time.Hour),
+		},
+		{
+			name: "other time zones use the UTC hour",
+			at:   hour.In(time.FixedZone("UTC+5:30", 5*60*60+30*60)),
+			want: hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := purgeStatsHour(tt.at)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+			assert.Equal(t, time.UTC, got.Location())
+		})
+	}
+}
+
+func TestHourlyStatsSinceQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	since := time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	var stats []*types.HourlyStats
+	query := hourlyStatsSinceQuery(db, &stats, since).String()
+
+	assert.Contains(t, query, `FROM "hourly_stats"`)
+	assert.Contains(t, query, "timestamp >= '2025-01-20 00:00:00+00:00'")
+	assert.Contains(t, query, `ORDER BY "timestamp" ASC`)
+}
+
+func TestGetCheckerPrecision(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	stats := NewStats(db, zap.NewNop())
+
+	// Decisions are in the far future so logs from other tests are not counted
+	since := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+	ids := []uint64{9_000_000_761, 9_000_000_762, 9_000_000_763}
+	newUser := func(id uint64, source enum.FlagSource) types.User {
+		return types.User{ID: id, UUID: uuid.New(), Name: "precision_" + strconv.FormatUint(id, 10), FlagSource: source}
+	}
+
+	// The first user was cleared and flagged again, the third is cleared while
+	// still being in the flagged table
+	_, err := db.NewInsert().Model(&types.FlaggedUser{User: newUser(ids[0], enum.FlagSourceFriend)}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.ConfirmedUser{User: newUser(ids[1], enum.FlagSourceAI), VerifiedAt: since}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.FlaggedUser{User: newUser(ids[2], enum.FlagSourceGroup)}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.ClearedUser{User: newUser(ids[2], enum.FlagSourceGroup), ClearedAt: since}).Exec(ctx)
+	require.NoError(t, err)
+
+	logs := []*types.ActivityLog{
+		{ReviewerID: 1, ActivityTarget: types.ActivityTarget{UserID: ids[0]}, ActivityType: enum.ActivityTypeUserCleared},
+		{ReviewerID: 1, ActivityTarget: types.ActivityTarget{UserID: ids[1]}, ActivityType: enum.ActivityTypeUserConfirmed},
+		{ReviewerID: 1, ActivityTarget: types.ActivityTarget{UserID: ids[2]}, ActivityType: enum.ActivityTypeUserCleared},
+	}
+	for i, log := range logs {
+		log.ActivityTimestamp = since.Add(time.Duration(i+1) * time.Minute)
+	}
+	_, err = db.NewInsert().Model(&logs).Exec(ctx)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.FlaggedUser)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.ConfirmedUser)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.ClearedUser)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.ActivityLog)(nil)).Where("user_id IN (?)", bun.In(ids)).Exec(ctx)
+	})
+
+	precision, err := stats.GetCheckerPrecision(ctx, since)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.CheckerPrecision{
+		{FlagSource: enum.FlagSourceAI, Confirmed: 1},
+		{FlagSource: enum.FlagSourceFriend, Cleared: 1},
+		{FlagSource: enum.FlagSourceGroup, Cleared: 1},
+	}, precision)
+}
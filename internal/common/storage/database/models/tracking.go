@@ -109,6 +109,70 @@ func (r *TrackingModel) PurgeOldTrackings(ctx context.Context, cutoffDate time.T
 	return int(rowsAffected), nil
 }
 
+// DeleteGroupTracking removes the tracking entry of a single group so its members no
+// longer count towards related users or growth checks. Returns the number of tracked
+// users that were dropped with the entry.
+func (r *TrackingModel) DeleteGroupTracking(ctx context.Context, groupID uint64) (int, error) {
+	return r.deleteGroupTracking(ctx, r.db, groupID)
+}
+
+// deleteGroupTracking deletes a group's tracking entry using the given database handle
+// so it can run inside a transaction. Returns the number of tracked users dropped.
+func (r *TrackingModel) deleteGroupTracking(ctx context.Context, db bun.IDB, groupID uint64) (int, error) {
+	var dropped []int
+	err := deleteGroupTrackingQuery(db, groupID).Scan(ctx, &dropped)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete group tracking: %w (groupID=%d)", err, groupID)
+	}
+
+	total := 0
+	for _, count := range dropped {
+		total += count
+	}
+
+	r.logger.Info("Deleted group tracking",
+		zap.Uint64("groupID", groupID),
+		zap.Int("droppedUsers", total))
+
+	return total, nil
+}
+
+// deleteGroupTrackingQuery builds the query deleting a group's tracking entry and
+// returning the size of its tracked user list.
+func deleteGroupTrackingQuery(db bun.IDB, groupID uint64) *bun.DeleteQuery {
+	return db.NewDelete().
+		Model((*types.GroupMemberTracking)(nil)).
+		Where("id = ?", groupID).
+		Returning("COALESCE(cardinality(flagged_users), 0)")
+}
+
+// PurgeClearedGroupTrackings removes tracking entries of groups that are in the
+// cleared_groups table. This cleans up entries left behind by groups that were
+// cleared before their tracking was removed on clear. Returns the number of
+// entries removed and the total number of tracked users dropped with them.
+func (r *TrackingModel) PurgeClearedGroupTrackings(ctx context.Context) (int, int, error) {
+	var dropped []int
+	err := purgeClearedGroupTrackingsQuery(r.db).Scan(ctx, &dropped)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to purge cleared group trackings: %w", err)
+	}
+
+	total := 0
+	for _, count := range dropped {
+		total += count
+	}
+	return len(dropped), total, nil
+}
+
+// purgeClearedGroupTrackingsQuery builds the query deleting tracking entries of
+// cleared groups and returning the size of each deleted tracked user list.
+func purgeClearedGroupTrackingsQuery(db bun.IDB) *bun.DeleteQuery {
+	return db.NewDelete().
+		Model((*types.GroupMemberTracking)(nil)).
+		Where("id IN (?)", db.NewSelect().Model((*types.ClearedGroup)(nil)).Column("id")).
+		Returning("COALESCE(cardinality(flagged_users), 0)")
+}
+
 // GetGroupTrackingsToCheck finds groups that haven't been checked recently
 // with priority for groups with more flagged users.
 func (r *TrackingModel) GetGroupTrackingsToCheck(ctx context.Context, batchSize int, minFlaggedUsers int, minFlaggedOverride int) (map[uint64][]uint64, error) {
@@ -214,3 +278,120 @@ func (r *TrackingModel) RemoveUserFromGroups(ctx context.Context, userID uint64,
 		zap.Uint64("userID", userID),
 		zap.Int("groupCount", len(groupIDs)))
 }
+
+// GetNetworkClusterInfo checks how many of the given confirmed and flagged friends
+// of a user are friends with each other using the friend lists stored for them.
+// Only the first MaxClusterFriends friends by ID are compared.
+func (r *TrackingModel) GetNetworkClusterInfo(ctx context.Context, userID uint64, friendIDs []uint64) (*types.NetworkClusterInfo, error) {
+	checkedIDs := clusterFriendIDs(userID, friendIDs)
+	if len(checkedIDs) < 2 {
+		return buildClusterInfo(len(friendIDs), checkedIDs, nil), nil
+	}
+
+	var rows []networkFriendsRow
+	err := networkFriendsQuery(r.db, checkedIDs).Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get friend lists: %w (userID=%d, friendCount=%d)", err, userID, len(checkedIDs))
+	}
+
+	friendLists := make(map[uint64][]uint64, len(rows))
+	for _, row := range rows {
+		for _, friend := range row.Friends {
+			friendLists[row.ID] = append(friendLists[row.ID], friend.ID)
+		}
+	}
+
+	info := buildClusterInfo(len(friendIDs), checkedIDs, friendLists)
+
+	r.logger.Debug("Calculated network cluster info",
+		zap.Uint64("userID", userID),
+		zap.Int("checkedFriends", info.CheckedCount),
+		zap.Int("connectedFriends", info.ConnectedCount),
+		zap.Float64("density", info.Density))
+
+	return info, nil
+}
+
+// networkFriendsRow is the stored friend list of a confirmed or flagged user.
+type networkFriendsRow struct {
+	ID      uint64
+	Friends []types.ExtendedFriend `bun:"type:jsonb"`
+}
+
+// clusterFriendIDs returns the sorted and deduplicated friend IDs to compare,
+// leaving out the user themselves and limited to MaxClusterFriends.
+func clusterFriendIDs(userID uint64, friendIDs []uint64) []uint64 {
+	seen := make(map[uint64]struct{}, len(friendIDs))
+	ids := make([]uint64, 0, len(friendIDs))
+	for _, id := range friendIDs {
+		if _, ok := seen[id]; ok || id == userID {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if len(ids) > types.MaxClusterFriends {
+		ids = ids[:types.MaxClusterFriends]
+	}
+	return ids
+}
+
+// networkFriendsQuery builds a query for the friend lists of the given users
+// from the confirmed and flagged user tables.
+func networkFriendsQuery(db bun.IDB, userIDs []uint64) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.ConfirmedUser)(nil)).
+		Column("id", "friends").
+		Where("id IN (?)", bun.In(userIDs)).
+		UnionAll(
+			db.NewSelect().
+				Model((*types.FlaggedUser)(nil)).
+				Column("id", "friends").
+				Where("id IN (?)", bun.In(userIDs)),
+		)
+}
+
+// buildClusterInfo counts the connections between the checked friends. Two friends
+// are connected if either of them has the other in their stored friend list, as
+// the lists may have been saved at different times.
+func buildClusterInfo(friendCount int, checkedIDs []uint64, friendLists map[uint64][]uint64) *types.NetworkClusterInfo {
+	checked := make(map[uint64]struct{}, len(checkedIDs))
+	for _, id := range checkedIDs {
+		checked[id] = struct{}{}
+	}
+
+	// Collect each connected pair once with the lower ID first
+	type pair struct{ a, b uint64 }
+	pairs := make(map[pair]struct{})
+	for id, friends := range friendLists {
+		if _, ok := checked[id]; !ok {
+			continue
+		}
+		for _, friendID := range friends {
+			if _, ok := checked[friendID]; !ok || friendID == id {
+				continue
+			}
+			p := pair{a: min(id, friendID), b: max(id, friendID)}
+			pairs[p] = struct{}{}
+		}
+	}
+
+	connected := make(map[uint64]struct{})
+	for p := range pairs {
+		connected[p.a] = struct{}{}
+		connected[p.b] = struct{}{}
+	}
+
+	info := &types.NetworkClusterInfo{
+		FriendCount:    friendCount,
+		CheckedCount:   len(checkedIDs),
+		ConnectedCount: len(connected),
+		Connections:    len(pairs),
+	}
+	if possible := len(checkedIDs) * (len(checkedIDs) - 1) / 2; possible > 0 {
+		info.Density = float64(len(pairs)) / float64(possible)
+	}
+	return info
+}
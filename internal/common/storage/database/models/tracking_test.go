@@ -0,0 +1,128 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+func TestDeleteGroupTrackingQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := deleteGroupTrackingQuery(db, 12345).String()
+
+	assert.Contains(t, query, `DELETE FROM "group_member_trackings"`)
+	assert.Contains(t, query, "id = 12345")
+	assert.Contains(t, query, "RETURNING COALESCE(cardinality(flagged_users), 0)")
+}
+
+func TestDeleteGroupTracking(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	tracking := NewTracking(db, zap.NewNop())
+
+	const groupID = 9_000_000_781
+	_, err := db.NewInsert().Model(&types.GroupMemberTracking{
+		ID:           groupID,
+		FlaggedUsers: []uint64{1, 2, 3},
+		LastAppended: time.Now(),
+		LastChecked:  time.Now(),
+	}).Exec(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.GroupMemberTracking)(nil)).Where("id = ?", groupID).Exec(ctx)
+	})
+
+	dropped, err := tracking.DeleteGroupTracking(ctx, groupID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, dropped)
+
+	exists, err := db.NewSelect().Model((*types.GroupMemberTracking)(nil)).Where("id = ?", groupID).Exists(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// Deleting a group that is not tracked drops nothing
+	dropped, err = tracking.DeleteGroupTracking(ctx, groupID)
+	require.NoError(t, err)
+	assert.Zero(t, dropped)
+}
+
+func TestPurgeClearedGroupTrackingsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := purgeClearedGroupTrackingsQuery(db).String()
+
+	assert.Contains(t, query, `DELETE FROM "group_member_trackings"`)
+	assert.Contains(t, query, `id IN (SELECT "cleared_group"."id" FROM "cleared_groups" AS "cleared_group")`)
+	assert.Contains(t, query, "RETURNING COALESCE(cardinality(flagged_users), 0)")
+}
+
+func TestNetworkFriendsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := networkFriendsQuery(db, []uint64{1, 2}).String()
+
+	assert.Contains(t, query, `FROM "confirmed_users"`)
+	assert.Contains(t, query, `FROM "flagged_users"`)
+	assert.Contains(t, query, "UNION ALL")
+	assert.Contains(t, query, "id IN (1, 2)")
+}
+
+func TestClusterFriendIDs(t *testing.T) {
+	t.Run("sorts and removes duplicates and the user", func(t *testing.T) {
+		assert.Equal(t, []uint64{1, 2, 3}, clusterFriendIDs(9, []uint64{3, 9, 1, 2, 3}))
+	})
+
+	t.Run("caps the compared friends", func(t *testing.T) {
+		friendIDs := make([]uint64, types.MaxClusterFriends+10)
+		for i := range friendIDs {
+			friendIDs[i] = uint64(len(friendIDs) - i)
+		}
+
+		ids := clusterFriendIDs(0, friendIDs)
+		assert.Len(t, ids, types.MaxClusterFriends)
+		assert.Equal(t, uint64(1), ids[0])
+	})
+}
+
+func TestBuildClusterInfo(t *testing.T) {
+	t.Run("counts each pair once from either side", func(t *testing.T) {
+		info := buildClusterInfo(4, []uint64{1, 2, 3, 4}, map[uint64][]uint64{
+			1: {2, 3, 100},
+			2: {1},
+			3: {},
+		})
+
+		assert.Equal(t, 4, info.FriendCount)
+		assert.Equal(t, 4, info.CheckedCount)
+		assert.Equal(t, 3, info.ConnectedCount)
+		assert.Equal(t, 2, info.Connections)
+		assert.InDelta(t, 2.0/6.0, info.Density, 0.0001)
+	})
+
+	t.Run("ignores friends outside the checked set", func(t *testing.T) {
+		info := buildClusterInfo(3, []uint64{1, 2}, map[uint64][]uint64{
+			1: {3},
+			3: {1, 2},
+		})
+
+		assert.Equal(t, 3, info.FriendCount)
+		assert.Zero(t, info.ConnectedCount)
+		assert.Zero(t, info.Density)
+	})
+
+	t.Run("handles too few friends", func(t *testing.T) {
+		info := buildClusterInfo(1, []uint64{1}, nil)
+
+		assert.Equal(t, 1, info.CheckedCount)
+		assert.Zero(t, info.Density)
+	})
+}
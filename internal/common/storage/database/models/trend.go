@@ -0,0 +1,106 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// TrendModel handles database operations for trending terms in flagged content.
+type TrendModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewTrend creates a new TrendModel instance.
+func NewTrend(db *bun.DB, logger *zap.Logger) *TrendModel {
+	return &TrendModel{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetFlaggedContent retrieves the flagged content of flagged and confirmed users last
+// updated between start and end. Confirmed users are included so that content of an
+// earlier window still counts after its users were reviewed. Each entry in the result
+// is a single piece of flagged content without its source prefix.
+func (m *TrendModel) GetFlaggedContent(ctx context.Context, start, end time.Time) ([]string, error) {
+	var users []types.User
+	err := flaggedContentQuery(m.db, start, end).Scan(ctx, &users)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flagged content: %w", err)
+	}
+
+	var contents []string
+	for _, user := range users {
+		for _, item := range user.FlaggedContent {
+			content, _ := types.SplitFlaggedContent(item)
+			contents = append(contents, content)
+		}
+	}
+	return contents, nil
+}
+
+// flaggedContentQuery builds the query selecting the flagged content of flagged
+// and confirmed users last updated between start and end.
+func flaggedContentQuery(db bun.IDB, start, end time.Time) *bun.SelectQuery {
+	users := db.NewSelect().
+		Model((*types.FlaggedUser)(nil)).
+		Column("flagged_content").
+		Where("last_updated >= ?", start).
+		Where("last_updated < ?", end).
+		UnionAll(
+			db.NewSelect().
+				Model((*types.ConfirmedUser)(nil)).
+				Column("flagged_content").
+				Where("last_updated >= ?", start).
+				Where("last_updated < ?", end),
+		)
+
+	return db.NewSelect().
+		TableExpr("(?) AS users", users).
+		Column("flagged_content")
+}
+
+// SaveTrendingTerms replaces the stored trending terms with the given terms.
+func (m *TrendModel) SaveTrendingTerms(ctx context.Context, terms []*types.TrendingTerm) error {
+	return m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewTruncateTable().
+			Model((*types.TrendingTerm)(nil)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to clear trending terms: %w", err)
+		}
+
+		if len(terms) == 0 {
+			return nil
+		}
+
+		_, err = tx.NewInsert().
+			Model(&terms).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert trending terms: %w", err)
+		}
+
+		m.logger.Debug("Saved trending terms", zap.Int("count", len(terms)))
+		return nil
+	})
+}
+
+// GetTrendingTerms retrieves the stored trending terms ordered by growth.
+func (m *TrendModel) GetTrendingTerms(ctx context.Context) ([]*types.TrendingTerm, error) {
+	var terms []*types.TrendingTerm
+	err := m.db.NewSelect().
+		Model(&terms).
+		Order("change DESC", "current_count DESC", "term ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending terms: %w", err)
+	}
+	return terms, nil
+}
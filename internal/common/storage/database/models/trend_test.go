@@ -0,0 +1,50 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGetFlaggedContentIncludesConfirmedUsers(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	trends := NewTrend(db, zap.NewNop())
+
+	// One user flagged this week and one flagged last week and confirmed since
+	now := time.Now()
+	flagged := types.User{
+		ID: 9_000_000_601, UUID: uuid.New(), Name: "trend_flagged",
+		FlaggedContent: []string{"free robux"}, LastUpdated: now.Add(-24 * time.Hour),
+	}
+	confirmed := types.User{
+		ID: 9_000_000_602, UUID: uuid.New(), Name: "trend_confirmed",
+		FlaggedContent: []string{types.OutfitContentPrefix + "free robux outfit"}, LastUpdated: now.Add(-10 * 24 * time.Hour),
+	}
+	_, err := db.NewInsert().Model(&types.FlaggedUser{User: flagged}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.ConfirmedUser{User: confirmed, VerifiedAt: now}).Exec(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.FlaggedUser)(nil)).Where("id = ?", flagged.ID).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.ConfirmedUser)(nil)).Where("id = ?", confirmed.ID).Exec(ctx)
+	})
+
+	week := 7 * 24 * time.Hour
+	current, err := trends.GetFlaggedContent(ctx, now.Add(-week), now)
+	require.NoError(t, err)
+	assert.Contains(t, current, "free robux")
+	assert.NotContains(t, current, "free robux outfit")
+
+	// The confirmed user still counts towards the previous window
+	previous, err := trends.GetFlaggedContent(ctx, now.Add(-2*week), now.Add(-week))
+	require.NoError(t, err)
+	assert.Contains(t, previous, "free robux outfit")
+	assert.NotContains(t, previous, "free robux")
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// AIUsageModel handles database operations for AI token usage statistics.
+type AIUsageModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewAIUsage creates a new AIUsageModel instance.
+func NewAIUsage(db *bun.DB, logger *zap.Logger) *AIUsageModel {
+	return &AIUsageModel{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// AddUsage adds token usage to the daily aggregate row for its model and user.
+// The date of the usage is truncated to the day in UTC.
+func (m *AIUsageModel) AddUsage(ctx context.Context, usage *types.AIUsageStats) error {
+	usage.Date = usageDay(usage.Date)
+	usage.UpdatedAt = time.Now()
+
+	_, err := addUsageQuery(m.db, usage).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to add AI usage: %w (model=%s, userID=%d)", err, usage.Model, usage.UserID)
+	}
+	return nil
+}
+
+// GetDailyCost returns the estimated cost of all AI usage on the given day.
+func (m *AIUsageModel) GetDailyCost(ctx context.Context, date time.Time) (float64, error) {
+	var cost float64
+	err := dailyCostQuery(m.db, usageDay(date)).Scan(ctx, &cost)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get daily AI cost: %w", err)
+	}
+	return cost, nil
+}
+
+// addUsageQuery builds the upsert that adds usage to an existing daily row.
+func addUsageQuery(db bun.IDB, usage *types.AIUsageStats) *bun.InsertQuery {
+	return db.NewInsert().
+		Model(usage).
+		On("CONFLICT (date, model, user_id) DO UPDATE").
+		Set("prompt_tokens = ai_usage_stats.prompt_tokens + EXCLUDED.prompt_tokens").
+		Set("completion_tokens = ai_usage_stats.completion_tokens + EXCLUDED.completion_tokens").
+		Set("estimated_cost = ai_usage_stats.estimated_cost + EXCLUDED.estimated_cost").
+		Set("updated_at = EXCLUDED.updated_at")
+}
+
+// dailyCostQuery builds the query summing the estimated cost of a day.
+func dailyCostQuery(db bun.IDB, date time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.AIUsageStats)(nil)).
+		ColumnExpr("COALESCE(SUM(estimated_cost), 0)").
+		Where("date = ?", date)
+}
+
+// usageDay returns the start of the UTC day containing t.
+func usageDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestAddUsageQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := addUsageQuery(db, &types.AIUsageStats{
+		Date:             time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC),
+		Model:            "gemini",
+		UserID:           7,
+		PromptTokens:     100,
+		CompletionTokens: 20,
+	}).String()
+
+	assert.Contains(t, query, `INSERT INTO "ai_usage_stats"`)
+	assert.Contains(t, query, `ON CONFLICT (date, model, user_id) DO UPDATE SET `+
+		`prompt_tokens = ai_usage_stats.prompt_tokens + EXCLUDED.prompt_tokens, `+
+		`completion_tokens = ai_usage_stats.completion_tokens + EXCLUDED.completion_tokens, `+
+		`estimated_cost = ai_usage_stats.estimated_cost + EXCLUDED.estimated_cost, `+
+		`updated_at = EXCLUDED.updated_at`)
+}
+
+func TestDailyCostQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := dailyCostQuery(db, time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC)).String()
+
+	assert.Contains(t, query, `SELECT COALESCE(SUM(estimated_cost), 0) FROM "ai_usage_stats"`)
+	assert.Contains(t, query, `WHERE (date = '2025-01-24 00:00:00+00:00')`)
+}
+
+func TestUsageDay(t *testing.T) {
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	got := usageDay(time.Date(2025, 1, 25, 5, 30, 0, 0, loc))
+
+	assert.Equal(t, time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC), got)
+}
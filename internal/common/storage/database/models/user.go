@@ -5,10 +5,14 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"github.com/uptrace/bun"
@@ -22,7 +26,9 @@ type UserModel struct {
 	activity   *ActivityModel
 	reputation *ReputationModel
 	votes      *VoteModel
+	protected  *ProtectedModel
 	logger     *zap.Logger
+	now        func() time.Time
 }
 
 // NewUser creates a UserModel with references to the tracking system.
@@ -32,6 +38,7 @@ func NewUser(
 	activity *ActivityModel,
 	reputation *ReputationModel,
 	votes *VoteModel,
+	protected *ProtectedModel,
 	logger *zap.Logger,
 ) *UserModel {
 	return &UserModel{
@@ -40,7 +47,9 @@ func NewUser(
 		activity:   activity,
 		reputation: reputation,
 		votes:      votes,
+		protected:  protected,
 		logger:     logger,
+		now:        time.Now,
 	}
 }
 
@@ -55,6 +64,8 @@ func (r *UserModel) SaveUsers(ctx context.Context, users map[uint64]*types.User)
 	// Get existing users with all their data
 	existingUsers, err := r.GetUsersByIDs(ctx, userIDs, types.UserFields{
 		Basic:      true,
+		Reason:     true,
+		Confidence: true,
 		Timestamps: true,
 	})
 	if err != nil {
@@ -80,6 +91,8 @@ func (r *UserModel) SaveUsers(ctx context.Context, users map[uint64]*types.User)
 		existingUser := existingUsers[id]
 		if existingUser.Status != enum.UserTypeUnflagged {
 			status = existingUser.Status
+			// Keep the findings of earlier checkers instead of overwriting them
+			user.MergeReason(&existingUser.User)
 		} else {
 			// Default to flagged_users for new users
 			status = enum.UserTypeFlagged
@@ -119,15 +132,21 @@ func (r *UserModel) SaveUsers(ctx context.Context, users map[uint64]*types.User)
 				return nil
 			}
 
+			// decisive_content is left untouched so reviewer marks survive rescans,
+			// and the stored name is kept in previous_names if the user was renamed
 			_, err := tx.NewInsert().
 				Model(users).
 				On("CONFLICT (id) DO UPDATE").
 				Set("uuid = EXCLUDED.uuid").
+				Set("previous_names = " + previousNamesExpr("EXCLUDED.name")).
 				Set("name = EXCLUDED.name").
 				Set("display_name = EXCLUDED.display_name").
 				Set("description = EXCLUDED.description").
 				Set("created_at = EXCLUDED.created_at").
 				Set("reason = EXCLUDED.reason").
+				Set("reason_category = EXCLUDED.reason_category").
+				Set("flag_generation = EXCLUDED.flag_generation").
+				Set("flag_source = EXCLUDED.flag_source").
 				Set("groups = EXCLUDED.groups").
 				Set("outfits = EXCLUDED.outfits").
 				Set("friends = EXCLUDED.friends").
@@ -180,53 +199,77 @@ func (r *UserModel) SaveUsers(ctx context.Context, users map[uint64]*types.User)
 }
 
 // ConfirmUser moves a user from other user tables to confirmed_users.
+// Returns types.ErrUserProtected if the user is a protected account.
 func (r *UserModel) ConfirmUser(ctx context.Context, user *types.ReviewUser) error {
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		confirmedUser := &types.ConfirmedUser{
-			User:       user.User,
-			VerifiedAt: time.Now(),
-		}
+		return confirmUserTx(ctx, tx, user, time.Now())
+	})
+	if err != nil {
+		return err
+	}
 
-		// Try to move user to confirmed_users table
-		result, err := tx.NewInsert().Model(confirmedUser).
-			On("CONFLICT (id) DO NOTHING").
-			Exec(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to insert user in confirmed_users: %w (userID=%d)", err, user.ID)
-		}
+	// Verify votes for the user
+	if err := r.votes.VerifyVotes(ctx, user.ID, true, enum.VoteTypeUser); err != nil {
+		r.logger.Error("Failed to verify votes", zap.Error(err))
+		return err
+	}
 
-		affected, err := result.RowsAffected()
-		if err != nil {
-			return fmt.Errorf("failed to get rows affected: %w", err)
-		}
-		if affected == 0 {
-			return nil // Skip if there was a conflict
-		}
+	return nil
+}
 
-		// Delete from other tables
-		_, err = tx.NewDelete().Model((*types.FlaggedUser)(nil)).Where("id = ?", user.ID).Exec(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to delete user from flagged_users: %w (userID=%d)", err, user.ID)
-		}
+// ConfirmUsers confirms several users in a single transaction. Each user is moved
+// within its own savepoint so a failure only rolls back that user. Returns the
+// errors of the users that could not be confirmed keyed by user ID.
+func (r *UserModel) ConfirmUsers(ctx context.Context, users []*types.ReviewUser) (map[uint64]error, error) {
+	return r.moveUsers(ctx, users, confirmUserTx, true)
+}
 
-		_, err = tx.NewDelete().Model((*types.ClearedUser)(nil)).Where("id = ?", user.ID).Exec(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to delete user from cleared_users: %w (userID=%d)", err, user.ID)
-		}
+// ConfirmUserWithPropagation confirms a user like ConfirmUser and then moves the
+// confirmed groups the user belongs to to the front of the group scan order, so
+// their other members are checked on the next group worker cycle. The propagation
+// runs in its own transaction after the confirm, and a failure there is only logged
+// so it never undoes the confirm. Returns the number of groups queued for a scan.
+func (r *UserModel) ConfirmUserWithPropagation(ctx context.Context, user *types.ReviewUser) (int, error) {
+	if err := r.ConfirmUser(ctx, user); err != nil {
+		return 0, err
+	}
 
-		_, err = tx.NewDelete().Model((*types.BannedUser)(nil)).Where("id = ?", user.ID).Exec(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to delete user from banned_users: %w (userID=%d)", err, user.ID)
-		}
+	if len(user.Groups) == 0 {
+		return 0, nil
+	}
 
-		return nil
+	groupIDs := make([]uint64, 0, len(user.Groups))
+	for _, group := range user.Groups {
+		groupIDs = append(groupIDs, group.Group.ID)
+	}
+
+	var queuedIDs []uint64
+	if err := prioritizeGroupScansQuery(r.db, groupIDs).Scan(ctx, &queuedIDs); err != nil {
+		r.logger.Error("Failed to prioritize scans of confirmed groups",
+			zap.Error(err),
+			zap.Uint64("userID", user.ID),
+			zap.Uint64s("groupIDs", groupIDs))
+		return 0, nil
+	}
+
+	r.logger.Debug("Prioritized scans of confirmed groups",
+		zap.Uint64("userID", user.ID),
+		zap.Uint64s("groupIDs", queuedIDs))
+
+	return len(queuedIDs), nil
+}
+
+// ClearUser moves a user from other user tables to cleared_users.
+func (r *UserModel) ClearUser(ctx context.Context, user *types.ReviewUser) error {
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return clearUserTx(ctx, tx, user, time.Now())
 	})
 	if err != nil {
 		return err
 	}
 
 	// Verify votes for the user
-	if err := r.votes.VerifyVotes(ctx, user.ID, true, enum.VoteTypeUser); err != nil {
+	if err := r.votes.VerifyVotes(ctx, user.ID, false, enum.VoteTypeUser); err != nil {
 		r.logger.Error("Failed to verify votes", zap.Error(err))
 		return err
 	}
@@ -234,20 +277,140 @@ func (r *UserModel) ConfirmUser(ctx context.Context, user *types.ReviewUser) err
 	return nil
 }
 
-// ClearUser moves a user from other user tables to cleared_users.
-func (r *UserModel) ClearUser(ctx context.Context, user *types.ReviewUser) error {
+// ClearUsers clears several users in a single transaction. Each user is moved
+// within its own savepoint so a failure only rolls back that user. Returns the
+// errors of the users that could not be cleared keyed by user ID.
+func (r *UserModel) ClearUsers(ctx context.Context, users []*types.ReviewUser) (map[uint64]error, error) {
+	return r.moveUsers(ctx, users, clearUserTx, false)
+}
+
+// moveUsers runs the move function for each user in its own savepoint of a single
+// transaction and verifies the votes of the users that were moved once the
+// transaction has been committed. Vote verification failures are only logged
+// as the users have already been moved.
+func (r *UserModel) moveUsers(
+	ctx context.Context, users []*types.ReviewUser,
+	move func(context.Context, bun.Tx, *types.ReviewUser, time.Time) error, confirmed bool,
+) (map[uint64]error, error) {
+	var failed map[uint64]error
+	var moved []uint64
+
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		clearedUser := &types.ClearedUser{
-			User:      user.User,
-			ClearedAt: time.Now(),
+		// Reset results in case the transaction is retried
+		failed = make(map[uint64]error)
+		moved = make([]uint64, 0, len(users))
+
+		now := time.Now()
+		for _, user := range users {
+			err := tx.RunInTx(ctx, nil, func(ctx context.Context, sp bun.Tx) error {
+				return move(ctx, sp, user, now)
+			})
+			if err != nil {
+				failed[user.ID] = err
+				continue
+			}
+			moved = append(moved, user.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to move users: %w", err)
+	}
+
+	// Verify votes for the moved users
+	for _, userID := range moved {
+		if err := r.votes.VerifyVotes(ctx, userID, confirmed, enum.VoteTypeUser); err != nil {
+			r.logger.Error("Failed to verify votes", zap.Error(err), zap.Uint64("userID", userID))
 		}
+	}
+
+	r.logger.Debug("Moved users in batch",
+		zap.Bool("confirmed", confirmed),
+		zap.Int("moved", len(moved)),
+		zap.Int("failed", len(failed)))
 
-		// Try to move user to cleared_users table
-		result, err := tx.NewInsert().Model(clearedUser).
+	return failed, nil
+}
+
+// confirmUserTx moves a user to confirmed_users within the given transaction.
+// Users that are already confirmed are left unchanged. Returns types.ErrUserProtected
+// if the user is a protected account, so no confirm path can confirm one.
+func confirmUserTx(ctx context.Context, tx bun.Tx, user *types.ReviewUser, now time.Time) error {
+	protected, err := protectedAccountQuery(tx, user.ID).Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check protected account: %w (userID=%d)", err, user.ID)
+	}
+	if protected {
+		return types.ErrUserProtected
+	}
+
+	confirmedUser := &types.ConfirmedUser{
+		User:       user.User,
+		VerifiedAt: now,
+	}
+
+	// Try to move user to confirmed_users table
+	result, err := tx.NewInsert().Model(confirmedUser).
+		On("CONFLICT (id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert user in confirmed_users: %w (userID=%d)", err, user.ID)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return nil // Skip if there was a conflict
+	}
+
+	// Delete from other tables
+	return deleteFromUserTables(ctx, tx, user.ID, enum.UserTypeConfirmed)
+}
+
+// clearUserTx moves a user to cleared_users within the given transaction.
+// Users that are already cleared are left unchanged.
+func clearUserTx(ctx context.Context, tx bun.Tx, user *types.ReviewUser, now time.Time) error {
+	clearedUser := &types.ClearedUser{
+		User:      user.User,
+		ClearedAt: now,
+	}
+
+	// Try to move user to cleared_users table
+	result, err := tx.NewInsert().Model(clearedUser).
+		On("CONFLICT (id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert user in cleared_users: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return nil // Skip if there was a conflict
+	}
+
+	// Delete from other tables
+	return deleteFromUserTables(ctx, tx, user.ID, enum.UserTypeCleared)
+}
+
+// ReturnUserToFlagged moves a confirmed user back to flagged_users so another reviewer
+// can take a second pass. The note is appended to the user's reason to record why the
+// user was returned. Votes are left unverified since no final decision has been made.
+// Returns types.ErrUserFlagged if the user is already in flagged_users.
+func (r *UserModel) ReturnUserToFlagged(ctx context.Context, user *types.ReviewUser, note string) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		flaggedUser := newReturnedFlaggedUser(user, note, time.Now())
+
+		// Try to move user to flagged_users table
+		result, err := tx.NewInsert().Model(flaggedUser).
 			On("CONFLICT (id) DO NOTHING").
 			Exec(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to insert user in cleared_users: %w", err)
+			return fmt.Errorf("failed to insert user in flagged_users: %w (userID=%d)", err, user.ID)
 		}
 
 		affected, err := result.RowsAffected()
@@ -255,40 +418,138 @@ func (r *UserModel) ClearUser(ctx context.Context, user *types.ReviewUser) error
 			return fmt.Errorf("failed to get rows affected: %w", err)
 		}
 		if affected == 0 {
-			return nil // Skip if there was a conflict
+			return types.ErrUserFlagged
 		}
 
 		// Delete from other tables
-		_, err = tx.NewDelete().Model((*types.FlaggedUser)(nil)).Where("id = ?", user.ID).Exec(ctx)
+		return deleteFromUserTables(ctx, tx, user.ID, enum.UserTypeFlagged)
+	})
+}
+
+// ReflagUser moves a cleared user back to flagged_users with the given reason so the
+// user goes through review again. Returns types.ErrUserNotCleared if the user is no
+// longer in cleared_users, or types.ErrUserProtected if the user is a protected account.
+func (r *UserModel) ReflagUser(ctx context.Context, user *types.ReviewUser, reason string) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		protected, err := protectedAccountQuery(tx, user.ID).Exists(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to delete user from flagged_users: %w", err)
+			return fmt.Errorf("failed to check protected account: %w (userID=%d)", err, user.ID)
+		}
+		if protected {
+			return types.ErrUserProtected
 		}
 
-		_, err = tx.NewDelete().Model((*types.ConfirmedUser)(nil)).Where("id = ?", user.ID).Exec(ctx)
+		flaggedUser := newReflaggedUser(user, reason, time.Now())
+
+		// Move user to flagged_users table
+		_, err = tx.NewInsert().Model(flaggedUser).
+			On("CONFLICT (id) DO UPDATE").
+			Set("reason = EXCLUDED.reason").
+			Set("reason_category = EXCLUDED.reason_category").
+			Set("last_updated = EXCLUDED.last_updated").
+			Set("last_viewed = EXCLUDED.last_viewed").
+			Exec(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to delete user from confirmed_users: %w", err)
+			return fmt.Errorf("failed to insert user in flagged_users: %w (userID=%d)", err, user.ID)
 		}
 
-		_, err = tx.NewDelete().Model((*types.BannedUser)(nil)).Where("id = ?", user.ID).Exec(ctx)
+		// Remove user from cleared_users
+		result, err := tx.NewDelete().
+			Model((*types.ClearedUser)(nil)).
+			Where("id = ?", user.ID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete user from cleared_users: %w (userID=%d)", err, user.ID)
+		}
+
+		affected, err := result.RowsAffected()
 		if err != nil {
-			return fmt.Errorf("failed to delete user from banned_users: %w", err)
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if affected == 0 {
+			return types.ErrUserNotCleared
 		}
 
+		r.logger.Debug("Reflagged cleared user",
+			zap.Uint64("userID", user.ID),
+			zap.String("reason", reason))
 		return nil
 	})
-	if err != nil {
-		return err
+}
+
+// userTable pairs a user table with the status it stores.
+type userTable struct {
+	status enum.UserType
+	name   string
+	model  interface{}
+}
+
+// userTablesExcept returns the user tables a user must be removed from
+// when being moved into the table for the given status.
+func userTablesExcept(status enum.UserType) []userTable {
+	tables := []userTable{
+		{status: enum.UserTypeFlagged, name: "flagged_users", model: (*types.FlaggedUser)(nil)},
+		{status: enum.UserTypeConfirmed, name: "confirmed_users", model: (*types.ConfirmedUser)(nil)},
+		{status: enum.UserTypeCleared, name: "cleared_users", model: (*types.ClearedUser)(nil)},
+		{status: enum.UserTypeBanned, name: "banned_users", model: (*types.BannedUser)(nil)},
 	}
 
-	// Verify votes for the user
-	if err := r.votes.VerifyVotes(ctx, user.ID, false, enum.VoteTypeUser); err != nil {
-		r.logger.Error("Failed to verify votes", zap.Error(err))
-		return err
+	result := make([]userTable, 0, len(tables)-1)
+	for _, table := range tables {
+		if table.status != status {
+			result = append(result, table)
+		}
+	}
+	return result
+}
+
+// deleteFromUserTables removes a user from every user table except the one for the given status.
+// A second opinion request on the user is resolved by any move out of flagged_users.
+func deleteFromUserTables(ctx context.Context, tx bun.Tx, userID uint64, status enum.UserType) error {
+	for _, table := range userTablesExcept(status) {
+		_, err := tx.NewDelete().Model(table.model).Where("id = ?", userID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete user from %s: %w (userID=%d)", table.name, err, userID)
+		}
 	}
 
+	if status != enum.UserTypeFlagged {
+		if _, err := deleteSecondOpinionsQuery(tx, []uint64{userID}).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete second opinion: %w (userID=%d)", err, userID)
+		}
+	}
 	return nil
 }
 
+// newReturnedFlaggedUser builds the flagged_users row for a user returned to review,
+// appending the note to the existing reason and resetting the view time so the user
+// is picked up again by the review queue.
+func newReturnedFlaggedUser(user *types.ReviewUser, note string, now time.Time) *types.FlaggedUser {
+	flaggedUser := &types.FlaggedUser{User: user.User}
+	if note != "" {
+		if flaggedUser.Reason != "" {
+			flaggedUser.Reason += "\n\n"
+		}
+		flaggedUser.Reason += note
+	}
+	flaggedUser.LastUpdated = now
+	flaggedUser.LastViewed = time.Time{}
+	return flaggedUser
+}
+
+// newReflaggedUser builds the flagged_users row for a cleared user sent back to review,
+// replacing the reason with the given one and resetting the view time so the user is
+// picked up again by the review queue. The reason is written by a reviewer, so the
+// category of the old reason is replaced with the one for custom reasons.
+func newReflaggedUser(user *types.ReviewUser, reason string, now time.Time) *types.FlaggedUser {
+	flaggedUser := &types.FlaggedUser{User: user.User}
+	flaggedUser.Reason = reason
+	flaggedUser.ReasonCategory = enum.ReasonCategoryOther
+	flaggedUser.LastUpdated = now
+	flaggedUser.LastViewed = time.Time{}
+	return flaggedUser
+}
+
 // GetConfirmedUsersCount returns the total number of users in confirmed_users.
 func (r *UserModel) GetConfirmedUsersCount(ctx context.Context) (int, error) {
 	count, err := r.db.NewSelect().
@@ -311,6 +572,49 @@ func (r *UserModel) GetFlaggedUsersCount(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+// GetCurrentlyViewedCounts returns how many users of each status are currently held
+// by a reviewer, meaning they were opened within the review hold window.
+func (r *UserModel) GetCurrentlyViewedCounts(ctx context.Context) (map[enum.UserType]int, error) {
+	now := r.now()
+	counts := make(map[enum.UserType]int)
+	for status, model := range map[enum.UserType]interface{}{
+		enum.UserTypeFlagged:   (*types.FlaggedUser)(nil),
+		enum.UserTypeConfirmed: (*types.ConfirmedUser)(nil),
+		enum.UserTypeCleared:   (*types.ClearedUser)(nil),
+		enum.UserTypeBanned:    (*types.BannedUser)(nil),
+	} {
+		count, err := viewedCountQuery(r.db, model, now).Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count viewed %s users: %w", status, err)
+		}
+		counts[status] = count
+	}
+	return counts, nil
+}
+
+// ReleaseReview resets the last_viewed timestamp of users held by a reviewer so they
+// return to the review queue immediately instead of after the hold window.
+func (r *UserModel) ReleaseReview(ctx context.Context, userIDs ...uint64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	now := r.now()
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, model := range []interface{}{
+			(*types.FlaggedUser)(nil),
+			(*types.ConfirmedUser)(nil),
+			(*types.ClearedUser)(nil),
+			(*types.BannedUser)(nil),
+		} {
+			if _, err := releaseReviewQuery(tx, model, userIDs, now).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to release users: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
 // GetRecentlyProcessedUsers checks which users exist in any table and have been updated within the past 7 days.
 // Returns a map of user IDs to their current status.
 func (r *UserModel) GetRecentlyProcessedUsers(ctx context.Context, userIDs []uint64) (map[uint64]enum.UserType, error) {
@@ -319,33 +623,7 @@ func (r *UserModel) GetRecentlyProcessedUsers(ctx context.Context, userIDs []uin
 		Status enum.UserType
 	}
 
-	err := r.db.NewSelect().Model((*types.ConfirmedUser)(nil)).
-		Column("id").
-		ColumnExpr("? AS status", enum.UserTypeConfirmed).
-		Where("id IN (?)", bun.In(userIDs)).
-		Where("last_updated > NOW() - INTERVAL '7 days'").
-		Union(
-			r.db.NewSelect().Model((*types.FlaggedUser)(nil)).
-				Column("id").
-				ColumnExpr("? AS status", enum.UserTypeFlagged).
-				Where("id IN (?)", bun.In(userIDs)).
-				Where("last_updated > NOW() - INTERVAL '7 days'"),
-		).
-		Union(
-			r.db.NewSelect().Model((*types.ClearedUser)(nil)).
-				Column("id").
-				ColumnExpr("? AS status", enum.UserTypeCleared).
-				Where("id IN (?)", bun.In(userIDs)).
-				Where("last_updated > NOW() - INTERVAL '7 days'"),
-		).
-		Union(
-			r.db.NewSelect().Model((*types.BannedUser)(nil)).
-				Column("id").
-				ColumnExpr("? AS status", enum.UserTypeBanned).
-				Where("id IN (?)", bun.In(userIDs)).
-				Where("last_updated > NOW() - INTERVAL '7 days'"),
-		).
-		Scan(ctx, &users)
+	err := recentlyProcessedUsersQuery(r.db, userIDs, r.now()).Scan(ctx, &users)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check recently processed users: %w", err)
 	}
@@ -362,19 +640,35 @@ func (r *UserModel) GetRecentlyProcessedUsers(ctx context.Context, userIDs []uin
 	return result, nil
 }
 
-// GetUserByID retrieves a user by either their numeric ID or UUID.
+// recentlyProcessedUsersQuery builds the query selecting which of the given users
+// were updated within the recently processed window, along with their status.
+func recentlyProcessedUsersQuery(db bun.IDB, userIDs []uint64, now time.Time) *bun.SelectQuery {
+	cutoff := now.Add(-RecentlyProcessedWindow)
+	query := db.NewSelect().Model((*types.ConfirmedUser)(nil)).
+		Column("id").
+		ColumnExpr("? AS status", enum.UserTypeConfirmed).
+		Where("id IN (?)", bun.In(userIDs)).
+		Where("last_updated > ?", cutoff)
+
+	for _, status := range []enum.UserType{enum.UserTypeFlagged, enum.UserTypeCleared, enum.UserTypeBanned} {
+		query.Union(db.NewSelect().Model(newUserModel(status)).
+			Column("id").
+			ColumnExpr("? AS status", status).
+			Where("id IN (?)", bun.In(userIDs)).
+			Where("last_updated > ?", cutoff))
+	}
+
+	return query
+}
+
+// GetUserByID retrieves a user by either their numeric ID or UUID. A user found
+// in several tables is returned with the status that comes first in userStatusPrecedence.
 func (r *UserModel) GetUserByID(ctx context.Context, userID string, fields types.UserFields) (*types.ReviewUser, error) {
 	var result types.ReviewUser
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		// Try each model in order until we find a user
-		models := []interface{}{
-			&types.FlaggedUser{},
-			&types.ConfirmedUser{},
-			&types.ClearedUser{},
-			&types.BannedUser{},
-		}
-
-		for _, model := range models {
+		// Try each table in order of precedence until we find the user
+		for _, status := range userStatusPrecedence {
+			model := newUserModel(status)
 			query := tx.NewSelect().
 				Model(model).
 				Column(fields.Columns()...).
@@ -406,6 +700,7 @@ func (r *UserModel) GetUserByID(ctx context.Context, userID string, fields types
 				case *types.ClearedUser:
 					result.User = m.User
 					result.ClearedAt = m.ClearedAt
+					result.IsPinned = m.Pinned
 					result.Status = enum.UserTypeCleared
 				case *types.BannedUser:
 					result.User = m.User
@@ -420,10 +715,18 @@ func (r *UserModel) GetUserByID(ctx context.Context, userID string, fields types
 				}
 				result.Reputation = reputation
 
+				// Check if the user is protected from flagging
+				isProtected, err := r.protected.IsProtected(ctx, result.ID)
+				if err != nil {
+					return fmt.Errorf("failed to check protected status: %w", err)
+				}
+				result.IsProtected = isProtected
+
 				// Update last_viewed if requested
+				result.LastViewed = r.now()
 				_, err = tx.NewUpdate().
 					Model(model).
-					Set("last_viewed = ?", time.Now()).
+					Set("last_viewed = ?", result.LastViewed).
 					Where("id = ?", result.ID).
 					Exec(ctx)
 				if err != nil {
@@ -447,150 +750,427 @@ func (r *UserModel) GetUserByID(ctx context.Context, userID string, fields types
 }
 
 // GetUsersByIDs retrieves specified user information for a list of user IDs.
-// Returns a map of user IDs to review users.
+// Returns a map of user IDs to review users. A user found in several tables
+// gets the status that comes first in userStatusPrecedence.
 func (r *UserModel) GetUsersByIDs(ctx context.Context, userIDs []uint64, fields types.UserFields) (map[uint64]*types.ReviewUser, error) {
-	users := make(map[uint64]*types.ReviewUser)
+	// Query all user tables at once
+	var rows []usersByIDsRow
+	err := usersByIDsQuery(r.db, userIDs, fields).Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by IDs: %w (userCount=%d)", err, len(userIDs))
+	}
 
-	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		// Build query with selected fields
-		columns := fields.Columns()
+	users := mergeUsersByIDs(rows, userIDs)
 
-		// Query confirmed users
-		var confirmedUsers []types.ConfirmedUser
-		err := tx.NewSelect().
-			Model(&confirmedUsers).
-			Column(columns...).
-			Where("id IN (?)", bun.In(userIDs)).
-			Scan(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get confirmed users: %w", err)
-		}
-		for _, user := range confirmedUsers {
-			users[user.ID] = &types.ReviewUser{
-				User:       user.User,
-				VerifiedAt: user.VerifiedAt,
-				Status:     enum.UserTypeConfirmed,
-			}
+	r.logger.Debug("Retrieved users by IDs",
+		zap.Int("requestedCount", len(userIDs)),
+		zap.Int("foundCount", len(rows)))
+
+	return users, nil
+}
+
+// usersByIDsRow is a row of the combined user tables query with the
+// timestamps of every table and the table the row came from.
+type usersByIDsRow struct {
+	types.User
+	VerifiedAt time.Time
+	ClearedAt  time.Time
+	Pinned     bool
+	PurgedAt   time.Time
+	Status     enum.UserType
+}
+
+// userStatusPrecedence lists the user statuses from highest to lowest precedence,
+// deciding which status wins when a user is found in several tables at once.
+// Banned comes first as it reflects the state of the account on Roblox, followed
+// by confirmed as a final review decision. Cleared comes last since cleared users
+// that are flagged again keep their cleared row until they are reviewed.
+var userStatusPrecedence = []enum.UserType{
+	enum.UserTypeBanned,
+	enum.UserTypeConfirmed,
+	enum.UserTypeFlagged,
+	enum.UserTypeCleared,
+}
+
+// userStatusRank returns the position of a status in userStatusPrecedence,
+// where a lower rank takes precedence. Unknown statuses rank last.
+func userStatusRank(status enum.UserType) int {
+	for i, s := range userStatusPrecedence {
+		if s == status {
+			return i
 		}
+	}
+	return len(userStatusPrecedence)
+}
 
-		// Query flagged users
-		var flaggedUsers []types.FlaggedUser
-		err = tx.NewSelect().
-			Model(&flaggedUsers).
-			Column(columns...).
-			Where("id IN (?)", bun.In(userIDs)).
-			Scan(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get flagged users: %w", err)
+// newUserModel returns an empty model of the table storing users with the given status.
+func newUserModel(status enum.UserType) interface{} {
+	switch status {
+	case enum.UserTypeConfirmed:
+		return &types.ConfirmedUser{}
+	case enum.UserTypeCleared:
+		return &types.ClearedUser{}
+	case enum.UserTypeBanned:
+		return &types.BannedUser{}
+	case enum.UserTypeFlagged, enum.UserTypeUnflagged:
+	}
+	return &types.FlaggedUser{}
+}
+
+// mergeUsersByIDs converts the rows of the combined user tables query into review
+// users. Requested IDs that were not found in any table are marked as unflagged.
+func mergeUsersByIDs(rows []usersByIDsRow, userIDs []uint64) map[uint64]*types.ReviewUser {
+	users := make(map[uint64]*types.ReviewUser, len(userIDs))
+	for _, row := range rows {
+		// Keep the status with the highest precedence if a user is in several tables
+		if existing, ok := users[row.ID]; ok && userStatusRank(existing.Status) <= userStatusRank(row.Status) {
+			continue
 		}
-		for _, user := range flaggedUsers {
-			users[user.ID] = &types.ReviewUser{
-				User:   user.User,
-				Status: enum.UserTypeFlagged,
+
+		users[row.ID] = reviewUserFromRow(row)
+	}
+
+	// Mark remaining IDs as unflagged
+	for _, id := range userIDs {
+		if _, ok := users[id]; !ok {
+			users[id] = &types.ReviewUser{
+				User:   types.User{ID: id},
+				Status: enum.UserTypeUnflagged,
 			}
 		}
+	}
 
-		// Query cleared users
-		var clearedUsers []types.ClearedUser
-		err = tx.NewSelect().
-			Model(&clearedUsers).
-			Column(columns...).
-			Where("id IN (?)", bun.In(userIDs)).
-			Scan(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get cleared users: %w", err)
+	return users
+}
+
+// reviewUserFromRow converts a row of the combined user tables query into a
+// review user, keeping only the timestamps of the table the row came from.
+func reviewUserFromRow(row usersByIDsRow) *types.ReviewUser {
+	user := &types.ReviewUser{
+		User:   row.User,
+		Status: row.Status,
+	}
+	switch row.Status {
+	case enum.UserTypeConfirmed:
+		user.VerifiedAt = row.VerifiedAt
+	case enum.UserTypeCleared:
+		user.ClearedAt = row.ClearedAt
+		user.IsPinned = row.Pinned
+	case enum.UserTypeBanned:
+		user.PurgedAt = row.PurgedAt
+	case enum.UserTypeFlagged, enum.UserTypeUnflagged:
+	}
+	return user
+}
+
+// SearchUsersByName finds users whose username or display name contains the query,
+// ignoring case, across the flagged, confirmed, cleared and banned tables. Results
+// are ordered by how closely the names match and include the status of each user.
+func (r *UserModel) SearchUsersByName(ctx context.Context, query string, limit int) ([]*types.ReviewUser, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < types.MinSearchQueryLength {
+		return nil, types.ErrSearchQueryTooShort
+	}
+
+	// Escape LIKE wildcards so the query is matched literally
+	escaper := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	pattern := "%" + escaper.Replace(query) + "%"
+
+	var rows []usersByIDsRow
+	err := usersByNameQuery(r.db, query, pattern, limit).Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users by name: %w (query=%q)", err, query)
+	}
+
+	users := mergeUsersByName(rows)
+
+	r.logger.Debug("Searched users by name",
+		zap.String("query", query),
+		zap.Int("resultCount", len(users)))
+
+	return users, nil
+}
+
+// mergeUsersByName converts the rows of the name search into review users while
+// keeping their order. Users found in several tables keep the status with the
+// highest precedence at the position of their first row.
+func mergeUsersByName(rows []usersByIDsRow) []*types.ReviewUser {
+	users := make([]*types.ReviewUser, 0, len(rows))
+	positions := make(map[uint64]int, len(rows))
+	for _, row := range rows {
+		if i, ok := positions[row.ID]; ok {
+			if userStatusRank(row.Status) < userStatusRank(users[i].Status) {
+				users[i] = reviewUserFromRow(row)
+			}
+			continue
 		}
-		for _, user := range clearedUsers {
-			users[user.ID] = &types.ReviewUser{
-				User:      user.User,
-				ClearedAt: user.ClearedAt,
-				Status:    enum.UserTypeCleared,
+
+		positions[row.ID] = len(users)
+		users = append(users, reviewUserFromRow(row))
+	}
+
+	return users
+}
+
+// usersByIDsQuery builds a UNION ALL query over the user tables for the given IDs.
+func usersByIDsQuery(db bun.IDB, userIDs []uint64, fields types.UserFields) *bun.SelectQuery {
+	return userTablesUnionQuery(db, fields, func(q *bun.SelectQuery, _ interface{}) *bun.SelectQuery {
+		return q.Where("id IN (?)", bun.In(userIDs))
+	})
+}
+
+// usersByNameQuery builds a UNION ALL query over the user tables matching the
+// pattern against the username, display name or previous usernames. Each table
+// finds its matches with a UNION of one subquery per name column, so every column
+// is searched with its own trigram index. Users are ordered by how closely either
+// name resembles the search query, with the ID as a tiebreaker.
+func usersByNameQuery(db bun.IDB, query, pattern string, limit int) *bun.SelectQuery {
+	union := userTablesUnionQuery(db, types.UserFields{Basic: true, Reason: true, Confidence: true},
+		func(q *bun.SelectQuery, model interface{}) *bun.SelectQuery {
+			var matches *bun.SelectQuery
+			for _, column := range []string{"name", "display_name", "previous_names_text(previous_names)"} {
+				match := db.NewSelect().
+					Model(model).
+					Column("id").
+					Where("? ILIKE ?", bun.Safe(column), pattern)
+				if matches == nil {
+					matches = match
+				} else {
+					matches = matches.Union(match)
+				}
 			}
+			return q.Where("id IN (?)", matches)
+		})
+
+	return db.NewSelect().
+		TableExpr("(?) AS results", union).
+		ColumnExpr("*").
+		OrderExpr("GREATEST(similarity(name, ?), similarity(display_name, ?)) DESC", query, query).
+		Order("id ASC").
+		Limit(limit)
+}
+
+// userTablesUnionQuery builds a UNION ALL query over the confirmed, flagged,
+// cleared and banned user tables with the filter applied to each table. The filter
+// is given the model of the table it is applied to. Each
+// table fills in the timestamps it does not have with NULL so every subquery
+// returns the same columns.
+func userTablesUnionQuery(
+	db bun.IDB, fields types.UserFields, filter func(q *bun.SelectQuery, model interface{}) *bun.SelectQuery,
+) *bun.SelectQuery {
+	columns := fields.Columns()
+	if len(columns) == 1 && columns[0] == "*" {
+		// Expand to the user columns as the tables have different extra columns
+		table := db.Dialect().Tables().Get(reflect.TypeOf(types.FlaggedUser{}))
+		columns = make([]string, 0, len(table.Fields))
+		for _, field := range table.Fields {
+			columns = append(columns, field.Name)
 		}
+	}
 
-		// Query banned users
-		var bannedUsers []types.BannedUser
-		err = tx.NewSelect().
-			Model(&bannedUsers).
+	tables := []struct {
+		status enum.UserType
+		model  interface{}
+		extra  string
+	}{
+		{
+			status: enum.UserTypeConfirmed,
+			model:  (*types.ConfirmedUser)(nil),
+			extra:  "verified_at, NULL::timestamptz AS cleared_at, false AS pinned, NULL::timestamptz AS purged_at",
+		},
+		{
+			status: enum.UserTypeFlagged,
+			model:  (*types.FlaggedUser)(nil),
+			extra:  "NULL::timestamptz AS verified_at, NULL::timestamptz AS cleared_at, false AS pinned, NULL::timestamptz AS purged_at",
+		},
+		{
+			status: enum.UserTypeCleared,
+			model:  (*types.ClearedUser)(nil),
+			extra:  "NULL::timestamptz AS verified_at, cleared_at, pinned, NULL::timestamptz AS purged_at",
+		},
+		{
+			status: enum.UserTypeBanned,
+			model:  (*types.BannedUser)(nil),
+			extra:  "NULL::timestamptz AS verified_at, NULL::timestamptz AS cleared_at, false AS pinned, purged_at",
+		},
+	}
+
+	var union *bun.SelectQuery
+	for _, table := range tables {
+		subq := db.NewSelect().
+			Model(table.model).
 			Column(columns...).
-			Where("id IN (?)", bun.In(userIDs)).
-			Scan(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get banned users: %w", err)
+			ColumnExpr(table.extra).
+			ColumnExpr("? AS status", table.status)
+		subq = filter(subq, table.model)
+
+		if union == nil {
+			union = subq
+		} else {
+			union = union.UnionAll(subq)
 		}
-		for _, user := range bannedUsers {
-			users[user.ID] = &types.ReviewUser{
-				User:     user.User,
-				PurgedAt: user.PurgedAt,
-				Status:   enum.UserTypeBanned,
+	}
+
+	return union
+}
+
+// UpdateUserNames stores the current usernames of confirmed and flagged users
+// whose name changed since they were saved. The replaced names are kept in
+// previous_names. Returns the number of renamed users.
+func (r *UserModel) UpdateUserNames(ctx context.Context, names map[uint64]string) (int, error) {
+	if len(names) == 0 {
+		return 0, nil
+	}
+
+	rows := make([]userNameRow, 0, len(names))
+	for id, name := range names {
+		rows = append(rows, userNameRow{ID: id, Name: name})
+	}
+
+	var renamed int
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, model := range []interface{}{(*types.ConfirmedUser)(nil), (*types.FlaggedUser)(nil)} {
+			result, err := updateUserNamesQuery(tx, model, &rows).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update user names: %w (userCount=%d)", err, len(rows))
 			}
-		}
 
-		// Mark remaining IDs as unflagged
-		for _, id := range userIDs {
-			if _, ok := users[id]; !ok {
-				users[id] = &types.ReviewUser{
-					User:   types.User{ID: id},
-					Status: enum.UserTypeUnflagged,
-				}
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("failed to get affected rows: %w", err)
 			}
+			renamed += int(affected)
 		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if renamed > 0 {
+		r.logger.Debug("Updated renamed users", zap.Int("count", renamed))
+	}
+
+	return renamed, nil
+}
+
+// userNameRow is the current username of a user used to update stored names.
+type userNameRow struct {
+	ID   uint64
+	Name string
+}
 
+// updateUserNamesQuery builds the query updating the names of the users in the
+// model's table whose stored name differs from their current one.
+func updateUserNamesQuery(db bun.IDB, model interface{}, rows *[]userNameRow) *bun.UpdateQuery {
+	return db.NewUpdate().
+		With("_data", db.NewValues(rows)).
+		Model(model).
+		TableExpr("_data").
+		Set("previous_names = " + previousNamesExpr("_data.name")).
+		Set("name = _data.name").
+		Where("?TableAlias.id = _data.id").
+		Where("?TableAlias.name <> _data.name")
+}
+
+// previousNamesExpr returns the SQL expression for the previous_names column of a
+// user that gets the given new name. The stored name is appended if it differs
+// from the new one, names are kept once and only the last MaxPreviousNames remain.
+func previousNamesExpr(newName string) string {
+	kept := fmt.Sprintf(
+		"array_append(array_remove(array_remove(COALESCE(?TableAlias.previous_names, '{}'), ?TableAlias.name), %s), ?TableAlias.name)",
+		newName,
+	)
+	return fmt.Sprintf(
+		"CASE WHEN ?TableAlias.name <> %s THEN (%s)[GREATEST(cardinality(%s) - %d, 1):] ELSE ?TableAlias.previous_names END",
+		newName, kept, kept, types.MaxPreviousNames-1,
+	)
+}
+
+// UpdateCounts stores the refreshed follow counts and games of flagged, confirmed
+// and cleared users and marks them as updated. Returns the number of updated users.
+func (r *UserModel) UpdateCounts(ctx context.Context, counts map[uint64]*types.UserFollowCounts) (int, error) {
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	rows := make([]userCountsRow, 0, len(counts))
+	for id, c := range counts {
+		rows = append(rows, userCountsRow{
+			ID:             id,
+			FollowerCount:  c.FollowerCount,
+			FollowingCount: c.FollowingCount,
+			Games:          c.Games,
+			LastUpdated:    now,
+		})
+	}
+
+	var updated int
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, model := range []interface{}{
+			(*types.FlaggedUser)(nil), (*types.ConfirmedUser)(nil), (*types.ClearedUser)(nil),
+		} {
+			result, err := updateUserCountsQuery(tx, model, &rows).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update user counts: %w (userCount=%d)", err, len(rows))
+			}
+
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("failed to get affected rows: %w", err)
+			}
+			updated += int(affected)
+		}
 		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get users by IDs: %w (userCount=%d)", err, len(userIDs))
+		return 0, err
 	}
 
-	r.logger.Debug("Retrieved users by IDs",
-		zap.Int("requestedCount", len(userIDs)),
-		zap.Int("foundCount", len(users)))
+	r.logger.Debug("Updated user counts", zap.Int("count", updated))
 
-	return users, nil
+	return updated, nil
+}
+
+// userCountsRow is the refreshed counts of a user used to update stored users.
+type userCountsRow struct {
+	ID             uint64
+	FollowerCount  uint64
+	FollowingCount uint64
+	Games          []*apiTypes.Game `bun:"type:jsonb"`
+	LastUpdated    time.Time
+}
+
+// updateUserCountsQuery builds the query updating the follow counts, games and
+// last updated time of the users in the model's table.
+func updateUserCountsQuery(db bun.IDB, model interface{}, rows *[]userCountsRow) *bun.UpdateQuery {
+	return db.NewUpdate().
+		With("_data", db.NewValues(rows)).
+		Model(model).
+		TableExpr("_data").
+		Set("follower_count = _data.follower_count").
+		Set("following_count = _data.following_count").
+		Set("games = _data.games").
+		Set("last_updated = _data.last_updated").
+		Where("?TableAlias.id = _data.id")
 }
 
 // GetUsersToCheck finds users that haven't been checked for banned status recently.
 // Returns a batch of user IDs and updates their last_purge_check timestamp.
 func (r *UserModel) GetUsersToCheck(ctx context.Context, limit int) ([]uint64, error) {
+	now := r.now()
 	var userIDs []uint64
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		// Get and update confirmed users
-		err := tx.NewRaw(`
-			WITH updated AS (
-				UPDATE confirmed_users
-				SET last_purge_check = NOW()
-				WHERE id IN (
-					SELECT id FROM confirmed_users
-					WHERE last_purge_check < NOW() - INTERVAL '1 day'
-					ORDER BY last_purge_check ASC
-					LIMIT ?
-					FOR UPDATE SKIP LOCKED
-				)
-				RETURNING id
-			)
-			SELECT * FROM updated
-		`, limit/2).Scan(ctx, &userIDs)
+		err := purgeCheckBatchQuery(tx, "confirmed_users", limit/2, now).Scan(ctx, &userIDs)
 		if err != nil {
 			return fmt.Errorf("failed to get and update confirmed users: %w", err)
 		}
 
 		// Get and update flagged users
 		var flaggedIDs []uint64
-		err = tx.NewRaw(`
-			WITH updated AS (
-				UPDATE flagged_users
-				SET last_purge_check = NOW()
-				WHERE id IN (
-					SELECT id FROM flagged_users
-					WHERE last_purge_check < NOW() - INTERVAL '1 day'
-					ORDER BY last_purge_check ASC
-					LIMIT ?
-					FOR UPDATE SKIP LOCKED
-				)
-				RETURNING id
-			)
-			SELECT * FROM updated
-		`, limit/2).Scan(ctx, &flaggedIDs)
+		err = purgeCheckBatchQuery(tx, "flagged_users", limit/2, now).Scan(ctx, &flaggedIDs)
 		if err != nil {
 			return fmt.Errorf("failed to get and update flagged users: %w", err)
 		}
@@ -602,9 +1182,41 @@ func (r *UserModel) GetUsersToCheck(ctx context.Context, limit int) ([]uint64, e
 	return userIDs, err
 }
 
+// PeekUsersToCheck returns the users GetUsersToCheck would return next without
+// updating their last_purge_check timestamp, so repeated calls return the same batch.
+func (r *UserModel) PeekUsersToCheck(ctx context.Context, limit int) ([]uint64, error) {
+	var userIDs []uint64
+	now := r.now()
+	err := usersToCheckQuery(r.db, (*types.ConfirmedUser)(nil), limit/2, now).Scan(ctx, &userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get confirmed users to check: %w", err)
+	}
+
+	var flaggedIDs []uint64
+	err = usersToCheckQuery(r.db, (*types.FlaggedUser)(nil), limit/2, now).Scan(ctx, &flaggedIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flagged users to check: %w", err)
+	}
+
+	return append(userIDs, flaggedIDs...), nil
+}
+
+// usersToCheckQuery builds the query selecting users of the given model that are
+// due for a ban check, oldest check first.
+func usersToCheckQuery(db bun.IDB, model interface{}, limit int, now time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(model).
+		Column("id").
+		Where("last_purge_check < ?", now.Add(-PurgeCheckInterval)).
+		Order("last_purge_check ASC").
+		Limit(limit)
+}
+
 // RemoveBannedUsers moves users from confirmed_users and flagged_users to banned_users.
-// This happens when users are found to be banned by Roblox.
+// This happens when users are found to be banned by Roblox. The users of each table
+// are inserted in a single query, and all of them share the same purge time.
 func (r *UserModel) RemoveBannedUsers(ctx context.Context, userIDs []uint64) error {
+	purgedAt := r.now()
 	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		// Move confirmed users to banned_users
 		var confirmedUsers []types.ConfirmedUser
@@ -615,16 +1227,13 @@ func (r *UserModel) RemoveBannedUsers(ctx context.Context, userIDs []uint64) err
 			return fmt.Errorf("failed to select confirmed users for banning: %w", err)
 		}
 
+		bannedUsers := make([]types.BannedUser, 0, len(confirmedUsers))
 		for _, user := range confirmedUsers {
-			bannedUser := &types.BannedUser{
-				User:     user.User,
-				PurgedAt: time.Now(),
-			}
-			_, err = tx.NewInsert().Model(bannedUser).
-				On("CONFLICT (id) DO UPDATE").
-				Exec(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to insert banned user from confirmed_users: %w (userID=%d)", err, user.ID)
+			bannedUsers = append(bannedUsers, types.BannedUser{User: user.User, PurgedAt: purgedAt})
+		}
+		if len(bannedUsers) > 0 {
+			if _, err := bannedUsersInsertQuery(tx, &bannedUsers).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to insert banned users from confirmed_users: %w (userCount=%d)", err, len(bannedUsers))
 			}
 		}
 
@@ -637,16 +1246,13 @@ func (r *UserModel) RemoveBannedUsers(ctx context.Context, userIDs []uint64) err
 			return fmt.Errorf("failed to select flagged users for banning: %w", err)
 		}
 
+		bannedUsers = make([]types.BannedUser, 0, len(flaggedUsers))
 		for _, user := range flaggedUsers {
-			bannedUser := &types.BannedUser{
-				User:     user.User,
-				PurgedAt: time.Now(),
-			}
-			_, err = tx.NewInsert().Model(bannedUser).
-				On("CONFLICT (id) DO UPDATE").
-				Exec(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to insert banned user from flagged_users: %w (userID=%d)", err, user.ID)
+			bannedUsers = append(bannedUsers, types.BannedUser{User: user.User, PurgedAt: purgedAt})
+		}
+		if len(bannedUsers) > 0 {
+			if _, err := bannedUsersInsertQuery(tx, &bannedUsers).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to insert banned users from flagged_users: %w (userCount=%d)", err, len(bannedUsers))
 			}
 		}
 
@@ -666,18 +1272,28 @@ func (r *UserModel) RemoveBannedUsers(ctx context.Context, userIDs []uint64) err
 			return fmt.Errorf("failed to remove banned users from flagged_users: %w (userCount=%d)", err, len(userIDs))
 		}
 
+		// Banned users no longer need a decision
+		if _, err := deleteSecondOpinionsQuery(tx, userIDs).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete second opinions of banned users: %w (userCount=%d)", err, len(userIDs))
+		}
+
 		r.logger.Debug("Moved banned users to banned_users", zap.Int("count", len(userIDs)))
 		return nil
 	})
 }
 
+// bannedUsersInsertQuery builds the query inserting the given users into banned_users
+// in a single statement, replacing the stored copy of users that are already banned.
+func bannedUsersInsertQuery(db bun.IDB, users *[]types.BannedUser) *bun.InsertQuery {
+	return db.NewInsert().
+		Model(users).
+		On("CONFLICT (id) DO UPDATE")
+}
+
 // PurgeOldClearedUsers removes cleared users older than the cutoff date.
 // This helps maintain database size by removing users that were cleared long ago.
 func (r *UserModel) PurgeOldClearedUsers(ctx context.Context, cutoffDate time.Time) (int, error) {
-	result, err := r.db.NewDelete().
-		Model((*types.ClearedUser)(nil)).
-		Where("cleared_at < ?", cutoffDate).
-		Exec(ctx)
+	result, err := purgeClearedUsersQuery(r.db, cutoffDate).Exec(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to purge old cleared users: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
 	}
@@ -694,112 +1310,386 @@ func (r *UserModel) PurgeOldClearedUsers(ctx context.Context, cutoffDate time.Ti
 	return int(affected), nil
 }
 
-// GetUsersForThumbnailUpdate retrieves users that need thumbnail updates.
-func (r *UserModel) GetUsersForThumbnailUpdate(ctx context.Context, limit int) (map[uint64]*types.User, error) {
-	users := make(map[uint64]*types.User)
+// purgeClearedUsersQuery builds the query deleting cleared users older than the
+// cutoff date. Pinned users are excluded so reference cases are never purged.
+func purgeClearedUsersQuery(db bun.IDB, cutoffDate time.Time) *bun.DeleteQuery {
+	return db.NewDelete().
+		Model((*types.ClearedUser)(nil)).
+		Where("cleared_at < ?", cutoffDate).
+		Where("pinned = false")
+}
+
+// PurgeOldBannedUsers removes banned users that were purged from the platform
+// before the cutoff date.
+func (r *UserModel) PurgeOldBannedUsers(ctx context.Context, cutoffDate time.Time) (int, error) {
+	result, err := purgeBannedUsersQuery(r.db, cutoffDate).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge old banned users: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
+	}
 
-	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		// Query users from each table that need thumbnail updates
-		for _, model := range []interface{}{
-			(*types.FlaggedUser)(nil),
-			(*types.ConfirmedUser)(nil),
-			(*types.ClearedUser)(nil),
-			(*types.BannedUser)(nil),
-		} {
-			var reviewUsers []types.ReviewUser
-			err := tx.NewSelect().
-				Model(model).
-				Where("last_thumbnail_update < NOW() - INTERVAL '7 days'").
-				OrderExpr("last_thumbnail_update ASC").
-				Limit(limit).
-				Scan(ctx, &reviewUsers)
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
+	}
+
+	r.logger.Debug("Purged old banned users",
+		zap.Int64("rowsAffected", affected),
+		zap.Time("cutoffDate", cutoffDate))
+
+	return int(affected), nil
+}
+
+// purgeBannedUsersQuery builds the query deleting banned users older than the cutoff date.
+func purgeBannedUsersQuery(db bun.IDB, cutoffDate time.Time) *bun.DeleteQuery {
+	return db.NewDelete().
+		Model((*types.BannedUser)(nil)).
+		Where("purged_at < ?", cutoffDate)
+}
+
+// GetOldClearedUserIDs returns the IDs of the cleared users PurgeOldClearedUsers
+// would remove for the cutoff date, without removing them.
+func (r *UserModel) GetOldClearedUserIDs(ctx context.Context, cutoffDate time.Time) ([]uint64, error) {
+	var userIDs []uint64
+	err := oldClearedUsersQuery(r.db, cutoffDate).Scan(ctx, &userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get old cleared users: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
+	}
+	return userIDs, nil
+}
+
+// oldClearedUsersQuery builds the query selecting the IDs matched by purgeClearedUsersQuery.
+func oldClearedUsersQuery(db bun.IDB, cutoffDate time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.ClearedUser)(nil)).
+		Column("id").
+		Where("cleared_at < ?", cutoffDate).
+		Where("pinned = false").
+		Order("cleared_at ASC")
+}
+
+// expiringClearedUsersQuery builds the query selecting unpinned cleared users that
+// will be purged once their cleared_at passes the purge cutoff, but are still within
+// the warning window ending at warnCutoff.
+func expiringClearedUsersQuery(db bun.IDB, purgeCutoff, warnCutoff time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model((*types.ClearedUser)(nil)).
+		Where("cleared_at >= ?", purgeCutoff).
+		Where("cleared_at < ?", warnCutoff).
+		Where("pinned = false")
+}
+
+// GetExpiringClearedUsers returns the number of unpinned cleared users that will be
+// purged within the warning window, along with a sample of the ones closest to purge.
+func (r *UserModel) GetExpiringClearedUsers(
+	ctx context.Context, purgeCutoff, warnCutoff time.Time, sampleSize int,
+) (int, []*types.ClearedUser, error) {
+	count, err := expiringClearedUsersQuery(r.db, purgeCutoff, warnCutoff).Count(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to count expiring cleared users: %w", err)
+	}
+
+	var sample []*types.ClearedUser
+	err = expiringClearedUsersQuery(r.db, purgeCutoff, warnCutoff).
+		Model(&sample).
+		Column("id", "name", "cleared_at").
+		Order("cleared_at ASC").
+		Limit(sampleSize).
+		Scan(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get expiring cleared users: %w", err)
+	}
+
+	return count, sample, nil
+}
 
-			if err != nil && !errors.Is(err, sql.ErrNoRows) {
-				return fmt.Errorf("failed to query users for thumbnail update: %w", err)
+// SetClearedUserPinned pins or unpins a cleared user. Pinned users are excluded from
+// the cleared user purge. Pinning fails with ErrPinLimitReached once maxPinned users
+// are already pinned.
+func (r *UserModel) SetClearedUserPinned(ctx context.Context, userID uint64, pinned bool, maxPinned int) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Enforce the deployment-wide cap when pinning. Concurrent pins wait for
+		// each other so they cannot both pass the count and exceed the cap.
+		if pinned {
+			if _, err := pinLockQuery(tx).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to lock pinned users: %w", err)
 			}
 
-			for _, review := range reviewUsers {
-				users[review.ID] = &review.User
+			count, err := tx.NewSelect().
+				Model((*types.ClearedUser)(nil)).
+				Where("pinned = true").
+				Where("id != ?", userID).
+				Count(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to count pinned users: %w", err)
 			}
+			if count >= maxPinned {
+				return types.ErrPinLimitReached
+			}
+		}
+
+		result, err := tx.NewUpdate().
+			Model((*types.ClearedUser)(nil)).
+			Set("pinned = ?", pinned).
+			Where("id = ?", userID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update pinned status: %w (userID=%d)", err, userID)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if affected == 0 {
+			return types.ErrUserNotCleared
 		}
+
+		r.logger.Debug("Updated cleared user pin",
+			zap.Uint64("userID", userID),
+			zap.Bool("pinned", pinned))
 		return nil
 	})
+}
+
+// pinLockQuery builds the query taking the transaction-level advisory lock that
+// serializes pinning cleared users.
+func pinLockQuery(db bun.IDB) *bun.SelectQuery {
+	return db.NewSelect().ColumnExpr("pg_advisory_xact_lock(hashtext(?))", "cleared_user_pins")
+}
+
+// GetUsersForThumbnailUpdate retrieves up to limit users whose thumbnails were
+// last updated before staleBefore. Users viewed after viewedAfter come first so
+// the thumbnails shown during review are refreshed before the rest.
+func (r *UserModel) GetUsersForThumbnailUpdate(
+	ctx context.Context, staleBefore, viewedAfter time.Time, limit int,
+) (map[uint64]*types.User, error) {
+	table := r.db.Dialect().Tables().Get(reflect.TypeOf(types.User{}))
+	columns := make([]string, 0, len(table.Fields))
+	for _, field := range table.Fields {
+		columns = append(columns, field.Name)
+	}
+
+	var rows []*types.User
+	err := staleThumbnailsQuery(r.db, []interface{}{
+		(*types.FlaggedUser)(nil),
+		(*types.ConfirmedUser)(nil),
+		(*types.ClearedUser)(nil),
+		(*types.BannedUser)(nil),
+	}, columns, staleBefore, viewedAfter, limit).Scan(ctx, &rows)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query users for thumbnail update: %w", err)
+	}
+
+	users := make(map[uint64]*types.User, len(rows))
+	for _, user := range rows {
+		users[user.ID] = user
+	}
+
+	return users, nil
+}
+
+// staleThumbnailsQuery builds a UNION ALL query over the given tables selecting
+// rows whose thumbnails were last updated before staleBefore. Rows viewed after
+// viewedAfter are ordered first, then the oldest thumbnails, so a capped batch
+// refreshes what reviewers are looking at before anything else.
+func staleThumbnailsQuery(
+	db bun.IDB, tableModels []interface{}, columns []string, staleBefore, viewedAfter time.Time, limit int,
+) *bun.SelectQuery {
+	var union *bun.SelectQuery
+	for _, model := range tableModels {
+		subq := db.NewSelect().
+			Model(model).
+			Column(columns...).
+			Where("last_thumbnail_update < ?", staleBefore)
+
+		if union == nil {
+			union = subq
+		} else {
+			union = union.UnionAll(subq)
+		}
+	}
 
-	return users, err
+	return db.NewSelect().
+		TableExpr("(?) AS stale", union).
+		ColumnExpr("*").
+		OrderExpr("last_viewed > ? DESC", viewedAfter).
+		Order("last_thumbnail_update ASC").
+		Limit(limit)
 }
 
-// DeleteUser removes a user and all associated data from the database.
-func (r *UserModel) DeleteUser(ctx context.Context, userID uint64) (bool, error) {
-	var totalAffected int64
+// DeleteUser removes a user from every user table and keeps a copy in the
+// deleted users archive so the deletion can be undone with RestoreDeletedUser.
+// If the user is in several tables, the copy of the table with the highest
+// precedence is archived. Returns false if the user was not found.
+func (r *UserModel) DeleteUser(ctx context.Context, userID uint64, deletedBy uint64) (bool, error) {
+	found := false
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		// Delete from flagged_users
-		result, err := tx.NewDelete().
-			Model((*types.FlaggedUser)(nil)).
-			Where("id = ?", userID).
-			Exec(ctx)
+		// Find the stored copy of the user to archive
+		var archived *types.User
+		for _, status := range userStatusPrecedence {
+			var user types.User
+			err := userFromTableQuery(tx, &user, userTableName(status), userID).Scan(ctx)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get user from %s: %w (userID=%d)", userTableName(status), err, userID)
+			}
+			archived = &user
+			break
+		}
+		if archived == nil {
+			return nil
+		}
+		found = true
+
+		// Replace any earlier archived copy of the user
+		_, err := tx.NewDelete().Model((*types.DeletedUser)(nil)).Where("id = ?", userID).Exec(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to delete from flagged_users: %w", err)
+			return fmt.Errorf("failed to delete earlier archived user: %w (userID=%d)", err, userID)
 		}
-		affected, _ := result.RowsAffected()
-		totalAffected += affected
 
-		// Delete from confirmed_users
-		result, err = tx.NewDelete().
-			Model((*types.ConfirmedUser)(nil)).
-			Where("id = ?", userID).
-			Exec(ctx)
+		_, err = tx.NewInsert().Model(&types.DeletedUser{
+			User:      *archived,
+			DeletedAt: r.now(),
+			DeletedBy: deletedBy,
+		}).Exec(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to delete from confirmed_users: %w", err)
+			return fmt.Errorf("failed to archive deleted user: %w (userID=%d)", err, userID)
 		}
-		affected, _ = result.RowsAffected()
-		totalAffected += affected
 
-		// Delete from cleared_users
-		result, err = tx.NewDelete().
-			Model((*types.ClearedUser)(nil)).
-			Where("id = ?", userID).
-			Exec(ctx)
+		// Remove the user from every user table
+		return deleteFromUserTables(ctx, tx, userID, enum.UserTypeUnflagged)
+	})
+
+	return found, err
+}
+
+// RestoreDeletedUser moves a user from the deleted users archive back into
+// flagged_users so they go through review again. Returns types.ErrUserNotDeleted
+// if the user is not archived, types.ErrUserExists if the user was added to a user
+// table again since being deleted, or types.ErrUserProtected if the user is a
+// protected account.
+func (r *UserModel) RestoreDeletedUser(ctx context.Context, userID uint64) (*types.DeletedUser, error) {
+	var deleted types.DeletedUser
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().Model(&deleted).Where("id = ?", userID).Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return types.ErrUserNotDeleted
+		}
 		if err != nil {
-			return fmt.Errorf("failed to delete from cleared_users: %w", err)
+			return fmt.Errorf("failed to get deleted user: %w (userID=%d)", err, userID)
 		}
-		affected, _ = result.RowsAffected()
-		totalAffected += affected
 
-		// Delete from banned_users table
-		result, err = tx.NewDelete().
-			Model((*types.BannedUser)(nil)).
-			Where("id = ?", userID).
-			Exec(ctx)
+		protected, err := protectedAccountQuery(tx, userID).Exists(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check protected account: %w (userID=%d)", err, userID)
+		}
+		if protected {
+			return types.ErrUserProtected
+		}
+
+		// Keep the newer data of a user that was flagged again since being deleted
+		for _, table := range userTablesExcept(enum.UserTypeUnflagged) {
+			exists, err := tx.NewSelect().Model(table.model).Where("id = ?", userID).Exists(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to check %s: %w (userID=%d)", table.name, err, userID)
+			}
+			if exists {
+				return types.ErrUserExists
+			}
+		}
+
+		_, err = tx.NewInsert().Model(newRestoredFlaggedUser(&deleted, r.now())).Exec(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to delete from banned_users: %w", err)
+			return fmt.Errorf("failed to insert user in flagged_users: %w (userID=%d)", err, userID)
+		}
+
+		_, err = tx.NewDelete().Model((*types.DeletedUser)(nil)).Where("id = ?", userID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete archived user: %w (userID=%d)", err, userID)
 		}
-		affected, _ = result.RowsAffected()
-		totalAffected += affected
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.logger.Debug("Restored deleted user", zap.Uint64("userID", userID))
+	return &deleted, nil
+}
+
+// userFromTableQuery builds the query selecting the shared user columns of a user
+// from the given user table.
+func userFromTableQuery(db bun.IDB, user *types.User, table string, userID uint64) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(user).
+		ModelTableExpr("? AS ?", bun.Ident(table), bun.Ident("user")).
+		Where("?.id = ?", bun.Ident("user"), userID)
+}
+
+// userTableName returns the name of the table storing users with the given status.
+func userTableName(status enum.UserType) string {
+	for _, table := range userTablesExcept(enum.UserTypeUnflagged) {
+		if table.status == status {
+			return table.name
+		}
+	}
+	return "flagged_users"
+}
+
+// newRestoredFlaggedUser builds the flagged_users row for a restored user,
+// resetting the view time so the user is picked up again by the review queue.
+func newRestoredFlaggedUser(deleted *types.DeletedUser, now time.Time) *types.FlaggedUser {
+	flaggedUser := &types.FlaggedUser{User: deleted.User}
+	flaggedUser.LastUpdated = now
+	flaggedUser.LastViewed = time.Time{}
+	return flaggedUser
+}
 
-	return totalAffected > 0, err
+// PurgeOldDeletedUsers permanently removes users deleted before the cutoff date
+// from the deleted users archive.
+func (r *UserModel) PurgeOldDeletedUsers(ctx context.Context, cutoffDate time.Time) (int, error) {
+	result, err := purgeDeletedUsersQuery(r.db, cutoffDate).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge old deleted users: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w (cutoffDate=%s)", err, cutoffDate.Format(time.RFC3339))
+	}
+
+	r.logger.Debug("Purged old deleted users",
+		zap.Int64("rowsAffected", affected),
+		zap.Time("cutoffDate", cutoffDate))
+
+	return int(affected), nil
+}
+
+// purgeDeletedUsersQuery builds the query deleting archived users that were
+// deleted before the cutoff date.
+func purgeDeletedUsersQuery(db bun.IDB, cutoffDate time.Time) *bun.DeleteQuery {
+	return db.NewDelete().
+		Model((*types.DeletedUser)(nil)).
+		Where("deleted_at < ?", cutoffDate)
 }
 
 // GetUserToScan finds the next user to scan from confirmed_users, falling back to flagged_users
 // if no confirmed users are available.
 func (r *UserModel) GetUserToScan(ctx context.Context) (*types.User, error) {
+	now := r.now()
 	var user *types.User
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		// First try confirmed users
 		var confirmedUser types.ConfirmedUser
-		err := tx.NewSelect().Model(&confirmedUser).
-			Where("last_scanned < NOW() - INTERVAL '1 day'").
-			Order("last_scanned ASC").
-			Limit(1).
-			For("UPDATE SKIP LOCKED").
-			Scan(ctx)
+		err := scanCandidateQuery(tx, &confirmedUser, now).Scan(ctx)
 
 		if err == nil {
 			// Update last_scanned
 			_, err = tx.NewUpdate().Model(&confirmedUser).
-				Set("last_scanned = ?", time.Now()).
+				Set("last_scanned = ?", now).
 				Where("id = ?", confirmedUser.ID).
 				Exec(ctx)
 			if err != nil {
@@ -818,17 +1708,12 @@ func (r *UserModel) GetUserToScan(ctx context.Context) (*types.User, error) {
 
 		// If no confirmed users, try flagged users
 		var flaggedUser types.FlaggedUser
-		err = tx.NewSelect().Model(&flaggedUser).
-			Where("last_scanned < NOW() - INTERVAL '1 day'").
-			Order("last_scanned ASC").
-			Limit(1).
-			For("UPDATE SKIP LOCKED").
-			Scan(ctx)
+		err = scanCandidateQuery(tx, &flaggedUser, now).Scan(ctx)
 
 		if err == nil {
 			// Update last_scanned
 			_, err = tx.NewUpdate().Model(&flaggedUser).
-				Set("last_scanned = ?", time.Now()).
+				Set("last_scanned = ?", now).
 				Where("id = ?", flaggedUser.ID).
 				Exec(ctx)
 			if err != nil {
@@ -841,12 +1726,7 @@ func (r *UserModel) GetUserToScan(ctx context.Context) (*types.User, error) {
 			return nil
 		}
 
-		if !errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("failed to query flagged users: %w", err)
-		}
-
-		// No users found to scan
-		return fmt.Errorf("no users available to scan: %w", err)
+		return notFoundError(fmt.Errorf("failed to query flagged users: %w", err), types.ErrNoUsersToScan)
 	})
 	if err != nil {
 		return nil, err
@@ -856,7 +1736,45 @@ func (r *UserModel) GetUserToScan(ctx context.Context) (*types.User, error) {
 }
 
 // GetUserToReview finds a user to review based on the sort method and target mode.
-func (r *UserModel) GetUserToReview(ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, reviewerID uint64) (*types.ReviewUser, error) {
+// Users below minConfidence are skipped unless it is zero or the sort is by reputation,
+// and users in lockedIDs are always skipped since another reviewer has them open.
+// In read-only mode the user is fetched without a row lock and last_viewed is left untouched.
+func (r *UserModel) GetUserToReview(
+	ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, minConfidence float64,
+	reviewerID uint64, lockedIDs []uint64, readOnly bool,
+) (*types.ReviewUser, error) {
+	// Get recently reviewed user IDs
+	recentIDs, err := r.activity.GetRecentlyReviewedIDs(ctx, reviewerID, false, 100)
+	if err != nil {
+		r.logger.Error("Failed to get recently reviewed user IDs", zap.Error(err))
+		// Continue without filtering if there's an error
+		recentIDs = []uint64{}
+	}
+	recentIDs = append(recentIDs, lockedIDs...)
+
+	// Try each model in priority order until we find a user
+	for _, model := range reviewModels(targetMode) {
+		result, err := r.getNextToReview(ctx, model, sortBy, minConfidence, recentIDs, reviewerID, readOnly)
+		if err == nil {
+			return result, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return nil, types.ErrNoUsersToReview
+}
+
+// GetUsersToReview finds up to limit users to review in a single transaction so a
+// reviewer can work through them without a round trip per user. Users are taken
+// from the same tables in the same priority order as GetUserToReview, and all of
+// them have their last_viewed timestamp updated. Users below minConfidence and users
+// in lockedIDs are skipped the same way as in GetUserToReview.
+func (r *UserModel) GetUsersToReview(
+	ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, minConfidence float64,
+	reviewerID uint64, lockedIDs []uint64, limit int,
+) ([]*types.ReviewUser, error) {
 	// Get recently reviewed user IDs
 	recentIDs, err := r.activity.GetRecentlyReviewedIDs(ctx, reviewerID, false, 100)
 	if err != nil {
@@ -864,56 +1782,234 @@ func (r *UserModel) GetUserToReview(ctx context.Context, sortBy enum.ReviewSortB
 		// Continue without filtering if there's an error
 		recentIDs = []uint64{}
 	}
+	recentIDs = append(recentIDs, lockedIDs...)
+
+	results := make([]*types.ReviewUser, 0, limit)
+	err = r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		excludeIDs := recentIDs
+
+		// Fill the batch from each model in priority order
+		for _, model := range reviewModels(targetMode) {
+			if len(results) >= limit {
+				break
+			}
+
+			users, err := r.getNextBatchToReview(
+				ctx, tx, model, sortBy, minConfidence, excludeIDs, reviewerID, limit-len(results),
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, user := range users {
+				excludeIDs = append(excludeIDs, user.ID)
+			}
+			results = append(results, users...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	// Define models in priority order based on target mode
-	var models []interface{}
+	if len(results) == 0 {
+		return nil, types.ErrNoUsersToReview
+	}
+
+	return results, nil
+}
+
+// reviewModels returns the user models to review in priority order for the target mode.
+func reviewModels(targetMode enum.ReviewTargetMode) []interface{} {
 	switch targetMode {
 	case enum.ReviewTargetModeFlagged:
-		models = []interface{}{
+		return []interface{}{
 			&types.FlaggedUser{},   // Primary target
 			&types.ConfirmedUser{}, // First fallback
 			&types.ClearedUser{},   // Second fallback
 			&types.BannedUser{},    // Last fallback
 		}
 	case enum.ReviewTargetModeConfirmed:
-		models = []interface{}{
+		return []interface{}{
 			&types.ConfirmedUser{}, // Primary target
 			&types.FlaggedUser{},   // First fallback
 			&types.ClearedUser{},   // Second fallback
 			&types.BannedUser{},    // Last fallback
 		}
 	case enum.ReviewTargetModeCleared:
-		models = []interface{}{
+		return []interface{}{
 			&types.ClearedUser{},   // Primary target
 			&types.FlaggedUser{},   // First fallback
 			&types.ConfirmedUser{}, // Second fallback
 			&types.BannedUser{},    // Last fallback
 		}
 	case enum.ReviewTargetModeBanned:
-		models = []interface{}{
+		return []interface{}{
 			&types.BannedUser{},    // Primary target
 			&types.FlaggedUser{},   // First fallback
 			&types.ConfirmedUser{}, // Second fallback
 			&types.ClearedUser{},   // Last fallback
 		}
 	}
+	return nil
+}
 
-	// Try each model in order until we find a user
-	for _, model := range models {
-		result, err := r.getNextToReview(ctx, model, sortBy, recentIDs)
-		if err == nil {
-			return result, nil
+// nextBatchToReviewQuery builds the query selecting the IDs of the next users to
+// review from the model's table, skipping the excluded IDs, users below minConfidence
+// and users the reviewer asked a second opinion on. The selected rows are locked,
+// skipping rows another transaction has locked, so reviewers prefetching at the
+// same time never claim the same users.
+func nextBatchToReviewQuery(
+	db bun.IDB, model interface{}, sortBy enum.ReviewSortBy, minConfidence float64, excludeIDs []uint64,
+	reviewerID uint64, limit int, now time.Time,
+) *bun.SelectQuery {
+	query := db.NewSelect().
+		Model(model).
+		Column("id")
+
+	if len(excludeIDs) > 0 {
+		query.Where("?TableAlias.id NOT IN (?)", bun.In(excludeIDs))
+	}
+
+	applyViewHold(query, now)
+	applyConfidenceThreshold(query, sortBy, minConfidence)
+	applySecondOpinions(query, reviewerID)
+	applyReviewSort(query, sortBy, "user_reputations")
+
+	return query.Limit(limit).For("UPDATE OF ?TableAlias SKIP LOCKED")
+}
+
+// getNextBatchToReview claims and loads the next users to review from a single model's
+// table within the given transaction and updates their last_viewed timestamp, which
+// keeps them out of other reviewers' batches after the transaction commits.
+func (r *UserModel) getNextBatchToReview(
+	ctx context.Context, tx bun.Tx, model interface{}, sortBy enum.ReviewSortBy, minConfidence float64,
+	excludeIDs []uint64, reviewerID uint64, limit int,
+) ([]*types.ReviewUser, error) {
+	// Get the IDs in review order
+	var ids []uint64
+	if err := nextBatchToReviewQuery(tx, model, sortBy, minConfidence, excludeIDs, reviewerID, limit, r.now()).Scan(ctx, &ids); err != nil {
+		return nil, fmt.Errorf("failed to get users to review: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	// Load the full records, which the ID query already locked
+	lockRows := func(dest interface{}) error {
+		return tx.NewSelect().
+			Model(dest).
+			Where("id IN (?)", bun.In(ids)).
+			Scan(ctx)
+	}
+
+	users := make(map[uint64]*types.ReviewUser, len(ids))
+	switch model.(type) {
+	case *types.FlaggedUser:
+		var rows []*types.FlaggedUser
+		if err := lockRows(&rows); err != nil {
+			return nil, fmt.Errorf("failed to lock flagged users: %w", err)
 		}
-		if !errors.Is(err, sql.ErrNoRows) {
-			return nil, err
+		for _, row := range rows {
+			users[row.ID] = newReviewUser(row)
+		}
+	case *types.ConfirmedUser:
+		var rows []*types.ConfirmedUser
+		if err := lockRows(&rows); err != nil {
+			return nil, fmt.Errorf("failed to lock confirmed users: %w", err)
+		}
+		for _, row := range rows {
+			users[row.ID] = newReviewUser(row)
+		}
+	case *types.ClearedUser:
+		var rows []*types.ClearedUser
+		if err := lockRows(&rows); err != nil {
+			return nil, fmt.Errorf("failed to lock cleared users: %w", err)
 		}
+		for _, row := range rows {
+			users[row.ID] = newReviewUser(row)
+		}
+	case *types.BannedUser:
+		var rows []*types.BannedUser
+		if err := lockRows(&rows); err != nil {
+			return nil, fmt.Errorf("failed to lock banned users: %w", err)
+		}
+		for _, row := range rows {
+			users[row.ID] = newReviewUser(row)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %T", types.ErrUnsupportedModel, model)
 	}
 
-	return nil, types.ErrNoUsersToReview
+	// Check which users are protected from flagging
+	protectedIDs, err := r.protected.GetProtectedIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check protected status: %w", err)
+	}
+
+	// Keep the review order and fill in the remaining details
+	now := r.now()
+	results := make([]*types.ReviewUser, 0, len(ids))
+	for _, id := range ids {
+		user, ok := users[id]
+		if !ok {
+			continue
+		}
+
+		reputation, err := r.reputation.GetUserReputation(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user reputation: %w", err)
+		}
+		user.Reputation = reputation
+		user.IsProtected = protectedIDs[id]
+		user.LastViewed = now
+
+		results = append(results, user)
+	}
+
+	// Update last_viewed for the whole batch
+	_, err = tx.NewUpdate().
+		Model(model).
+		Set("last_viewed = ?", now).
+		Where("id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update last_viewed: %w", err)
+	}
+
+	return results, nil
+}
+
+// newReviewUser converts a row from one of the user tables into a review user.
+func newReviewUser(model interface{}) *types.ReviewUser {
+	result := &types.ReviewUser{}
+	switch m := model.(type) {
+	case *types.FlaggedUser:
+		result.User = m.User
+		result.Status = enum.UserTypeFlagged
+	case *types.ConfirmedUser:
+		result.User = m.User
+		result.VerifiedAt = m.VerifiedAt
+		result.Status = enum.UserTypeConfirmed
+	case *types.ClearedUser:
+		result.User = m.User
+		result.ClearedAt = m.ClearedAt
+		result.IsPinned = m.Pinned
+		result.Status = enum.UserTypeCleared
+	case *types.BannedUser:
+		result.User = m.User
+		result.PurgedAt = m.PurgedAt
+		result.Status = enum.UserTypeBanned
+	}
+	return result
 }
 
 // getNextToReview handles the common logic for getting the next item to review.
-func (r *UserModel) getNextToReview(ctx context.Context, model interface{}, sortBy enum.ReviewSortBy, recentIDs []uint64) (*types.ReviewUser, error) {
+func (r *UserModel) getNextToReview(
+	ctx context.Context, model interface{}, sortBy enum.ReviewSortBy, minConfidence float64, recentIDs []uint64,
+	reviewerID uint64, readOnly bool,
+) (*types.ReviewUser, error) {
 	var result types.ReviewUser
 	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		// Build subquery to get ID
@@ -923,51 +2019,33 @@ func (r *UserModel) getNextToReview(ctx context.Context, model interface{}, sort
 
 		// Exclude recently reviewed IDs if any exist
 		if len(recentIDs) > 0 {
-			subq.Where("id NOT IN (?)", bun.In(recentIDs))
+			subq.Where("?TableAlias.id NOT IN (?)", bun.In(recentIDs))
 		}
 
-		// Apply sort order to subquery
-		switch sortBy {
-		case enum.ReviewSortByConfidence:
-			subq.Order("confidence DESC")
-		case enum.ReviewSortByLastUpdated:
-			subq.Order("last_updated ASC")
-		case enum.ReviewSortByReputation:
-			subq.Join("LEFT JOIN user_reputations ON user_reputations.id = ?TableAlias.id").
-				OrderExpr("COALESCE(user_reputations.score, 0) ASC")
-		case enum.ReviewSortByRandom:
-			subq.OrderExpr("RANDOM()")
-		}
+		// Skip users held by another reviewer, then apply confidence threshold and sort order
+		// with users awaiting a second opinion from this reviewer first
+		applyViewHold(subq, r.now())
+		applyConfidenceThreshold(subq, sortBy, minConfidence)
+		applySecondOpinions(subq, reviewerID)
+		applyReviewSort(subq, sortBy, "user_reputations")
 
 		subq.Limit(1)
 
-		// Main query to get the full record with FOR UPDATE
-		err := tx.NewSelect().
+		// Main query to get the full record, locking it unless in read-only mode
+		query := tx.NewSelect().
 			Model(model).
-			Where("id = (?)", subq).
-			For("UPDATE").
-			Scan(ctx)
-		if err != nil {
+			Where("id = (?)", subq)
+		if !readOnly {
+			query.For("UPDATE")
+		}
+		if err := query.Scan(ctx); err != nil {
 			return err
 		}
 
 		// Set result based on model type
-		switch m := model.(type) {
-		case *types.FlaggedUser:
-			result.User = m.User
-			result.Status = enum.UserTypeFlagged
-		case *types.ConfirmedUser:
-			result.User = m.User
-			result.VerifiedAt = m.VerifiedAt
-			result.Status = enum.UserTypeConfirmed
-		case *types.ClearedUser:
-			result.User = m.User
-			result.ClearedAt = m.ClearedAt
-			result.Status = enum.UserTypeCleared
-		case *types.BannedUser:
-			result.User = m.User
-			result.PurgedAt = m.PurgedAt
-			result.Status = enum.UserTypeBanned
+		switch model.(type) {
+		case *types.FlaggedUser, *types.ConfirmedUser, *types.ClearedUser, *types.BannedUser:
+			result = *newReviewUser(model)
 		default:
 			return fmt.Errorf("%w: %T", types.ErrUnsupportedModel, model)
 		}
@@ -979,10 +2057,23 @@ func (r *UserModel) getNextToReview(ctx context.Context, model interface{}, sort
 		}
 		result.Reputation = reputation
 
+		// Check if the user is protected from flagging
+		isProtected, err := r.protected.IsProtected(ctx, result.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check protected status: %w", err)
+		}
+		result.IsProtected = isProtected
+
+		// Skip the last_viewed update in read-only mode
+		if readOnly {
+			return nil
+		}
+
 		// Update last_viewed
+		result.LastViewed = r.now()
 		_, err = tx.NewUpdate().
 			Model(model).
-			Set("last_viewed = ?", time.Now()).
+			Set("last_viewed = ?", result.LastViewed).
 			Where("id = ?", result.ID).
 			Exec(ctx)
 		if err != nil {
@@ -0,0 +1,862 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+func TestUserTablesExcept(t *testing.T) {
+	tests := []struct {
+		name   string
+		status enum.UserType
+		want   []string
+	}{
+		{
+			name:   "clearing removes from flagged, confirmed and banned",
+			status: enum.UserTypeCleared,
+			want:   []string{"flagged_users", "confirmed_users", "banned_users"},
+		},
+		{
+			name:   "returning to flagged removes from confirmed, cleared and banned",
+			status: enum.UserTypeFlagged,
+			want:   []string{"confirmed_users", "cleared_users", "banned_users"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables := userTablesExcept(tt.status)
+			got := make([]string, 0, len(tables))
+			for _, table := range tables {
+				got = append(got, table.name)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewReturnedFlaggedUser(t *testing.T) {
+	now := time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+	viewed := now.Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		reason     string
+		note       string
+		wantReason string
+	}{
+		{
+			name:       "appends note to existing reason",
+			reason:     "Inappropriate description",
+			note:       "Returned for review after appeal #1: too harsh",
+			wantReason: "Inappropriate description\n\nReturned for review after appeal #1: too harsh",
+		},
+		{
+			name:       "uses note when reason is empty",
+			reason:     "",
+			note:       "Returned for review after appeal #2: check friends",
+			wantReason: "Returned for review after appeal #2: check friends",
+		},
+		{
+			name:       "keeps reason when note is empty",
+			reason:     "Inappropriate description",
+			note:       "",
+			wantReason: "Inappropriate description",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &types.ReviewUser{
+				User: types.User{
+					ID:         1,
+					Name:       "test",
+					Reason:     tt.reason,
+					LastViewed: viewed,
+				},
+				Status: enum.UserTypeConfirmed,
+			}
+
+			got := newReturnedFlaggedUser(user, tt.note, now)
+			assert.Equal(t, tt.wantReason, got.Reason)
+			assert.Equal(t, uint64(1), got.ID)
+			assert.Equal(t, now, got.LastUpdated)
+			assert.True(t, got.LastViewed.IsZero())
+
+			// Source user must be left untouched
+			assert.Equal(t, tt.reason, user.Reason)
+			assert.Equal(t, viewed, user.LastViewed)
+		})
+	}
+}
+
+func TestNewReflaggedUser(t *testing.T) {
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	viewed := now.Add(-time.Hour)
+
+	user := &types.ReviewUser{
+		User: types.User{
+			ID:             1,
+			Name:           "test",
+			Reason:         "Inappropriate description",
+			ReasonCategory: enum.ReasonCategoryContent,
+			Confidence:     0.8,
+			LastViewed:     viewed,
+		},
+		ClearedAt: viewed,
+		Status:    enum.UserTypeCleared,
+	}
+
+	got := newReflaggedUser(user, "Cleared by mistake, profile links to a condo group", now)
+	assert.Equal(t, "Cleared by mistake, profile links to a condo group", got.Reason)
+	assert.Equal(t, enum.ReasonCategoryOther, got.ReasonCategory, "the old reason category no longer applies")
+	assert.Equal(t, uint64(1), got.ID)
+	assert.InDelta(t, 0.8, got.Confidence, 0.0001)
+	assert.Equal(t, now, got.LastUpdated)
+	assert.True(t, got.LastViewed.IsZero())
+
+	// Source user must be left untouched
+	assert.Equal(t, "Inappropriate description", user.Reason)
+	assert.Equal(t, enum.ReasonCategoryContent, user.ReasonCategory)
+	assert.Equal(t, viewed, user.LastViewed)
+}
+
+func TestNewRestoredFlaggedUser(t *testing.T) {
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	viewed := now.Add(-time.Hour)
+
+	deleted := &types.DeletedUser{
+		User: types.User{
+			ID:         1,
+			Name:       "test",
+			Reason:     "Inappropriate description",
+			LastViewed: viewed,
+		},
+		DeletedAt: viewed,
+		DeletedBy: 456,
+	}
+
+	got := newRestoredFlaggedUser(deleted, now)
+	assert.Equal(t, uint64(1), got.ID)
+	assert.Equal(t, "Inappropriate description", got.Reason)
+	assert.Equal(t, now, got.LastUpdated)
+	assert.True(t, got.LastViewed.IsZero())
+
+	// Archived user must be left untouched
+	assert.Equal(t, viewed, deleted.LastViewed)
+}
+
+func TestUserFromTableQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	var user types.User
+	query := userFromTableQuery(db, &user, userTableName(enum.UserTypeConfirmed), 123).String()
+
+	assert.Contains(t, query, `FROM "confirmed_users" AS "user"`)
+	assert.Contains(t, query, `"user"."flag_source"`)
+	assert.Contains(t, query, `"user".id = 123`)
+
+	assert.Equal(t, "banned_users", userTableName(enum.UserTypeBanned))
+	assert.Equal(t, "cleared_users", userTableName(enum.UserTypeCleared))
+	assert.Equal(t, "flagged_users", userTableName(enum.UserTypeFlagged))
+}
+
+func TestRemoveBannedUsersBatchesInserts(t *testing.T) {
+	db, fake := newFakeDB(t, 1, 2, 3)
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	model := NewUser(db, nil, nil, nil, nil, nil, zap.NewNop())
+	model.now = func() time.Time { return now }
+
+	require.NoError(t, model.RemoveBannedUsers(context.Background(), []uint64{1, 2, 3}))
+
+	// One insert per source table regardless of how many users are moved
+	inserts := fake.Queries("INSERT")
+	require.Len(t, inserts, 2)
+	for _, query := range inserts {
+		assert.Contains(t, query, `INSERT INTO "banned_users"`)
+		assert.Contains(t, query, "ON CONFLICT (id) DO UPDATE")
+		assert.Equal(t, 3, strings.Count(query, "'2025-01-24 12:00:00+00:00'"),
+			"every user in the batch shares the same purge time")
+	}
+	assert.Equal(t, 2, fake.Count(`DELETE FROM "confirmed_users"`)+fake.Count(`DELETE FROM "flagged_users"`))
+	assert.Equal(t, 1, fake.Count(`DELETE FROM "user_second_opinions"`))
+}
+
+func TestReviewModels(t *testing.T) {
+	tests := []struct {
+		name       string
+		targetMode enum.ReviewTargetMode
+		want       []interface{}
+	}{
+		{
+			name:       "flagged first",
+			targetMode: enum.ReviewTargetModeFlagged,
+			want:       []interface{}{&types.FlaggedUser{}, &types.ConfirmedUser{}, &types.ClearedUser{}, &types.BannedUser{}},
+		},
+		{
+			name:       "cleared first",
+			targetMode: enum.ReviewTargetModeCleared,
+			want:       []interface{}{&types.ClearedUser{}, &types.FlaggedUser{}, &types.ConfirmedUser{}, &types.BannedUser{}},
+		},
+		{
+			name:       "banned first",
+			targetMode: enum.ReviewTargetModeBanned,
+			want:       []interface{}{&types.BannedUser{}, &types.FlaggedUser{}, &types.ConfirmedUser{}, &types.ClearedUser{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reviewModels(tt.targetMode))
+		})
+	}
+}
+
+func TestNextBatchToReviewQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		excludeIDs []uint64
+		wantWhere  string
+	}{
+		{
+			name:       "without exclusions",
+			excludeIDs: nil,
+			wantWhere:  `WHERE ("flagged_user".last_viewed < '2025-01-24 11:50:00+00:00')`,
+		},
+		{
+			name:       "skips excluded IDs",
+			excludeIDs: []uint64{1, 2},
+			wantWhere: `WHERE ("flagged_user".id NOT IN (1, 2)) ` +
+				`AND ("flagged_user".last_viewed < '2025-01-24 11:50:00+00:00')`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := nextBatchToReviewQuery(
+				db, (*types.FlaggedUser)(nil), enum.ReviewSortByConfidence, 0, tt.excludeIDs, 7, 5, now,
+			).String()
+
+			assert.Contains(t, query, `SELECT "flagged_user"."id" FROM "flagged_users" AS "flagged_user"`)
+			assert.Contains(t, query, `so.requested_by = 7`)
+			assert.Contains(t, query, `"flagged_user".id) DESC, "flagged_user".confidence DESC`)
+			assert.Contains(t, query, "LIMIT 5")
+			assert.Contains(t, query, `FOR UPDATE OF "flagged_user" SKIP LOCKED`)
+			assert.Contains(t, query, tt.wantWhere)
+		})
+	}
+}
+
+func TestGetUsersToReviewSkipsClaimedUsers(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	ids := []uint64{9_000_000_401, 9_000_000_402, 9_000_000_403, 9_000_000_404}
+	seedFlaggedUsers(t, db, 1.0, ids...)
+
+	// Another reviewer's prefetch is still running with the first two users locked
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback() }()
+	var locked []uint64
+	err = tx.NewSelect().Model((*types.FlaggedUser)(nil)).Column("id").
+		Where("id IN (?)", bun.In(ids[:2])).For("UPDATE").Scan(ctx, &locked)
+	require.NoError(t, err)
+
+	// The prefetch skips the locked users instead of waiting for them
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	batch, err := users.GetUsersToReview(timeoutCtx, enum.ReviewSortByConfidence, enum.ReviewTargetModeFlagged, 0, 1, nil, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ids[2:], reviewUserIDs(batch))
+
+	// Once released, the locked users go to the next reviewer and the claimed ones do not
+	require.NoError(t, tx.Rollback())
+	batch, err = users.GetUsersToReview(ctx, enum.ReviewSortByConfidence, enum.ReviewTargetModeFlagged, 0, 2, nil, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ids[:2], reviewUserIDs(batch))
+}
+
+// reviewUserIDs returns the IDs of the review users.
+func reviewUserIDs(users []*types.ReviewUser) []uint64 {
+	ids := make([]uint64, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	return ids
+}
+
+func TestNewReviewUser(t *testing.T) {
+	verifiedAt := time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		model interface{}
+		want  *types.ReviewUser
+	}{
+		{
+			name:  "flagged user",
+			model: &types.FlaggedUser{User: types.User{ID: 1}},
+			want:  &types.ReviewUser{User: types.User{ID: 1}, Status: enum.UserTypeFlagged},
+		},
+		{
+			name:  "confirmed user keeps verification time",
+			model: &types.ConfirmedUser{User: types.User{ID: 2}, VerifiedAt: verifiedAt},
+			want:  &types.ReviewUser{User: types.User{ID: 2}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+		},
+		{
+			name:  "cleared user keeps pin",
+			model: &types.ClearedUser{User: types.User{ID: 3}, ClearedAt: verifiedAt, Pinned: true},
+			want: &types.ReviewUser{
+				User: types.User{ID: 3}, ClearedAt: verifiedAt, IsPinned: true, Status: enum.UserTypeCleared,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newReviewUser(tt.model))
+		})
+	}
+}
+
+func TestUsersToCheckQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		model interface{}
+		table string
+	}{
+		{name: "confirmed users", model: (*types.ConfirmedUser)(nil), table: `"confirmed_users"`},
+		{name: "flagged users", model: (*types.FlaggedUser)(nil), table: `"flagged_users"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := usersToCheckQuery(db, tt.model, 25, now).String()
+
+			assert.Contains(t, query, "FROM "+tt.table)
+			assert.Contains(t, query, "last_purge_check < '2025-01-23 12:00:00+00:00'")
+			assert.Contains(t, query, "LIMIT 25")
+			assert.NotContains(t, query, "UPDATE", "peeking must not touch last_purge_check")
+		})
+	}
+}
+
+func TestRecentlyProcessedUsersQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+
+	query := recentlyProcessedUsersQuery(db, []uint64{1, 2}, now).String()
+
+	for _, table := range []string{"confirmed_users", "flagged_users", "cleared_users", "banned_users"} {
+		assert.Contains(t, query, `FROM "`+table+`"`)
+	}
+	assert.Equal(t, 4, strings.Count(query, "(id IN (1, 2)) AND (last_updated > '2025-01-17 12:00:00+00:00')"))
+	assert.NotContains(t, query, "NOW()")
+}
+
+func TestUsersByIDsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	tests := []struct {
+		name        string
+		fields      types.UserFields
+		wantColumns []string
+	}{
+		{
+			name:        "all fields expands to user columns",
+			fields:      types.UserFields{},
+			wantColumns: []string{`"flagged_user"."uuid"`, `"flagged_user"."last_thumbnail_update"`},
+		},
+		{
+			name:        "selected fields only",
+			fields:      types.UserFields{Basic: true},
+			wantColumns: []string{`"flagged_user"."id"`, `"flagged_user"."display_name"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := usersByIDsQuery(db, []uint64{1, 2}, tt.fields).String()
+
+			assert.Equal(t, 3, strings.Count(query, "UNION ALL"))
+			assert.Equal(t, 4, strings.Count(query, "WHERE (id IN (1, 2))"))
+			assert.NotContains(t, query, "*")
+			for _, column := range tt.wantColumns {
+				assert.Contains(t, query, column)
+			}
+			for _, table := range []string{"confirmed_users", "flagged_users", "cleared_users", "banned_users"} {
+				assert.Contains(t, query, `FROM "`+table+`"`)
+			}
+			assert.Contains(t, query, "verified_at, NULL::timestamptz AS cleared_at, false AS pinned, NULL::timestamptz AS purged_at")
+			assert.Contains(t, query, "NULL::timestamptz AS verified_at, cleared_at, pinned, NULL::timestamptz AS purged_at")
+		})
+	}
+}
+
+func TestMergeUsersByIDs(t *testing.T) {
+	verifiedAt := time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+	clearedAt := verifiedAt.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		rows    []usersByIDsRow
+		userIDs []uint64
+		want    map[uint64]*types.ReviewUser
+	}{
+		{
+			name: "keeps only the timestamps of the row's table",
+			rows: []usersByIDsRow{
+				{User: types.User{ID: 1}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+				{User: types.User{ID: 2}, ClearedAt: clearedAt, Pinned: true, Status: enum.UserTypeCleared},
+			},
+			userIDs: []uint64{1, 2},
+			want: map[uint64]*types.ReviewUser{
+				1: {User: types.User{ID: 1}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+				2: {User: types.User{ID: 2}, ClearedAt: clearedAt, IsPinned: true, Status: enum.UserTypeCleared},
+			},
+		},
+		{
+			name:    "missing IDs are unflagged",
+			rows:    nil,
+			userIDs: []uint64{3},
+			want: map[uint64]*types.ReviewUser{
+				3: {User: types.User{ID: 3}, Status: enum.UserTypeUnflagged},
+			},
+		},
+		{
+			name: "status precedence applies regardless of row order",
+			rows: []usersByIDsRow{
+				{User: types.User{ID: 4}, ClearedAt: clearedAt, Status: enum.UserTypeCleared},
+				{User: types.User{ID: 4}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+				{User: types.User{ID: 5}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+				{User: types.User{ID: 5}, ClearedAt: clearedAt, Status: enum.UserTypeCleared},
+			},
+			userIDs: []uint64{4, 5},
+			want: map[uint64]*types.ReviewUser{
+				4: {User: types.User{ID: 4}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+				5: {User: types.User{ID: 5}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+			},
+		},
+		{
+			name: "banned takes precedence over every other table",
+			rows: []usersByIDsRow{
+				{User: types.User{ID: 6}, Status: enum.UserTypeFlagged},
+				{User: types.User{ID: 6}, PurgedAt: clearedAt, Status: enum.UserTypeBanned},
+				{User: types.User{ID: 6}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+			},
+			userIDs: []uint64{6},
+			want: map[uint64]*types.ReviewUser{
+				6: {User: types.User{ID: 6}, PurgedAt: clearedAt, Status: enum.UserTypeBanned},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mergeUsersByIDs(tt.rows, tt.userIDs))
+		})
+	}
+}
+
+func TestUserStatusPrecedence(t *testing.T) {
+	assert.Equal(t, []enum.UserType{
+		enum.UserTypeBanned, enum.UserTypeConfirmed, enum.UserTypeFlagged, enum.UserTypeCleared,
+	}, userStatusPrecedence)
+	assert.Equal(t, len(userStatusPrecedence), userStatusRank(enum.UserTypeUnflagged))
+
+	// Every status in the precedence is looked up in its own table
+	assert.IsType(t, &types.BannedUser{}, newUserModel(enum.UserTypeBanned))
+	assert.IsType(t, &types.ConfirmedUser{}, newUserModel(enum.UserTypeConfirmed))
+	assert.IsType(t, &types.FlaggedUser{}, newUserModel(enum.UserTypeFlagged))
+	assert.IsType(t, &types.ClearedUser{}, newUserModel(enum.UserTypeCleared))
+}
+
+func TestUsersByNameQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	query := usersByNameQuery(db, "john", "%john%", 25).String()
+
+	assert.Equal(t, 3, strings.Count(query, "UNION ALL"))
+
+	// Each name column is matched in its own subquery so its trigram index is used
+	assert.NotContains(t, query, " OR ")
+	assert.NotContains(t, query, "unnest")
+	assert.Equal(t, 4, strings.Count(query, "WHERE (name ILIKE '%john%')"))
+	assert.Equal(t, 4, strings.Count(query, "WHERE (display_name ILIKE '%john%')"))
+	assert.Equal(t, 4, strings.Count(query, "WHERE (previous_names_text(previous_names) ILIKE '%john%')"))
+	assert.Equal(t, 8, strings.Count(query, ") UNION ("))
+	for _, table := range []string{"confirmed_users", "flagged_users", "cleared_users", "banned_users"} {
+		assert.Contains(t, query, table)
+	}
+	assert.Contains(t, query, "ORDER BY GREATEST(similarity(name, 'john'), similarity(display_name, 'john')) DESC, \"id\" ASC")
+	assert.Contains(t, query, "LIMIT 25")
+}
+
+func TestUpdateUserNamesQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	rows := []userNameRow{{ID: 1, Name: "new_name"}}
+
+	query := updateUserNamesQuery(db, (*types.FlaggedUser)(nil), &rows).String()
+
+	assert.Contains(t, query, `WITH "_data" ("id", "name") AS (VALUES (1::BIGINT, 'new_name'::VARCHAR))`)
+	assert.Contains(t, query, `UPDATE "flagged_users" AS "flagged_user"`)
+	assert.Contains(t, query, `CASE WHEN "flagged_user".name <> _data.name THEN (array_append(array_remove(array_remove(`+
+		`COALESCE("flagged_user".previous_names, '{}'), "flagged_user".name), _data.name), "flagged_user".name))`+
+		`[GREATEST(cardinality(`)
+	assert.Contains(t, query, `) - 4, 1):] ELSE "flagged_user".previous_names END`)
+	assert.Contains(t, query, "name = _data.name FROM _data")
+	assert.Contains(t, query, `("flagged_user".id = _data.id) AND ("flagged_user".name <> _data.name)`)
+}
+
+func TestUpdateUserCountsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	rows := []userCountsRow{{
+		ID:             1,
+		FollowerCount:  120,
+		FollowingCount: 3,
+		Games:          []*apiTypes.Game{{ID: 5, PlaceVisits: 900}},
+		LastUpdated:    time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC),
+	}}
+
+	query := updateUserCountsQuery(db, (*types.ClearedUser)(nil), &rows).String()
+
+	assert.Contains(t, query, `WITH "_data" ("id", "follower_count", "following_count", "games", "last_updated") AS (VALUES (1::BIGINT, 120::BIGINT, 3::BIGINT, '[{"id":5,`)
+	assert.Contains(t, query, `"placeVisits":900}]'::jsonb, '2025-01-24 12:00:00+00:00'::TIMESTAMPTZ)`)
+	assert.Contains(t, query, `UPDATE "cleared_users" AS "cleared_user"`)
+	assert.Contains(t, query, "SET follower_count = _data.follower_count, following_count = _data.following_count, "+
+		"games = _data.games, last_updated = _data.last_updated FROM _data")
+	assert.Contains(t, query, `WHERE ("cleared_user".id = _data.id)`)
+}
+
+func TestMergeUsersByName(t *testing.T) {
+	verifiedAt := time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC)
+	clearedAt := verifiedAt.Add(time.Hour)
+
+	rows := []usersByIDsRow{
+		{User: types.User{ID: 2, Name: "john_two"}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+		{User: types.User{ID: 1, Name: "john_one"}, Status: enum.UserTypeFlagged},
+		{User: types.User{ID: 2, Name: "john_two"}, ClearedAt: clearedAt, Status: enum.UserTypeCleared},
+	}
+
+	want := []*types.ReviewUser{
+		{User: types.User{ID: 2, Name: "john_two"}, VerifiedAt: verifiedAt, Status: enum.UserTypeConfirmed},
+		{User: types.User{ID: 1, Name: "john_one"}, Status: enum.UserTypeFlagged},
+	}
+
+	assert.Equal(t, want, mergeUsersByName(rows))
+}
+
+func TestStaleThumbnailsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	staleBefore := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	viewedAfter := time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	query := staleThumbnailsQuery(db, []interface{}{
+		(*types.FlaggedUser)(nil),
+		(*types.BannedUser)(nil),
+	}, []string{"id", "thumbnail_url"}, staleBefore, viewedAfter, 50).String()
+
+	assert.Contains(t, query, `FROM "flagged_users" AS "flagged_user" WHERE (last_thumbnail_update < '2025-01-01 00:00:00+00:00')`)
+	assert.Contains(t, query, `UNION ALL (SELECT "banned_user"."id", "banned_user"."thumbnail_url" FROM "banned_users"`)
+	assert.Contains(t, query, `ORDER BY last_viewed > '2025-01-07 00:00:00+00:00' DESC, "last_thumbnail_update" ASC LIMIT 50`)
+}
+
+func TestDeleteAndRestoreUser(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	const userID = 9_000_000_401
+	seedFlaggedUsers(t, db, 0.8, userID)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.DeletedUser)(nil)).Where("id = ?", userID).Exec(ctx)
+	})
+
+	// Deleting archives the user and removes them from flagged_users
+	found, err := users.DeleteUser(ctx, userID, 42)
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	exists, err := db.NewSelect().Model((*types.FlaggedUser)(nil)).Where("id = ?", userID).Exists(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// Purging with a cutoff before the deletion keeps the archived copy
+	purged, err := users.PurgeOldDeletedUsers(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+
+	// Restoring puts the user back in flagged_users with the archived data
+	deleted, err := users.RestoreDeletedUser(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), deleted.DeletedBy)
+
+	var restored types.FlaggedUser
+	require.NoError(t, db.NewSelect().Model(&restored).Where("id = ?", userID).Scan(ctx))
+	assert.InDelta(t, 0.8, restored.Confidence, 0.0001)
+	assert.True(t, restored.LastViewed.IsZero())
+
+	// The archive no longer holds the user
+	_, err = users.RestoreDeletedUser(ctx, userID)
+	require.ErrorIs(t, err, types.ErrUserNotDeleted)
+}
+
+func TestReturnUserToFlagged(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	const userID = 9_000_000_411
+	seedFlaggedUsers(t, db, 0.8, userID)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.ConfirmedUser)(nil)).Where("id = ?", userID).Exec(ctx)
+	})
+
+	user := &types.ReviewUser{User: types.User{ID: userID, UUID: uuid.New(), Name: "returned", Reason: "bio"}}
+	require.NoError(t, users.ConfirmUser(ctx, user))
+
+	// Returning moves the confirmed user back to flagged_users
+	require.NoError(t, users.ReturnUserToFlagged(ctx, user, "appeal accepted"))
+
+	exists, err := db.NewSelect().Model((*types.ConfirmedUser)(nil)).Where("id = ?", userID).Exists(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	var flagged types.FlaggedUser
+	require.NoError(t, db.NewSelect().Model(&flagged).Where("id = ?", userID).Scan(ctx))
+	assert.Contains(t, flagged.Reason, "appeal accepted")
+
+	// Returning a user that is already flagged reports the conflict
+	err = users.ReturnUserToFlagged(ctx, user, "appeal accepted again")
+	require.ErrorIs(t, err, types.ErrUserFlagged)
+}
+
+func TestConfirmRejectsProtectedAccounts(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	const protectedID, otherID = 9_000_000_421, 9_000_000_422
+	seedFlaggedUsers(t, db, 0.9, protectedID, otherID)
+	_, err := db.NewInsert().Model(&types.ProtectedAccount{ID: protectedID, AddedBy: 1, AddedAt: time.Now()}).Exec(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.ProtectedAccount)(nil)).Where("id = ?", protectedID).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.ConfirmedUser)(nil)).
+			Where("id IN (?)", bun.In([]uint64{protectedID, otherID})).Exec(ctx)
+	})
+
+	protectedUser := &types.ReviewUser{User: types.User{ID: protectedID, UUID: uuid.New(), Name: "protected"}}
+	otherUser := &types.ReviewUser{User: types.User{ID: otherID, UUID: uuid.New(), Name: "other"}}
+
+	// A single confirm is rejected even if the caller did not check the account
+	err = users.ConfirmUser(ctx, protectedUser)
+	require.ErrorIs(t, err, types.ErrUserProtected)
+
+	_, err = users.ConfirmUserWithPropagation(ctx, protectedUser)
+	require.ErrorIs(t, err, types.ErrUserProtected)
+
+	// A batch confirm only rejects the protected account
+	failed, err := users.ConfirmUsers(ctx, []*types.ReviewUser{protectedUser, otherUser})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.ErrorIs(t, failed[protectedID], types.ErrUserProtected)
+
+	exists, err := db.NewSelect().Model((*types.FlaggedUser)(nil)).Where("id = ?", protectedID).Exists(ctx)
+	require.NoError(t, err)
+	assert.True(t, exists, "the protected account must stay flagged")
+
+	exists, err = db.NewSelect().Model((*types.ConfirmedUser)(nil)).Where("id = ?", otherID).Exists(ctx)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestMoveUsersRollsBackOnlyFailedUsers(t *testing.T) {
+	db, fake := newFakeDB(t)
+	users := newTestUserModel(db)
+
+	errMove := errors.New("move failed")
+	batch := []*types.ReviewUser{
+		{User: types.User{ID: 1}},
+		{User: types.User{ID: 2}},
+		{User: types.User{ID: 3}},
+	}
+
+	var attempted []uint64
+	failed, err := users.moveUsers(context.Background(), batch,
+		func(_ context.Context, _ bun.Tx, user *types.ReviewUser, _ time.Time) error {
+			attempted = append(attempted, user.ID)
+			if user.ID == 2 {
+				return errMove
+			}
+			return nil
+		}, true)
+	require.NoError(t, err)
+
+	// Every user is attempted and only the failing one is reported
+	assert.Equal(t, []uint64{1, 2, 3}, attempted)
+	require.Len(t, failed, 1)
+	require.ErrorIs(t, failed[2], errMove)
+
+	// Each user gets its own savepoint and only the failing one is rolled back
+	assert.Equal(t, 3, fake.Count("SAVEPOINT"))
+	assert.Equal(t, 1, fake.Count("ROLLBACK TO SAVEPOINT"))
+	assert.Equal(t, 2, fake.Count("RELEASE SAVEPOINT"))
+}
+
+func TestConfirmAndClearUsers(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	ids := []uint64{9_000_000_771, 9_000_000_772, 9_000_000_773}
+	seedFlaggedUsers(t, db, 0.9, ids...)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.ConfirmedUser)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.ClearedUser)(nil)).Where("id IN (?)", bun.In(ids)).Exec(ctx)
+	})
+
+	reviewUsers := make([]*types.ReviewUser, 0, len(ids))
+	for _, id := range ids {
+		reviewUsers = append(reviewUsers, &types.ReviewUser{
+			User: types.User{ID: id, UUID: uuid.New(), Name: "batch_" + strconv.FormatUint(id, 10)},
+		})
+	}
+
+	// Confirm the first two users and clear the last one
+	failed, err := users.ConfirmUsers(ctx, reviewUsers[:2])
+	require.NoError(t, err)
+	assert.Empty(t, failed)
+
+	failed, err = users.ClearUsers(ctx, reviewUsers[2:])
+	require.NoError(t, err)
+	assert.Empty(t, failed)
+
+	flagged, err := db.NewSelect().Model((*types.FlaggedUser)(nil)).Where("id IN (?)", bun.In(ids)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, flagged)
+
+	var confirmedIDs []uint64
+	err = db.NewSelect().Model((*types.ConfirmedUser)(nil)).Column("id").
+		Where("id IN (?)", bun.In(ids)).Order("id").Scan(ctx, &confirmedIDs)
+	require.NoError(t, err)
+	assert.Equal(t, ids[:2], confirmedIDs)
+
+	var clearedIDs []uint64
+	err = db.NewSelect().Model((*types.ClearedUser)(nil)).Column("id").
+		Where("id IN (?)", bun.In(ids)).Order("id").Scan(ctx, &clearedIDs)
+	require.NoError(t, err)
+	assert.Equal(t, ids[2:], clearedIDs)
+}
+
+func TestReflagRejectsProtectedAccounts(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	const userID = 9_000_000_791
+	user := &types.ReviewUser{User: types.User{ID: userID, UUID: uuid.New(), Name: "protected_reflag"}}
+	_, err := db.NewInsert().Model(&types.ClearedUser{User: user.User, ClearedAt: time.Now()}).Exec(ctx)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.ProtectedAccount{ID: userID, AddedBy: 1, AddedAt: time.Now()}).Exec(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.ProtectedAccount)(nil)).Where("id = ?", userID).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.ClearedUser)(nil)).Where("id = ?", userID).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.FlaggedUser)(nil)).Where("id = ?", userID).Exec(ctx)
+	})
+
+	err = users.ReflagUser(ctx, user, "looks suspicious again")
+	require.ErrorIs(t, err, types.ErrUserProtected)
+
+	exists, err := db.NewSelect().Model((*types.ClearedUser)(nil)).Where("id = ?", userID).Exists(ctx)
+	require.NoError(t, err)
+	assert.True(t, exists, "the protected account must stay cleared")
+
+	exists, err = db.NewSelect().Model((*types.FlaggedUser)(nil)).Where("id = ?", userID).Exists(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestRestoreRejectsProtectedAccounts(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	const userID = 9_000_000_792
+	seedFlaggedUsers(t, db, 0.8, userID)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.ProtectedAccount)(nil)).Where("id = ?", userID).Exec(ctx)
+		_, _ = db.NewDelete().Model((*types.DeletedUser)(nil)).Where("id = ?", userID).Exec(ctx)
+	})
+
+	// The account is protected after it was deleted
+	_, err := users.DeleteUser(ctx, userID, 42)
+	require.NoError(t, err)
+	_, err = db.NewInsert().Model(&types.ProtectedAccount{ID: userID, AddedBy: 1, AddedAt: time.Now()}).Exec(ctx)
+	require.NoError(t, err)
+
+	_, err = users.RestoreDeletedUser(ctx, userID)
+	require.ErrorIs(t, err, types.ErrUserProtected)
+
+	exists, err := db.NewSelect().Model((*types.FlaggedUser)(nil)).Where("id = ?", userID).Exists(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	exists, err = db.NewSelect().Model((*types.DeletedUser)(nil)).Where("id = ?", userID).Exists(ctx)
+	require.NoError(t, err)
+	assert.True(t, exists, "the archived copy must be kept")
+}
+
+func TestSearchUsersByNameMatchesPreviousNames(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users := newTestUserModel(db)
+
+	user := types.User{
+		ID: 9_000_000_801, UUID: uuid.New(), Name: "search_current",
+		DisplayName: "Search Display", PreviousNames: []string{"oldsearchname", "ab"},
+	}
+	_, err := db.NewInsert().Model(&types.FlaggedUser{User: user}).Exec(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.NewDelete().Model((*types.FlaggedUser)(nil)).Where("id = ?", user.ID).Exec(ctx)
+	})
+
+	// Users are found by a previous name as well as their current names
+	for _, query := range []string{"search_current", "search display", "oldsearch"} {
+		results, err := users.SearchUsersByName(ctx, query, 10)
+		require.NoError(t, err)
+		require.Len(t, results, 1, query)
+		assert.Equal(t, user.ID, results[0].ID)
+	}
+
+	// A search does not match across the end of one previous name and the next
+	results, err := users.SearchUsersByName(ctx, "nameab", 10)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
@@ -130,6 +130,63 @@ func (v *VoteModel) GetLeaderboard(ctx context.Context, period enum.LeaderboardP
 	return stats, nextCursor, err
 }
 
+// GetAllReviewerStats retrieves the confirm, clear and skip counts of every reviewer
+// who made a decision since the given time, along with their vote accuracy for the
+// period. Reviewers are ordered by the number of confirms and clears they made.
+func (v *VoteModel) GetAllReviewerStats(
+	ctx context.Context, period enum.LeaderboardPeriod, since time.Time,
+) ([]*types.ReviewerStats, error) {
+	// Try to refresh the materialized view if stale
+	err := v.views.RefreshIfStale(ctx, period)
+	if err != nil {
+		v.logger.Warn("Failed to refresh materialized view",
+			zap.Error(err),
+			zap.String("period", period.String()))
+		// Continue anyway - we'll use slightly stale data
+	}
+
+	var stats []*types.ReviewerStats
+	err = reviewerStatsQuery(v.db, period, since).Scan(ctx, &stats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer stats: %w (period=%s)", err, period)
+	}
+
+	return stats, nil
+}
+
+// reviewerStatsQuery builds the query counting each reviewer's decisions since
+// the given time from the activity logs. Vote accuracy is joined from the
+// leaderboard view of the period. System actions are excluded.
+func reviewerStatsQuery(db bun.IDB, period enum.LeaderboardPeriod, since time.Time) *bun.SelectQuery {
+	confirms := []enum.ActivityType{
+		enum.ActivityTypeUserConfirmed,
+		enum.ActivityTypeUserConfirmedCustom,
+		enum.ActivityTypeGroupConfirmed,
+		enum.ActivityTypeGroupConfirmedCustom,
+	}
+	clears := []enum.ActivityType{enum.ActivityTypeUserCleared, enum.ActivityTypeGroupCleared}
+	skips := []enum.ActivityType{enum.ActivityTypeUserSkipped, enum.ActivityTypeGroupSkipped}
+	decisionTypes := append(append(append([]enum.ActivityType{}, confirms...), clears...), skips...)
+
+	decisions := db.NewSelect().
+		TableExpr("activity_logs").
+		ColumnExpr("reviewer_id").
+		ColumnExpr("COUNT(*) FILTER (WHERE activity_type IN (?)) AS confirms", bun.In(confirms)).
+		ColumnExpr("COUNT(*) FILTER (WHERE activity_type IN (?)) AS clears", bun.In(clears)).
+		ColumnExpr("COUNT(*) FILTER (WHERE activity_type IN (?)) AS skips", bun.In(skips)).
+		Where("activity_timestamp >= ?", since).
+		Where("activity_type IN (?)", bun.In(decisionTypes)).
+		Where("reviewer_id != 0").
+		Group("reviewer_id")
+
+	return db.NewSelect().
+		With("decisions", decisions).
+		TableExpr("decisions AS d").
+		ColumnExpr("d.reviewer_id, d.confirms, d.clears, d.skips, v.accuracy").
+		Join("LEFT JOIN vote_leaderboard_stats_" + period.String() + " AS v ON v.discord_user_id = d.reviewer_id").
+		OrderExpr("d.confirms + d.clears DESC, d.reviewer_id ASC")
+}
+
 // getUserRank gets the user's rank based on correct votes.
 func (v *VoteModel) getUserRank(ctx context.Context, discordUserID uint64, period enum.LeaderboardPeriod) (int, error) {
 	var rank int
@@ -152,8 +209,78 @@ func (v *VoteModel) getUserRank(ctx context.Context, discordUserID uint64, perio
 	return rank, nil
 }
 
-// SaveVote records a new vote from a Discord user.
+// GetVoteBreakdown retrieves the number of voters and upvotes on a target
+// and whether the given Discord user has already voted on it.
+func (v *VoteModel) GetVoteBreakdown(
+	ctx context.Context, targetID uint64, discordUserID uint64, voteType enum.VoteType,
+) (*types.VoteBreakdown, error) {
+	var model interface{}
+	switch voteType {
+	case enum.VoteTypeUser:
+		model = (*types.UserVote)(nil)
+	case enum.VoteTypeGroup:
+		model = (*types.GroupVote)(nil)
+	default:
+		return nil, fmt.Errorf("%w: %s", types.ErrInvalidVoteType, voteType)
+	}
+
+	var breakdown types.VoteBreakdown
+	err := voteBreakdownQuery(v.db, model, targetID, discordUserID).Scan(ctx, &breakdown)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote breakdown: %w (targetID=%d)", err, targetID)
+	}
+
+	return &breakdown, nil
+}
+
+// voteBreakdownQuery builds the query counting the voters and upvotes on a target.
+// Votes are keyed by target and Discord user, so each row is a distinct voter.
+func voteBreakdownQuery(db bun.IDB, model interface{}, targetID uint64, discordUserID uint64) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(model).
+		ColumnExpr("COUNT(*) AS voters").
+		ColumnExpr("COUNT(*) FILTER (WHERE is_upvote) AS upvotes").
+		ColumnExpr("COALESCE(BOOL_OR(discord_user_id = ?), false) AS has_voted", discordUserID).
+		Where("id = ?", targetID)
+}
+
+// SaveVote records a new vote from a Discord user. Votes can only be cast on
+// flagged targets, so types.ErrTargetDecided is returned once a target has been
+// confirmed or cleared.
 func (v *VoteModel) SaveVote(ctx context.Context, targetID uint64, discordUserID uint64, isUpvote bool, voteType enum.VoteType) error {
+	err := v.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return saveVoteTx(ctx, tx, targetID, discordUserID, isUpvote, voteType)
+	})
+	if err != nil {
+		if errors.Is(err, types.ErrTargetDecided) {
+			v.VerifyDecidedVotes(ctx, targetID, voteType)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// saveVoteTx records a vote within the given transaction. The flagged target is
+// locked until the transaction ends, so a decision on the target waits for the
+// vote to be saved and then verifies it along with the others. A vote arriving
+// after a decision has started waits for it instead and is rejected.
+func saveVoteTx(
+	ctx context.Context, tx bun.Tx, targetID uint64, discordUserID uint64, isUpvote bool, voteType enum.VoteType,
+) error {
+	query, err := votableTargetQuery(tx, targetID, voteType)
+	if err != nil {
+		return err
+	}
+
+	var id uint64
+	if err := query.Scan(ctx, &id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w (targetID=%d)", types.ErrTargetDecided, targetID)
+		}
+		return fmt.Errorf("failed to lock vote target: %w", err)
+	}
+
 	vote := types.Vote{
 		ID:            targetID,
 		DiscordUserID: discordUserID,
@@ -163,7 +290,7 @@ func (v *VoteModel) SaveVote(ctx context.Context, targetID uint64, discordUserID
 		VotedAt:       time.Now(),
 	}
 
-	insert := v.db.NewInsert()
+	insert := tx.NewInsert()
 	switch voteType {
 	case enum.VoteTypeUser:
 		userVote := &types.UserVote{Vote: vote}
@@ -175,7 +302,7 @@ func (v *VoteModel) SaveVote(ctx context.Context, targetID uint64, discordUserID
 		return fmt.Errorf("%w: %s", types.ErrInvalidVoteType, voteType)
 	}
 
-	_, err := insert.
+	_, err = insert.
 		On("CONFLICT (id, discord_user_id) DO UPDATE").
 		Set("is_upvote = EXCLUDED.is_upvote").
 		Set("voted_at = EXCLUDED.voted_at").
@@ -187,6 +314,75 @@ func (v *VoteModel) SaveVote(ctx context.Context, targetID uint64, discordUserID
 	return nil
 }
 
+// votableTargetQuery builds the query locking a target that can still be voted on.
+// Only flagged targets can be voted on, and the shared lock blocks the delete
+// that moves the target out of the flagged table when it is decided.
+func votableTargetQuery(db bun.IDB, targetID uint64, voteType enum.VoteType) (*bun.SelectQuery, error) {
+	var model interface{}
+	switch voteType {
+	case enum.VoteTypeUser:
+		model = (*types.FlaggedUser)(nil)
+	case enum.VoteTypeGroup:
+		model = (*types.FlaggedGroup)(nil)
+	default:
+		return nil, fmt.Errorf("%w: %s", types.ErrInvalidVoteType, voteType)
+	}
+
+	return db.NewSelect().
+		Model(model).
+		Column("id").
+		Where("id = ?", targetID).
+		For("SHARE"), nil
+}
+
+// decidedTarget is a table of decided targets along with whether the targets
+// in it were found to be inappropriate.
+type decidedTarget struct {
+	model            interface{}
+	wasInappropriate bool
+}
+
+// decidedTargets returns the tables of targets whose votes can be verified.
+func decidedTargets(voteType enum.VoteType) []decidedTarget {
+	switch voteType {
+	case enum.VoteTypeUser:
+		return []decidedTarget{
+			{model: (*types.ConfirmedUser)(nil), wasInappropriate: true},
+			{model: (*types.ClearedUser)(nil), wasInappropriate: false},
+		}
+	case enum.VoteTypeGroup:
+		return []decidedTarget{
+			{model: (*types.ConfirmedGroup)(nil), wasInappropriate: true},
+			{model: (*types.ClearedGroup)(nil), wasInappropriate: false},
+		}
+	}
+	return nil
+}
+
+// VerifyDecidedVotes verifies votes that were saved on a target after it was
+// confirmed or cleared but before votes on it were locked. Failures are only
+// logged as the votes are verified again on the next decision.
+func (v *VoteModel) VerifyDecidedVotes(ctx context.Context, targetID uint64, voteType enum.VoteType) {
+	for _, decided := range decidedTargets(voteType) {
+		exists, err := v.db.NewSelect().
+			Model(decided.model).
+			Where("id = ?", targetID).
+			Exists(ctx)
+		if err != nil {
+			v.logger.Error("Failed to check decided vote target", zap.Error(err), zap.Uint64("targetID", targetID))
+			return
+		}
+		if !exists {
+			continue
+		}
+
+		if err := v.VerifyVotes(ctx, targetID, decided.wasInappropriate, voteType); err != nil {
+			v.logger.Error("Failed to verify votes of decided target", zap.Error(err), zap.Uint64("targetID", targetID))
+		}
+		return
+	}
+}
+
 // VerifyVotes verifies all unverified votes for a target and updates vote statistics.
 func (v *VoteModel) VerifyVotes(ctx context.Context, targetID uint64, wasInappropriate bool, voteType enum.VoteType) error {
 	// First handle the vote verification in a transaction
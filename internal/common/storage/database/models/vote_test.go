@@ -0,0 +1,225 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+func TestVoteBreakdownQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	tests := []struct {
+		name  string
+		model interface{}
+		table string
+	}{
+		{name: "user votes", model: (*types.UserVote)(nil), table: `"user_votes"`},
+		{name: "group votes", model: (*types.GroupVote)(nil), table: `"group_votes"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := voteBreakdownQuery(db, tt.model, 123, 456).String()
+
+			assert.Contains(t, query, "FROM "+tt.table)
+			assert.Contains(t, query, "COUNT(*) AS voters")
+			assert.Contains(t, query, "COUNT(*) FILTER (WHERE is_upvote) AS upvotes")
+			assert.Contains(t, query, "COALESCE(BOOL_OR(discord_user_id = 456), false) AS has_voted")
+			assert.Contains(t, query, "(id = 123)")
+		})
+	}
+}
+
+func TestReviewerStatsQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := reviewerStatsQuery(db, enum.LeaderboardPeriodWeekly, since).String()
+
+	assert.Contains(t, query, `COUNT(*) FILTER (WHERE activity_type IN (3, 4, 13, 14)) AS confirms`)
+	assert.Contains(t, query, `COUNT(*) FILTER (WHERE activity_type IN (5, 15)) AS clears`)
+	assert.Contains(t, query, `COUNT(*) FILTER (WHERE activity_type IN (6, 16)) AS skips`)
+	assert.Contains(t, query, `(activity_timestamp >= '2025-01-01 00:00:00+00:00')`)
+	assert.Contains(t, query, `(activity_type IN (3, 4, 13, 14, 5, 15, 6, 16))`)
+	assert.Contains(t, query, `(reviewer_id != 0)`)
+	assert.Contains(t, query, `LEFT JOIN vote_leaderboard_stats_Weekly AS v ON v.discord_user_id = d.reviewer_id`)
+	assert.Contains(t, query, `ORDER BY d.confirms + d.clears DESC, d.reviewer_id ASC`)
+}
+
+func TestVotableTargetQuery(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+
+	tests := []struct {
+		name     string
+		voteType enum.VoteType
+		want     string
+	}{
+		{
+			name:     "user votes",
+			voteType: enum.VoteTypeUser,
+			want:     `SELECT "flagged_user"."id" FROM "flagged_users" AS "flagged_user" WHERE (id = 123) FOR SHARE`,
+		},
+		{
+			name:     "group votes",
+			voteType: enum.VoteTypeGroup,
+			want:     `SELECT "flagged_group"."id" FROM "flagged_groups" AS "flagged_group" WHERE (id = 123) FOR SHARE`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := votableTargetQuery(db, 123, tt.voteType)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query.String())
+		})
+	}
+
+	t.Run("invalid vote type", func(t *testing.T) {
+		_, err := votableTargetQuery(db, 123, enum.VoteType(99))
+		assert.ErrorIs(t, err, types.ErrInvalidVoteType)
+	})
+}
+
+// TestVoteRacesConfirm covers votes that arrive while a user is being confirmed.
+// Votes take a shared lock on the flagged row, which the confirm deletes, so
+// whichever starts first finishes before the other continues.
+func TestVoteRacesConfirm(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	activity := NewActivity(db, logger)
+	votes := NewVote(db, activity, NewMaterializedView(db, logger), logger)
+	reputation := NewReputation(db, votes, logger)
+	users := NewUser(db, NewTracking(db, logger), activity, reputation, votes, NewProtected(db, logger), logger)
+
+	// seedFlagged adds a flagged user and removes every trace of it after the test
+	seedFlagged := func(t *testing.T, id uint64) *types.ReviewUser {
+		t.Helper()
+
+		user := types.User{ID: id, UUID: uuid.New(), Name: "vote_lock_test"}
+		_, err := db.NewInsert().Model(&types.FlaggedUser{User: user}).Exec(ctx)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			for _, model := range []interface{}{
+				(*types.FlaggedUser)(nil), (*types.ConfirmedUser)(nil),
+				(*types.UserVote)(nil), (*types.UserReputation)(nil),
+			} {
+				_, _ = db.NewDelete().Model(model).Where("id = ?", id).Exec(ctx)
+			}
+		})
+
+		return &types.ReviewUser{User: user}
+	}
+
+	t.Run("vote waits for the confirm and is rejected", func(t *testing.T) {
+		user := seedFlagged(t, 9_000_000_101)
+
+		// Start confirming but hold the transaction open before it commits
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback() }()
+		require.NoError(t, confirmUserTx(ctx, tx, user, time.Now()))
+
+		voted := make(chan error, 1)
+		go func() {
+			voted <- reputation.UpdateUserVotes(ctx, user.ID, 42, false)
+		}()
+
+		select {
+		case err := <-voted:
+			t.Fatalf("vote finished before the confirm committed: %v", err)
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		require.NoError(t, tx.Commit())
+		require.ErrorIs(t, <-voted, types.ErrTargetDecided)
+
+		count, err := db.NewSelect().Model((*types.UserVote)(nil)).Where("id = ?", user.ID).Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count, "the rejected vote must not be saved")
+
+		rep, err := reputation.GetUserReputation(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Zero(t, rep.Downvotes, "the rejected vote must not be counted")
+	})
+
+	t.Run("confirm waits for the vote and verifies it", func(t *testing.T) {
+		user := seedFlagged(t, 9_000_000_102)
+
+		// Save a vote but hold the transaction open before it commits
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback() }()
+		require.NoError(t, saveVoteTx(ctx, tx, user.ID, 42, false, enum.VoteTypeUser))
+
+		confirmed := make(chan error, 1)
+		go func() {
+			confirmed <- users.ConfirmUser(ctx, user)
+		}()
+
+		select {
+		case err := <-confirmed:
+			t.Fatalf("confirm finished before the vote committed: %v", err)
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		require.NoError(t, tx.Commit())
+		require.NoError(t, <-confirmed)
+
+		var vote types.UserVote
+		err = db.NewSelect().Model(&vote).Where("id = ?", user.ID).Where("discord_user_id = ?", 42).Scan(ctx)
+		require.NoError(t, err)
+		assert.True(t, vote.IsVerified)
+		assert.True(t, vote.IsCorrect)
+	})
+
+	t.Run("straggler is verified when the next vote is rejected", func(t *testing.T) {
+		user := seedFlagged(t, 9_000_000_103)
+		require.NoError(t, users.ConfirmUser(ctx, user))
+
+		// An unverified vote left behind on the confirmed user
+		_, err := db.NewInsert().Model(&types.UserVote{Vote: types.Vote{
+			ID: user.ID, DiscordUserID: 43, IsUpvote: true, VotedAt: time.Now(),
+		}}).Exec(ctx)
+		require.NoError(t, err)
+
+		err = votes.SaveVote(ctx, user.ID, 44, false, enum.VoteTypeUser)
+		require.ErrorIs(t, err, types.ErrTargetDecided)
+
+		var straggler types.UserVote
+		err = db.NewSelect().Model(&straggler).Where("id = ?", user.ID).Where("discord_user_id = ?", 43).Scan(ctx)
+		require.NoError(t, err)
+		assert.True(t, straggler.IsVerified)
+		assert.False(t, straggler.IsCorrect)
+	})
+}
+
+func TestDecidedTargets(t *testing.T) {
+	users := decidedTargets(enum.VoteTypeUser)
+	require.Len(t, users, 2)
+	assert.IsType(t, (*types.ConfirmedUser)(nil), users[0].model)
+	assert.True(t, users[0].wasInappropriate)
+	assert.IsType(t, (*types.ClearedUser)(nil), users[1].model)
+	assert.False(t, users[1].wasInappropriate)
+
+	groups := decidedTargets(enum.VoteTypeGroup)
+	require.Len(t, groups, 2)
+	assert.IsType(t, (*types.ConfirmedGroup)(nil), groups[0].model)
+	assert.True(t, groups[0].wasInappropriate)
+	assert.IsType(t, (*types.ClearedGroup)(nil), groups[1].model)
+	assert.False(t, groups[1].wasInappropriate)
+
+	assert.Empty(t, decidedTargets(enum.VoteType(99)))
+}
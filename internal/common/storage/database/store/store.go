@@ -0,0 +1,155 @@
+// Package store defines the database operations used by the bot menus as interfaces,
+// so menus can be tested with fakes instead of a live database.
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+)
+
+// UserStore defines the user operations used by the bot.
+type UserStore interface {
+	// GetUserByID retrieves a user by their ID from any user table.
+	GetUserByID(ctx context.Context, userID string, fields types.UserFields) (*types.ReviewUser, error)
+	// GetUsersByIDs retrieves the given users from any user table.
+	GetUsersByIDs(ctx context.Context, userIDs []uint64, fields types.UserFields) (map[uint64]*types.ReviewUser, error)
+	// GetUserToReview finds the next user to review, skipping the locked users.
+	GetUserToReview(
+		ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, minConfidence float64,
+		reviewerID uint64, lockedIDs []uint64, readOnly bool,
+	) (*types.ReviewUser, error)
+	// GetUsersToReview finds several users to review at once, skipping the locked users.
+	GetUsersToReview(
+		ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, minConfidence float64,
+		reviewerID uint64, lockedIDs []uint64, limit int,
+	) ([]*types.ReviewUser, error)
+	// SearchUsersByName finds users whose name or display name contains the query.
+	SearchUsersByName(ctx context.Context, query string, limit int) ([]*types.ReviewUser, error)
+	// ConfirmUserWithPropagation confirms a user and returns how many of their groups were queued for scanning.
+	ConfirmUserWithPropagation(ctx context.Context, user *types.ReviewUser) (int, error)
+	// ConfirmUsers confirms several users, returning the errors of users that failed.
+	ConfirmUsers(ctx context.Context, users []*types.ReviewUser) (map[uint64]error, error)
+	// ClearUser clears a user.
+	ClearUser(ctx context.Context, user *types.ReviewUser) error
+	// ClearUsers clears several users, returning the errors of users that failed.
+	ClearUsers(ctx context.Context, users []*types.ReviewUser) (map[uint64]error, error)
+	// ReflagUser moves a cleared user back to flagged with the given reason.
+	ReflagUser(ctx context.Context, user *types.ReviewUser, reason string) error
+	// ReturnUserToFlagged moves a confirmed user back to flagged with the given note.
+	// Returns types.ErrUserFlagged if the user is already flagged.
+	ReturnUserToFlagged(ctx context.Context, user *types.ReviewUser, note string) error
+	// SetClearedUserPinned pins or unpins a cleared user.
+	SetClearedUserPinned(ctx context.Context, userID uint64, pinned bool, maxPinned int) error
+	// UpdateCounts stores the refreshed follow counts and games of users and returns how many were updated.
+	UpdateCounts(ctx context.Context, counts map[uint64]*types.UserFollowCounts) (int, error)
+	// RemoveBannedUsers moves users that were banned by Roblox to the banned users.
+	RemoveBannedUsers(ctx context.Context, userIDs []uint64) error
+}
+
+// GroupStore defines the group operations used by the bot.
+type GroupStore interface {
+	// CheckExistingGroups returns the status of each of the given groups that is in any group table.
+	CheckExistingGroups(ctx context.Context, groupIDs []uint64) (map[uint64]enum.GroupType, error)
+	// GetGroupsByIDs retrieves the given groups from any group table.
+	GetGroupsByIDs(ctx context.Context, groupIDs []uint64, fields types.GroupFields) (map[uint64]*types.ReviewGroup, error)
+	// GetGroupToReview finds the next group to review, skipping the locked groups.
+	GetGroupToReview(
+		ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, reviewerID uint64,
+		lockedIDs []uint64, readOnly bool,
+	) (*types.ReviewGroup, error)
+	// GetGroupFlaggedMemberCounts counts the flagged members of a group by status.
+	GetGroupFlaggedMemberCounts(ctx context.Context, groupID uint64) (*types.GroupMemberCounts, error)
+	// ConfirmGroup confirms a group.
+	ConfirmGroup(ctx context.Context, group *types.ReviewGroup) error
+	// ClearGroup clears a group.
+	ClearGroup(ctx context.Context, group *types.ReviewGroup) error
+}
+
+// AppealStore defines the appeal operations used by the bot.
+type AppealStore interface {
+	// CreateAppeal submits a new appeal request.
+	CreateAppeal(ctx context.Context, appeal *types.Appeal, reason string) error
+	// AcceptAppeal marks an appeal as accepted.
+	AcceptAppeal(ctx context.Context, appealID int64, reviewerID uint64, reason string) error
+	// RejectAppeal marks an appeal as rejected. A reviewer ID of 0 marks a rejection by the system.
+	RejectAppeal(ctx context.Context, appealID int64, reviewerID uint64, reason string) error
+	// ClaimAppeal assigns a pending appeal to the reviewer.
+	ClaimAppeal(ctx context.Context, appealID int64, reviewerID uint64) error
+	// UnclaimAppeal releases the reviewer's claim on an appeal.
+	UnclaimAppeal(ctx context.Context, appealID int64, reviewerID uint64) error
+	// HasPendingAppealByRequester checks if a requester already has any pending appeals.
+	HasPendingAppealByRequester(ctx context.Context, requesterID uint64) (bool, error)
+	// GetAppealsByUserID gets the appeals for a user ID, including resolved ones if requested.
+	GetAppealsByUserID(ctx context.Context, userID uint64, includeResolved bool) ([]*types.Appeal, error)
+	// AnnotateDuplicateAppeal marks an appeal and the other appeals for the same user as duplicates.
+	AnnotateDuplicateAppeal(ctx context.Context, appeal *types.Appeal, duplicates []*types.Appeal) error
+	// HasRecentRejection checks if a user ID had an appeal rejected within the given window.
+	HasRecentRejection(ctx context.Context, userID uint64, window time.Duration) (bool, time.Time, error)
+	// GetAppealsToReview gets a page of appeals for reviewers.
+	GetAppealsToReview(
+		ctx context.Context, sortBy enum.AppealSortBy, statusFilter enum.AppealStatus, reviewerID uint64,
+		cursor *types.AppealTimeline, limit int,
+	) ([]*types.Appeal, *types.AppealTimeline, *types.AppealTimeline, error)
+	// GetAppealsByRequester gets a page of the appeals submitted by a user.
+	GetAppealsByRequester(
+		ctx context.Context, statusFilter enum.AppealStatus, requesterID uint64,
+		cursor *types.AppealTimeline, limit int,
+	) ([]*types.Appeal, *types.AppealTimeline, *types.AppealTimeline, error)
+	// GetAppealMessages gets the messages for an appeal.
+	GetAppealMessages(ctx context.Context, appealID int64) ([]*types.AppealMessage, error)
+	// GetAppealAttachments gets the images attached to the given messages, keyed by message ID.
+	GetAppealAttachments(ctx context.Context, messageIDs []int64) (map[int64]*types.AppealAttachment, error)
+	// AddAppealMessage adds a new message to an appeal.
+	AddAppealMessage(ctx context.Context, message *types.AppealMessage, appeal *types.Appeal, minInterval time.Duration) error
+	// GetResponseTimeStats calculates the response times of appeals submitted since the given time.
+	GetResponseTimeStats(ctx context.Context, since time.Time) (*types.AppealResponseTimeStats, error)
+	// GetRegressionStats calculates how often users of appeals accepted since the given time were flagged again.
+	GetRegressionStats(ctx context.Context, since time.Time) (*types.AppealRegressionStats, error)
+}
+
+// ActivityStore defines the activity log operations used by the bot.
+type ActivityStore interface {
+	// Log records an activity. Failures are logged instead of returned.
+	Log(ctx context.Context, log *types.ActivityLog)
+	// GetLogs retrieves a page of activity logs matching the filter.
+	GetLogs(
+		ctx context.Context, filter types.ActivityFilter, cursor *types.LogCursor, limit int,
+	) ([]*types.ActivityLog, *types.LogCursor, error)
+	// CountReviewerActivities counts the activities of the given types made by a reviewer since the given time.
+	CountReviewerActivities(ctx context.Context, reviewerID uint64, activityTypes []enum.ActivityType, since time.Time) (int, error)
+	// GetReviewerSummary counts the activities of a reviewer by type between start and end.
+	GetReviewerSummary(ctx context.Context, reviewerID uint64, start, end time.Time) ([]*types.ActivityTypeCount, error)
+	// GetDecisionDurations calculates the time spent per decision since the given time.
+	GetDecisionDurations(ctx context.Context, since time.Time) (*types.DecisionDurations, error)
+}
+
+// SettingStore defines the settings operations used by the bot.
+type SettingStore interface {
+	// SaveUserSettings saves the settings of a user.
+	SaveUserSettings(ctx context.Context, settings *types.UserSetting) error
+	// GetAnonymousLeaderboardUsers returns which of the given users appear anonymously on the leaderboard.
+	GetAnonymousLeaderboardUsers(ctx context.Context, userIDs []uint64) (map[uint64]bool, error)
+}
+
+// NoteStore defines the reviewer note operations used by the bot.
+type NoteStore interface {
+	// AddNote saves a new note on a user or group.
+	AddNote(ctx context.Context, note *types.ReviewNote) error
+	// GetNotes retrieves the latest notes of a user or group, newest first.
+	GetNotes(ctx context.Context, targetType enum.NoteTargetType, targetID uint64, limit int) ([]*types.ReviewNote, error)
+	// DeleteNote deletes a note of a user or group if the reviewer wrote it or is an admin.
+	DeleteNote(
+		ctx context.Context, targetType enum.NoteTargetType, targetID uint64, noteID int64, reviewerID uint64, isAdmin bool,
+	) (*types.ReviewNote, error)
+}
+
+// SecondOpinionStore defines the second opinion request operations used by the bot.
+type SecondOpinionStore interface {
+	// RequestSecondOpinion saves a request for another reviewer to decide on a user.
+	RequestSecondOpinion(ctx context.Context, opinion *types.SecondOpinion) error
+	// GetSecondOpinion retrieves the pending second opinion request on a user, or nil if there is none.
+	GetSecondOpinion(ctx context.Context, userID uint64) (*types.SecondOpinion, error)
+}
@@ -31,6 +31,12 @@ type LogCursor struct {
 	Sequence  int64
 }
 
+// ActivityTypeCount holds the number of logs of a single activity type.
+type ActivityTypeCount struct {
+	ActivityType enum.ActivityType `bun:"activity_type"`
+	Count        int               `bun:"count"`
+}
+
 // ActivityLog stores information about moderator actions.
 type ActivityLog struct {
 	Sequence          int64                  `bun:",pk,autoincrement"`
@@ -40,3 +46,40 @@ type ActivityLog struct {
 	ActivityTimestamp time.Time              `bun:",notnull,pk"`
 	Details           map[string]interface{} `bun:"type:jsonb"`
 }
+
+// Keys used in the details of review decision logs to measure review time.
+const (
+	DetailKeyReviewSeconds  = "review_seconds"
+	DetailKeyReasonCategory = "reason_category"
+	DetailKeyConfidence     = "confidence"
+)
+
+// MaxDecisionDuration is the longest review time included in decision duration
+// aggregates. Longer durations are treated as abandoned sessions.
+const MaxDecisionDuration = 30 * time.Minute
+
+// DecisionDurationStat holds review time percentiles for a single bucket.
+type DecisionDurationStat struct {
+	Label         string  `bun:"label"`
+	Count         int     `bun:"count"`
+	MedianSeconds float64 `bun:"median_seconds"`
+	P90Seconds    float64 `bun:"p90_seconds"`
+}
+
+// DecisionDurations holds review time statistics grouped by reason category
+// and by confidence decile.
+type DecisionDurations struct {
+	ByCategory   []*DecisionDurationStat
+	ByConfidence []*DecisionDurationStat
+}
+
+// ReviewerStats counts the review decisions a reviewer made in a period.
+// Accuracy is the reviewer's training vote accuracy for the same period and
+// is nil when they have not cast any verified votes.
+type ReviewerStats struct {
+	ReviewerID uint64   `bun:"reviewer_id"`
+	Confirms   int64    `bun:"confirms"`
+	Clears     int64    `bun:"clears"`
+	Skips      int64    `bun:"skips"`
+	Accuracy   *float64 `bun:"accuracy"`
+}
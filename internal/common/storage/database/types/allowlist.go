@@ -0,0 +1,11 @@
+package types
+
+import "time"
+
+// AllowlistedGroup represents a Roblox group that must never be flagged.
+type AllowlistedGroup struct {
+	ID      uint64    `bun:",pk"`        // Roblox group ID
+	Reason  string    `bun:",type:text"` // Why the group is allowlisted
+	AddedBy uint64    `bun:",notnull"`   // Discord ID of the admin who added the group
+	AddedAt time.Time `bun:",notnull"`   // When the group was added
+}
@@ -2,30 +2,91 @@ package types
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 )
 
 var (
-	ErrNoAppealsFound      = errors.New("no appeals found")
-	ErrInvalidAppealStatus = errors.New("invalid appeal status")
+	ErrAppealNotFound       = errors.New("appeal not found")
+	ErrInvalidAppealStatus  = errors.New("invalid appeal status")
+	ErrAppealAlreadyClaimed = errors.New("appeal is already claimed by another reviewer")
+	ErrAppealNotClaimed     = errors.New("appeal is not claimed by this reviewer")
+	ErrRateLimited          = errors.New("appeal messages are rate limited")
 )
 
+// RateLimitError is returned when a message is sent to an appeal too soon after the
+// sender's previous one. It matches ErrRateLimited with errors.Is and carries how
+// long to wait before sending again.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s (retry after %s)", ErrRateLimited, e.RetryAfter)
+}
+
+// Unwrap allows matching ErrRateLimited.
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
 // Appeal represents a user appeal request in the database.
 type Appeal struct {
-	ID           int64             `bun:",pk,autoincrement"` // Unique numeric identifier
-	UserID       uint64            `bun:",notnull"`          // The Roblox user ID being appealed
-	RequesterID  uint64            `bun:",notnull"`          // The Discord user ID who submitted the appeal
-	ReviewerID   uint64            `bun:",nullzero"`         // The Discord user ID who reviewed the appeal
-	ReviewedAt   time.Time         `bun:",nullzero"`         // When the appeal was reviewed
-	ReviewReason string            `bun:",nullzero"`         // The reason for accepting/rejecting the appeal
-	Status       enum.AppealStatus `bun:",notnull"`          // Status of the appeal (pending, accepted, rejected)
-	ClaimedBy    uint64            `bun:",nullzero"`         // Discord ID of reviewer who claimed the appeal
-	ClaimedAt    time.Time         `bun:",nullzero"`         // When the appeal was claimed
-	Timestamp    time.Time         `bun:"-"`                 // When the appeal was submitted
-	LastViewed   time.Time         `bun:"-"`                 // When the appeal was last viewed
-	LastActivity time.Time         `bun:"-"`                 // When the last message was sent
+	ID              int64             `bun:",pk,autoincrement"` // Unique numeric identifier
+	UserID          uint64            `bun:",notnull"`          // The Roblox user ID being appealed
+	RequesterID     uint64            `bun:",notnull"`          // The Discord user ID who submitted the appeal
+	ReviewerID      uint64            `bun:",nullzero"`         // The Discord user ID who reviewed the appeal
+	ReviewedAt      time.Time         `bun:",nullzero"`         // When the appeal was reviewed
+	ReviewReason    string            `bun:",nullzero"`         // The reason for accepting/rejecting the appeal
+	Status          enum.AppealStatus `bun:",notnull"`          // Status of the appeal (pending, accepted, rejected)
+	ClaimedBy       uint64            `bun:",nullzero"`         // Discord ID of reviewer who claimed the appeal
+	ClaimedAt       time.Time         `bun:",nullzero"`         // When the appeal was claimed
+	FirstResponseAt time.Time         `bun:",nullzero"`         // When a moderator first responded to the appeal
+	HasDuplicates   bool              `bun:",notnull"`          // Whether other requesters appealed the same user around the same time
+	Timestamp       time.Time         `bun:"-"`                 // When the appeal was submitted
+	LastViewed      time.Time         `bun:"-"`                 // When the appeal was last viewed
+	LastActivity    time.Time         `bun:"-"`                 // When the last message was sent
+	ClaimActivity   time.Time         `bun:"-"`                 // When the claimer last acted on the appeal
+}
+
+// FindDuplicateAppeals returns the appeals for the same user that were submitted by
+// other requesters and are either pending or were rejected after the given time.
+func FindDuplicateAppeals(appeals []*Appeal, requesterID uint64, rejectedSince time.Time) []*Appeal {
+	var duplicates []*Appeal
+	for _, appeal := range appeals {
+		if appeal.RequesterID == requesterID {
+			continue
+		}
+
+		pending := appeal.Status == enum.AppealStatusPending
+		recentlyRejected := appeal.Status == enum.AppealStatusRejected && appeal.ReviewedAt.After(rejectedSince)
+		if pending || recentlyRejected {
+			duplicates = append(duplicates, appeal)
+		}
+	}
+	return duplicates
+}
+
+// DuplicateAppealNote returns the system message added to a new appeal that lists
+// the other appeals for the same user.
+func DuplicateAppealNote(duplicates []*Appeal) string {
+	lines := make([]string, 0, len(duplicates)+1)
+	lines = append(lines, "🔁 This user was also appealed from other Discord accounts:")
+	for _, appeal := range duplicates {
+		lines = append(lines, fmt.Sprintf("- Appeal #%d by <@%d> (%s)",
+			appeal.ID, appeal.RequesterID, strings.ToLower(appeal.Status.String())))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DuplicateAppealBacklink returns the system message added to an earlier appeal
+// when the same user is appealed again by another requester.
+func DuplicateAppealBacklink(appeal *Appeal) string {
+	return fmt.Sprintf("🔁 This user was appealed again in appeal #%d by <@%d>.", appeal.ID, appeal.RequesterID)
 }
 
 // AppealTimeline represents the time-series data for appeals in the hypertable.
@@ -38,10 +99,80 @@ type AppealTimeline struct {
 
 // AppealMessage represents a message in an appeal conversation.
 type AppealMessage struct {
-	ID        int64            `bun:",pk,autoincrement"` // Unique identifier for the message
-	AppealID  int64            `bun:",notnull"`          // ID of the appeal this message belongs to
-	UserID    uint64           `bun:",notnull"`          // Discord ID of the message sender
-	Role      enum.MessageRole `bun:",notnull"`          // Role of the message sender
-	Content   string           `bun:",notnull"`          // Message content
-	CreatedAt time.Time        `bun:",notnull"`          // When the message was sent
+	ID            int64            `bun:",pk,autoincrement"` // Unique identifier for the message
+	AppealID      int64            `bun:",notnull"`          // ID of the appeal this message belongs to
+	UserID        uint64           `bun:",notnull"`          // Discord ID of the message sender
+	Role          enum.MessageRole `bun:",notnull"`          // Role of the message sender
+	Content       string           `bun:",notnull"`          // Message content
+	HasAttachment bool             `bun:",notnull"`          // Whether an image is attached to the message
+	CreatedAt     time.Time        `bun:",notnull"`          // When the message was sent
+
+	// Attachment is the image saved together with a new message. It is not loaded
+	// with the message and never stored in the session.
+	Attachment *AppealAttachment `bun:"-" json:"-"`
+}
+
+// AppealAttachment represents an image attached to an appeal message.
+type AppealAttachment struct {
+	MessageID   int64     `bun:",pk"`      // ID of the message the image is attached to
+	AppealID    int64     `bun:",notnull"` // ID of the appeal the message belongs to
+	Filename    string    `bun:",notnull"` // Original filename of the image
+	ContentType string    `bun:",notnull"` // MIME type of the image
+	Data        []byte    `bun:",notnull"` // Raw image bytes
+	CreatedAt   time.Time `bun:",notnull"` // When the image was attached
+}
+
+// AppealAttachmentExtensions maps the image types accepted as appeal attachments
+// to their file extensions.
+var AppealAttachmentExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/webp": ".webp",
+}
+
+// UploadName returns a filename for uploading the image that does not reveal
+// anything from its original filename.
+func (a *AppealAttachment) UploadName() string {
+	return fmt.Sprintf("appeal_attachment_%d%s", a.MessageID, AppealAttachmentExtensions[a.ContentType])
+}
+
+// AppealTimingStat holds time statistics for a single appeal response metric.
+type AppealTimingStat struct {
+	Count         int     `bun:"count"`
+	AvgSeconds    float64 `bun:"avg_seconds"`
+	MedianSeconds float64 `bun:"median_seconds"`
+	P95Seconds    float64 `bun:"p95_seconds"`
+}
+
+// AppealResponseTimeStats holds how long appeals waited for a first moderator
+// response and for a decision.
+type AppealResponseTimeStats struct {
+	FirstResponse AppealTimingStat
+	Resolution    AppealTimingStat
+}
+
+// AppealRegression records a user who was flagged again after their appeal was
+// accepted and they were cleared.
+type AppealRegression struct {
+	AppealID     int64     `bun:",pk"`      // The accepted appeal
+	UserID       uint64    `bun:",notnull"` // The Roblox user ID that was flagged again
+	ReviewerID   uint64    `bun:",notnull"` // The Discord user ID who accepted the appeal
+	DaysToReflag int       `bun:",notnull"` // Days between accepting the appeal and the new flag
+	ReflaggedAt  time.Time `bun:",notnull"` // When the user was flagged again
+}
+
+// AppealRegressionStats holds how many accepted appeals were followed by the
+// user being flagged again.
+type AppealRegressionStats struct {
+	AcceptedCount   int     `bun:"accepted_count"`
+	RegressedCount  int     `bun:"regressed_count"`
+	AvgDaysToReflag float64 `bun:"avg_days_to_reflag"`
+}
+
+// ReflagRate returns the share of accepted appeals whose user was flagged again.
+func (s *AppealRegressionStats) ReflagRate() float64 {
+	if s.AcceptedCount == 0 {
+		return 0
+	}
+	return float64(s.RegressedCount) / float64(s.AcceptedCount)
 }
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitError(t *testing.T) {
+	err := fmt.Errorf("failed to add message: %w", &RateLimitError{RetryAfter: 30 * time.Second})
+
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	var rateLimitErr *RateLimitError
+	require.ErrorAs(t, err, &rateLimitErr)
+	assert.Equal(t, 30*time.Second, rateLimitErr.RetryAfter)
+	assert.False(t, errors.Is(err, ErrAppealNotClaimed))
+}
+
+func TestAppealRegressionStatsReflagRate(t *testing.T) {
+	assert.Zero(t, (&AppealRegressionStats{}).ReflagRate())
+	assert.InDelta(t, 0.25, (&AppealRegressionStats{AcceptedCount: 8, RegressedCount: 2}).ReflagRate(), 1e-9)
+}
+
+func TestFindDuplicateAppeals(t *testing.T) {
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	appeals := []*Appeal{
+		{ID: 1, RequesterID: 100, Status: enum.AppealStatusPending},
+		{ID: 2, RequesterID: 200, Status: enum.AppealStatusPending},
+		{ID: 3, RequesterID: 300, Status: enum.AppealStatusRejected, ReviewedAt: now.Add(-24 * time.Hour)},
+		{ID: 4, RequesterID: 300, Status: enum.AppealStatusRejected, ReviewedAt: now.Add(-60 * 24 * time.Hour)},
+		{ID: 5, RequesterID: 400, Status: enum.AppealStatusAccepted, ReviewedAt: now.Add(-time.Hour)},
+	}
+
+	duplicates := FindDuplicateAppeals(appeals, 100, now.Add(-30*24*time.Hour))
+
+	ids := make([]int64, 0, len(duplicates))
+	for _, appeal := range duplicates {
+		ids = append(ids, appeal.ID)
+	}
+	assert.Equal(t, []int64{2, 3}, ids)
+	assert.Empty(t, FindDuplicateAppeals(appeals[:1], 100, now))
+}
+
+func TestDuplicateAppealMessages(t *testing.T) {
+	duplicates := []*Appeal{
+		{ID: 2, RequesterID: 200, Status: enum.AppealStatusPending},
+		{ID: 3, RequesterID: 300, Status: enum.AppealStatusRejected},
+	}
+
+	assert.Equal(t, "🔁 This user was also appealed from other Discord accounts:\n"+
+		"- Appeal #2 by <@200> (pending)\n"+
+		"- Appeal #3 by <@300> (rejected)", DuplicateAppealNote(duplicates))
+	assert.Equal(t, "🔁 This user was appealed again in appeal #7 by <@100>.",
+		DuplicateAppealBacklink(&Appeal{ID: 7, RequesterID: 100}))
+}
+
+func TestAppealAttachmentUploadName(t *testing.T) {
+	attachment := &AppealAttachment{MessageID: 42, Filename: "player123_chat.PNG", ContentType: "image/png"}
+	assert.Equal(t, "appeal_attachment_42.png", attachment.UploadName())
+
+	attachment = &AppealAttachment{MessageID: 7, Filename: "photo.jpeg", ContentType: "image/jpeg"}
+	assert.Equal(t, "appeal_attachment_7.jpg", attachment.UploadName())
+}
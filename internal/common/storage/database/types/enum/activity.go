@@ -64,4 +64,55 @@ const (
 	ActivityTypeDiscordUserBanned
 	// ActivityTypeDiscordUserUnbanned tracks when a Discord user is unbanned.
 	ActivityTypeDiscordUserUnbanned
+
+	// ActivityTypeGroupMembersQueued tracks when a moderator queues a confirmed group's tracked members for recheck.
+	ActivityTypeGroupMembersQueued
+
+	// ActivityTypeReadOnlyToggled tracks when an admin turns read-only mode on or off.
+	ActivityTypeReadOnlyToggled
+
+	// ActivityTypeAppealAcceptedReturned tracks when a moderator accepts an appeal
+	// but returns the user to the review queue instead of clearing them.
+	ActivityTypeAppealAcceptedReturned
+
+	// ActivityTypeUserPinned tracks when a moderator pins or unpins a cleared user.
+	ActivityTypeUserPinned
+
+	// ActivityTypeUserReflagged tracks when a moderator sends a cleared user back to review.
+	ActivityTypeUserReflagged
+
+	// ActivityTypeGroupAllowlisted tracks when an admin adds or removes a group from the allowlist.
+	ActivityTypeGroupAllowlisted
+
+	// ActivityTypeBotSettingUpdated tracks when an admin changes a bot setting.
+	ActivityTypeBotSettingUpdated
+
+	// ActivityTypeViewsRefreshed tracks when an admin force refreshes the leaderboard views.
+	ActivityTypeViewsRefreshed
+
+	// ActivityTypeUserNoteAdded tracks when a moderator adds a note to a user.
+	ActivityTypeUserNoteAdded
+	// ActivityTypeUserNoteDeleted tracks when a moderator deletes a note from a user.
+	ActivityTypeUserNoteDeleted
+	// ActivityTypeGroupNoteAdded tracks when a moderator adds a note to a group.
+	ActivityTypeGroupNoteAdded
+	// ActivityTypeGroupNoteDeleted tracks when a moderator deletes a note from a group.
+	ActivityTypeGroupNoteDeleted
+
+	// ActivityTypeUserSecondOpinionRequested tracks when a moderator asks another moderator to decide on a user.
+	ActivityTypeUserSecondOpinionRequested
+	// ActivityTypeUserSecondOpinionResolved tracks when a moderator decides on a user awaiting a second opinion.
+	ActivityTypeUserSecondOpinionResolved
+
+	// ActivityTypeGroupOwnerGroupsFlagged tracks when a moderator flags the other groups owned by a confirmed group's owner.
+	ActivityTypeGroupOwnerGroupsFlagged
+
+	// ActivityTypeUserSettingUpdated tracks when a user changes a setting that affects what others see.
+	ActivityTypeUserSettingUpdated
+
+	// ActivityTypeUserRestored tracks when an admin restores a deleted user to the flagged users.
+	ActivityTypeUserRestored
+
+	// ActivityTypeUserProtected tracks when an admin adds or removes a user from the protected accounts.
+	ActivityTypeUserProtected
 )
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _ActivityTypeName = "AllUserViewedUserLookupUserConfirmedUserConfirmedCustomUserClearedUserSkippedUserRecheckedUserTrainingUpvoteUserTrainingDownvoteUserDeletedGroupViewedGroupLookupGroupConfirmedGroupConfirmedCustomGroupClearedGroupSkippedGroupTrainingUpvoteGroupTrainingDownvoteGroupDeletedAppealSubmittedAppealSkippedAppealAcceptedAppealRejectedAppealClosedDiscordUserBannedDiscordUserUnbanned"
+const _ActivityTypeName = "AllUserViewedUserLookupUserConfirmedUserConfirmedCustomUserClearedUserSkippedUserRecheckedUserTrainingUpvoteUserTrainingDownvoteUserDeletedGroupViewedGroupLookupGroupConfirmedGroupConfirmedCustomGroupClearedGroupSkippedGroupTrainingUpvoteGroupTrainingDownvoteGroupDeletedAppealSubmittedAppealSkippedAppealAcceptedAppealRejectedAppealClosedDiscordUserBannedDiscordUserUnbannedGroupMembersQueuedReadOnlyToggledAppealAcceptedReturnedUserPinnedUserReflaggedGroupAllowlistedBotSettingUpdatedViewsRefreshedUserNoteAddedUserNoteDeletedGroupNoteAddedGroupNoteDeletedUserSecondOpinionRequestedUserSecondOpinionResolvedGroupOwnerGroupsFlaggedUserSettingUpdatedUserRestoredUserProtected"
 
-var _ActivityTypeIndex = [...]uint16{0, 3, 13, 23, 36, 55, 66, 77, 90, 108, 128, 139, 150, 161, 175, 195, 207, 219, 238, 259, 271, 286, 299, 313, 327, 339, 356, 375}
+var _ActivityTypeIndex = [...]uint16{0, 3, 13, 23, 36, 55, 66, 77, 90, 108, 128, 139, 150, 161, 175, 195, 207, 219, 238, 259, 271, 286, 299, 313, 327, 339, 356, 375, 393, 408, 430, 440, 453, 469, 486, 500, 513, 528, 542, 558, 584, 609, 632, 650, 662, 675}
 
-const _ActivityTypeLowerName = "alluservieweduserlookupuserconfirmeduserconfirmedcustomusercleareduserskippeduserrecheckedusertrainingupvoteusertrainingdownvoteuserdeletedgroupviewedgrouplookupgroupconfirmedgroupconfirmedcustomgroupclearedgroupskippedgrouptrainingupvotegrouptrainingdownvotegroupdeletedappealsubmittedappealskippedappealacceptedappealrejectedappealcloseddiscorduserbanneddiscorduserunbanned"
+const _ActivityTypeLowerName = "alluservieweduserlookupuserconfirmeduserconfirmedcustomusercleareduserskippeduserrecheckedusertrainingupvoteusertrainingdownvoteuserdeletedgroupviewedgrouplookupgroupconfirmedgroupconfirmedcustomgroupclearedgroupskippedgrouptrainingupvotegrouptrainingdownvotegroupdeletedappealsubmittedappealskippedappealacceptedappealrejectedappealcloseddiscorduserbanneddiscorduserunbannedgroupmembersqueuedreadonlytoggledappealacceptedreturneduserpinneduserreflaggedgroupallowlistedbotsettingupdatedviewsrefreshedusernoteaddedusernotedeletedgroupnoteaddedgroupnotedeletedusersecondopinionrequestedusersecondopinionresolvedgroupownergroupsflaggedusersettingupdateduserrestoreduserprotected"
 
 func (i ActivityType) String() string {
 	if i < 0 || i >= ActivityType(len(_ActivityTypeIndex)-1) {
@@ -51,9 +51,27 @@ func _ActivityTypeNoOp() {
 	_ = x[ActivityTypeAppealClosed-(24)]
 	_ = x[ActivityTypeDiscordUserBanned-(25)]
 	_ = x[ActivityTypeDiscordUserUnbanned-(26)]
+	_ = x[ActivityTypeGroupMembersQueued-(27)]
+	_ = x[ActivityTypeReadOnlyToggled-(28)]
+	_ = x[ActivityTypeAppealAcceptedReturned-(29)]
+	_ = x[ActivityTypeUserPinned-(30)]
+	_ = x[ActivityTypeUserReflagged-(31)]
+	_ = x[ActivityTypeGroupAllowlisted-(32)]
+	_ = x[ActivityTypeBotSettingUpdated-(33)]
+	_ = x[ActivityTypeViewsRefreshed-(34)]
+	_ = x[ActivityTypeUserNoteAdded-(35)]
+	_ = x[ActivityTypeUserNoteDeleted-(36)]
+	_ = x[ActivityTypeGroupNoteAdded-(37)]
+	_ = x[ActivityTypeGroupNoteDeleted-(38)]
+	_ = x[ActivityTypeUserSecondOpinionRequested-(39)]
+	_ = x[ActivityTypeUserSecondOpinionResolved-(40)]
+	_ = x[ActivityTypeGroupOwnerGroupsFlagged-(41)]
+	_ = x[ActivityTypeUserSettingUpdated-(42)]
+	_ = x[ActivityTypeUserRestored-(43)]
+	_ = x[ActivityTypeUserProtected-(44)]
 }
 
-var _ActivityTypeValues = []ActivityType{ActivityTypeAll, ActivityTypeUserViewed, ActivityTypeUserLookup, ActivityTypeUserConfirmed, ActivityTypeUserConfirmedCustom, ActivityTypeUserCleared, ActivityTypeUserSkipped, ActivityTypeUserRechecked, ActivityTypeUserTrainingUpvote, ActivityTypeUserTrainingDownvote, ActivityTypeUserDeleted, ActivityTypeGroupViewed, ActivityTypeGroupLookup, ActivityTypeGroupConfirmed, ActivityTypeGroupConfirmedCustom, ActivityTypeGroupCleared, ActivityTypeGroupSkipped, ActivityTypeGroupTrainingUpvote, ActivityTypeGroupTrainingDownvote, ActivityTypeGroupDeleted, ActivityTypeAppealSubmitted, ActivityTypeAppealSkipped, ActivityTypeAppealAccepted, ActivityTypeAppealRejected, ActivityTypeAppealClosed, ActivityTypeDiscordUserBanned, ActivityTypeDiscordUserUnbanned}
+var _ActivityTypeValues = []ActivityType{ActivityTypeAll, ActivityTypeUserViewed, ActivityTypeUserLookup, ActivityTypeUserConfirmed, ActivityTypeUserConfirmedCustom, ActivityTypeUserCleared, ActivityTypeUserSkipped, ActivityTypeUserRechecked, ActivityTypeUserTrainingUpvote, ActivityTypeUserTrainingDownvote, ActivityTypeUserDeleted, ActivityTypeGroupViewed, ActivityTypeGroupLookup, ActivityTypeGroupConfirmed, ActivityTypeGroupConfirmedCustom, ActivityTypeGroupCleared, ActivityTypeGroupSkipped, ActivityTypeGroupTrainingUpvote, ActivityTypeGroupTrainingDownvote, ActivityTypeGroupDeleted, ActivityTypeAppealSubmitted, ActivityTypeAppealSkipped, ActivityTypeAppealAccepted, ActivityTypeAppealRejected, ActivityTypeAppealClosed, ActivityTypeDiscordUserBanned, ActivityTypeDiscordUserUnbanned, ActivityTypeGroupMembersQueued, ActivityTypeReadOnlyToggled, ActivityTypeAppealAcceptedReturned, ActivityTypeUserPinned, ActivityTypeUserReflagged, ActivityTypeGroupAllowlisted, ActivityTypeBotSettingUpdated, ActivityTypeViewsRefreshed, ActivityTypeUserNoteAdded, ActivityTypeUserNoteDeleted, ActivityTypeGroupNoteAdded, ActivityTypeGroupNoteDeleted, ActivityTypeUserSecondOpinionRequested, ActivityTypeUserSecondOpinionResolved, ActivityTypeGroupOwnerGroupsFlagged, ActivityTypeUserSettingUpdated, ActivityTypeUserRestored, ActivityTypeUserProtected}
 
 var _ActivityTypeNameToValueMap = map[string]ActivityType{
 	_ActivityTypeName[0:3]:          ActivityTypeAll,
@@ -110,6 +128,42 @@ var _ActivityTypeNameToValueMap = map[string]ActivityType{
 	_ActivityTypeLowerName[339:356]: ActivityTypeDiscordUserBanned,
 	_ActivityTypeName[356:375]:      ActivityTypeDiscordUserUnbanned,
 	_ActivityTypeLowerName[356:375]: ActivityTypeDiscordUserUnbanned,
+	_ActivityTypeName[375:393]:      ActivityTypeGroupMembersQueued,
+	_ActivityTypeLowerName[375:393]: ActivityTypeGroupMembersQueued,
+	_ActivityTypeName[393:408]:      ActivityTypeReadOnlyToggled,
+	_ActivityTypeLowerName[393:408]: ActivityTypeReadOnlyToggled,
+	_ActivityTypeName[408:430]:      ActivityTypeAppealAcceptedReturned,
+	_ActivityTypeLowerName[408:430]: ActivityTypeAppealAcceptedReturned,
+	_ActivityTypeName[430:440]:      ActivityTypeUserPinned,
+	_ActivityTypeLowerName[430:440]: ActivityTypeUserPinned,
+	_ActivityTypeName[440:453]:      ActivityTypeUserReflagged,
+	_ActivityTypeLowerName[440:453]: ActivityTypeUserReflagged,
+	_ActivityTypeName[453:469]:      ActivityTypeGroupAllowlisted,
+	_ActivityTypeLowerName[453:469]: ActivityTypeGroupAllowlisted,
+	_ActivityTypeName[469:486]:      ActivityTypeBotSettingUpdated,
+	_ActivityTypeLowerName[469:486]: ActivityTypeBotSettingUpdated,
+	_ActivityTypeName[486:500]:      ActivityTypeViewsRefreshed,
+	_ActivityTypeLowerName[486:500]: ActivityTypeViewsRefreshed,
+	_ActivityTypeName[500:513]:      ActivityTypeUserNoteAdded,
+	_ActivityTypeLowerName[500:513]: ActivityTypeUserNoteAdded,
+	_ActivityTypeName[513:528]:      ActivityTypeUserNoteDeleted,
+	_ActivityTypeLowerName[513:528]: ActivityTypeUserNoteDeleted,
+	_ActivityTypeName[528:542]:      ActivityTypeGroupNoteAdded,
+	_ActivityTypeLowerName[528:542]: ActivityTypeGroupNoteAdded,
+	_ActivityTypeName[542:558]:      ActivityTypeGroupNoteDeleted,
+	_ActivityTypeLowerName[542:558]: ActivityTypeGroupNoteDeleted,
+	_ActivityTypeName[558:584]:      ActivityTypeUserSecondOpinionRequested,
+	_ActivityTypeLowerName[558:584]: ActivityTypeUserSecondOpinionRequested,
+	_ActivityTypeName[584:609]:      ActivityTypeUserSecondOpinionResolved,
+	_ActivityTypeLowerName[584:609]: ActivityTypeUserSecondOpinionResolved,
+	_ActivityTypeName[609:632]:      ActivityTypeGroupOwnerGroupsFlagged,
+	_ActivityTypeLowerName[609:632]: ActivityTypeGroupOwnerGroupsFlagged,
+	_ActivityTypeName[632:650]:      ActivityTypeUserSettingUpdated,
+	_ActivityTypeLowerName[632:650]: ActivityTypeUserSettingUpdated,
+	_ActivityTypeName[650:662]:      ActivityTypeUserRestored,
+	_ActivityTypeLowerName[650:662]: ActivityTypeUserRestored,
+	_ActivityTypeName[662:675]:      ActivityTypeUserProtected,
+	_ActivityTypeLowerName[662:675]: ActivityTypeUserProtected,
 }
 
 var _ActivityTypeNames = []string{
@@ -140,6 +194,24 @@ var _ActivityTypeNames = []string{
 	_ActivityTypeName[327:339],
 	_ActivityTypeName[339:356],
 	_ActivityTypeName[356:375],
+	_ActivityTypeName[375:393],
+	_ActivityTypeName[393:408],
+	_ActivityTypeName[408:430],
+	_ActivityTypeName[430:440],
+	_ActivityTypeName[440:453],
+	_ActivityTypeName[453:469],
+	_ActivityTypeName[469:486],
+	_ActivityTypeName[486:500],
+	_ActivityTypeName[500:513],
+	_ActivityTypeName[513:528],
+	_ActivityTypeName[528:542],
+	_ActivityTypeName[542:558],
+	_ActivityTypeName[558:584],
+	_ActivityTypeName[584:609],
+	_ActivityTypeName[609:632],
+	_ActivityTypeName[632:650],
+	_ActivityTypeName[650:662],
+	_ActivityTypeName[662:675],
 }
 
 // ActivityTypeString retrieves an enum value from the enum constants string name.
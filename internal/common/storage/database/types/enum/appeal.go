@@ -12,6 +12,8 @@ const (
 	AppealSortByOldest
 	// AppealSortByClaimed orders appeals by claimed status and last activity.
 	AppealSortByClaimed
+	// AppealSortByUnclaimed orders unclaimed appeals by last activity, newest first.
+	AppealSortByUnclaimed
 )
 
 // AppealStatus represents the status of an appeal.
@@ -33,4 +35,6 @@ type MessageRole int
 const (
 	MessageRoleUser MessageRole = iota
 	MessageRoleModerator
+	// MessageRoleSystem marks notes added automatically that only reviewers can see.
+	MessageRoleSystem
 )
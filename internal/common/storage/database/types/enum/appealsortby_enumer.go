@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _AppealSortByName = "NewestOldestClaimed"
+const _AppealSortByName = "NewestOldestClaimedUnclaimed"
 
-var _AppealSortByIndex = [...]uint8{0, 6, 12, 19}
+var _AppealSortByIndex = [...]uint8{0, 6, 12, 19, 28}
 
-const _AppealSortByLowerName = "newestoldestclaimed"
+const _AppealSortByLowerName = "newestoldestclaimedunclaimed"
 
 func (i AppealSortBy) String() string {
 	if i < 0 || i >= AppealSortBy(len(_AppealSortByIndex)-1) {
@@ -27,9 +27,10 @@ func _AppealSortByNoOp() {
 	_ = x[AppealSortByNewest-(0)]
 	_ = x[AppealSortByOldest-(1)]
 	_ = x[AppealSortByClaimed-(2)]
+	_ = x[AppealSortByUnclaimed-(3)]
 }
 
-var _AppealSortByValues = []AppealSortBy{AppealSortByNewest, AppealSortByOldest, AppealSortByClaimed}
+var _AppealSortByValues = []AppealSortBy{AppealSortByNewest, AppealSortByOldest, AppealSortByClaimed, AppealSortByUnclaimed}
 
 var _AppealSortByNameToValueMap = map[string]AppealSortBy{
 	_AppealSortByName[0:6]:        AppealSortByNewest,
@@ -38,12 +39,15 @@ var _AppealSortByNameToValueMap = map[string]AppealSortBy{
 	_AppealSortByLowerName[6:12]:  AppealSortByOldest,
 	_AppealSortByName[12:19]:      AppealSortByClaimed,
 	_AppealSortByLowerName[12:19]: AppealSortByClaimed,
+	_AppealSortByName[19:28]:      AppealSortByUnclaimed,
+	_AppealSortByLowerName[19:28]: AppealSortByUnclaimed,
 }
 
 var _AppealSortByNames = []string{
 	_AppealSortByName[0:6],
 	_AppealSortByName[6:12],
 	_AppealSortByName[12:19],
+	_AppealSortByName[19:28],
 }
 
 // AppealSortByString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,90 @@
+// Code generated by "enumer -type=FlagSource -trimprefix=FlagSource"; DO NOT EDIT.
+
+package enum
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _FlagSourceName = "UnknownAIFriendGroupManualRecheck"
+
+var _FlagSourceIndex = [...]uint8{0, 7, 9, 15, 20, 33}
+
+const _FlagSourceLowerName = "unknownaifriendgroupmanualrecheck"
+
+func (i FlagSource) String() string {
+	if i < 0 || i >= FlagSource(len(_FlagSourceIndex)-1) {
+		return fmt.Sprintf("FlagSource(%d)", i)
+	}
+	return _FlagSourceName[_FlagSourceIndex[i]:_FlagSourceIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _FlagSourceNoOp() {
+	var x [1]struct{}
+	_ = x[FlagSourceUnknown-(0)]
+	_ = x[FlagSourceAI-(1)]
+	_ = x[FlagSourceFriend-(2)]
+	_ = x[FlagSourceGroup-(3)]
+	_ = x[FlagSourceManualRecheck-(4)]
+}
+
+var _FlagSourceValues = []FlagSource{FlagSourceUnknown, FlagSourceAI, FlagSourceFriend, FlagSourceGroup, FlagSourceManualRecheck}
+
+var _FlagSourceNameToValueMap = map[string]FlagSource{
+	_FlagSourceName[0:7]:        FlagSourceUnknown,
+	_FlagSourceLowerName[0:7]:   FlagSourceUnknown,
+	_FlagSourceName[7:9]:        FlagSourceAI,
+	_FlagSourceLowerName[7:9]:   FlagSourceAI,
+	_FlagSourceName[9:15]:       FlagSourceFriend,
+	_FlagSourceLowerName[9:15]:  FlagSourceFriend,
+	_FlagSourceName[15:20]:      FlagSourceGroup,
+	_FlagSourceLowerName[15:20]: FlagSourceGroup,
+	_FlagSourceName[20:33]:      FlagSourceManualRecheck,
+	_FlagSourceLowerName[20:33]: FlagSourceManualRecheck,
+}
+
+var _FlagSourceNames = []string{
+	_FlagSourceName[0:7],
+	_FlagSourceName[7:9],
+	_FlagSourceName[9:15],
+	_FlagSourceName[15:20],
+	_FlagSourceName[20:33],
+}
+
+// FlagSourceString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func FlagSourceString(s string) (FlagSource, error) {
+	if val, ok := _FlagSourceNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _FlagSourceNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to FlagSource values", s)
+}
+
+// FlagSourceValues returns all values of the enum
+func FlagSourceValues() []FlagSource {
+	return _FlagSourceValues
+}
+
+// FlagSourceStrings returns a slice of all String values of the enum
+func FlagSourceStrings() []string {
+	strs := make([]string, len(_FlagSourceNames))
+	copy(strs, _FlagSourceNames)
+	return strs
+}
+
+// IsAFlagSource returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i FlagSource) IsAFlagSource() bool {
+	for _, v := range _FlagSourceValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
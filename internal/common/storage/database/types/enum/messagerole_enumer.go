@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _MessageRoleName = "UserModerator"
+const _MessageRoleName = "UserModeratorSystem"
 
-var _MessageRoleIndex = [...]uint8{0, 4, 13}
+var _MessageRoleIndex = [...]uint8{0, 4, 13, 19}
 
-const _MessageRoleLowerName = "usermoderator"
+const _MessageRoleLowerName = "usermoderatorsystem"
 
 func (i MessageRole) String() string {
 	if i < 0 || i >= MessageRole(len(_MessageRoleIndex)-1) {
@@ -26,20 +26,24 @@ func _MessageRoleNoOp() {
 	var x [1]struct{}
 	_ = x[MessageRoleUser-(0)]
 	_ = x[MessageRoleModerator-(1)]
+	_ = x[MessageRoleSystem-(2)]
 }
 
-var _MessageRoleValues = []MessageRole{MessageRoleUser, MessageRoleModerator}
+var _MessageRoleValues = []MessageRole{MessageRoleUser, MessageRoleModerator, MessageRoleSystem}
 
 var _MessageRoleNameToValueMap = map[string]MessageRole{
-	_MessageRoleName[0:4]:       MessageRoleUser,
-	_MessageRoleLowerName[0:4]:  MessageRoleUser,
-	_MessageRoleName[4:13]:      MessageRoleModerator,
-	_MessageRoleLowerName[4:13]: MessageRoleModerator,
+	_MessageRoleName[0:4]:        MessageRoleUser,
+	_MessageRoleLowerName[0:4]:   MessageRoleUser,
+	_MessageRoleName[4:13]:       MessageRoleModerator,
+	_MessageRoleLowerName[4:13]:  MessageRoleModerator,
+	_MessageRoleName[13:19]:      MessageRoleSystem,
+	_MessageRoleLowerName[13:19]: MessageRoleSystem,
 }
 
 var _MessageRoleNames = []string{
 	_MessageRoleName[0:4],
 	_MessageRoleName[4:13],
+	_MessageRoleName[13:19],
 }
 
 // MessageRoleString retrieves an enum value from the enum constants string name.
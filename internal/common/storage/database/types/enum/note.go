@@ -0,0 +1,11 @@
+package enum
+
+// NoteTargetType represents the type of entity a reviewer note is attached to.
+//
+//go:generate enumer -type=NoteTargetType -trimprefix=NoteTargetType
+type NoteTargetType int
+
+const (
+	NoteTargetTypeUser NoteTargetType = iota
+	NoteTargetTypeGroup
+)
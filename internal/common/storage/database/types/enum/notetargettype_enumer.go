@@ -0,0 +1,78 @@
+// Code generated by "enumer -type=NoteTargetType -trimprefix=NoteTargetType"; DO NOT EDIT.
+
+package enum
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _NoteTargetTypeName = "UserGroup"
+
+var _NoteTargetTypeIndex = [...]uint8{0, 4, 9}
+
+const _NoteTargetTypeLowerName = "usergroup"
+
+func (i NoteTargetType) String() string {
+	if i < 0 || i >= NoteTargetType(len(_NoteTargetTypeIndex)-1) {
+		return fmt.Sprintf("NoteTargetType(%d)", i)
+	}
+	return _NoteTargetTypeName[_NoteTargetTypeIndex[i]:_NoteTargetTypeIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _NoteTargetTypeNoOp() {
+	var x [1]struct{}
+	_ = x[NoteTargetTypeUser-(0)]
+	_ = x[NoteTargetTypeGroup-(1)]
+}
+
+var _NoteTargetTypeValues = []NoteTargetType{NoteTargetTypeUser, NoteTargetTypeGroup}
+
+var _NoteTargetTypeNameToValueMap = map[string]NoteTargetType{
+	_NoteTargetTypeName[0:4]:      NoteTargetTypeUser,
+	_NoteTargetTypeLowerName[0:4]: NoteTargetTypeUser,
+	_NoteTargetTypeName[4:9]:      NoteTargetTypeGroup,
+	_NoteTargetTypeLowerName[4:9]: NoteTargetTypeGroup,
+}
+
+var _NoteTargetTypeNames = []string{
+	_NoteTargetTypeName[0:4],
+	_NoteTargetTypeName[4:9],
+}
+
+// NoteTargetTypeString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func NoteTargetTypeString(s string) (NoteTargetType, error) {
+	if val, ok := _NoteTargetTypeNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _NoteTargetTypeNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to NoteTargetType values", s)
+}
+
+// NoteTargetTypeValues returns all values of the enum
+func NoteTargetTypeValues() []NoteTargetType {
+	return _NoteTargetTypeValues
+}
+
+// NoteTargetTypeStrings returns a slice of all String values of the enum
+func NoteTargetTypeStrings() []string {
+	strs := make([]string, len(_NoteTargetTypeNames))
+	copy(strs, _NoteTargetTypeNames)
+	return strs
+}
+
+// IsANoteTargetType returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i NoteTargetType) IsANoteTargetType() bool {
+	for _, v := range _NoteTargetTypeValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
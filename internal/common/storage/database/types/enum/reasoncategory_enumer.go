@@ -0,0 +1,90 @@
+// Code generated by "enumer -type=ReasonCategory -trimprefix=ReasonCategory"; DO NOT EDIT.
+
+package enum
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _ReasonCategoryName = "OtherFriendGroupContentMultiple"
+
+var _ReasonCategoryIndex = [...]uint8{0, 5, 11, 16, 23, 31}
+
+const _ReasonCategoryLowerName = "otherfriendgroupcontentmultiple"
+
+func (i ReasonCategory) String() string {
+	if i < 0 || i >= ReasonCategory(len(_ReasonCategoryIndex)-1) {
+		return fmt.Sprintf("ReasonCategory(%d)", i)
+	}
+	return _ReasonCategoryName[_ReasonCategoryIndex[i]:_ReasonCategoryIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _ReasonCategoryNoOp() {
+	var x [1]struct{}
+	_ = x[ReasonCategoryOther-(0)]
+	_ = x[ReasonCategoryFriend-(1)]
+	_ = x[ReasonCategoryGroup-(2)]
+	_ = x[ReasonCategoryContent-(3)]
+	_ = x[ReasonCategoryMultiple-(4)]
+}
+
+var _ReasonCategoryValues = []ReasonCategory{ReasonCategoryOther, ReasonCategoryFriend, ReasonCategoryGroup, ReasonCategoryContent, ReasonCategoryMultiple}
+
+var _ReasonCategoryNameToValueMap = map[string]ReasonCategory{
+	_ReasonCategoryName[0:5]:        ReasonCategoryOther,
+	_ReasonCategoryLowerName[0:5]:   ReasonCategoryOther,
+	_ReasonCategoryName[5:11]:       ReasonCategoryFriend,
+	_ReasonCategoryLowerName[5:11]:  ReasonCategoryFriend,
+	_ReasonCategoryName[11:16]:      ReasonCategoryGroup,
+	_ReasonCategoryLowerName[11:16]: ReasonCategoryGroup,
+	_ReasonCategoryName[16:23]:      ReasonCategoryContent,
+	_ReasonCategoryLowerName[16:23]: ReasonCategoryContent,
+	_ReasonCategoryName[23:31]:      ReasonCategoryMultiple,
+	_ReasonCategoryLowerName[23:31]: ReasonCategoryMultiple,
+}
+
+var _ReasonCategoryNames = []string{
+	_ReasonCategoryName[0:5],
+	_ReasonCategoryName[5:11],
+	_ReasonCategoryName[11:16],
+	_ReasonCategoryName[16:23],
+	_ReasonCategoryName[23:31],
+}
+
+// ReasonCategoryString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ReasonCategoryString(s string) (ReasonCategory, error) {
+	if val, ok := _ReasonCategoryNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _ReasonCategoryNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to ReasonCategory values", s)
+}
+
+// ReasonCategoryValues returns all values of the enum
+func ReasonCategoryValues() []ReasonCategory {
+	return _ReasonCategoryValues
+}
+
+// ReasonCategoryStrings returns a slice of all String values of the enum
+func ReasonCategoryStrings() []string {
+	strs := make([]string, len(_ReasonCategoryNames))
+	copy(strs, _ReasonCategoryNames)
+	return strs
+}
+
+// IsAReasonCategory returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i ReasonCategory) IsAReasonCategory() bool {
+	for _, v := range _ReasonCategoryValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
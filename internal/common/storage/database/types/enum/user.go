@@ -17,3 +17,40 @@ const (
 	// UserTypeUnflagged indicates a user was not found in the database.
 	UserTypeUnflagged
 )
+
+// ReasonCategory represents the kind of check that flagged a user.
+//
+//go:generate enumer -type=ReasonCategory -trimprefix=ReasonCategory
+type ReasonCategory int
+
+const (
+	// ReasonCategoryOther is used for custom reasons and users flagged before categories were stored.
+	ReasonCategoryOther ReasonCategory = iota
+	// ReasonCategoryFriend indicates a user was flagged for their friend network.
+	ReasonCategoryFriend
+	// ReasonCategoryGroup indicates a user was flagged for their group memberships.
+	ReasonCategoryGroup
+	// ReasonCategoryContent indicates a user was flagged by AI analysis of their content.
+	ReasonCategoryContent
+	// ReasonCategoryMultiple indicates a user was flagged by more than one check.
+	ReasonCategoryMultiple
+)
+
+// FlagSource represents the checker that first flagged a user, used to measure
+// how often each checker's flags are confirmed by reviewers.
+//
+//go:generate enumer -type=FlagSource -trimprefix=FlagSource
+type FlagSource int
+
+const (
+	// FlagSourceUnknown is used for users flagged before flag sources were stored.
+	FlagSourceUnknown FlagSource = iota
+	// FlagSourceAI indicates a user was flagged by AI analysis of their content.
+	FlagSourceAI
+	// FlagSourceFriend indicates a user was flagged by the friend checker.
+	FlagSourceFriend
+	// FlagSourceGroup indicates a user was flagged by the group checker.
+	FlagSourceGroup
+	// FlagSourceManualRecheck indicates a user was flagged after a moderator queued them for a recheck.
+	FlagSourceManualRecheck
+)
@@ -10,10 +10,15 @@ import (
 )
 
 var (
-	ErrGroupNotFound    = errors.New("group not found")
-	ErrNoGroupsToReview = errors.New("no groups available to review")
+	ErrGroupNotFound       = errors.New("group not found")
+	ErrNoGroupsToReview    = errors.New("no groups available to review")
+	ErrNoGroupsToScan      = errors.New("no groups available to scan")
+	ErrSearchQueryTooShort = errors.New("search query is too short")
 )
 
+// MinSearchQueryLength is the minimum number of characters required for a search query.
+const MinSearchQueryLength = 3
+
 // Group combines all the information needed to review a group.
 type Group struct {
 	ID                  uint64            `bun:",pk"        json:"id"`
@@ -67,6 +72,38 @@ type ReviewGroup struct {
 	Reputation *Reputation    `json:"reputation"`
 }
 
+// GroupMemberCounts breaks down how many of a group's tracked members are
+// confirmed or flagged in the database.
+type GroupMemberCounts struct {
+	Tracked   int `bun:"tracked"`
+	Confirmed int `bun:"confirmed"`
+	Flagged   int `bun:"flagged"`
+}
+
+// GroupSearchCursor represents a pagination cursor for group search results.
+type GroupSearchCursor struct {
+	ID     uint64         `json:"id"`
+	Status enum.GroupType `json:"status"`
+}
+
+// GroupSearchResult contains the summary of a group matching a search query.
+type GroupSearchResult struct {
+	ID             uint64         `bun:"id"              json:"id"`
+	Name           string         `bun:"name"            json:"name"`
+	Reason         string         `bun:"reason"          json:"reason"`
+	Confidence     float64        `bun:"confidence"      json:"confidence"`
+	Status         enum.GroupType `bun:"status"          json:"status"`
+	FlaggedMembers int            `bun:"flagged_members" json:"flaggedMembers"`
+}
+
+// OwnedGroup is a group owned by the owner of a reviewed group, with its status in
+// the database. Groups that are not in the database have the unflagged status.
+type OwnedGroup struct {
+	ID     uint64         `json:"id"`
+	Name   string         `json:"name"`
+	Status enum.GroupType `json:"status"`
+}
+
 // GroupFields represents the fields that can be requested when fetching groups.
 type GroupFields struct {
 	// Basic group information
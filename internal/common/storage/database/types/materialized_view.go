@@ -1,9 +1,22 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+)
 
 // MaterializedViewRefresh tracks when materialized views were last refreshed
 type MaterializedViewRefresh struct {
 	ViewName    string    `bun:",pk"      json:"viewName"`
 	LastRefresh time.Time `bun:",notnull" json:"lastRefresh"`
 }
+
+// ViewRefreshResult describes the outcome of refreshing one materialized view.
+// Skipped is set when the view was already being refreshed elsewhere.
+type ViewRefreshResult struct {
+	ViewName string
+	Period   enum.LeaderboardPeriod
+	Duration time.Duration
+	Skipped  bool
+}
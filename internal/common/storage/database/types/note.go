@@ -0,0 +1,30 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/uptrace/bun"
+)
+
+var (
+	ErrNoteNotFound  = errors.New("note not found")
+	ErrNotNoteAuthor = errors.New("note was written by another reviewer")
+)
+
+// MaxNoteLength is the longest note a reviewer can leave on a user or group.
+const MaxNoteLength = 500
+
+// ReviewNote represents a note left by a reviewer on a user or group. Notes are
+// keyed by the target's ID so they are kept when the target changes status.
+type ReviewNote struct {
+	bun.BaseModel `bun:"table:review_notes"`
+
+	ID         int64               `bun:",pk,autoincrement"  json:"id"`         // Unique identifier of the note
+	TargetType enum.NoteTargetType `bun:",notnull"           json:"targetType"` // Whether the note is on a user or group
+	TargetID   uint64              `bun:",notnull"           json:"targetId"`   // ID of the user or group
+	AuthorID   uint64              `bun:",notnull"           json:"authorId"`   // Discord ID of the reviewer who wrote the note
+	Content    string              `bun:",notnull,type:text" json:"content"`    // Body of the note
+	CreatedAt  time.Time           `bun:",notnull"           json:"createdAt"`  // When the note was written
+}
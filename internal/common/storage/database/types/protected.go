@@ -0,0 +1,17 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUserProtected is returned when a protected account would be confirmed.
+var ErrUserProtected = errors.New("user is a protected account")
+
+// ProtectedAccount represents a Roblox account that must never be flagged.
+type ProtectedAccount struct {
+	ID      uint64    `bun:",pk"`        // Roblox user ID
+	Note    string    `bun:",type:text"` // Why the account is protected
+	AddedBy uint64    `bun:",notnull"`   // Discord ID of the admin who added the account
+	AddedAt time.Time `bun:",notnull"`   // When the account was added
+}
@@ -2,13 +2,23 @@ package types
 
 import "time"
 
+const (
+	// VoteDecayAge is how old a vote must be before it counts at reduced weight.
+	VoteDecayAge = 90 * 24 * time.Hour
+	// DecayedVoteWeight is how much a vote older than VoteDecayAge counts toward the decayed score.
+	DecayedVoteWeight = 0.5
+)
+
 // Reputation tracks voting data for users and groups.
+// Score is the exact difference between upvotes and downvotes, while DecayedScore
+// counts older votes at reduced weight and is only used to prioritize reviews.
 type Reputation struct {
-	ID        uint64    `bun:",pk"      json:"id"`
-	Upvotes   int32     `bun:",notnull" json:"upvotes"`
-	Downvotes int32     `bun:",notnull" json:"downvotes"`
-	Score     int32     `bun:",notnull" json:"score"`
-	UpdatedAt time.Time `bun:",notnull" json:"updatedAt"`
+	ID           uint64    `bun:",pk"                json:"id"`
+	Upvotes      int32     `bun:",notnull"           json:"upvotes"`
+	Downvotes    int32     `bun:",notnull"           json:"downvotes"`
+	Score        int32     `bun:",notnull"           json:"score"`
+	DecayedScore float64   `bun:",notnull,default:0" json:"decayedScore"`
+	UpdatedAt    time.Time `bun:",notnull"           json:"updatedAt"`
 }
 
 // UserReputation tracks voting data for users.
@@ -20,3 +30,16 @@ type UserReputation struct {
 type GroupReputation struct {
 	Reputation `bun:"embed"`
 }
+
+// VoteTally sums the votes on a target, split by whether they are older than VoteDecayAge.
+// Upvotes count as 1 and downvotes as -1.
+type VoteTally struct {
+	ID          uint64 `bun:"id"`
+	RecentScore int32  `bun:"recent_score"`
+	OldScore    int32  `bun:"old_score"`
+}
+
+// DecayedScore returns the score with old votes counted at DecayedVoteWeight.
+func (t *VoteTally) DecayedScore() float64 {
+	return float64(t.RecentScore) + float64(t.OldScore)*DecayedVoteWeight
+}
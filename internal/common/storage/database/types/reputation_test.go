@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoteTallyDecayedScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		tally VoteTally
+		want  float64
+	}{
+		{
+			name:  "no votes",
+			tally: VoteTally{},
+			want:  0,
+		},
+		{
+			name:  "recent votes count fully",
+			tally: VoteTally{RecentScore: -6},
+			want:  -6,
+		},
+		{
+			name:  "old votes count at half weight",
+			tally: VoteTally{OldScore: -10},
+			want:  -5,
+		},
+		{
+			name:  "recent and old votes combined",
+			tally: VoteTally{RecentScore: 3, OldScore: -4},
+			want:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.tally.DecayedScore(), 0.0001)
+		})
+	}
+}
+
+func TestVoteTallyDecayOrdering(t *testing.T) {
+	// A user flagged long ago with many downvotes is reviewed first while the votes are recent
+	oldUser := VoteTally{ID: 1, RecentScore: -10}
+	recentUser := VoteTally{ID: 2, RecentScore: -6}
+	assert.Less(t, oldUser.DecayedScore(), recentUser.DecayedScore())
+
+	// Once those votes pass VoteDecayAge it falls behind the moderately downvoted recent user
+	oldUser = VoteTally{ID: 1, OldScore: -10}
+	assert.Greater(t, oldUser.DecayedScore(), recentUser.DecayedScore())
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// ErrSecondOpinionRequested is returned when another reviewer already asked for
+// a second opinion on a user.
+var ErrSecondOpinionRequested = errors.New("second opinion was already requested by another reviewer")
+
+// MaxSecondOpinionNoteLength is the longest note a reviewer can leave when asking for a second opinion.
+const MaxSecondOpinionNoteLength = 200
+
+// SecondOpinion represents a reviewer asking for another reviewer to decide on a user.
+// The requester is not served the user again until the request is resolved.
+type SecondOpinion struct {
+	bun.BaseModel `bun:"table:user_second_opinions"`
+
+	UserID      uint64    `bun:",pk"                json:"userId"`      // ID of the user to decide on
+	RequestedBy uint64    `bun:",notnull"           json:"requestedBy"` // Discord ID of the reviewer asking
+	Note        string    `bun:",notnull,type:text" json:"note"`        // Optional context for the next reviewer
+	RequestedAt time.Time `bun:",notnull"           json:"requestedAt"` // When the second opinion was requested
+}
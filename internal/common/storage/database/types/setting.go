@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -71,19 +73,91 @@ func (c *CaptchaUsage) ResetReviews() {
 
 // UserSetting stores user-specific preferences.
 type UserSetting struct {
-	UserID             snowflake.ID           `bun:",pk"`
-	StreamerMode       bool                   `bun:",notnull"`
-	UserDefaultSort    enum.ReviewSortBy      `bun:",notnull"`
-	GroupDefaultSort   enum.ReviewSortBy      `bun:",notnull"`
-	AppealDefaultSort  enum.AppealSortBy      `bun:",notnull"`
-	AppealStatusFilter enum.AppealStatus      `bun:",notnull"`
-	ChatModel          enum.ChatModel         `bun:",notnull"`
-	ReviewMode         enum.ReviewMode        `bun:",notnull"`
-	ReviewTargetMode   enum.ReviewTargetMode  `bun:",notnull"`
-	ChatMessageUsage   ChatMessageUsage       `bun:",embed"`
-	SkipUsage          SkipUsage              `bun:",embed"`
-	CaptchaUsage       CaptchaUsage           `bun:",embed"`
-	LeaderboardPeriod  enum.LeaderboardPeriod `bun:",notnull"`
+	UserID                    snowflake.ID           `bun:",pk"`
+	StreamerMode              bool                   `bun:",notnull"`
+	UserDefaultSort           enum.ReviewSortBy      `bun:",notnull"`
+	GroupDefaultSort          enum.ReviewSortBy      `bun:",notnull"`
+	AppealDefaultSort         enum.AppealSortBy      `bun:",notnull"`
+	AppealStatusFilter        enum.AppealStatus      `bun:",notnull"`
+	ChatModel                 enum.ChatModel         `bun:",notnull"`
+	ReviewMode                enum.ReviewMode        `bun:",notnull"`
+	ReviewTargetMode          enum.ReviewTargetMode  `bun:",notnull"`
+	ReviewConfidenceThreshold float64                `bun:",notnull,default:0"`
+	ChatMessageUsage          ChatMessageUsage       `bun:",embed"`
+	SkipUsage                 SkipUsage              `bun:",embed"`
+	CaptchaUsage              CaptchaUsage           `bun:",embed"`
+	LeaderboardPeriod         enum.LeaderboardPeriod `bun:",notnull"`
+	LeaderboardAnonymous      bool                   `bun:",notnull,default:false"`
+	ReasonPresets             []ReasonPreset         `bun:"reason_presets,type:jsonb"`
+	UIState                   json.RawMessage        `bun:"ui_state,type:jsonb"`
+}
+
+// LoadUIState decodes the persisted UI state. Missing, oversized, outdated or
+// malformed state falls back to an empty state so menus use their defaults.
+func (s *UserSetting) LoadUIState() *UIState {
+	state := &UIState{}
+	if len(s.UIState) == 0 || len(s.UIState) > MaxUIStateSize {
+		return state
+	}
+
+	if err := json.Unmarshal(s.UIState, state); err != nil || state.Version != UIStateVersion {
+		return &UIState{}
+	}
+
+	return state
+}
+
+// StoreUIState encodes the UI state with the current version. The stored state
+// is left unchanged if the encoded state is larger than MaxUIStateSize.
+func (s *UserSetting) StoreUIState(state *UIState) error {
+	state.Version = UIStateVersion
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode UI state: %w", err)
+	}
+
+	if len(data) > MaxUIStateSize {
+		return ErrUIStateTooLarge
+	}
+
+	s.UIState = data
+	return nil
+}
+
+const (
+	// UIStateVersion is the current version of the persisted UI state format.
+	// State saved with any other version is discarded when loaded.
+	UIStateVersion = 1
+	// MaxUIStateSize caps the encoded size of the persisted UI state in bytes.
+	MaxUIStateSize = 2048
+)
+
+// ErrUIStateTooLarge indicates that the encoded UI state exceeds MaxUIStateSize.
+var ErrUIStateTooLarge = errors.New("UI state is too large")
+
+// UIState stores non-sensitive menu state that is restored when a new session
+// does not have its own values yet.
+type UIState struct {
+	Version    int             `json:"version"`
+	LogFilters *LogFilterState `json:"logFilters,omitempty"`
+}
+
+// LogFilterState stores the filters selected in the log menu.
+type LogFilterState struct {
+	DiscordID    uint64            `json:"discordId,omitempty"`
+	UserID       uint64            `json:"userId,omitempty"`
+	GroupID      uint64            `json:"groupId,omitempty"`
+	ReviewerID   uint64            `json:"reviewerId,omitempty"`
+	ActivityType enum.ActivityType `json:"activityType"`
+	StartDate    time.Time         `json:"startDate"`
+	EndDate      time.Time         `json:"endDate"`
+}
+
+// ReasonPreset stores a named confirm reason template.
+type ReasonPreset struct {
+	Name     string `json:"name"`     // Display name shown in the preset menu
+	Template string `json:"template"` // Reason text with optional placeholders
 }
 
 // Announcement stores the dashboard announcement configuration.
@@ -101,17 +175,24 @@ type APIKeyInfo struct {
 
 // BotSetting stores bot-wide configuration options.
 type BotSetting struct {
-	ID             uint64                 `bun:",pk,autoincrement"`
-	ReviewerIDs    []uint64               `bun:"reviewer_ids,type:bigint[]"`
-	AdminIDs       []uint64               `bun:"admin_ids,type:bigint[]"`
-	SessionLimit   uint64                 `bun:",notnull"`
-	WelcomeMessage string                 `bun:",notnull,default:''"`
-	Announcement   Announcement           `bun:",embed"`
-	APIKeys        []APIKeyInfo           `bun:"api_keys,type:jsonb"`
-	reviewerMap    map[uint64]struct{}    // In-memory map for O(1) lookups
-	adminMap       map[uint64]struct{}    // In-memory map for O(1) lookups
-	apiKeyMap      map[string]*APIKeyInfo // In-memory map for O(1) lookups
-	lastRefresh    time.Time
+	ID                    uint64                 `bun:",pk,autoincrement"`
+	ReviewerIDs           []uint64               `bun:"reviewer_ids,type:bigint[]"`
+	AdminIDs              []uint64               `bun:"admin_ids,type:bigint[]"`
+	SessionLimit          uint64                 `bun:",notnull"`
+	AppealStaleDays       uint64                 `bun:",notnull,default:7"`
+	AppealCooldownDays    uint64                 `bun:",notnull,default:7"`
+	NotificationChannelID uint64                 `bun:",notnull,default:0"`
+	MaxPinnedUsers        uint64                 `bun:",notnull,default:25"`
+	WelcomeMessage        string                 `bun:",notnull,default:''"`
+	Announcement          Announcement           `bun:",embed"`
+	APIKeys               []APIKeyInfo           `bun:"api_keys,type:jsonb"`
+	AllowedGuildIDs       []uint64               `bun:"allowed_guild_ids,type:bigint[]"`
+	AllowDMs              bool                   `bun:",notnull,default:true"`
+	reviewerMap           map[uint64]struct{}    // In-memory map for O(1) lookups
+	adminMap              map[uint64]struct{}    // In-memory map for O(1) lookups
+	guildMap              map[uint64]struct{}    // In-memory map for O(1) lookups
+	apiKeyMap             map[string]*APIKeyInfo // In-memory map for O(1) lookups
+	lastRefresh           time.Time
 }
 
 // IsAdmin checks if the given user ID is in the admin list.
@@ -140,6 +221,29 @@ func (s *BotSetting) IsReviewer(userID uint64) bool {
 	return exists
 }
 
+// IsGuildAllowed checks if interactions from the given guild are allowed. A nil
+// guild ID means a direct message, which is allowed only when AllowDMs is set.
+// Every guild is allowed while the guild allowlist is empty.
+func (s *BotSetting) IsGuildAllowed(guildID *snowflake.ID) bool {
+	if guildID == nil {
+		return s.AllowDMs
+	}
+
+	if len(s.AllowedGuildIDs) == 0 {
+		return true
+	}
+
+	if s.guildMap == nil || len(s.AllowedGuildIDs) != len(s.guildMap) {
+		s.guildMap = make(map[uint64]struct{}, len(s.AllowedGuildIDs))
+		for _, id := range s.AllowedGuildIDs {
+			s.guildMap[id] = struct{}{}
+		}
+	}
+
+	_, exists := s.guildMap[uint64(*guildID)]
+	return exists
+}
+
 // IsAPIKey checks if the given key is valid.
 func (s *BotSetting) IsAPIKey(key string) (*APIKeyInfo, bool) {
 	if s.apiKeyMap == nil || len(s.APIKeys) != len(s.apiKeyMap) {
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSettingLoadUIState(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  json.RawMessage
+		want *LogFilterState
+	}{
+		{
+			name: "no saved state",
+			raw:  nil,
+			want: nil,
+		},
+		{
+			name: "malformed state",
+			raw:  json.RawMessage(`{"version":1,"logFilters":`),
+			want: nil,
+		},
+		{
+			name: "outdated version",
+			raw:  json.RawMessage(`{"version":0,"logFilters":{"userId":5}}`),
+			want: nil,
+		},
+		{
+			name: "oversized state",
+			raw:  json.RawMessage(`{"version":1,"padding":"` + strings.Repeat("a", MaxUIStateSize) + `"}`),
+			want: nil,
+		},
+		{
+			name: "valid state",
+			raw:  json.RawMessage(`{"version":1,"logFilters":{"userId":5,"activityType":0}}`),
+			want: &LogFilterState{UserID: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &UserSetting{UIState: tt.raw}
+			assert.Equal(t, tt.want, settings.LoadUIState().LogFilters)
+		})
+	}
+}
+
+func TestUserSettingStoreUIState(t *testing.T) {
+	filters := &LogFilterState{
+		GroupID:      42,
+		ActivityType: enum.ActivityTypeGroupConfirmed,
+		StartDate:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:      time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	settings := &UserSetting{}
+	require.NoError(t, settings.StoreUIState(&UIState{LogFilters: filters}))
+
+	got := settings.LoadUIState()
+	assert.Equal(t, UIStateVersion, got.Version)
+	assert.Equal(t, filters, got.LogFilters)
+
+}
+
+func TestBotSettingIsGuildAllowed(t *testing.T) {
+	allowed := snowflake.ID(100)
+	other := snowflake.ID(200)
+
+	tests := []struct {
+		name     string
+		settings *BotSetting
+		guildID  *snowflake.ID
+		want     bool
+	}{
+		{
+			name:     "empty allowlist allows any guild",
+			settings: &BotSetting{},
+			guildID:  &other,
+			want:     true,
+		},
+		{
+			name:     "allowlisted guild",
+			settings: &BotSetting{AllowedGuildIDs: []uint64{100}},
+			guildID:  &allowed,
+			want:     true,
+		},
+		{
+			name:     "guild not in allowlist",
+			settings: &BotSetting{AllowedGuildIDs: []uint64{100}},
+			guildID:  &other,
+			want:     false,
+		},
+		{
+			name:     "direct messages allowed",
+			settings: &BotSetting{AllowedGuildIDs: []uint64{100}, AllowDMs: true},
+			guildID:  nil,
+			want:     true,
+		},
+		{
+			name:     "direct messages disallowed",
+			settings: &BotSetting{AllowDMs: false},
+			guildID:  nil,
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.settings.IsGuildAllowed(tt.guildID))
+		})
+	}
+}
+
+func TestBotSettingIsGuildAllowedAfterUpdate(t *testing.T) {
+	guildID := snowflake.ID(300)
+	settings := &BotSetting{AllowedGuildIDs: []uint64{100}}
+	assert.False(t, settings.IsGuildAllowed(&guildID))
+
+	// The lookup map is rebuilt when the allowlist changes
+	settings.AllowedGuildIDs = append(settings.AllowedGuildIDs, 300)
+	assert.True(t, settings.IsGuildAllowed(&guildID))
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// MaxShoutHistory is the number of recent shouts kept for each group.
+const MaxShoutHistory = 20
+
+// GroupShoutHistory represents a shout that was seen on a group.
+type GroupShoutHistory struct {
+	bun.BaseModel `bun:"table:group_shout_history"`
+
+	ID         int64     `bun:",pk,autoincrement"`            // Unique identifier of the entry
+	GroupID    uint64    `bun:",notnull,unique:group_posted"` // Roblox group ID
+	Content    string    `bun:",notnull,type:text"`           // Body of the shout
+	PostedAt   time.Time `bun:",notnull,unique:group_posted"` // When the shout was posted
+	RecordedAt time.Time `bun:",notnull"`                     // When the shout was first seen
+}
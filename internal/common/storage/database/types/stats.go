@@ -1,18 +1,74 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+)
 
 // HourlyStats stores cumulative statistics for each hour.
 type HourlyStats struct {
-	Timestamp       time.Time `bun:",pk"      json:"timestamp"`
-	UsersConfirmed  int64     `bun:",notnull" json:"usersConfirmed"`
-	UsersFlagged    int64     `bun:",notnull" json:"usersFlagged"`
-	UsersCleared    int64     `bun:",notnull" json:"usersCleared"`
-	UsersBanned     int64     `bun:",notnull" json:"usersBanned"`
-	GroupsConfirmed int64     `bun:",notnull" json:"groupsConfirmed"`
-	GroupsFlagged   int64     `bun:",notnull" json:"groupsFlagged"`
-	GroupsCleared   int64     `bun:",notnull" json:"groupsCleared"`
-	GroupsLocked    int64     `bun:",notnull" json:"groupsLocked"`
+	Timestamp       time.Time `bun:",pk"                    json:"timestamp"`
+	UsersConfirmed  int64     `bun:",notnull"               json:"usersConfirmed"`
+	UsersFlagged    int64     `bun:",notnull"               json:"usersFlagged"`
+	UsersCleared    int64     `bun:",notnull"               json:"usersCleared"`
+	UsersBanned     int64     `bun:",notnull"               json:"usersBanned"`
+	GroupsConfirmed int64     `bun:",notnull"               json:"groupsConfirmed"`
+	GroupsFlagged   int64     `bun:",notnull"               json:"groupsFlagged"`
+	GroupsCleared   int64     `bun:",notnull"               json:"groupsCleared"`
+	GroupsLocked    int64     `bun:",notnull"               json:"groupsLocked"`
+	Backfilled      bool      `bun:",notnull,default:false" json:"backfilled"`
+
+	// Cleared users and groups removed by the retention policy during the hour
+	UsersPurgedCleared  int64 `bun:",notnull,default:0" json:"usersPurgedCleared"`
+	GroupsPurgedCleared int64 `bun:",notnull,default:0" json:"groupsPurgedCleared"`
+}
+
+// DailyStats is the rollup of the hourly statistics of a single UTC day.
+type DailyStats struct {
+	Date            time.Time `json:"date"`
+	UsersConfirmed  int64     `json:"usersConfirmed"`
+	UsersFlagged    int64     `json:"usersFlagged"`
+	UsersCleared    int64     `json:"usersCleared"`
+	UsersBanned     int64     `json:"usersBanned"`
+	GroupsConfirmed int64     `json:"groupsConfirmed"`
+	GroupsFlagged   int64     `json:"groupsFlagged"`
+	GroupsCleared   int64     `json:"groupsCleared"`
+	GroupsLocked    int64     `json:"groupsLocked"`
+	Hours           int       `json:"hours"` // Number of hourly snapshots in the day
+
+	// Cleared users and groups removed by the retention policy during the day
+	UsersPurgedCleared  int64 `json:"usersPurgedCleared"`
+	GroupsPurgedCleared int64 `json:"groupsPurgedCleared"`
+}
+
+// RollupDailyStats groups hourly statistics sorted oldest first into UTC days.
+// The user and group counts of a day come from its last snapshot, since each
+// snapshot holds totals and the banned and locked counts already cover the day
+// so far. Purge counts are recorded per hour, so they are summed instead.
+func RollupDailyStats(hourly []*HourlyStats) []*DailyStats {
+	days := make([]*DailyStats, 0)
+	for _, hour := range hourly {
+		date := hour.Timestamp.UTC().Truncate(24 * time.Hour)
+		if len(days) == 0 || !days[len(days)-1].Date.Equal(date) {
+			days = append(days, &DailyStats{Date: date})
+		}
+
+		day := days[len(days)-1]
+		day.UsersConfirmed = hour.UsersConfirmed
+		day.UsersFlagged = hour.UsersFlagged
+		day.UsersCleared = hour.UsersCleared
+		day.UsersBanned = hour.UsersBanned
+		day.GroupsConfirmed = hour.GroupsConfirmed
+		day.GroupsFlagged = hour.GroupsFlagged
+		day.GroupsCleared = hour.GroupsCleared
+		day.GroupsLocked = hour.GroupsLocked
+		day.UsersPurgedCleared += hour.UsersPurgedCleared
+		day.GroupsPurgedCleared += hour.GroupsPurgedCleared
+		day.Hours++
+	}
+
+	return days
 }
 
 // UserCounts holds all user-related statistics.
@@ -30,3 +86,32 @@ type GroupCounts struct {
 	Cleared   int
 	Locked    int
 }
+
+// ReasonCategoryCount holds the number of flagged and confirmed users of a single reason category.
+type ReasonCategoryCount struct {
+	ReasonCategory enum.ReasonCategory `bun:"reason_category"`
+	Count          int                 `bun:"count"`
+}
+
+// CheckerPrecision holds how many users flagged by a single checker were
+// confirmed or cleared by reviewers.
+type CheckerPrecision struct {
+	FlagSource enum.FlagSource `bun:"flag_source"`
+	Confirmed  int             `bun:"confirmed"`
+	Cleared    int             `bun:"cleared"`
+}
+
+// ConfirmRate returns the share of the checker's reviewed flags that were confirmed.
+func (p *CheckerPrecision) ConfirmRate() float64 {
+	total := p.Confirmed + p.Cleared
+	if total == 0 {
+		return 0
+	}
+	return float64(p.Confirmed) / float64(total)
+}
+
+// FlagGenerationCount holds the number of flagged users at a single flag generation.
+type FlagGenerationCount struct {
+	FlagGeneration int `bun:"flag_generation"`
+	Count          int `bun:"count"`
+}
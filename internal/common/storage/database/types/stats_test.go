@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupDailyStats(t *testing.T) {
+	day := time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC)
+	hourly := []*HourlyStats{
+		{Timestamp: day.Add(22 * time.Hour), UsersFlagged: 10, UsersConfirmed: 5, UsersBanned: 1, UsersPurgedCleared: 2},
+		{Timestamp: day.Add(23 * time.Hour), UsersFlagged: 12, UsersConfirmed: 6, UsersBanned: 3, UsersPurgedCleared: 1},
+		{Timestamp: day.Add(24 * time.Hour), UsersFlagged: 11, UsersConfirmed: 8, GroupsLocked: 2, GroupsPurgedCleared: 4},
+	}
+
+	days := RollupDailyStats(hourly)
+	require.Len(t, days, 2)
+
+	assert.Equal(t, &DailyStats{
+		Date:               day,
+		UsersConfirmed:     6,
+		UsersFlagged:       12,
+		UsersBanned:        3,
+		UsersPurgedCleared: 3,
+		Hours:              2,
+	}, days[0], "counts come from the last snapshot and purges are summed")
+
+	assert.Equal(t, &DailyStats{
+		Date:                day.AddDate(0, 0, 1),
+		UsersConfirmed:      8,
+		UsersFlagged:        11,
+		GroupsLocked:        2,
+		GroupsPurgedCleared: 4,
+		Hours:               1,
+	}, days[1])
+
+	assert.Empty(t, RollupDailyStats(nil))
+}
+
+func TestCheckerPrecisionConfirmRate(t *testing.T) {
+	assert.Zero(t, (&CheckerPrecision{}).ConfirmRate())
+	assert.InDelta(t, 0.75, (&CheckerPrecision{Confirmed: 6, Cleared: 2}).ConfirmRate(), 1e-9)
+}
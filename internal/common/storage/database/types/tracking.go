@@ -11,3 +11,17 @@ type GroupMemberTracking struct {
 	LastChecked  time.Time `bun:",notnull"`
 	IsFlagged    bool      `bun:",notnull"`
 }
+
+// NetworkClusterInfo describes how closely the confirmed and flagged friends
+// of a user are connected to each other through their stored friend lists.
+type NetworkClusterInfo struct {
+	FriendCount    int     // Confirmed and flagged friends of the user
+	CheckedCount   int     // Friends that were compared, limited to MaxClusterFriends
+	ConnectedCount int     // Checked friends that are friends with another checked friend
+	Connections    int     // Pairs of checked friends that are friends with each other
+	Density        float64 // Share of all possible pairs among checked friends that are connected
+}
+
+// MaxClusterFriends limits how many friends are compared pairwise so users with
+// hundreds of flagged friends do not cause a quadratic number of comparisons.
+const MaxClusterFriends = 50
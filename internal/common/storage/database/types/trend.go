@@ -0,0 +1,12 @@
+package types
+
+import "time"
+
+// TrendingTerm represents a term that is appearing more often in recently flagged content.
+type TrendingTerm struct {
+	Term          string    `bun:",pk"`      // Normalized term
+	CurrentCount  int       `bun:",notnull"` // Occurrences in the current period
+	PreviousCount int       `bun:",notnull"` // Occurrences in the previous period
+	Change        float64   `bun:",notnull"` // Percentage change from the previous period
+	UpdatedAt     time.Time `bun:",notnull"` // When the terms were last computed
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// AIUsageStats stores the daily token usage of an AI model. Usage from workers
+// is stored with a UserID of 0, while chat usage is attributed to the Discord
+// user who sent the messages.
+type AIUsageStats struct {
+	bun.BaseModel `bun:"table:ai_usage_stats"`
+
+	Date             time.Time `bun:",pk,type:date"` // Day the usage was recorded on
+	Model            string    `bun:",pk"`           // Name of the model used
+	UserID           uint64    `bun:",pk"`           // Discord user ID, or 0 for workers
+	PromptTokens     int64     `bun:",notnull"`      // Tokens sent to the model
+	CompletionTokens int64     `bun:",notnull"`      // Tokens generated by the model
+	EstimatedCost    float64   `bun:",notnull"`      // Estimated cost in USD
+	UpdatedAt        time.Time `bun:",notnull"`      // When the usage was last added to
+}
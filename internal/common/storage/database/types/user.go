@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,7 +13,25 @@ import (
 var (
 	ErrUserNotFound     = errors.New("user not found")
 	ErrNoUsersToReview  = errors.New("no users available to review")
+	ErrNoUsersToScan    = errors.New("no users available to scan")
 	ErrUnsupportedModel = errors.New("unsupported model type")
+	ErrUserNotCleared   = errors.New("user is not cleared")
+	ErrPinLimitReached  = errors.New("pinned user limit reached")
+	ErrUserNotDeleted   = errors.New("user is not in the deleted users archive")
+	ErrUserExists       = errors.New("user is already in the database")
+	ErrUserFlagged      = errors.New("user is already flagged")
+)
+
+const (
+	// ClearedUserExpiryWarning is how long before being purged that cleared users
+	// are included in the expiry digest.
+	ClearedUserExpiryWarning = 7 * 24 * time.Hour
+	// ReasonSeparator separates the sections of a reason written by different sources.
+	ReasonSeparator = "\n\n"
+	// MaxPreviousNames is how many earlier usernames are kept for renamed users.
+	MaxPreviousNames = 5
+	// OutfitContentPrefix marks flagged content that was found in an outfit name.
+	OutfitContentPrefix = "outfit:"
 )
 
 // ExtendedFriend contains additional user information beyond the basic Friend type.
@@ -29,15 +48,20 @@ type User struct {
 	ID                  uint64                  `bun:",pk"        json:"id"`
 	UUID                uuid.UUID               `bun:",notnull"   json:"uuid"`
 	Name                string                  `bun:",notnull"   json:"name"`
+	PreviousNames       []string                `bun:",array"     json:"previousNames"`
 	DisplayName         string                  `bun:",notnull"   json:"displayName"`
 	Description         string                  `bun:",notnull"   json:"description"`
 	CreatedAt           time.Time               `bun:",notnull"   json:"createdAt"`
 	Reason              string                  `bun:",notnull"   json:"reason"`
+	ReasonCategory      enum.ReasonCategory     `bun:",notnull"   json:"reasonCategory"`
+	FlagGeneration      int                     `bun:",notnull"   json:"flagGeneration"`
+	FlagSource          enum.FlagSource         `bun:",notnull"   json:"flagSource"`
 	Groups              []*types.UserGroupRoles `bun:"type:jsonb" json:"groups"`
 	Outfits             []types.Outfit          `bun:"type:jsonb" json:"outfits"`
 	Friends             []ExtendedFriend        `bun:"type:jsonb" json:"friends"`
 	Games               []*types.Game           `bun:"type:jsonb" json:"games"`
 	FlaggedContent      []string                `bun:"type:jsonb" json:"flaggedContent"`
+	DecisiveContent     []string                `bun:"type:jsonb" json:"decisiveContent"`
 	FollowerCount       uint64                  `bun:",notnull"   json:"followerCount"`
 	FollowingCount      uint64                  `bun:",notnull"   json:"followingCount"`
 	Confidence          float64                 `bun:",notnull"   json:"confidence"`
@@ -49,6 +73,123 @@ type User struct {
 	LastThumbnailUpdate time.Time               `bun:",notnull"   json:"lastThumbnailUpdate"`
 }
 
+// SortedFlaggedContent returns the flagged content with items marked as decisive
+// moved to the front, along with the number of leading decisive items. Content
+// without any decisive marks is returned in its original order.
+func (u *User) SortedFlaggedContent() ([]string, int) {
+	if len(u.DecisiveContent) == 0 {
+		return u.FlaggedContent, 0
+	}
+
+	decisive := make(map[string]struct{}, len(u.DecisiveContent))
+	for _, item := range u.DecisiveContent {
+		decisive[item] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(u.FlaggedContent))
+	rest := make([]string, 0, len(u.FlaggedContent))
+	for _, item := range u.FlaggedContent {
+		if _, ok := decisive[item]; ok {
+			sorted = append(sorted, item)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	decisiveCount := len(sorted)
+
+	return append(sorted, rest...), decisiveCount
+}
+
+// SplitFlaggedContent returns a flagged content item without its source prefix,
+// and whether the item was found in one of the user's outfit names.
+func SplitFlaggedContent(item string) (string, bool) {
+	if content, found := strings.CutPrefix(item, OutfitContentPrefix); found {
+		return content, true
+	}
+	return item, false
+}
+
+// GroupOwnerRank is the role rank Roblox gives to the owner of a group.
+const GroupOwnerRank = 255
+
+// IsGroupOwner reports whether the user owns the group, either by holding the
+// owner rank or by being listed as the owner of the group.
+func IsGroupOwner(userID uint64, group *types.UserGroupRoles) bool {
+	if group.Role.Rank == GroupOwnerRank {
+		return true
+	}
+	return group.Group.Owner != nil && group.Group.Owner.UserID == userID
+}
+
+// MergeReason combines the reason of an already stored flag into this user so
+// that re-flagging by another checker does not discard the earlier findings.
+// The highest confidence of the two is kept, and the category becomes multiple
+// when the flags came from different categories. A flag that is no longer purely
+// friend-based goes back to generation 0. The flag stays attributed to the
+// checker that flagged the user first.
+func (u *User) MergeReason(existing *User) {
+	if existing.FlagSource != enum.FlagSourceUnknown {
+		u.FlagSource = existing.FlagSource
+	}
+
+	if existing.Reason == "" {
+		return
+	}
+
+	if u.Reason != "" && u.ReasonCategory != existing.ReasonCategory {
+		u.ReasonCategory = enum.ReasonCategoryMultiple
+		u.FlagGeneration = 0
+	} else if u.Reason == "" {
+		u.ReasonCategory = existing.ReasonCategory
+		u.FlagGeneration = existing.FlagGeneration
+	}
+	u.Reason = MergeReasons(existing.Reason, u.Reason)
+	u.Confidence = max(u.Confidence, existing.Confidence)
+}
+
+// SplitReason splits a reason into its sections, one per source or note.
+func SplitReason(reason string) []string {
+	var sections []string
+	for _, section := range strings.Split(reason, ReasonSeparator) {
+		if section = strings.TrimSpace(section); section != "" {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+// MergeReasons appends the sections of the incoming reason to the existing one.
+// A section from a known source such as "AI Analysis: " replaces the existing
+// section from that source, and identical sections are only kept once.
+func MergeReasons(existing, incoming string) string {
+	sections := SplitReason(existing)
+	for _, section := range SplitReason(incoming) {
+		source := reasonSource(section)
+		merged := false
+		for i, current := range sections {
+			if current == section || (source != "" && reasonSource(current) == source) {
+				sections[i] = section
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			sections = append(sections, section)
+		}
+	}
+	return strings.Join(sections, ReasonSeparator)
+}
+
+// reasonSource returns the source prefix of a reason section written by one of
+// the checkers, or an empty string for other sections such as reviewer notes.
+func reasonSource(section string) string {
+	prefix, _, found := strings.Cut(section, ": ")
+	if !found || strings.Contains(prefix, "\n") || !strings.HasSuffix(prefix, " Analysis") {
+		return ""
+	}
+	return prefix
+}
+
 // FlaggedUser extends User to track users that need review.
 // The base User structure contains all the fields needed for review.
 type FlaggedUser struct {
@@ -64,9 +205,11 @@ type ConfirmedUser struct {
 
 // ClearedUser extends User to track users that were cleared during review.
 // The ClearedAt field shows when the user was cleared by a moderator.
+// Pinned users are kept as reference cases and are never purged.
 type ClearedUser struct {
 	User      `json:"user"`
-	ClearedAt time.Time `bun:",notnull" json:"clearedAt"`
+	ClearedAt time.Time `bun:",notnull"               json:"clearedAt"`
+	Pinned    bool      `bun:",notnull,default:false" json:"pinned"`
 }
 
 // BannedUser extends User to track users that were banned and removed.
@@ -76,14 +219,33 @@ type BannedUser struct {
 	PurgedAt time.Time `bun:",notnull" json:"purgedAt"`
 }
 
+// DeletedUser extends User to archive users removed by an admin so that a
+// mistaken deletion can be restored. Deleted users are not part of any review
+// or scan and are purged permanently after the retention period.
+type DeletedUser struct {
+	User      `json:"user"`
+	DeletedAt time.Time `bun:",notnull" json:"deletedAt"`
+	DeletedBy uint64    `bun:",notnull" json:"deletedBy"`
+}
+
 // ReviewUser combines all possible user states into a single structure for review.
 type ReviewUser struct {
-	User       `json:"user"`
-	VerifiedAt time.Time     `json:"verifiedAt,omitempty"`
-	ClearedAt  time.Time     `json:"clearedAt,omitempty"`
-	PurgedAt   time.Time     `json:"purgedAt,omitempty"`
-	Status     enum.UserType `json:"status"`
-	Reputation *Reputation   `json:"reputation"`
+	User        `json:"user"`
+	VerifiedAt  time.Time     `json:"verifiedAt,omitempty"`
+	ClearedAt   time.Time     `json:"clearedAt,omitempty"`
+	PurgedAt    time.Time     `json:"purgedAt,omitempty"`
+	Status      enum.UserType `json:"status"`
+	Reputation  *Reputation   `json:"reputation"`
+	IsProtected bool          `json:"isProtected"`
+	IsPinned    bool          `json:"isPinned"`
+}
+
+// UserFollowCounts holds the follow counts and games of a user that change often
+// enough to be refreshed without rescanning the user.
+type UserFollowCounts struct {
+	FollowerCount  uint64
+	FollowingCount uint64
+	Games          []*types.Game
 }
 
 // UserFields represents the fields that can be requested when fetching users.
@@ -91,7 +253,7 @@ type UserFields struct {
 	// Basic user information
 	Basic       bool // ID, Name, DisplayName
 	Description bool // Description
-	Reason      bool // Reason for flagging
+	Reason      bool // Reason for flagging, its category, flag generation and flag source
 	CreatedAt   bool // Account creation date
 	Thumbnail   bool // ThumbnailURL
 
@@ -124,7 +286,7 @@ func (f UserFields) Columns() []string {
 		columns = append(columns, "description")
 	}
 	if f.Reason {
-		columns = append(columns, "reason")
+		columns = append(columns, "reason", "reason_category", "flag_generation", "flag_source")
 	}
 	if f.CreatedAt {
 		columns = append(columns, "created_at")
@@ -145,7 +307,7 @@ func (f UserFields) Columns() []string {
 		columns = append(columns, "games")
 	}
 	if f.Content {
-		columns = append(columns, "flagged_content")
+		columns = append(columns, "flagged_content", "decisive_content")
 	}
 	if f.Followers {
 		columns = append(columns, "follower_count", "following_count")
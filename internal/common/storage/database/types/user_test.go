@@ -0,0 +1,250 @@
+package types
+
+import (
+	"testing"
+
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedFlaggedContent(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      []string
+		decisive     []string
+		want         []string
+		wantDecisive int
+	}{
+		{
+			name:         "no content",
+			content:      nil,
+			decisive:     nil,
+			want:         nil,
+			wantDecisive: 0,
+		},
+		{
+			name:         "old data without marks is unchanged",
+			content:      []string{"first", "second", "third"},
+			decisive:     nil,
+			want:         []string{"first", "second", "third"},
+			wantDecisive: 0,
+		},
+		{
+			name:         "decisive items move to the front in original order",
+			content:      []string{"first", "second", "third", "fourth"},
+			decisive:     []string{"fourth", "second"},
+			want:         []string{"second", "fourth", "first", "third"},
+			wantDecisive: 2,
+		},
+		{
+			name:         "marks for content no longer present are ignored",
+			content:      []string{"first", "second"},
+			decisive:     []string{"removed", "second"},
+			want:         []string{"second", "first"},
+			wantDecisive: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &User{FlaggedContent: tt.content, DecisiveContent: tt.decisive}
+			got, decisiveCount := user.SortedFlaggedContent()
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDecisive, decisiveCount)
+		})
+	}
+}
+
+func TestMergeReasons(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		incoming string
+		want     string
+	}{
+		{
+			name:     "empty existing reason",
+			existing: "",
+			incoming: "AI Analysis: inappropriate description",
+			want:     "AI Analysis: inappropriate description",
+		},
+		{
+			name:     "different sources are appended",
+			existing: "Friend Analysis: many flagged friends",
+			incoming: "AI Analysis: inappropriate description",
+			want:     "Friend Analysis: many flagged friends\n\nAI Analysis: inappropriate description",
+		},
+		{
+			name:     "same source is replaced in place",
+			existing: "Friend Analysis: many flagged friends\n\nAI Analysis: old finding",
+			incoming: "AI Analysis: new finding",
+			want:     "Friend Analysis: many flagged friends\n\nAI Analysis: new finding",
+		},
+		{
+			name:     "identical sections are kept once",
+			existing: "⚠️ **WARNING: Popular user**\n\nGroup Analysis: member of groups",
+			incoming: "⚠️ **WARNING: Popular user**\n\nGroup Analysis: member of groups",
+			want:     "⚠️ **WARNING: Popular user**\n\nGroup Analysis: member of groups",
+		},
+		{
+			name:     "notes without a source are appended",
+			existing: "AI Analysis: inappropriate description",
+			incoming: "Returned to review: appeal rejected",
+			want:     "AI Analysis: inappropriate description\n\nReturned to review: appeal rejected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MergeReasons(tt.existing, tt.incoming))
+		})
+	}
+}
+
+func TestUserMergeReason(t *testing.T) {
+	existing := &User{
+		Reason:         "Friend Analysis: many flagged friends",
+		ReasonCategory: enum.ReasonCategoryFriend,
+		Confidence:     0.9,
+	}
+
+	t.Run("different category becomes multiple", func(t *testing.T) {
+		user := &User{
+			Reason:         "AI Analysis: inappropriate description",
+			ReasonCategory: enum.ReasonCategoryContent,
+			Confidence:     0.6,
+		}
+		user.MergeReason(existing)
+
+		assert.Equal(t, "Friend Analysis: many flagged friends\n\nAI Analysis: inappropriate description", user.Reason)
+		assert.Equal(t, enum.ReasonCategoryMultiple, user.ReasonCategory)
+		assert.InDelta(t, 0.9, user.Confidence, 0.0001)
+	})
+
+	t.Run("same category is kept", func(t *testing.T) {
+		user := &User{
+			Reason:         "Friend Analysis: more flagged friends",
+			ReasonCategory: enum.ReasonCategoryFriend,
+			Confidence:     1.0,
+		}
+		user.MergeReason(existing)
+
+		assert.Equal(t, "Friend Analysis: more flagged friends", user.Reason)
+		assert.Equal(t, enum.ReasonCategoryFriend, user.ReasonCategory)
+		assert.InDelta(t, 1.0, user.Confidence, 0.0001)
+	})
+
+	t.Run("missing reason keeps the existing flag", func(t *testing.T) {
+		user := &User{}
+		user.MergeReason(existing)
+
+		assert.Equal(t, existing.Reason, user.Reason)
+		assert.Equal(t, enum.ReasonCategoryFriend, user.ReasonCategory)
+		assert.InDelta(t, 0.9, user.Confidence, 0.0001)
+	})
+}
+
+func TestUserMergeReasonFlagGeneration(t *testing.T) {
+	existing := &User{
+		Reason:         "Friend Analysis: many flagged friends",
+		ReasonCategory: enum.ReasonCategoryFriend,
+		FlagGeneration: 3,
+	}
+
+	t.Run("content flag resets the generation", func(t *testing.T) {
+		user := &User{Reason: "AI Analysis: inappropriate description", ReasonCategory: enum.ReasonCategoryContent}
+		user.MergeReason(existing)
+
+		assert.Equal(t, 0, user.FlagGeneration)
+	})
+
+	t.Run("new friend flag keeps its own generation", func(t *testing.T) {
+		user := &User{Reason: "Friend Analysis: fewer flagged friends", ReasonCategory: enum.ReasonCategoryFriend, FlagGeneration: 1}
+		user.MergeReason(existing)
+
+		assert.Equal(t, 1, user.FlagGeneration)
+	})
+
+	t.Run("missing reason keeps the existing generation", func(t *testing.T) {
+		user := &User{}
+		user.MergeReason(existing)
+
+		assert.Equal(t, 3, user.FlagGeneration)
+	})
+}
+
+func TestUserMergeReasonFlagSource(t *testing.T) {
+	t.Run("first checker keeps the flag", func(t *testing.T) {
+		existing := &User{Reason: "Friend Analysis: many flagged friends", FlagSource: enum.FlagSourceFriend}
+		user := &User{Reason: "AI Analysis: inappropriate description", FlagSource: enum.FlagSourceAI}
+		user.MergeReason(existing)
+
+		assert.Equal(t, enum.FlagSourceFriend, user.FlagSource)
+	})
+
+	t.Run("unknown source takes the new checker", func(t *testing.T) {
+		existing := &User{Reason: "Flagged before sources were stored"}
+		user := &User{Reason: "AI Analysis: inappropriate description", FlagSource: enum.FlagSourceAI}
+		user.MergeReason(existing)
+
+		assert.Equal(t, enum.FlagSourceAI, user.FlagSource)
+	})
+}
+
+func TestSplitFlaggedContent(t *testing.T) {
+	content, fromOutfit := SplitFlaggedContent("outfit:bad outfit")
+	assert.Equal(t, "bad outfit", content)
+	assert.True(t, fromOutfit)
+
+	content, fromOutfit = SplitFlaggedContent("bad description")
+	assert.Equal(t, "bad description", content)
+	assert.False(t, fromOutfit)
+}
+
+func TestIsGroupOwner(t *testing.T) {
+	tests := []struct {
+		name  string
+		group *apiTypes.UserGroupRoles
+		want  bool
+	}{
+		{
+			name: "owner rank",
+			group: &apiTypes.UserGroupRoles{
+				Group: apiTypes.GroupResponse{ID: 1},
+				Role:  apiTypes.UserGroupRole{Rank: GroupOwnerRank},
+			},
+			want: true,
+		},
+		{
+			name: "listed as owner",
+			group: &apiTypes.UserGroupRoles{
+				Group: apiTypes.GroupResponse{ID: 1, Owner: &apiTypes.GroupUser{UserID: 123}},
+				Role:  apiTypes.UserGroupRole{Rank: 254},
+			},
+			want: true,
+		},
+		{
+			name: "member",
+			group: &apiTypes.UserGroupRoles{
+				Group: apiTypes.GroupResponse{ID: 1, Owner: &apiTypes.GroupUser{UserID: 456}},
+				Role:  apiTypes.UserGroupRole{Rank: 1},
+			},
+			want: false,
+		},
+		{
+			name: "member of group without owner",
+			group: &apiTypes.UserGroupRoles{
+				Group: apiTypes.GroupResponse{ID: 1},
+				Role:  apiTypes.UserGroupRole{Rank: 1},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsGroupOwner(123, tt.group))
+		})
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"time"
 )
 
-var ErrInvalidVoteType = errors.New("invalid vote type")
+var (
+	ErrInvalidVoteType = errors.New("invalid vote type")
+	ErrTargetDecided   = errors.New("target has already been decided")
+)
 
 // LeaderboardCursor represents a pagination cursor for leaderboard results.
 type LeaderboardCursor struct {
@@ -60,3 +63,18 @@ type VoteAccuracy struct {
 	VotedAt       time.Time `json:"votedAt"`
 	Rank          int       `json:"rank"`
 }
+
+// VoteBreakdown summarizes the votes cast on a single target.
+type VoteBreakdown struct {
+	Voters   int  `bun:"voters"    json:"voters"`
+	Upvotes  int  `bun:"upvotes"   json:"upvotes"`
+	HasVoted bool `bun:"has_voted" json:"hasVoted"`
+}
+
+// UpvoteRatio returns the share of voters that upvoted, or 0 if nobody has voted.
+func (b *VoteBreakdown) UpvoteRatio() float64 {
+	if b.Voters == 0 {
+		return 0
+	}
+	return float64(b.Upvotes) / float64(b.Voters)
+}
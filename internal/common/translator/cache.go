@@ -0,0 +1,85 @@
+package translator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync/atomic"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultCacheSize is the number of translations kept in memory.
+	DefaultCacheSize = 5000
+	// DefaultCacheTTL is how long a translation is reused before it is translated again.
+	DefaultCacheTTL = 6 * time.Hour
+	// hitRateLogInterval is the number of lookups between logs of the cache hit rate.
+	hitRateLogInterval = 100
+)
+
+// Translation is a translated text along with the text it was translated from,
+// so that a stored translation can be checked against the current source text.
+type Translation struct {
+	Source string `json:"source"`
+	Text   string `json:"text"`
+}
+
+// Cache wraps a Translator with an in-memory cache of recent translations so
+// that the same text is not sent to the translation API repeatedly.
+type Cache struct {
+	translator *Translator
+	cache      *utils.LRUCache[string, string]
+	lookups    atomic.Uint64
+	logger     *zap.Logger
+}
+
+// NewCache creates a Cache holding at most size translations for the given TTL.
+func NewCache(translator *Translator, size int, ttl time.Duration, logger *zap.Logger) *Cache {
+	return &Cache{
+		translator: translator,
+		cache:      utils.NewLRUCache[string, string](size, ttl),
+		logger:     logger.Named("translation_cache"),
+	}
+}
+
+// Translate returns the cached translation of the input if there is one.
+// Otherwise, it translates the input and caches the result on success.
+func (c *Cache) Translate(ctx context.Context, input, sourceLang, targetLang string) (string, error) {
+	if translated, ok := c.Lookup(input, sourceLang, targetLang); ok {
+		return translated, nil
+	}
+
+	translated, err := c.translator.Translate(ctx, input, sourceLang, targetLang)
+	if err != nil {
+		return "", err
+	}
+
+	c.cache.Set(cacheKey(input, sourceLang, targetLang), translated)
+	return translated, nil
+}
+
+// Lookup returns the cached translation of the input without calling the
+// translation API. The hit rate is logged every so many lookups.
+func (c *Cache) Lookup(input, sourceLang, targetLang string) (string, bool) {
+	translated, ok := c.cache.Get(cacheKey(input, sourceLang, targetLang))
+
+	if c.lookups.Add(1)%hitRateLogInterval == 0 {
+		hits, misses := c.cache.Stats()
+		c.logger.Info("Translation cache hit rate",
+			zap.Uint64("hits", hits),
+			zap.Uint64("misses", misses),
+			zap.Float64("hitRate", float64(hits)/float64(hits+misses)))
+	}
+
+	return translated, ok
+}
+
+// cacheKey returns the cache key of a translation, hashing the input so that
+// long descriptions are not kept as keys.
+func cacheKey(input, sourceLang, targetLang string) string {
+	sum := sha256.Sum256([]byte(sourceLang + "\x00" + targetLang + "\x00" + input))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,39 @@
+package translator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCacheKey(t *testing.T) {
+	key := cacheKey("hola mundo", "auto", "en")
+
+	assert.Len(t, key, 64)
+	assert.Equal(t, key, cacheKey("hola mundo", "auto", "en"))
+	assert.NotEqual(t, key, cacheKey("hola mundo!", "auto", "en"))
+	assert.NotEqual(t, key, cacheKey("hola mundo", "auto", "de"))
+}
+
+func TestCacheTranslate(t *testing.T) {
+	c := NewCache(New(nil), 10, time.Hour, zap.NewNop())
+
+	_, ok := c.Lookup("hi", "auto", "en")
+	assert.False(t, ok)
+
+	// Short input is returned as is without calling the translation API
+	translated, err := c.Translate(context.Background(), "hi", "auto", "en")
+	require.NoError(t, err)
+	assert.Equal(t, "hi", translated)
+
+	translated, ok = c.Lookup("hi", "auto", "en")
+	assert.True(t, ok)
+	assert.Equal(t, "hi", translated)
+
+	_, ok = c.Lookup("hi", "auto", "de")
+	assert.False(t, ok)
+}
@@ -13,6 +13,11 @@ import (
 	"github.com/jaxron/axonet/pkg/client"
 )
 
+// MaxInputLength is the number of characters of a text that are sent for
+// translation. Review embeds only show the start of long texts, so the rest
+// is not translated.
+const MaxInputLength = 1000
+
 // ErrInvalidBinary is returned when the binary input is malformed or incomplete.
 var ErrInvalidBinary = errors.New("invalid binary string")
 
@@ -41,6 +46,20 @@ func New(client *client.Client) *Translator {
 	}
 }
 
+// PrepareInput returns the part of the text to send for translation, cut to
+// MaxInputLength characters. It returns false for text that is empty or only
+// whitespace, which is never translated.
+func PrepareInput(text string) (string, bool) {
+	if strings.TrimSpace(text) == "" {
+		return "", false
+	}
+
+	if runes := []rune(text); len(runes) > MaxInputLength {
+		text = string(runes[:MaxInputLength])
+	}
+	return text, true
+}
+
 // Translate automatically detects and translates mixed content in the input string.
 // It first attempts to translate any morse code or binary segments, then performs
 // a single language translation on the entire resulting text if languages are specified.
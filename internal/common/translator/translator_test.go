@@ -0,0 +1,35 @@
+package translator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		want   string
+		wantOK bool
+	}{
+		{name: "empty", text: "", wantOK: false},
+		{name: "whitespace only", text: " \n\t ", wantOK: false},
+		{name: "kept as is", text: "hola mundo", want: "hola mundo", wantOK: true},
+		{
+			name:   "cut to the maximum length",
+			text:   strings.Repeat("й", MaxInputLength+10),
+			want:   strings.Repeat("й", MaxInputLength),
+			wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := PrepareInput(tt.text)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package trends
+
+import (
+	_ "embed"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/utils"
+)
+
+const (
+	// MinTermLength is the minimum number of characters a term needs to be counted.
+	MinTermLength = 3
+	// MinTermCount is the minimum number of occurrences in the current period
+	// before a term is considered trending.
+	MinTermCount = 3
+)
+
+//go:embed stopwords.txt
+var stopwordsData string
+
+// stopwords holds the normalized words excluded from term counts.
+var stopwords = parseStopwords(stopwordsData) //nolint:gochecknoglobals
+
+// parseStopwords reads one word per line, skipping blank lines and comments.
+func parseStopwords(data string) map[string]struct{} {
+	words := make(map[string]struct{})
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words[utils.NormalizeString(line)] = struct{}{}
+	}
+	return words
+}
+
+// Tokenize splits text into normalized terms. Text is split on anything that is
+// not a letter or number, each piece is normalized, and short terms, numbers and
+// stopwords are dropped.
+func Tokenize(text string) []string {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	terms := make([]string, 0, len(fields))
+	for _, field := range fields {
+		term := utils.NormalizeString(field)
+		if utf8.RuneCountInString(term) < MinTermLength || isNumeric(term) {
+			continue
+		}
+		if _, ok := stopwords[term]; ok {
+			continue
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// CountTerms counts how many content items each term appears in.
+// A term repeated within the same item is only counted once so a single
+// spammy profile cannot dominate the results.
+func CountTerms(contents []string) map[string]int {
+	counts := make(map[string]int)
+	for _, content := range contents {
+		seen := make(map[string]struct{})
+		for _, term := range Tokenize(content) {
+			if _, ok := seen[term]; ok {
+				continue
+			}
+			seen[term] = struct{}{}
+			counts[term]++
+		}
+	}
+	return counts
+}
+
+// RisingTerms compares term counts from the current period against the previous
+// period and returns up to limit terms that grew the most. Terms without a
+// baseline are treated as if they appeared once in the previous period.
+func RisingTerms(current, previous map[string]int, limit int, now time.Time) []*types.TrendingTerm {
+	terms := make([]*types.TrendingTerm, 0)
+	for term, count := range current {
+		prevCount := previous[term]
+		if count < MinTermCount || count <= prevCount {
+			continue
+		}
+
+		terms = append(terms, &types.TrendingTerm{
+			Term:          term,
+			CurrentCount:  count,
+			PreviousCount: prevCount,
+			Change:        float64(count-prevCount) / float64(max(prevCount, 1)) * 100,
+			UpdatedAt:     now,
+		})
+	}
+
+	// Sort by growth, then by volume, then alphabetically for stable output
+	sort.Slice(terms, func(i, j int) bool {
+		if terms[i].Change != terms[j].Change {
+			return terms[i].Change > terms[j].Change
+		}
+		if terms[i].CurrentCount != terms[j].CurrentCount {
+			return terms[i].CurrentCount > terms[j].CurrentCount
+		}
+		return terms[i].Term < terms[j].Term
+	})
+
+	if len(terms) > limit {
+		terms = terms[:limit]
+	}
+	return terms
+}
+
+// isNumeric reports whether the term consists only of digits.
+func isNumeric(term string) bool {
+	for _, r := range term {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
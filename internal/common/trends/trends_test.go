@@ -0,0 +1,127 @@
+package trends
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "empty string",
+			input: "",
+			want:  []string{},
+		},
+		{
+			name:  "drops stopwords and short terms",
+			input: "the cat is on a mat",
+			want:  []string{"cat", "mat"},
+		},
+		{
+			name:  "splits on punctuation and normalizes",
+			input: "Héllo, WÖRLD!",
+			want:  []string{"hello", "world"},
+		},
+		{
+			name:  "drops numbers",
+			input: "discord 12345 abc123",
+			want:  []string{"discord", "abc123"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Tokenize(tt.input)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCountTerms(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents []string
+		want     map[string]int
+	}{
+		{
+			name:     "no content",
+			contents: nil,
+			want:     map[string]int{},
+		},
+		{
+			name:     "repeated term in one item counts once",
+			contents: []string{"spam spam spam"},
+			want:     map[string]int{"spam": 1},
+		},
+		{
+			name:     "term across items",
+			contents: []string{"trade offer", "trade robux", "offer"},
+			want:     map[string]int{"trade": 2, "offer": 2, "robux": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CountTerms(tt.contents)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRisingTerms(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		current  map[string]int
+		previous map[string]int
+		limit    int
+		want     []string
+	}{
+		{
+			name:     "ignores terms below minimum count",
+			current:  map[string]int{"rare": MinTermCount - 1},
+			previous: map[string]int{},
+			limit:    10,
+			want:     []string{},
+		},
+		{
+			name:     "ignores terms that did not grow",
+			current:  map[string]int{"steady": 5, "falling": 4},
+			previous: map[string]int{"steady": 5, "falling": 8},
+			limit:    10,
+			want:     []string{},
+		},
+		{
+			name:     "sorts by change then count then term",
+			current:  map[string]int{"alpha": 10, "beta": 6, "gamma": 6, "delta": 4},
+			previous: map[string]int{"alpha": 5, "beta": 3, "gamma": 3},
+			limit:    10,
+			want:     []string{"delta", "alpha", "beta", "gamma"},
+		},
+		{
+			name:     "truncates to limit",
+			current:  map[string]int{"alpha": 10, "beta": 8, "gamma": 6},
+			previous: map[string]int{},
+			limit:    2,
+			want:     []string{"alpha", "beta"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RisingTerms(tt.current, tt.previous, tt.limit, now)
+			require.Len(t, got, len(tt.want))
+			for i, term := range got {
+				assert.Equal(t, tt.want[i], term.Term)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// lruEntry is a cached value along with its key and when it stops being valid.
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// LRUCache provides a thread-safe cache with a maximum number of entries and
+// expiring values. The least recently used entry is evicted when the cache is full.
+type LRUCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	items   map[K]*list.Element
+	order   *list.List
+	hits    uint64
+	misses  uint64
+	nowFunc func() time.Time
+}
+
+// NewLRUCache creates a new LRUCache holding at most size entries for the given TTL.
+func NewLRUCache[K comparable, V any](size int, ttl time.Duration) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		size:    size,
+		ttl:     ttl,
+		items:   make(map[K]*list.Element, size),
+		order:   list.New(),
+		nowFunc: time.Now,
+	}
+}
+
+// Get retrieves a value from the cache and marks it as recently used.
+// Returns the value and whether it exists and has not expired.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, exists := c.items[key]
+	if !exists {
+		c.misses++
+		var zero V
+		return zero, false
+	}
+
+	// Drop expired entries
+	entry := elem.Value.(*lruEntry[K, V])
+	if !c.nowFunc().Before(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		c.misses++
+		var zero V
+		return zero, false
+	}
+
+	c.order.MoveToFront(elem)
+	c.hits++
+	return entry.value, true
+}
+
+// Set adds or updates a value in the cache, evicting the least recently used
+// entry if the cache is full.
+func (c *LRUCache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.nowFunc().Add(c.ttl)
+
+	if elem, exists := c.items[key]; exists {
+		entry := elem.Value.(*lruEntry[K, V])
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, expiresAt: expiresAt})
+
+	// Evict the least recently used entry
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// that have not been evicted yet.
+func (c *LRUCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Stats returns the number of cache hits and misses since the cache was created.
+func (c *LRUCache[K, V]) Stats() (hits, misses uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Run("basic set and get", func(t *testing.T) {
+		c := NewLRUCache[uint64, string](2, time.Minute)
+		c.Set(1, "one")
+
+		value, exists := c.Get(1)
+		assert.True(t, exists)
+		assert.Equal(t, "one", value)
+	})
+
+	t.Run("non-existent key", func(t *testing.T) {
+		c := NewLRUCache[uint64, string](2, time.Minute)
+
+		_, exists := c.Get(1)
+		assert.False(t, exists)
+	})
+
+	t.Run("update existing key", func(t *testing.T) {
+		c := NewLRUCache[uint64, string](2, time.Minute)
+		c.Set(1, "one")
+		c.Set(1, "uno")
+
+		value, exists := c.Get(1)
+		assert.True(t, exists)
+		assert.Equal(t, "uno", value)
+		assert.Equal(t, 1, c.Len())
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		c := NewLRUCache[uint64, string](2, time.Minute)
+		c.Set(1, "one")
+		c.Set(2, "two")
+		c.Get(1) // Mark 1 as recently used
+		c.Set(3, "three")
+
+		_, exists := c.Get(2)
+		assert.False(t, exists)
+		_, exists = c.Get(1)
+		assert.True(t, exists)
+		_, exists = c.Get(3)
+		assert.True(t, exists)
+		assert.Equal(t, 2, c.Len())
+	})
+
+	t.Run("counts hits and misses", func(t *testing.T) {
+		c := NewLRUCache[uint64, string](2, time.Minute)
+		c.Set(1, "one")
+		c.Get(1)
+		c.Get(1)
+		c.Get(2)
+
+		hits, misses := c.Stats()
+		assert.Equal(t, uint64(2), hits)
+		assert.Equal(t, uint64(1), misses)
+	})
+}
+
+func TestLRUCacheStaleness(t *testing.T) {
+	now := time.Date(2025, 1, 24, 12, 0, 0, 0, time.UTC)
+	c := NewLRUCache[uint64, string](10, 10*time.Minute)
+	c.nowFunc = func() time.Time { return now }
+
+	c.Set(1, "flagged")
+
+	// A status change is not seen until the entry expires
+	now = now.Add(10*time.Minute - time.Second)
+	value, exists := c.Get(1)
+	assert.True(t, exists)
+	assert.Equal(t, "flagged", value)
+
+	// Reading does not extend the entry's lifetime
+	now = now.Add(time.Second)
+	_, exists = c.Get(1)
+	assert.False(t, exists)
+	assert.Equal(t, 0, c.Len())
+
+	// Setting the entry again starts a new window
+	c.Set(1, "confirmed")
+	now = now.Add(5 * time.Minute)
+	value, exists = c.Get(1)
+	assert.True(t, exists)
+	assert.Equal(t, "confirmed", value)
+}
@@ -52,6 +52,7 @@ func User(user *types.ReviewUser) *restTypes.User {
 		Upvotes:        user.Reputation.Upvotes,
 		Downvotes:      user.Reputation.Downvotes,
 		Reputation:     user.Reputation.Score,
+		IsProtected:    user.IsProtected,
 	}
 }
 
@@ -68,6 +68,7 @@ type User struct {
 	Upvotes        int32       `json:"upvotes"`
 	Downvotes      int32       `json:"downvotes"`
 	Reputation     int32       `json:"reputation"`
+	IsProtected    bool        `json:"isProtected"`
 }
 
 // GroupUser represents a user in the context of a group.
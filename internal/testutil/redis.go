@@ -0,0 +1,77 @@
+package testutil
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/redis/rueidis"
+)
+
+// TestRedisAddrEnv names the environment variable holding the address of a
+// disposable Redis server used by tests that need real Redis semantics.
+const TestRedisAddrEnv = "ROTECTOR_TEST_REDIS_ADDR"
+
+// OpenTestRedis connects to the Redis server named by TestRedisAddrEnv, skipping
+// the test if it is not set. Tests should use keys unlikely to collide with real
+// data and delete them when done.
+func OpenTestRedis(t *testing.T) rueidis.Client {
+	t.Helper()
+
+	addr := os.Getenv(TestRedisAddrEnv)
+	if addr == "" {
+		t.Skipf("%s is not set", TestRedisAddrEnv)
+	}
+
+	client, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:  []string{addr},
+		DisableCache: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to test Redis: %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+// NewUnavailableRedis returns a client for a Redis server that drops every
+// connection, so every command fails as it would during a Redis outage.
+func NewUnavailableRedis(t *testing.T) rueidis.Client {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			if err == nil {
+				_ = conn.Close()
+			}
+		}
+	}()
+
+	// Skip the handshake so that creating the client does not need a reply
+	client, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:       []string{listener.Addr().String()},
+		DisableCache:      true,
+		AlwaysRESP2:       true,
+		DisableRetry:      true,
+		ForceSingleClient: true,
+		ClientSetInfo:     rueidis.DisableClientSetInfo,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	return client
+}
@@ -0,0 +1,334 @@
+// Package testutil provides in-memory fakes of the database stores for tests.
+//
+// The fakes embed their store interface, so calling a method that a fake does
+// not implement panics with a nil pointer dereference. Implement the method on
+// the fake when a test needs it.
+package testutil
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/robalyx/rotector/internal/common/storage/database/store"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
+)
+
+// These type assertions ensure that the fakes implement the store interfaces.
+var (
+	_ store.UserStore          = (*UserStore)(nil)
+	_ store.GroupStore         = (*GroupStore)(nil)
+	_ store.AppealStore        = (*AppealStore)(nil)
+	_ store.ActivityStore      = (*ActivityStore)(nil)
+	_ store.SettingStore       = (*SettingStore)(nil)
+	_ store.SecondOpinionStore = (*SecondOpinionStore)(nil)
+)
+
+// UserStore is an in-memory fake of store.UserStore.
+type UserStore struct {
+	store.UserStore
+
+	Users     map[uint64]*types.ReviewUser // Users that can be looked up
+	Confirmed []uint64                     // IDs of confirmed users in order
+	Cleared   []uint64                     // IDs of cleared users in order
+	Err       error                        // Returned by every implemented method when set
+
+	mu sync.Mutex
+}
+
+// NewUserStore creates a UserStore holding the given users.
+func NewUserStore(users ...*types.ReviewUser) *UserStore {
+	s := &UserStore{Users: make(map[uint64]*types.ReviewUser, len(users))}
+	for _, user := range users {
+		s.Users[user.ID] = user
+	}
+	return s
+}
+
+// GetUserByID returns the user with the given ID or types.ErrUserNotFound.
+func (s *UserStore) GetUserByID(_ context.Context, userID string, _ types.UserFields) (*types.ReviewUser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	id, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return nil, types.ErrUserNotFound
+	}
+	user, ok := s.Users[id]
+	if !ok {
+		return nil, types.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// GetUsersByIDs returns the users with the given IDs that exist.
+func (s *UserStore) GetUsersByIDs(_ context.Context, userIDs []uint64, _ types.UserFields) (map[uint64]*types.ReviewUser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	users := make(map[uint64]*types.ReviewUser, len(userIDs))
+	for _, id := range userIDs {
+		if user, ok := s.Users[id]; ok {
+			users[id] = user
+		}
+	}
+	return users, nil
+}
+
+// ConfirmUserWithPropagation marks the user as confirmed. No groups are queued.
+func (s *UserStore) ConfirmUserWithPropagation(_ context.Context, user *types.ReviewUser) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return 0, s.Err
+	}
+
+	user.Status = enum.UserTypeConfirmed
+	s.Confirmed = append(s.Confirmed, user.ID)
+	return 0, nil
+}
+
+// ClearUser marks the user as cleared.
+func (s *UserStore) ClearUser(_ context.Context, user *types.ReviewUser) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	user.Status = enum.UserTypeCleared
+	s.Cleared = append(s.Cleared, user.ID)
+	return nil
+}
+
+// GroupStore is an in-memory fake of store.GroupStore.
+type GroupStore struct {
+	store.GroupStore
+
+	Confirmed []uint64 // IDs of confirmed groups in order
+	Cleared   []uint64 // IDs of cleared groups in order
+	Err       error    // Returned by every implemented method when set
+
+	mu sync.Mutex
+}
+
+// ConfirmGroup marks the group as confirmed.
+func (s *GroupStore) ConfirmGroup(_ context.Context, group *types.ReviewGroup) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	group.Status = enum.GroupTypeConfirmed
+	s.Confirmed = append(s.Confirmed, group.ID)
+	return nil
+}
+
+// ClearGroup marks the group as cleared.
+func (s *GroupStore) ClearGroup(_ context.Context, group *types.ReviewGroup) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	group.Status = enum.GroupTypeCleared
+	s.Cleared = append(s.Cleared, group.ID)
+	return nil
+}
+
+// AppealDecision records an accepted or rejected appeal.
+type AppealDecision struct {
+	AppealID   int64
+	ReviewerID uint64
+	Reason     string
+}
+
+// AppealStore is an in-memory fake of store.AppealStore.
+type AppealStore struct {
+	store.AppealStore
+
+	Accepted []AppealDecision // Accepted appeals in order
+	Rejected []AppealDecision // Rejected appeals in order
+	Err      error            // Returned by every implemented method when set
+
+	mu sync.Mutex
+}
+
+// AcceptAppeal records the appeal as accepted.
+func (s *AppealStore) AcceptAppeal(_ context.Context, appealID int64, reviewerID uint64, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	s.Accepted = append(s.Accepted, AppealDecision{AppealID: appealID, ReviewerID: reviewerID, Reason: reason})
+	return nil
+}
+
+// RejectAppeal records the appeal as rejected.
+func (s *AppealStore) RejectAppeal(_ context.Context, appealID int64, reviewerID uint64, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	s.Rejected = append(s.Rejected, AppealDecision{AppealID: appealID, ReviewerID: reviewerID, Reason: reason})
+	return nil
+}
+
+// SecondOpinionStore is an in-memory fake of store.SecondOpinionStore.
+type SecondOpinionStore struct {
+	store.SecondOpinionStore
+
+	Err error // Returned by every implemented method when set
+
+	mu       sync.Mutex
+	opinions map[uint64]*types.SecondOpinion
+}
+
+// NewSecondOpinionStore creates a SecondOpinionStore holding the given requests.
+func NewSecondOpinionStore(opinions ...*types.SecondOpinion) *SecondOpinionStore {
+	s := &SecondOpinionStore{opinions: make(map[uint64]*types.SecondOpinion)}
+	for _, opinion := range opinions {
+		s.opinions[opinion.UserID] = opinion
+	}
+	return s
+}
+
+// GetSecondOpinion returns the request on the user, or nil if there is none.
+func (s *SecondOpinionStore) GetSecondOpinion(_ context.Context, userID uint64) (*types.SecondOpinion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	return s.opinions[userID], nil
+}
+
+// ActivityStore is an in-memory fake of store.ActivityStore.
+type ActivityStore struct {
+	store.ActivityStore
+
+	Filters []types.ActivityFilter // Filters passed to GetLogs in order
+
+	mu   sync.Mutex
+	logs []*types.ActivityLog
+}
+
+// Log records the activity.
+func (s *ActivityStore) Log(_ context.Context, log *types.ActivityLog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logs = append(s.logs, log)
+}
+
+// GetLogs returns a page of the recorded activities, newest first, that come after
+// the cursor. The filter is recorded in Filters but not applied.
+func (s *ActivityStore) GetLogs(
+	_ context.Context, filter types.ActivityFilter, cursor *types.LogCursor, limit int,
+) ([]*types.ActivityLog, *types.LogCursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Filters = append(s.Filters, filter)
+
+	logs := append([]*types.ActivityLog(nil), s.logs...)
+	sort.Slice(logs, func(i, j int) bool {
+		if !logs[i].ActivityTimestamp.Equal(logs[j].ActivityTimestamp) {
+			return logs[i].ActivityTimestamp.After(logs[j].ActivityTimestamp)
+		}
+		return logs[i].Sequence > logs[j].Sequence
+	})
+
+	page := make([]*types.ActivityLog, 0, limit)
+	var nextCursor *types.LogCursor
+	for _, log := range logs {
+		if cursor != nil && !log.ActivityTimestamp.Before(cursor.Timestamp) &&
+			(!log.ActivityTimestamp.Equal(cursor.Timestamp) || log.Sequence >= cursor.Sequence) {
+			continue
+		}
+		if len(page) == limit {
+			last := page[limit-1]
+			nextCursor = &types.LogCursor{Timestamp: last.ActivityTimestamp, Sequence: last.Sequence}
+			break
+		}
+		page = append(page, log)
+	}
+
+	return page, nextCursor, nil
+}
+
+// Logs returns the recorded activities in order.
+func (s *ActivityStore) Logs() []*types.ActivityLog {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*types.ActivityLog(nil), s.logs...)
+}
+
+// SettingStore is an in-memory fake of store.SettingStore.
+type SettingStore struct {
+	Saved []*types.UserSetting // Saved settings in order
+	Err   error                // Returned by every method when set
+
+	mu sync.Mutex
+}
+
+// SaveUserSettings records the settings.
+func (s *SettingStore) SaveUserSettings(_ context.Context, settings *types.UserSetting) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	s.Saved = append(s.Saved, settings)
+	return nil
+}
+
+// GetAnonymousLeaderboardUsers returns which of the given users were last saved
+// with leaderboard anonymity enabled.
+func (s *SettingStore) GetAnonymousLeaderboardUsers(_ context.Context, userIDs []uint64) (map[uint64]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	latest := make(map[uint64]bool)
+	for _, settings := range s.Saved {
+		latest[uint64(settings.UserID)] = settings.LeaderboardAnonymous
+	}
+
+	anonymous := make(map[uint64]bool)
+	for _, userID := range userIDs {
+		if latest[userID] {
+			anonymous[userID] = true
+		}
+	}
+	return anonymous, nil
+}
@@ -2,15 +2,19 @@ package ai
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/jaxron/roapi.go/pkg/api"
+	"github.com/robalyx/rotector/internal/common/client/ai"
 	"github.com/robalyx/rotector/internal/common/client/checker"
 	"github.com/robalyx/rotector/internal/common/client/fetcher"
 	"github.com/robalyx/rotector/internal/common/progress"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/storage/redis"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"go.uber.org/zap"
 )
@@ -25,6 +29,8 @@ type FriendWorker struct {
 	userChecker      *checker.UserChecker
 	friendFetcher    *fetcher.FriendFetcher
 	reporter         *core.StatusReporter
+	usageReporter    *core.UsageReporter
+	recentScans      *core.RecentScans
 	logger           *zap.Logger
 	batchSize        int
 	flaggedThreshold int
@@ -33,10 +39,18 @@ type FriendWorker struct {
 // NewFriendWorker creates a FriendWorker.
 func NewFriendWorker(app *setup.App, bar *progress.Bar, logger *zap.Logger) *FriendWorker {
 	userFetcher := fetcher.NewUserFetcher(app, logger)
-	userChecker := checker.NewUserChecker(app, userFetcher, logger)
+	usageTracker := ai.NewUsageTracker()
+	userChecker := checker.NewUserChecker(app, bar, userFetcher, usageTracker, logger)
 	friendFetcher := fetcher.NewFriendFetcher(app.RoAPI, logger)
 	reporter := core.NewStatusReporter(app.StatusClient, "ai", "friend", logger)
 
+	// Get Redis client for remembering recently analyzed users
+	cacheClient, err := app.RedisManager.GetClient(redis.CacheDBIndex)
+	if err != nil {
+		logger.Fatal("Failed to get Redis client for recent scans", zap.Error(err))
+	}
+	recentScanTTL := time.Duration(app.Config.Worker.RecentScans.TTL) * time.Hour
+
 	return &FriendWorker{
 		db:               app.DB,
 		roAPI:            app.RoAPI,
@@ -45,6 +59,8 @@ func NewFriendWorker(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Fri
 		userChecker:      userChecker,
 		friendFetcher:    friendFetcher,
 		reporter:         reporter,
+		usageReporter:    core.NewUsageReporter(app, usageTracker, logger),
+		recentScans:      core.NewRecentScans(cacheClient, recentScanTTL, logger),
 		logger:           logger,
 		batchSize:        app.Config.Worker.BatchSizes.FriendUsers,
 		flaggedThreshold: app.Config.Worker.ThresholdLimits.FlaggedUsers,
@@ -55,8 +71,8 @@ func NewFriendWorker(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Fri
 // 1. Gets a batch of users to process
 // 2. Fetches friend lists for each user
 // 3. Checks friends for inappropriate content
-// 4. Repeats until stopped.
-func (f *FriendWorker) Start() {
+// 4. Repeats until the context is cancelled, finishing the current batch first.
+func (f *FriendWorker) Start(ctx context.Context) {
 	f.logger.Info("Friend Worker started", zap.String("workerID", f.reporter.GetWorkerID()))
 	f.reporter.Start()
 	defer f.reporter.Stop()
@@ -64,7 +80,7 @@ func (f *FriendWorker) Start() {
 	f.bar.SetTotal(100)
 
 	var oldFriendIDs []uint64
-	for {
+	for ctx.Err() == nil {
 		f.bar.Reset()
 		f.reporter.SetHealthy(true)
 
@@ -73,7 +89,7 @@ func (f *FriendWorker) Start() {
 		if err != nil {
 			f.logger.Error("Error getting flagged users count", zap.Error(err))
 			f.reporter.SetHealthy(false)
-			time.Sleep(5 * time.Minute)
+			core.SleepContext(ctx, 5*time.Minute)
 			continue
 		}
 
@@ -84,29 +100,41 @@ func (f *FriendWorker) Start() {
 			f.logger.Info("Pausing worker - flagged users threshold exceeded",
 				zap.Int("flaggedCount", flaggedCount),
 				zap.Int("threshold", f.flaggedThreshold))
-			time.Sleep(5 * time.Minute)
+			core.SleepContext(ctx, 5*time.Minute)
 			continue
 		}
 
 		// Step 1: Process friends batch (20%)
 		f.bar.SetStepMessage("Processing friends batch", 20)
 		f.reporter.UpdateStatus("Processing friends batch", 20)
-		friendIDs, err := f.processFriendsBatch(oldFriendIDs)
+		f.bar.SetPhase("fetching friends")
+		friendIDs, skipped, err := f.processFriendsBatch(oldFriendIDs)
+
+		// If no users to scan, wait before checking again
+		if errors.Is(err, types.ErrNoUsersToScan) {
+			f.bar.SetStepMessage("No users to scan, waiting", 0)
+			f.reporter.UpdateStatus("No users to scan, waiting", 0)
+			core.SleepContext(ctx, time.Minute)
+			continue
+		}
 		if err != nil {
 			f.reporter.SetHealthy(false)
-			time.Sleep(5 * time.Minute)
+			core.SleepContext(ctx, 5*time.Minute)
 			continue
 		}
 
 		// Step 2: Fetch user info (40%)
 		f.bar.SetStepMessage("Fetching user info", 40)
 		f.reporter.UpdateStatus("Fetching user info", 40)
-		userInfos := f.userFetcher.FetchInfos(friendIDs[:f.batchSize])
+		f.bar.SetPhase("fetching users")
+		f.bar.SetTotalItems(int64(f.batchSize))
+		userInfos := f.userFetcher.FetchInfos(progress.WithBar(context.Background(), f.bar), friendIDs[:f.batchSize])
 
 		// Step 3: Process users (60%)
 		f.bar.SetStepMessage("Processing users", 60)
 		f.reporter.UpdateStatus("Processing users", 60)
-		failedValidationIDs := f.userChecker.ProcessUsers(userInfos)
+		checkedIDs, failedValidationIDs := f.userChecker.ProcessUsers(userInfos)
+		f.recentScans.Mark(context.Background(), checkedIDs)
 
 		// Step 4: Prepare for next batch
 		oldFriendIDs = friendIDs[f.batchSize:]
@@ -121,24 +149,44 @@ func (f *FriendWorker) Start() {
 		// Step 5: Completed (100%)
 		f.bar.SetStepMessage("Completed", 100)
 		f.reporter.UpdateStatus("Completed", 100)
+		f.reporter.SetProcessed(len(userInfos))
+		f.usageReporter.ReportBatch(context.Background())
+		f.logger.Info("Finished friends batch",
+			zap.Int("processedUsers", len(userInfos)),
+			zap.Int("skippedDuplicates", skipped))
 
 		// Short pause before next iteration
-		time.Sleep(1 * time.Second)
+		core.SleepContext(ctx, 1*time.Second)
 	}
+
+	// Report that the worker stopped after finishing its last batch
+	f.bar.SetStepMessage("Stopped", 100)
+	f.reporter.UpdateStatus("Stopped", 100)
+	f.logger.Info("Friend Worker stopped")
 }
 
 // processFriendsBatch builds a list of friend IDs to check by:
 // 1. Getting confirmed users from the database
 // 2. Fetching their friend lists
-// 3. Filtering out already processed users
+// 3. Filtering out already queued, processed and recently analyzed users
 // 4. Collecting enough IDs to fill a batch.
-func (f *FriendWorker) processFriendsBatch(friendIDs []uint64) ([]uint64, error) {
+// Returns the IDs along with the number of candidates skipped as duplicates.
+func (f *FriendWorker) processFriendsBatch(friendIDs []uint64) ([]uint64, int, error) {
+	queued := make(map[uint64]struct{}, len(friendIDs))
+	for _, friendID := range friendIDs {
+		queued[friendID] = struct{}{}
+	}
+
+	skipped := 0
 	for len(friendIDs) < f.batchSize {
 		// Get the next confirmed user
 		user, err := f.db.Users().GetUserToScan(context.Background())
+		if errors.Is(err, types.ErrNoUsersToScan) {
+			return nil, 0, err
+		}
 		if err != nil {
 			f.logger.Error("Error getting user to scan", zap.Error(err))
-			return nil, err
+			return nil, 0, err
 		}
 
 		// Fetch friends for the user
@@ -160,11 +208,32 @@ func (f *FriendWorker) processFriendsBatch(friendIDs []uint64) ([]uint64, error)
 			continue
 		}
 
-		// Add only new users to the friendIDs slice
+		// Keep users that are not already queued or stored
+		candidates := make([]uint64, 0, len(userFriendIDs))
 		for _, friendID := range userFriendIDs {
-			if _, exists := existingUsers[friendID]; !exists {
-				friendIDs = append(friendIDs, friendID)
+			if _, exists := existingUsers[friendID]; exists {
+				continue
+			}
+			if _, exists := queued[friendID]; exists {
+				skipped++
+				continue
 			}
+			candidates = append(candidates, friendID)
+		}
+
+		// Skip users the AI analyzed recently, even if they were not flagged
+		newFriendIDs, err := f.recentScans.Filter(context.Background(), candidates)
+		if err != nil {
+			// Analyzing some users twice is better than dropping the friends of this user
+			f.logger.Error("Error checking recently scanned users", zap.Error(err))
+			newFriendIDs = candidates
+		}
+		skipped += len(candidates) - len(newFriendIDs)
+
+		// Add only new users to the friendIDs slice
+		for _, friendID := range newFriendIDs {
+			queued[friendID] = struct{}{}
+			friendIDs = append(friendIDs, friendID)
 		}
 
 		f.logger.Info("Fetched friends",
@@ -173,5 +242,5 @@ func (f *FriendWorker) processFriendsBatch(friendIDs []uint64) ([]uint64, error)
 			zap.Uint64("userID", user.ID))
 	}
 
-	return friendIDs, nil
+	return friendIDs, skipped, nil
 }
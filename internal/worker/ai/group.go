@@ -2,16 +2,19 @@ package ai
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/jaxron/roapi.go/pkg/api"
 	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
+	"github.com/robalyx/rotector/internal/common/client/ai"
 	"github.com/robalyx/rotector/internal/common/client/checker"
 	"github.com/robalyx/rotector/internal/common/client/fetcher"
 	"github.com/robalyx/rotector/internal/common/progress"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"go.uber.org/zap"
 )
@@ -25,6 +28,7 @@ type GroupWorker struct {
 	userFetcher      *fetcher.UserFetcher
 	userChecker      *checker.UserChecker
 	reporter         *core.StatusReporter
+	usageReporter    *core.UsageReporter
 	logger           *zap.Logger
 	batchSize        int
 	flaggedThreshold int
@@ -33,7 +37,8 @@ type GroupWorker struct {
 // NewGroupWorker creates a GroupWorker.
 func NewGroupWorker(app *setup.App, bar *progress.Bar, logger *zap.Logger) *GroupWorker {
 	userFetcher := fetcher.NewUserFetcher(app, logger)
-	userChecker := checker.NewUserChecker(app, userFetcher, logger)
+	usageTracker := ai.NewUsageTracker()
+	userChecker := checker.NewUserChecker(app, bar, userFetcher, usageTracker, logger)
 	reporter := core.NewStatusReporter(app.StatusClient, "ai", "member", logger)
 
 	return &GroupWorker{
@@ -43,6 +48,7 @@ func NewGroupWorker(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Grou
 		userFetcher:      userFetcher,
 		userChecker:      userChecker,
 		reporter:         reporter,
+		usageReporter:    core.NewUsageReporter(app, usageTracker, logger),
 		logger:           logger,
 		batchSize:        app.Config.Worker.BatchSizes.GroupUsers,
 		flaggedThreshold: app.Config.Worker.ThresholdLimits.FlaggedUsers,
@@ -53,8 +59,8 @@ func NewGroupWorker(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Grou
 // 1. Gets a confirmed group to process
 // 2. Fetches member lists in batches
 // 3. Checks members for inappropriate content
-// 4. Repeats until stopped.
-func (g *GroupWorker) Start() {
+// 4. Repeats until the context is cancelled, finishing the current batch first.
+func (g *GroupWorker) Start(ctx context.Context) {
 	g.logger.Info("Group Worker started", zap.String("workerID", g.reporter.GetWorkerID()))
 	g.reporter.Start()
 	defer g.reporter.Stop()
@@ -62,7 +68,7 @@ func (g *GroupWorker) Start() {
 	g.bar.SetTotal(100)
 
 	var oldUserIDs []uint64
-	for {
+	for ctx.Err() == nil {
 		g.bar.Reset()
 		g.reporter.SetHealthy(true)
 
@@ -71,7 +77,7 @@ func (g *GroupWorker) Start() {
 		if err != nil {
 			g.logger.Error("Error getting flagged users count", zap.Error(err))
 			g.reporter.SetHealthy(false)
-			time.Sleep(5 * time.Minute)
+			core.SleepContext(ctx, 5*time.Minute)
 			continue
 		}
 
@@ -82,7 +88,7 @@ func (g *GroupWorker) Start() {
 			g.logger.Info("Pausing worker - flagged users threshold exceeded",
 				zap.Int("flaggedCount", flaggedCount),
 				zap.Int("threshold", g.flaggedThreshold))
-			time.Sleep(5 * time.Minute)
+			core.SleepContext(ctx, 5*time.Minute)
 			continue
 		}
 
@@ -90,32 +96,43 @@ func (g *GroupWorker) Start() {
 		g.bar.SetStepMessage("Fetching next group to process", 10)
 		g.reporter.UpdateStatus("Fetching next group to process", 10)
 		group, err := g.db.Groups().GetGroupToScan(context.Background())
+
+		// If no groups to scan, wait before checking again
+		if errors.Is(err, types.ErrNoGroupsToScan) {
+			g.bar.SetStepMessage("No groups to scan, waiting", 0)
+			g.reporter.UpdateStatus("No groups to scan, waiting", 0)
+			core.SleepContext(ctx, time.Minute)
+			continue
+		}
 		if err != nil {
 			g.logger.Error("Error getting group to scan", zap.Error(err))
 			g.reporter.SetHealthy(false)
-			time.Sleep(5 * time.Minute)
+			core.SleepContext(ctx, 5*time.Minute)
 			continue
 		}
 
 		// Step 2: Get group users (40%)
 		g.bar.SetStepMessage("Processing group users", 40)
 		g.reporter.UpdateStatus("Processing group users", 40)
+		g.bar.SetPhase("fetching group members")
 		userIDs, err := g.processGroup(group.ID, oldUserIDs)
 		if err != nil {
 			g.reporter.SetHealthy(false)
-			time.Sleep(5 * time.Minute)
+			core.SleepContext(ctx, 5*time.Minute)
 			continue
 		}
 
 		// Step 3: Fetch user info (70%)
 		g.bar.SetStepMessage("Fetching user info", 70)
 		g.reporter.UpdateStatus("Fetching user info", 70)
-		userInfos := g.userFetcher.FetchInfos(userIDs[:g.batchSize])
+		g.bar.SetPhase("fetching users")
+		g.bar.SetTotalItems(int64(g.batchSize))
+		userInfos := g.userFetcher.FetchInfos(progress.WithBar(context.Background(), g.bar), userIDs[:g.batchSize])
 
 		// Step 4: Process users (90%)
 		g.bar.SetStepMessage("Processing users", 90)
 		g.reporter.UpdateStatus("Processing users", 90)
-		failedValidationIDs := g.userChecker.ProcessUsers(userInfos)
+		_, failedValidationIDs := g.userChecker.ProcessUsers(userInfos)
 
 		// Step 5: Prepare for next batch
 		oldUserIDs = userIDs[g.batchSize:]
@@ -130,10 +147,17 @@ func (g *GroupWorker) Start() {
 		// Step 6: Completed (100%)
 		g.bar.SetStepMessage("Completed", 100)
 		g.reporter.UpdateStatus("Completed", 100)
+		g.reporter.SetProcessed(len(userInfos))
+		g.usageReporter.ReportBatch(context.Background())
 
 		// Short pause before next iteration
-		time.Sleep(1 * time.Second)
+		core.SleepContext(ctx, 1*time.Second)
 	}
+
+	// Report that the worker stopped after finishing its last batch
+	g.bar.SetStepMessage("Stopped", 100)
+	g.reporter.UpdateStatus("Stopped", 100)
+	g.logger.Info("Group Worker stopped")
 }
 
 // processGroup builds a list of member IDs to check by:
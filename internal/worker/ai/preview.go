@@ -0,0 +1,74 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/bytedance/sonic"
+	"github.com/robalyx/rotector/internal/common/client/ai"
+	"github.com/robalyx/rotector/internal/common/client/fetcher"
+	"github.com/robalyx/rotector/internal/common/setup"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/robalyx/rotector/internal/common/translator"
+	"go.uber.org/zap"
+)
+
+// ErrUserNotStored indicates the user to preview is not in the database.
+var ErrUserNotStored = errors.New("user not found in database")
+
+// PreviewUserPrompt writes the exact prompt the AI checker would send for a stored user.
+// If callModel is true, the model is also called and its parsed response is written
+// alongside the prompt. Nothing is saved to the database.
+func PreviewUserPrompt(ctx context.Context, app *setup.App, logger *zap.Logger, userID uint64, callModel bool, w io.Writer) error {
+	users, err := app.DB.Users().GetUsersByIDs(ctx, []uint64{userID}, types.UserFields{
+		Basic:       true,
+		Description: true,
+		CreatedAt:   true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	user, ok := users[userID]
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrUserNotStored, userID)
+	}
+
+	// Build the same info the checker receives from the fetcher
+	info := &fetcher.Info{
+		ID:          user.ID,
+		Name:        user.Name,
+		DisplayName: user.DisplayName,
+		Description: user.Description,
+		CreatedAt:   user.CreatedAt,
+	}
+
+	analyzer := ai.NewUserAnalyzer(app, translator.New(app.RoAPI.GetClient()), nil, logger)
+	preview, err := analyzer.PreviewUsers(ctx, []*fetcher.Info{info}, callModel)
+	if err != nil {
+		return fmt.Errorf("failed to preview user: %w", err)
+	}
+
+	fmt.Fprintf(w, "=== System Prompt ===\n%s\n\n", ai.ReviewSystemPrompt)
+	fmt.Fprintf(w, "=== User Prompt ===\n%s\n", preview.Prompt)
+
+	if !callModel {
+		return nil
+	}
+
+	response, err := sonic.MarshalIndent(preview.FlaggedUsers, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal model response: %w", err)
+	}
+	fmt.Fprintf(w, "\n=== Model Response ===\n%s\n", response)
+
+	validated, err := sonic.MarshalIndent(preview.ValidatedUsers, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal validated users: %w", err)
+	}
+	fmt.Fprintf(w, "\n=== Validated Result ===\n%s\n", validated)
+
+	return nil
+}
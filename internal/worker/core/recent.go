@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/rueidis"
+	"go.uber.org/zap"
+)
+
+// RecentScanKeyPrefix prefixes the keys marking users that were recently analyzed.
+const RecentScanKeyPrefix = "recent_scan:"
+
+// RecentScans remembers which users were recently analyzed so workers do not
+// send them to the AI again before the TTL passes. Users are remembered in Redis
+// so the record is shared between workers and survives restarts.
+type RecentScans struct {
+	client rueidis.Client
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+// NewRecentScans creates a RecentScans that remembers users for the given TTL.
+// A TTL of zero or less disables the check.
+func NewRecentScans(client rueidis.Client, ttl time.Duration, logger *zap.Logger) *RecentScans {
+	return &RecentScans{
+		client: client,
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+// Filter returns the given users that were not analyzed within the TTL,
+// keeping their order. All users are returned if the check is disabled.
+func (r *RecentScans) Filter(ctx context.Context, userIDs []uint64) ([]uint64, error) {
+	if r.ttl <= 0 || len(userIDs) == 0 {
+		return userIDs, nil
+	}
+
+	keys := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		keys[i] = recentScanKey(userID)
+	}
+
+	values, err := r.client.Do(ctx, r.client.B().Mget().Key(keys...).Build()).ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check recent scans: %w", err)
+	}
+
+	remaining := make([]uint64, 0, len(userIDs))
+	for i, value := range values {
+		if value.IsNil() {
+			remaining = append(remaining, userIDs[i])
+		}
+	}
+	return remaining, nil
+}
+
+// Mark remembers the given users as analyzed for the TTL.
+func (r *RecentScans) Mark(ctx context.Context, userIDs []uint64) {
+	if r.ttl <= 0 || len(userIDs) == 0 {
+		return
+	}
+
+	cmds := make(rueidis.Commands, 0, len(userIDs))
+	for _, userID := range userIDs {
+		cmds = append(cmds, r.client.B().Set().Key(recentScanKey(userID)).Value("1").Ex(r.ttl).Build())
+	}
+
+	for _, resp := range r.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			r.logger.Error("Failed to mark recently scanned users", zap.Error(err))
+			return
+		}
+	}
+}
+
+// recentScanKey returns the key marking a user as recently analyzed.
+func recentScanKey(userID uint64) string {
+	return RecentScanKeyPrefix + strconv.FormatUint(userID, 10)
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// cleanupRecentScans deletes the recent scan keys of the given users.
+func cleanupRecentScans(t *testing.T, scans *RecentScans, userIDs ...uint64) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		for _, userID := range userIDs {
+			scans.client.Do(context.Background(), scans.client.B().Del().Key(recentScanKey(userID)).Build())
+		}
+	})
+}
+
+func TestRecentScansMark(t *testing.T) {
+	ctx := context.Background()
+	scans := NewRecentScans(testutil.OpenTestRedis(t), time.Hour, zap.NewNop())
+	cleanupRecentScans(t, scans, 9_000_000_001, 9_000_000_002, 9_000_000_003)
+
+	scans.Mark(ctx, []uint64{9_000_000_001, 9_000_000_003})
+
+	remaining, err := scans.Filter(ctx, []uint64{9_000_000_001, 9_000_000_002, 9_000_000_003})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{9_000_000_002}, remaining)
+}
+
+func TestRecentScansExpiry(t *testing.T) {
+	ctx := context.Background()
+	scans := NewRecentScans(testutil.OpenTestRedis(t), time.Second, zap.NewNop())
+	cleanupRecentScans(t, scans, 9_000_000_011)
+
+	scans.Mark(ctx, []uint64{9_000_000_011})
+
+	remaining, err := scans.Filter(ctx, []uint64{9_000_000_011})
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+
+	// The user is analyzed again once the TTL passes
+	time.Sleep(1500 * time.Millisecond)
+	remaining, err = scans.Filter(ctx, []uint64{9_000_000_011})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{9_000_000_011}, remaining)
+}
+
+func TestRecentScansDisabled(t *testing.T) {
+	ctx := context.Background()
+	scans := NewRecentScans(testutil.NewUnavailableRedis(t), 0, zap.NewNop())
+
+	// Redis is not used when the TTL is zero
+	scans.Mark(ctx, []uint64{1, 2})
+	remaining, err := scans.Filter(ctx, []uint64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2}, remaining)
+}
+
+func TestRecentScansRedisUnavailable(t *testing.T) {
+	ctx := context.Background()
+	scans := NewRecentScans(testutil.NewUnavailableRedis(t), time.Hour, zap.NewNop())
+
+	// Marking fails quietly and filtering reports the error so the batch is not dropped
+	scans.Mark(ctx, []uint64{1, 2})
+	_, err := scans.Filter(ctx, []uint64{1, 2})
+	require.Error(t, err)
+}
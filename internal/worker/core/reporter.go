@@ -59,8 +59,11 @@ func (r *StatusReporter) Start() {
 	}()
 }
 
-// Stop ends status reporting.
+// Stop reports the final status and ends status reporting.
 func (r *StatusReporter) Stop() {
+	if err := r.monitor.ReportStatus(context.Background(), r.status); err != nil {
+		r.logger.Error("Failed to report final status", zap.Error(err))
+	}
 	close(r.stopChan)
 }
 
@@ -70,6 +73,11 @@ func (r *StatusReporter) UpdateStatus(task string, progress int) {
 	r.status.Progress = progress
 }
 
+// SetProcessed records the number of items handled in the last completed cycle.
+func (r *StatusReporter) SetProcessed(count int) {
+	r.status.Processed = count
+}
+
 // SetHealthy updates the health status.
 func (r *StatusReporter) SetHealthy(healthy bool) {
 	r.status.IsHealthy = healthy
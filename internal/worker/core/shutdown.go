@@ -0,0 +1,18 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// SleepContext pauses for the given duration or until the context is cancelled,
+// so workers waiting between batches stop promptly on shutdown.
+func SleepContext(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -29,6 +29,7 @@ type Status struct {
 	LastSeen    time.Time `json:"lastSeen"`
 	CurrentTask string    `json:"currentTask,omitempty"`
 	Progress    int       `json:"progress"`
+	Processed   int       `json:"processed"` // Items handled in the last completed cycle
 	IsHealthy   bool      `json:"isHealthy"`
 }
 
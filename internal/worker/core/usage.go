@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/client/ai"
+	"github.com/robalyx/rotector/internal/common/setup"
+	"github.com/robalyx/rotector/internal/common/setup/config"
+	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"go.uber.org/zap"
+)
+
+// UsageReporter records the AI token usage of a worker after each batch.
+type UsageReporter struct {
+	db          *database.Client
+	tracker     *ai.UsageTracker
+	pricing     config.GeminiAI
+	logger      *zap.Logger
+	logInterval int
+	batches     int
+}
+
+// NewUsageReporter creates a usage reporter for the given tracker.
+func NewUsageReporter(app *setup.App, tracker *ai.UsageTracker, logger *zap.Logger) *UsageReporter {
+	return &UsageReporter{
+		db:          app.DB,
+		tracker:     tracker,
+		pricing:     app.Config.Common.GeminiAI,
+		logger:      logger,
+		logInterval: app.Config.Worker.AIUsage.LogInterval,
+	}
+}
+
+// ReportBatch adds the usage of the last batch to the daily statistics and logs
+// a summary of the worker's total usage every configured number of batches.
+func (r *UsageReporter) ReportBatch(ctx context.Context) {
+	usage := r.tracker.Flush()
+	r.batches++
+
+	if usage.Requests > 0 {
+		err := r.db.AIUsage().AddUsage(ctx, &types.AIUsageStats{
+			Date:             time.Now(),
+			Model:            r.pricing.Model,
+			PromptTokens:     usage.PromptTokens,
+			CompletionTokens: usage.CompletionTokens,
+			EstimatedCost:    ai.EstimateCost(usage, r.pricing),
+		})
+		if err != nil {
+			r.logger.Error("Failed to save AI usage", zap.Error(err))
+		}
+	}
+
+	if r.logInterval > 0 && r.batches%r.logInterval == 0 {
+		total := r.tracker.Total()
+		r.logger.Info("AI usage summary",
+			zap.Int("batches", r.batches),
+			zap.Int64("requests", total.Requests),
+			zap.Int64("promptTokens", total.PromptTokens),
+			zap.Int64("completionTokens", total.CompletionTokens),
+			zap.Float64("estimatedCost", ai.EstimateCost(total, r.pricing)))
+	}
+}
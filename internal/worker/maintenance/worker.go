@@ -11,9 +11,18 @@ import (
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"github.com/robalyx/rotector/internal/worker/core"
+	"github.com/robalyx/rotector/internal/worker/retention"
 	"go.uber.org/zap"
 )
 
+const (
+	// DefaultThumbnailStaleAge is used when no thumbnail staleness is configured.
+	DefaultThumbnailStaleAge = 7 * 24 * time.Hour
+	// RecentlyViewedWindow is how recently a user or group must have been viewed
+	// for its thumbnail to be refreshed ahead of the rest.
+	RecentlyViewedWindow = 24 * time.Hour
+)
+
 // Worker handles all maintenance operations.
 type Worker struct {
 	db                      *database.Client
@@ -30,15 +39,17 @@ type Worker struct {
 	trackBatchSize          int
 	thumbnailUserBatchSize  int
 	thumbnailGroupBatchSize int
+	thumbnailStaleAge       time.Duration
 	minGroupFlaggedUsers    int
 	minFlaggedOverride      int
 	minFlaggedPercent       float64
+	clearedRetention        time.Duration
 }
 
 // New creates a new maintenance worker.
 func New(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Worker {
 	userFetcher := fetcher.NewUserFetcher(app, logger)
-	groupFetcher := fetcher.NewGroupFetcher(app.RoAPI, logger)
+	groupFetcher := fetcher.NewGroupFetcher(app.RoAPI, app.DB.Shouts(), logger)
 	thumbnailFetcher := fetcher.NewThumbnailFetcher(app.RoAPI, logger)
 	reporter := core.NewStatusReporter(app.StatusClient, "maintenance", "main", logger)
 	groupChecker := checker.NewGroupChecker(app.DB, logger,
@@ -47,6 +58,16 @@ func New(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Worker {
 		app.Config.Worker.ThresholdLimits.MinFlaggedPercentage,
 	)
 
+	// The expiry digest needs the cleared users retention period
+	if err := retention.Validate(app.Config.Worker.Retention); err != nil {
+		logger.Fatal("Invalid retention policy", zap.Error(err))
+	}
+
+	thumbnailStaleAge := time.Duration(app.Config.Worker.ThresholdLimits.ThumbnailStaleDays) * 24 * time.Hour
+	if thumbnailStaleAge <= 0 {
+		thumbnailStaleAge = DefaultThumbnailStaleAge
+	}
+
 	return &Worker{
 		db:                      app.DB,
 		roAPI:                   app.RoAPI,
@@ -62,56 +83,60 @@ func New(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Worker {
 		trackBatchSize:          app.Config.Worker.BatchSizes.TrackGroups,
 		thumbnailUserBatchSize:  app.Config.Worker.BatchSizes.ThumbnailUsers,
 		thumbnailGroupBatchSize: app.Config.Worker.BatchSizes.ThumbnailGroups,
+		thumbnailStaleAge:       thumbnailStaleAge,
 		minGroupFlaggedUsers:    app.Config.Worker.ThresholdLimits.MinGroupFlaggedUsers,
 		minFlaggedOverride:      app.Config.Worker.ThresholdLimits.MinFlaggedOverride,
 		minFlaggedPercent:       app.Config.Worker.ThresholdLimits.MinFlaggedPercentage,
+		clearedRetention:        time.Duration(app.Config.Worker.Retention.ClearedUsers) * 24 * time.Hour,
 	}
 }
 
-// Start begins the maintenance worker's main loop.
-func (w *Worker) Start() {
+// Start begins the maintenance worker's main loop, which runs until the context
+// is cancelled and always finishes the current pass first.
+func (w *Worker) Start(ctx context.Context) {
 	w.logger.Info("Maintenance Worker started", zap.String("workerID", w.reporter.GetWorkerID()))
 	w.reporter.Start()
 	defer w.reporter.Stop()
 
 	w.bar.SetTotal(100)
 
-	for {
+	for ctx.Err() == nil {
 		w.bar.Reset()
 		w.reporter.SetHealthy(true)
 
 		// Step 1: Process banned users (20%)
-		w.processBannedUsers()
-
-		// Step 2: Process locked groups (30%)
-		w.processLockedGroups()
-
-		// Step 3: Process cleared users (40%)
-		w.processClearedUsers()
+		checkedUsers := w.processBannedUsers()
 
-		// Step 4: Process cleared groups (50%)
-		w.processClearedGroups()
+		// Step 2: Process locked groups (35%)
+		checkedGroups := w.processLockedGroups()
 
-		// Step 5: Process group tracking (65%)
+		// Step 3: Process group tracking (50%)
 		w.processGroupTracking()
 
-		// Step 6: Process user thumbnails (80%)
+		// Step 4: Process user thumbnails (70%)
 		w.processUserThumbnails()
 
-		// Step 7: Process group thumbnails (95%)
+		// Step 5: Process group thumbnails (90%)
 		w.processGroupThumbnails()
 
-		// Step 8: Completed (100%)
+		// Step 6: Completed (100%)
 		w.bar.SetStepMessage("Completed", 100)
 		w.reporter.UpdateStatus("Completed", 100)
+		w.reporter.SetProcessed(checkedUsers + checkedGroups)
 
 		// Short pause before next iteration
-		time.Sleep(10 * time.Second)
+		core.SleepContext(ctx, 10*time.Second)
 	}
+
+	// Report that the worker stopped after finishing its last batch
+	w.bar.SetStepMessage("Stopped", 100)
+	w.reporter.UpdateStatus("Stopped", 100)
+	w.logger.Info("Maintenance Worker stopped")
 }
 
 // processBannedUsers checks for and removes banned users.
-func (w *Worker) processBannedUsers() {
+// Returns the number of users checked.
+func (w *Worker) processBannedUsers() int {
 	w.bar.SetStepMessage("Processing banned users", 20)
 	w.reporter.UpdateStatus("Processing banned users", 20)
 
@@ -120,116 +145,108 @@ func (w *Worker) processBannedUsers() {
 	if err != nil {
 		w.logger.Error("Error getting users to check", zap.Error(err))
 		w.reporter.SetHealthy(false)
-		return
+		return 0
 	}
 
 	if len(users) == 0 {
 		w.logger.Info("No users to check for bans")
-		return
+		return 0
 	}
 
 	// Check for banned users
-	bannedUserIDs, err := w.userFetcher.FetchBannedUsers(users)
+	w.bar.SetPhase("fetching users")
+	w.bar.SetTotalItems(int64(len(users)))
+	checked, err := w.userFetcher.CheckUsers(progress.WithBar(context.Background(), w.bar), users)
 	if err != nil {
 		w.logger.Error("Error fetching banned users", zap.Error(err))
 		w.reporter.SetHealthy(false)
-		return
+		return 0
 	}
 
 	// Remove banned users
-	if len(bannedUserIDs) > 0 {
-		err = w.db.Users().RemoveBannedUsers(context.Background(), bannedUserIDs)
+	w.bar.SetPhase("saving")
+	if len(checked.BannedIDs) > 0 {
+		err = w.db.Users().RemoveBannedUsers(context.Background(), checked.BannedIDs)
 		if err != nil {
 			w.logger.Error("Error removing banned users", zap.Error(err))
 			w.reporter.SetHealthy(false)
-			return
+			return 0
 		}
-		w.logger.Info("Removed banned users", zap.Int("count", len(bannedUserIDs)))
+		w.logger.Info("Removed banned users", zap.Int("count", len(checked.BannedIDs)))
+	}
+
+	// Update users who changed their username since they were flagged
+	renamed, err := w.db.Users().UpdateUserNames(context.Background(), checked.Names)
+	if err != nil {
+		w.logger.Error("Error updating renamed users", zap.Error(err))
+		w.reporter.SetHealthy(false)
+	} else if renamed > 0 {
+		w.logger.Info("Updated renamed users", zap.Int("count", renamed))
 	}
+
+	return len(users)
 }
 
 // processLockedGroups checks for and removes locked groups.
-func (w *Worker) processLockedGroups() {
-	w.bar.SetStepMessage("Processing locked groups", 30)
-	w.reporter.UpdateStatus("Processing locked groups", 30)
+// Returns the number of groups checked.
+func (w *Worker) processLockedGroups() int {
+	w.bar.SetStepMessage("Processing locked groups", 35)
+	w.reporter.UpdateStatus("Processing locked groups", 35)
 
 	// Get groups to check
 	groups, err := w.db.Groups().GetGroupsToCheck(context.Background(), w.groupBatchSize)
 	if err != nil {
 		w.logger.Error("Error getting groups to check", zap.Error(err))
 		w.reporter.SetHealthy(false)
-		return
+		return 0
 	}
 
 	if len(groups) == 0 {
 		w.logger.Info("No groups to check for locks")
-		return
+		return 0
 	}
 
 	// Check for locked groups
+	w.bar.SetPhase("fetching groups")
+	w.bar.SetTotalItems(int64(len(groups)))
 	lockedGroupIDs, err := w.groupFetcher.FetchLockedGroups(groups)
 	if err != nil {
 		w.logger.Error("Error fetching locked groups", zap.Error(err))
 		w.reporter.SetHealthy(false)
-		return
+		return 0
 	}
+	w.bar.IncrementProcessed(int64(len(groups)))
 
 	// Remove locked groups
+	w.bar.SetPhase("saving")
 	if len(lockedGroupIDs) > 0 {
 		err = w.db.Groups().RemoveLockedGroups(context.Background(), lockedGroupIDs)
 		if err != nil {
 			w.logger.Error("Error removing locked groups", zap.Error(err))
 			w.reporter.SetHealthy(false)
-			return
+			return 0
 		}
 		w.logger.Info("Removed locked groups", zap.Int("count", len(lockedGroupIDs)))
 	}
-}
-
-// processClearedUsers removes old cleared users.
-func (w *Worker) processClearedUsers() {
-	w.bar.SetStepMessage("Processing cleared users", 40)
-	w.reporter.UpdateStatus("Processing cleared users", 40)
-
-	cutoffDate := time.Now().AddDate(0, 0, -30) // 30 days ago
-	affected, err := w.db.Users().PurgeOldClearedUsers(context.Background(), cutoffDate)
-	if err != nil {
-		w.logger.Error("Error purging old cleared users", zap.Error(err))
-		w.reporter.SetHealthy(false)
-		return
-	}
 
-	if affected > 0 {
-		w.logger.Info("Purged old cleared users",
-			zap.Int("affected", affected),
-			zap.Time("cutoffDate", cutoffDate))
-	}
+	return len(groups)
 }
 
-// processClearedGroups removes old cleared groups.
-func (w *Worker) processClearedGroups() {
-	w.bar.SetStepMessage("Processing cleared groups", 50)
-	w.reporter.UpdateStatus("Processing cleared groups", 50)
+// processGroupTracking manages group tracking data.
+func (w *Worker) processGroupTracking() {
+	w.bar.SetStepMessage("Processing group tracking", 50)
+	w.reporter.UpdateStatus("Processing group tracking", 50)
 
-	cutoffDate := time.Now().AddDate(0, 0, -30) // 30 days ago
-	affected, err := w.db.Groups().PurgeOldClearedGroups(context.Background(), cutoffDate)
+	// Remove trackings of groups that were cleared before trackings were removed on clear
+	purged, droppedUsers, err := w.db.Tracking().PurgeClearedGroupTrackings(context.Background())
 	if err != nil {
-		w.logger.Error("Error purging old cleared groups", zap.Error(err))
+		w.logger.Error("Error purging cleared group trackings", zap.Error(err))
 		w.reporter.SetHealthy(false)
-		return
-	}
-
-	if affected > 0 {
-		w.logger.Info("Purged old cleared groups",
-			zap.Int("affected", affected),
-			zap.Time("cutoffDate", cutoffDate))
+	} else if purged > 0 {
+		w.logger.Info("Purged cleared group trackings",
+			zap.Int("affected", purged),
+			zap.Int("droppedUsers", droppedUsers))
 	}
-}
-
-// processGroupTracking manages group tracking data.
-func (w *Worker) processGroupTracking() {
-	w.bar.SetStepMessage("Processing group tracking", 65)
-	w.reporter.UpdateStatus("Processing group tracking", 65)
 
 	// Get groups to check
 	groupsWithUsers, err := w.db.Tracking().GetGroupTrackingsToCheck(
@@ -257,7 +274,10 @@ func (w *Worker) processGroupTracking() {
 	}
 
 	// Load group information from API
+	w.bar.SetPhase("fetching groups")
+	w.bar.SetTotalItems(int64(len(groupIDs)))
 	groupInfos := w.groupFetcher.FetchGroupInfos(groupIDs)
+	w.bar.IncrementProcessed(int64(len(groupIDs)))
 	if len(groupInfos) == 0 {
 		return
 	}
@@ -272,6 +292,7 @@ func (w *Worker) processGroupTracking() {
 	flaggedGroups = w.thumbnailFetcher.AddGroupImageURLs(flaggedGroups)
 
 	// Save flagged groups to database
+	w.bar.SetPhase("saving")
 	if err := w.db.Groups().SaveGroups(context.Background(), flaggedGroups); err != nil {
 		w.logger.Error("Failed to save flagged groups", zap.Error(err))
 		return
@@ -296,11 +317,13 @@ func (w *Worker) processGroupTracking() {
 
 // processUserThumbnails updates user thumbnails.
 func (w *Worker) processUserThumbnails() {
-	w.bar.SetStepMessage("Processing user thumbnails", 80)
-	w.reporter.UpdateStatus("Processing user thumbnails", 80)
+	w.bar.SetStepMessage("Processing user thumbnails", 70)
+	w.reporter.UpdateStatus("Processing user thumbnails", 70)
 
-	// Get users that need thumbnail updates
-	users, err := w.db.Users().GetUsersForThumbnailUpdate(context.Background(), w.thumbnailUserBatchSize)
+	// Get users with stale thumbnails, recently viewed users first
+	now := time.Now()
+	users, err := w.db.Users().GetUsersForThumbnailUpdate(context.Background(),
+		now.Add(-w.thumbnailStaleAge), now.Add(-RecentlyViewedWindow), w.thumbnailUserBatchSize)
 	if err != nil {
 		w.logger.Error("Error getting users for thumbnail update", zap.Error(err))
 		w.reporter.SetHealthy(false)
@@ -313,10 +336,12 @@ func (w *Worker) processUserThumbnails() {
 	}
 
 	// Update thumbnails
+	w.bar.SetPhase("fetching thumbnails")
+	w.bar.SetTotalItems(int64(len(users)))
 	thumbnailMap := w.thumbnailFetcher.AddImageURLs(users)
+	w.bar.IncrementProcessed(int64(len(users)))
 
 	// Update last thumbnail update time
-	now := time.Now()
 	for id, thumbnail := range thumbnailMap {
 		if user, ok := users[id]; ok {
 			user.ThumbnailURL = thumbnail
@@ -325,6 +350,7 @@ func (w *Worker) processUserThumbnails() {
 	}
 
 	// Save updated users
+	w.bar.SetPhase("saving")
 	if err := w.db.Users().SaveUsers(context.Background(), users); err != nil {
 		w.logger.Error("Error saving updated user thumbnails", zap.Error(err))
 		w.reporter.SetHealthy(false)
@@ -338,11 +364,13 @@ func (w *Worker) processUserThumbnails() {
 
 // processGroupThumbnails updates group thumbnails.
 func (w *Worker) processGroupThumbnails() {
-	w.bar.SetStepMessage("Processing group thumbnails", 95)
-	w.reporter.UpdateStatus("Processing group thumbnails", 95)
+	w.bar.SetStepMessage("Processing group thumbnails", 90)
+	w.reporter.UpdateStatus("Processing group thumbnails", 90)
 
-	// Get groups that need thumbnail updates
-	groups, err := w.db.Groups().GetGroupsForThumbnailUpdate(context.Background(), w.thumbnailGroupBatchSize)
+	// Get groups with stale thumbnails, recently viewed groups first
+	now := time.Now()
+	groups, err := w.db.Groups().GetGroupsForThumbnailUpdate(context.Background(),
+		now.Add(-w.thumbnailStaleAge), now.Add(-RecentlyViewedWindow), w.thumbnailGroupBatchSize)
 	if err != nil {
 		w.logger.Error("Error getting groups for thumbnail update", zap.Error(err))
 		w.reporter.SetHealthy(false)
@@ -355,9 +383,13 @@ func (w *Worker) processGroupThumbnails() {
 	}
 
 	// Update thumbnails
+	w.bar.SetPhase("fetching thumbnails")
+	w.bar.SetTotalItems(int64(len(groups)))
 	updatedGroups := w.thumbnailFetcher.AddGroupImageURLs(groups)
+	w.bar.IncrementProcessed(int64(len(groups)))
 
 	// Save updated groups
+	w.bar.SetPhase("saving")
 	if err := w.db.Groups().SaveGroups(context.Background(), groups); err != nil {
 		w.logger.Error("Error saving updated group thumbnails", zap.Error(err))
 		w.reporter.SetHealthy(false)
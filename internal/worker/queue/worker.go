@@ -2,50 +2,57 @@ package queue
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/bytedance/sonic"
 	"github.com/jaxron/roapi.go/pkg/api"
+	"github.com/robalyx/rotector/internal/common/client/ai"
 	"github.com/robalyx/rotector/internal/common/client/checker"
 	"github.com/robalyx/rotector/internal/common/client/fetcher"
 	"github.com/robalyx/rotector/internal/common/progress"
 	"github.com/robalyx/rotector/internal/common/queue"
 	"github.com/robalyx/rotector/internal/common/setup"
 	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/robalyx/rotector/internal/common/storage/database/types/enum"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"go.uber.org/zap"
 )
 
 // Worker handles items in the queues.
 type Worker struct {
-	db          *database.Client
-	roAPI       *api.API
-	queue       *queue.Manager
-	bar         *progress.Bar
-	userFetcher *fetcher.UserFetcher
-	userChecker *checker.UserChecker
-	reporter    *core.StatusReporter
-	logger      *zap.Logger
-	batchSize   int
+	db            *database.Client
+	roAPI         *api.API
+	queue         *queue.Manager
+	bar           *progress.Bar
+	userFetcher   *fetcher.UserFetcher
+	userChecker   *checker.UserChecker
+	reporter      *core.StatusReporter
+	usageReporter *core.UsageReporter
+	logger        *zap.Logger
+	batchSize     int
 }
 
 // New creates a new queue core.
 func New(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Worker {
 	userFetcher := fetcher.NewUserFetcher(app, logger)
-	userChecker := checker.NewUserChecker(app, userFetcher, logger)
+	usageTracker := ai.NewUsageTracker()
+	userChecker := checker.NewUserChecker(app, bar, userFetcher, usageTracker, logger)
+	userChecker.SetFlagSource(enum.FlagSourceManualRecheck)
 	reporter := core.NewStatusReporter(app.StatusClient, "queue", "process", logger)
 
 	return &Worker{
-		db:          app.DB,
-		roAPI:       app.RoAPI,
-		queue:       app.Queue,
-		bar:         bar,
-		userFetcher: userFetcher,
-		userChecker: userChecker,
-		reporter:    reporter,
-		logger:      logger,
-		batchSize:   app.Config.Worker.BatchSizes.QueueItems,
+		db:            app.DB,
+		roAPI:         app.RoAPI,
+		queue:         app.Queue,
+		bar:           bar,
+		userFetcher:   userFetcher,
+		userChecker:   userChecker,
+		reporter:      reporter,
+		usageReporter: core.NewUsageReporter(app, usageTracker, logger),
+		logger:        logger,
+		batchSize:     app.Config.Worker.BatchSizes.QueueItems,
 	}
 }
 
@@ -53,15 +60,15 @@ func New(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Worker {
 // 1. Gets items from queues in priority order
 // 2. Processes each item through AI analysis
 // 3. Updates queue status and position
-// 4. Repeats until stopped.
-func (w *Worker) Start() {
+// 4. Repeats until the context is cancelled, finishing the current batch first.
+func (w *Worker) Start(ctx context.Context) {
 	w.logger.Info("Process Worker started", zap.String("workerID", w.reporter.GetWorkerID()))
 	w.reporter.Start()
 	defer w.reporter.Stop()
 
 	w.bar.SetTotal(100)
 
-	for {
+	for ctx.Err() == nil {
 		w.bar.Reset()
 		w.reporter.SetHealthy(true)
 
@@ -72,7 +79,7 @@ func (w *Worker) Start() {
 		if err != nil {
 			w.logger.Error("Error getting next batch", zap.Error(err))
 			w.reporter.SetHealthy(false)
-			time.Sleep(5 * time.Minute)
+			core.SleepContext(ctx, 5*time.Minute)
 			continue
 		}
 
@@ -80,17 +87,29 @@ func (w *Worker) Start() {
 		if len(items) == 0 {
 			w.bar.SetStepMessage("No items to process, waiting", 0)
 			w.reporter.UpdateStatus("No items to process, waiting", 0)
-			time.Sleep(10 * time.Second)
+			core.SleepContext(ctx, 10*time.Second)
 			continue
 		}
 
 		// Step 2: Process items (80%)
-		w.processItems(items)
+		retryAfter := w.processItems(items)
 
 		// Step 3: Completed (100%)
 		w.bar.SetStepMessage("Completed", 100)
 		w.reporter.UpdateStatus("Completed", 100)
+		w.reporter.SetProcessed(len(items))
+		w.usageReporter.ReportBatch(context.Background())
+
+		// Back off before retrying items that were rate limited
+		if retryAfter > 0 {
+			core.SleepContext(ctx, retryAfter)
+		}
 	}
+
+	// Report that the worker stopped after finishing its last batch
+	w.bar.SetStepMessage("Stopped", 100)
+	w.reporter.UpdateStatus("Stopped", 100)
+	w.logger.Info("Process Worker stopped")
 }
 
 // getNextBatch retrieves items from queues based on priority order.
@@ -138,7 +157,9 @@ func (w *Worker) getNextBatch() ([]*queue.Item, error) {
 // 3. Running AI analysis on the batch
 // 4. Updating final queue status for all items
 // 5. Removing processed items from queue.
-func (w *Worker) processItems(items []*queue.Item) {
+// Items that were rate limited stay in the queue, and the returned duration
+// tells the caller how long to wait before retrying them.
+func (w *Worker) processItems(items []*queue.Item) time.Duration {
 	ctx := context.Background()
 	itemCount := len(items)
 
@@ -167,13 +188,20 @@ func (w *Worker) processItems(items []*queue.Item) {
 	w.bar.SetStepMessage("Fetching user information", 50)
 	w.reporter.UpdateStatus("Fetching user information", 50)
 
-	userInfos := w.userFetcher.FetchInfos(userIDs)
+	fetchResult := w.userFetcher.FetchInfosWithErrors(ctx, userIDs)
+	userInfos, fetchErrors := fetchResult.Infos, fetchResult.Failed
+
+	// Create set of users skipped while the circuit breaker was open
+	skippedIDSet := make(map[uint64]bool, len(fetchResult.Skipped))
+	for _, id := range fetchResult.Skipped {
+		skippedIDSet[id] = true
+	}
 
 	// Process users with AI checker
 	w.bar.SetStepMessage("Processing with AI", 75)
 	w.reporter.UpdateStatus("Processing with AI", 75)
 
-	failedValidationIDs := w.userChecker.ProcessUsers(userInfos)
+	_, failedValidationIDs := w.userChecker.ProcessUsers(userInfos)
 
 	// Create set of failed IDs for quick lookup
 	failedIDSet := make(map[uint64]bool)
@@ -185,8 +213,44 @@ func (w *Worker) processItems(items []*queue.Item) {
 	w.bar.SetStepMessage("Updating queue status", 100)
 	w.reporter.UpdateStatus("Updating queue status", 100)
 
+	var maxRetryAfter time.Duration
+	requeued := 0
 	for _, userID := range userIDs {
 		item := userIDToItem[userID]
+
+		// Leave rate limited items in the queue to be retried in a later batch
+		if retryAfter, ok := fetcher.RetryAfter(fetchErrors[userID]); ok {
+			if err := w.queue.SetQueueInfo(ctx, item.UserID, queue.StatusPending, item.Priority, 0); err != nil {
+				w.logger.Error("Failed to update queue info",
+					zap.Error(err),
+					zap.Uint64("userID", item.UserID))
+			}
+			w.logger.Warn("Rate limited while fetching queued user, leaving in queue",
+				zap.Uint64("userID", userID),
+				zap.Duration("retryAfter", retryAfter))
+			maxRetryAfter = max(maxRetryAfter, retryAfter)
+			requeued++
+			continue
+		}
+
+		// Leave items skipped by the circuit breaker in the queue to be retried in a later batch
+		if skippedIDSet[userID] {
+			if err := w.queue.SetQueueInfo(ctx, item.UserID, queue.StatusPending, item.Priority, 0); err != nil {
+				w.logger.Error("Failed to update queue info",
+					zap.Error(err),
+					zap.Uint64("userID", item.UserID))
+			}
+			maxRetryAfter = max(maxRetryAfter, fetcher.DefaultRetryAfter)
+			requeued++
+			continue
+		}
+
+		// Skip users that are banned or no longer exist
+		if errors.Is(fetchErrors[userID], fetcher.ErrUserBanned) || errors.Is(fetchErrors[userID], fetcher.ErrDeleted) {
+			w.updateQueueStatus(ctx, item, queue.StatusSkipped)
+			continue
+		}
+
 		if failedIDSet[userID] {
 			// Update status to skipped for failed validations
 			w.updateQueueStatus(ctx, item, queue.StatusSkipped)
@@ -198,13 +262,17 @@ func (w *Worker) processItems(items []*queue.Item) {
 
 	w.logger.Info("Finished processing batch",
 		zap.Int("totalItems", len(items)),
-		zap.Int("failedValidations", len(failedValidationIDs)))
+		zap.Int("failedValidations", len(failedValidationIDs)),
+		zap.Int("requeued", requeued))
+
+	return maxRetryAfter
 }
 
 // updateQueueStatus handles the final state of a queue item by:
 // 1. Setting the final status in queue info
 // 2. Removing the item from its priority queue
-// 3. Logging any errors that occur.
+// 3. Recording the item as processed for the queue stats
+// 4. Logging any errors that occur.
 func (w *Worker) updateQueueStatus(ctx context.Context, item *queue.Item, status string) {
 	// Update queue info with final status
 	if err := w.queue.SetQueueInfo(ctx, item.UserID, status, item.Priority, 0); err != nil {
@@ -220,4 +288,11 @@ func (w *Worker) updateQueueStatus(ctx context.Context, item *queue.Item, status
 			zap.Error(err),
 			zap.Uint64("userID", item.UserID))
 	}
+
+	// Record item for queue stats
+	if err := w.queue.MarkProcessed(ctx, item, time.Now()); err != nil {
+		w.logger.Error("Failed to record processed queue item",
+			zap.Error(err),
+			zap.Uint64("userID", item.UserID))
+	}
 }
@@ -0,0 +1,181 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/setup/config"
+	"github.com/robalyx/rotector/internal/common/storage/database"
+	"go.uber.org/zap"
+)
+
+// ErrRetentionUnset is returned when a retention period is zero or missing, since
+// purging with it would remove every row of that class.
+var ErrRetentionUnset = errors.New("retention period is not set")
+
+// Result holds how much was removed from each class of data in a retention pass.
+type Result struct {
+	ClearedUsers    int
+	ClearedGroups   int
+	BannedUsers     int
+	DeletedUsers    int
+	ActivityChunks  int
+	HourlyStats     int
+	ResolvedAppeals int
+}
+
+// Store purges each class of stored data older than a cutoff.
+type Store interface {
+	PurgeOldClearedUsers(ctx context.Context, cutoff time.Time) (int, error)
+	PurgeOldClearedGroups(ctx context.Context, cutoff time.Time) (int, error)
+	PurgeOldBannedUsers(ctx context.Context, cutoff time.Time) (int, error)
+	PurgeOldDeletedUsers(ctx context.Context, cutoff time.Time) (int, error)
+	PurgeOldLogs(ctx context.Context, cutoff time.Time) (int, error)
+	PurgeOldStats(ctx context.Context, cutoff time.Time) (int, error)
+	PurgeOldResolvedAppeals(ctx context.Context, cutoff time.Time) (int, error)
+	// IncrementPurgeCounts records purged cleared users and groups in the hourly stats.
+	IncrementPurgeCounts(ctx context.Context, users, groups int) error
+}
+
+// dbStore is the Store backed by the database models.
+type dbStore struct {
+	db *database.Client
+}
+
+func (s dbStore) PurgeOldClearedUsers(ctx context.Context, cutoff time.Time) (int, error) {
+	return s.db.Users().PurgeOldClearedUsers(ctx, cutoff)
+}
+
+func (s dbStore) PurgeOldClearedGroups(ctx context.Context, cutoff time.Time) (int, error) {
+	return s.db.Groups().PurgeOldClearedGroups(ctx, cutoff)
+}
+
+func (s dbStore) PurgeOldBannedUsers(ctx context.Context, cutoff time.Time) (int, error) {
+	return s.db.Users().PurgeOldBannedUsers(ctx, cutoff)
+}
+
+func (s dbStore) PurgeOldDeletedUsers(ctx context.Context, cutoff time.Time) (int, error) {
+	return s.db.Users().PurgeOldDeletedUsers(ctx, cutoff)
+}
+
+func (s dbStore) PurgeOldLogs(ctx context.Context, cutoff time.Time) (int, error) {
+	return s.db.Activity().PurgeOldLogs(ctx, cutoff)
+}
+
+func (s dbStore) PurgeOldStats(ctx context.Context, cutoff time.Time) (int, error) {
+	return s.db.Stats().PurgeOldStats(ctx, cutoff)
+}
+
+func (s dbStore) PurgeOldResolvedAppeals(ctx context.Context, cutoff time.Time) (int, error) {
+	return s.db.Appeals().PurgeOldResolvedAppeals(ctx, cutoff)
+}
+
+func (s dbStore) IncrementPurgeCounts(ctx context.Context, users, groups int) error {
+	return s.db.Stats().IncrementPurgeCounts(ctx, users, groups)
+}
+
+// Runner applies the configured retention policy to each class of stored data.
+type Runner struct {
+	store  Store
+	policy config.Retention
+	logger *zap.Logger
+}
+
+// NewRunner creates a runner for the given retention policy.
+func NewRunner(db *database.Client, policy config.Retention, logger *zap.Logger) *Runner {
+	return &Runner{
+		store:  dbStore{db: db},
+		policy: policy,
+		logger: logger.Named("retention"),
+	}
+}
+
+// Validate checks that every retention period of the policy is set. All unset
+// classes are listed in the error so they can be fixed at once.
+func Validate(policy config.Retention) error {
+	var unset []string
+	for _, class := range []struct {
+		name string
+		days int
+	}{
+		{"cleared_users", policy.ClearedUsers},
+		{"cleared_groups", policy.ClearedGroups},
+		{"banned_users", policy.BannedUsers},
+		{"deleted_users", policy.DeletedUsers},
+		{"activity_logs", policy.ActivityLogs},
+		{"hourly_stats", policy.HourlyStats},
+		{"resolved_appeals", policy.ResolvedAppeals},
+	} {
+		if class.days <= 0 {
+			unset = append(unset, class.name)
+		}
+	}
+	if len(unset) > 0 {
+		return fmt.Errorf("%w: %s", ErrRetentionUnset, strings.Join(unset, ", "))
+	}
+	return nil
+}
+
+// class is a class of stored data purged by the retention pass.
+type class struct {
+	name  string
+	days  int
+	count *int
+	purge func(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+// classes returns every class of data, each recording how much it purged in the result.
+func (r *Runner) classes(result *Result) []class {
+	return []class{
+		{"cleared_users", r.policy.ClearedUsers, &result.ClearedUsers, r.store.PurgeOldClearedUsers},
+		{"cleared_groups", r.policy.ClearedGroups, &result.ClearedGroups, r.store.PurgeOldClearedGroups},
+		{"banned_users", r.policy.BannedUsers, &result.BannedUsers, r.store.PurgeOldBannedUsers},
+		{"deleted_users", r.policy.DeletedUsers, &result.DeletedUsers, r.store.PurgeOldDeletedUsers},
+		{"activity_logs", r.policy.ActivityLogs, &result.ActivityChunks, r.store.PurgeOldLogs},
+		{"hourly_stats", r.policy.HourlyStats, &result.HourlyStats, r.store.PurgeOldStats},
+		{"resolved_appeals", r.policy.ResolvedAppeals, &result.ResolvedAppeals, r.store.PurgeOldResolvedAppeals},
+	}
+}
+
+// Run purges every class of data older than its retention period. Nothing is
+// purged if any period is unset. A failing class does not stop the others, and
+// the errors of all failed classes are returned together once every class was tried.
+func (r *Runner) Run(ctx context.Context) (*Result, error) {
+	if err := Validate(r.policy); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := &Result{}
+	var errs []error
+	for _, c := range r.classes(result) {
+		n, err := c.purge(ctx, now.AddDate(0, 0, -c.days))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		*c.count = n
+	}
+
+	// Record purged users and groups in the hourly stats
+	if result.ClearedUsers > 0 || result.ClearedGroups > 0 {
+		if err := r.store.IncrementPurgeCounts(ctx, result.ClearedUsers, result.ClearedGroups); err != nil {
+			r.logger.Error("Failed to record purged cleared users and groups", zap.Error(err))
+		}
+	}
+
+	r.logger.Info("Applied retention policies",
+		zap.Int("clearedUsers", result.ClearedUsers),
+		zap.Int("clearedGroups", result.ClearedGroups),
+		zap.Int("bannedUsers", result.BannedUsers),
+		zap.Int("deletedUsers", result.DeletedUsers),
+		zap.Int("activityLogChunks", result.ActivityChunks),
+		zap.Int("hourlyStats", result.HourlyStats),
+		zap.Int("resolvedAppeals", result.ResolvedAppeals),
+		zap.Int("failedClasses", len(errs)))
+
+	return result, errors.Join(errs...)
+}
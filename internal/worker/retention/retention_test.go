@@ -0,0 +1,175 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/common/setup/config"
+	"github.com/robalyx/rotector/internal/common/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fullPolicy is a policy with every retention period set.
+var fullPolicy = config.Retention{
+	ClearedUsers:    7,
+	ClearedGroups:   14,
+	BannedUsers:     365,
+	DeletedUsers:    3,
+	ActivityLogs:    180,
+	HourlyStats:     60,
+	ResolvedAppeals: 90,
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(fullPolicy))
+
+	// Every unset class is listed so they can be fixed at once
+	policy := fullPolicy
+	policy.BannedUsers = 0
+	policy.ActivityLogs = -1
+	err := Validate(policy)
+	require.ErrorIs(t, err, ErrRetentionUnset)
+	assert.ErrorContains(t, err, "banned_users, activity_logs")
+
+	// A config without a retention section sets nothing
+	require.ErrorIs(t, Validate(config.Retention{}), ErrRetentionUnset)
+}
+
+// fakeStore records the cutoff of each purged class and returns the configured
+// counts and errors.
+type fakeStore struct {
+	counts  map[string]int
+	errs    map[string]error
+	cutoffs map[string]time.Time
+
+	purgedUsers  int
+	purgedGroups int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		counts:  make(map[string]int),
+		errs:    make(map[string]error),
+		cutoffs: make(map[string]time.Time),
+	}
+}
+
+func (s *fakeStore) purge(name string, cutoff time.Time) (int, error) {
+	s.cutoffs[name] = cutoff
+	return s.counts[name], s.errs[name]
+}
+
+func (s *fakeStore) PurgeOldClearedUsers(_ context.Context, cutoff time.Time) (int, error) {
+	return s.purge("cleared_users", cutoff)
+}
+
+func (s *fakeStore) PurgeOldClearedGroups(_ context.Context, cutoff time.Time) (int, error) {
+	return s.purge("cleared_groups", cutoff)
+}
+
+func (s *fakeStore) PurgeOldBannedUsers(_ context.Context, cutoff time.Time) (int, error) {
+	return s.purge("banned_users", cutoff)
+}
+
+func (s *fakeStore) PurgeOldDeletedUsers(_ context.Context, cutoff time.Time) (int, error) {
+	return s.purge("deleted_users", cutoff)
+}
+
+func (s *fakeStore) PurgeOldLogs(_ context.Context, cutoff time.Time) (int, error) {
+	return s.purge("activity_logs", cutoff)
+}
+
+func (s *fakeStore) PurgeOldStats(_ context.Context, cutoff time.Time) (int, error) {
+	return s.purge("hourly_stats", cutoff)
+}
+
+func (s *fakeStore) PurgeOldResolvedAppeals(_ context.Context, cutoff time.Time) (int, error) {
+	return s.purge("resolved_appeals", cutoff)
+}
+
+func (s *fakeStore) IncrementPurgeCounts(_ context.Context, users, groups int) error {
+	s.purgedUsers += users
+	s.purgedGroups += groups
+	return nil
+}
+
+// newTestRunner creates a runner for the policy that purges from the store.
+func newTestRunner(store Store, policy config.Retention) *Runner {
+	return &Runner{
+		store:  store,
+		policy: policy,
+		logger: zap.NewNop(),
+	}
+}
+
+// purgedClasses returns the names of the classes purged from the store.
+func purgedClasses(store *fakeStore) []string {
+	names := make([]string, 0, len(store.cutoffs))
+	for name := range store.cutoffs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestNewRunner(t *testing.T) {
+	// Building a runner must not touch the models of the client
+	runner := NewRunner(&database.Client{}, fullPolicy, zap.NewNop())
+	assert.Len(t, runner.classes(&Result{}), 7)
+}
+
+func TestRunPurgesEveryClass(t *testing.T) {
+	store := newFakeStore()
+	before := time.Now()
+	_, err := newTestRunner(store, fullPolicy).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"activity_logs", "banned_users", "cleared_groups", "cleared_users",
+		"deleted_users", "hourly_stats", "resolved_appeals",
+	}, purgedClasses(store))
+
+	// Each class is purged with its own period
+	assert.WithinDuration(t, before.AddDate(0, 0, -7), store.cutoffs["cleared_users"], time.Minute)
+	assert.WithinDuration(t, before.AddDate(0, 0, -365), store.cutoffs["banned_users"], time.Minute)
+	assert.WithinDuration(t, before.AddDate(0, 0, -180), store.cutoffs["activity_logs"], time.Minute)
+}
+
+func TestRunRefusesUnsetPeriods(t *testing.T) {
+	policy := fullPolicy
+	policy.HourlyStats = 0
+
+	store := newFakeStore()
+	result, err := newTestRunner(store, policy).Run(context.Background())
+	require.ErrorIs(t, err, ErrRetentionUnset)
+	assert.Nil(t, result)
+
+	// Nothing is purged, not even the classes that are set
+	assert.Empty(t, store.cutoffs)
+}
+
+func TestRunRecordsCounts(t *testing.T) {
+	errPurge := errors.New("purge failed")
+
+	store := newFakeStore()
+	store.counts["cleared_users"] = 4
+	store.counts["cleared_groups"] = 2
+	store.counts["activity_logs"] = 3
+	store.counts["hourly_stats"] = 5
+	store.errs["hourly_stats"] = errPurge
+
+	result, err := newTestRunner(store, fullPolicy).Run(context.Background())
+
+	// A failing class is reported without stopping the others
+	require.ErrorIs(t, err, errPurge)
+	assert.Equal(t, &Result{ClearedUsers: 4, ClearedGroups: 2, ActivityChunks: 3}, result)
+	assert.Contains(t, store.cutoffs, "deleted_users")
+
+	// Purged cleared users and groups are recorded in the hourly stats
+	assert.Equal(t, 4, store.purgedUsers)
+	assert.Equal(t, 2, store.purgedGroups)
+}
@@ -70,7 +70,7 @@ func (b *ChartBuilder) Build() (*bytes.Buffer, *bytes.Buffer, error) {
 // buildUserChart creates a chart showing user-related statistics.
 func (b *ChartBuilder) buildUserChart() (*bytes.Buffer, error) {
 	// Extract data points for user series
-	xValues, confirmedSeries, flaggedSeries, clearedSeries, bannedSeries := b.prepareUserDataSeries()
+	xValues, confirmedSeries, flaggedSeries, clearedSeries, bannedSeries, purgedSeries := b.prepareUserDataSeries()
 
 	// Configure and create the chart
 	graph := &chart.Chart{
@@ -84,6 +84,7 @@ func (b *ChartBuilder) buildUserChart() (*bytes.Buffer, error) {
 			b.createSeries("Flagged", xValues, flaggedSeries, chart.ColorOrange),
 			b.createSeries("Cleared", xValues, clearedSeries, chart.ColorGreen),
 			b.createSeries("Banned", xValues, bannedSeries, chart.ColorBlue),
+			b.createSeries("Purged", xValues, purgedSeries, chart.ColorAlternateGray),
 		},
 	}
 
@@ -104,7 +105,7 @@ func (b *ChartBuilder) buildUserChart() (*bytes.Buffer, error) {
 // buildGroupChart creates a chart showing group-related statistics.
 func (b *ChartBuilder) buildGroupChart() (*bytes.Buffer, error) {
 	// Extract data points for group series
-	xValues, confirmedSeries, flaggedSeries, clearedSeries, lockedSeries := b.prepareGroupDataSeries()
+	xValues, confirmedSeries, flaggedSeries, clearedSeries, lockedSeries, purgedSeries := b.prepareGroupDataSeries()
 
 	// Configure and create the chart
 	graph := &chart.Chart{
@@ -118,6 +119,7 @@ func (b *ChartBuilder) buildGroupChart() (*bytes.Buffer, error) {
 			b.createSeries("Flagged", xValues, flaggedSeries, chart.ColorOrange),
 			b.createSeries("Cleared", xValues, clearedSeries, chart.ColorGreen),
 			b.createSeries("Locked", xValues, lockedSeries, chart.ColorBlue),
+			b.createSeries("Purged", xValues, purgedSeries, chart.ColorAlternateGray),
 		},
 	}
 
@@ -136,12 +138,13 @@ func (b *ChartBuilder) buildGroupChart() (*bytes.Buffer, error) {
 }
 
 // prepareUserDataSeries extracts user-related data points from hourly statistics.
-func (b *ChartBuilder) prepareUserDataSeries() ([]float64, []float64, []float64, []float64, []float64) {
+func (b *ChartBuilder) prepareUserDataSeries() ([]float64, []float64, []float64, []float64, []float64, []float64) {
 	xValues := make([]float64, hoursToShow)
 	confirmedSeries := make([]float64, hoursToShow)
 	flaggedSeries := make([]float64, hoursToShow)
 	clearedSeries := make([]float64, hoursToShow)
 	bannedSeries := make([]float64, hoursToShow)
+	purgedSeries := make([]float64, hoursToShow)
 
 	// Create a map of truncated timestamps to stats for lookup
 	statsMap := make(map[time.Time]*types.HourlyStats)
@@ -162,19 +165,21 @@ func (b *ChartBuilder) prepareUserDataSeries() ([]float64, []float64, []float64,
 			flaggedSeries[idx] = float64(stat.UsersFlagged)
 			clearedSeries[idx] = float64(stat.UsersCleared)
 			bannedSeries[idx] = float64(stat.UsersBanned)
+			purgedSeries[idx] = float64(stat.UsersPurgedCleared)
 		}
 	}
 
-	return xValues, confirmedSeries, flaggedSeries, clearedSeries, bannedSeries
+	return xValues, confirmedSeries, flaggedSeries, clearedSeries, bannedSeries, purgedSeries
 }
 
 // prepareGroupDataSeries extracts group-related data points from hourly statistics.
-func (b *ChartBuilder) prepareGroupDataSeries() ([]float64, []float64, []float64, []float64, []float64) {
+func (b *ChartBuilder) prepareGroupDataSeries() ([]float64, []float64, []float64, []float64, []float64, []float64) {
 	xValues := make([]float64, hoursToShow)
 	confirmedSeries := make([]float64, hoursToShow)
 	flaggedSeries := make([]float64, hoursToShow)
 	clearedSeries := make([]float64, hoursToShow)
 	lockedSeries := make([]float64, hoursToShow)
+	purgedSeries := make([]float64, hoursToShow)
 
 	// Create a map of truncated timestamps to stats for lookup
 	statsMap := make(map[time.Time]*types.HourlyStats)
@@ -195,10 +200,11 @@ func (b *ChartBuilder) prepareGroupDataSeries() ([]float64, []float64, []float64
 			flaggedSeries[idx] = float64(stat.GroupsFlagged)
 			clearedSeries[idx] = float64(stat.GroupsCleared)
 			lockedSeries[idx] = float64(stat.GroupsLocked)
+			purgedSeries[idx] = float64(stat.GroupsPurgedCleared)
 		}
 	}
 
-	return xValues, confirmedSeries, flaggedSeries, clearedSeries, lockedSeries
+	return xValues, confirmedSeries, flaggedSeries, clearedSeries, lockedSeries, purgedSeries
 }
 
 // prepareGridLinesAndTicks creates grid lines and x-axis labels.
@@ -0,0 +1,306 @@
+package stats
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/robalyx/rotector/internal/common/setup/config"
+	"github.com/robalyx/rotector/internal/common/storage/database/types"
+	"github.com/uptrace/bunrouter"
+	"go.uber.org/zap"
+)
+
+const (
+	// PublicStatsCacheDuration is how long public statistics responses are reused.
+	PublicStatsCacheDuration = 60 * time.Second
+	// PublicStatsDefaultDays is the number of days returned by /stats/daily when none are requested.
+	PublicStatsDefaultDays = 30
+	// PublicStatsMaxDays is the most days that can be requested from /stats/daily.
+	PublicStatsMaxDays = 90
+	// PublicStatsShutdownTimeout is how long in-flight requests are given to finish on shutdown.
+	PublicStatsShutdownTimeout = 10 * time.Second
+	// DefaultPublicStatsAddress is used when no address is configured for the public stats server.
+	DefaultPublicStatsAddress = "127.0.0.1:8090"
+)
+
+// ErrPublicStatsToken indicates that the public stats server has no token while anonymous access is off.
+var ErrPublicStatsToken = errors.New("public stats server requires a token unless anonymous access is allowed")
+
+// statsSource provides the statistics served by the public stats server.
+type statsSource interface {
+	GetHourlyStats(ctx context.Context) ([]*types.HourlyStats, error)
+	GetDailyAggregates(ctx context.Context, days int) ([]*types.DailyStats, error)
+}
+
+// publicCounts holds the moderation volume published for a period.
+// Only aggregate counts belong here, never anything about a single user or group.
+type publicCounts struct {
+	UsersFlagged    int64 `json:"usersFlagged"`
+	UsersConfirmed  int64 `json:"usersConfirmed"`
+	UsersCleared    int64 `json:"usersCleared"`
+	UsersBanned     int64 `json:"usersBanned"`
+	GroupsFlagged   int64 `json:"groupsFlagged"`
+	GroupsConfirmed int64 `json:"groupsConfirmed"`
+	GroupsCleared   int64 `json:"groupsCleared"`
+	GroupsLocked    int64 `json:"groupsLocked"`
+}
+
+// publicHourlyStats is a single hour in the /stats/hourly response.
+type publicHourlyStats struct {
+	Timestamp string `json:"timestamp"`
+	publicCounts
+}
+
+// publicDailyStats is a single day in the /stats/daily response.
+type publicDailyStats struct {
+	Date string `json:"date"`
+	publicCounts
+}
+
+// publicStatsResponse is the body of every public statistics response.
+type publicStatsResponse struct {
+	GeneratedAt string `json:"generatedAt"`
+	Stats       any    `json:"stats"`
+}
+
+// cachedResponse is an encoded response kept until it expires.
+type cachedResponse struct {
+	body      []byte
+	expiresAt time.Time
+}
+
+// PublicServer serves anonymized moderation volume over HTTP so it can be shown
+// on a community status page without access to the database.
+type PublicServer struct {
+	stats   statsSource
+	config  config.StatsServer
+	handler http.Handler
+	srv     *http.Server
+	logger  *zap.Logger
+	cacheMu sync.Mutex
+	cache   map[string]cachedResponse
+}
+
+// NewPublicServer creates a PublicServer. A token is required unless anonymous access is allowed.
+func NewPublicServer(stats statsSource, cfg config.StatsServer, logger *zap.Logger) (*PublicServer, error) {
+	if cfg.Token == "" && !cfg.AllowAnonymous {
+		return nil, ErrPublicStatsToken
+	}
+	if cfg.Address == "" {
+		cfg.Address = DefaultPublicStatsAddress
+	}
+
+	s := &PublicServer{
+		stats:  stats,
+		config: cfg,
+		logger: logger.Named("public_stats"),
+		cache:  make(map[string]cachedResponse),
+	}
+
+	router := bunrouter.New()
+	router.Use(s.authorize).WithGroup("/stats", func(g *bunrouter.Group) {
+		g.GET("/hourly", s.handleHourly)
+		g.GET("/daily", s.handleDaily)
+	})
+	s.handler = router
+
+	return s, nil
+}
+
+// Start listens on the configured address and serves requests in the background.
+func (s *PublicServer) Start() error {
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.config.Address, err)
+	}
+
+	// A server cannot be reused after shutdown, so each start gets a new one
+	s.srv = &http.Server{
+		Handler:           s.handler,
+		ReadTimeout:       5 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func(srv *http.Server) {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Public stats server failed", zap.Error(err))
+		}
+	}(s.srv)
+
+	s.logger.Info("Public stats server started",
+		zap.String("address", listener.Addr().String()),
+		zap.Bool("allowAnonymous", s.config.AllowAnonymous))
+	return nil
+}
+
+// Shutdown stops accepting requests and waits for in-flight requests to finish.
+func (s *PublicServer) Shutdown() {
+	if s.srv == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), PublicStatsShutdownTimeout)
+	defer cancel()
+
+	if err := s.srv.Shutdown(ctx); err != nil {
+		s.logger.Error("Public stats server forced to shut down", zap.Error(err))
+		return
+	}
+	s.logger.Info("Public stats server stopped")
+}
+
+// authorize rejects requests without the configured bearer token unless anonymous access is allowed.
+func (s *PublicServer) authorize(next bunrouter.HandlerFunc) bunrouter.HandlerFunc {
+	return func(w http.ResponseWriter, req bunrouter.Request) error {
+		if !s.config.AllowAnonymous {
+			token, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+			if !found || subtle.ConstantTimeCompare([]byte(token), []byte(s.config.Token)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return nil
+			}
+		}
+		return next(w, req)
+	}
+}
+
+// handleHourly serves the statistics of each hour in the last 24 hours.
+func (s *PublicServer) handleHourly(w http.ResponseWriter, req bunrouter.Request) error {
+	body, err := s.cached("hourly", func() (any, error) {
+		hourly, err := s.stats.GetHourlyStats(req.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		stats := make([]publicHourlyStats, 0, len(hourly))
+		for _, hour := range hourly {
+			stats = append(stats, publicHourlyStats{
+				Timestamp:    hour.Timestamp.UTC().Format(time.RFC3339),
+				publicCounts: hourlyCounts(hour),
+			})
+		}
+		return stats, nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to get hourly stats", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return nil
+	}
+
+	s.writeJSON(w, body)
+	return nil
+}
+
+// handleDaily serves the statistics of each day, going back the number of days
+// given by the days query parameter.
+func (s *PublicServer) handleDaily(w http.ResponseWriter, req bunrouter.Request) error {
+	days := PublicStatsDefaultDays
+	if value := req.URL.Query().Get("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			http.Error(w, "days must be a positive number", http.StatusBadRequest)
+			return nil
+		}
+		days = min(parsed, PublicStatsMaxDays)
+	}
+
+	body, err := s.cached("daily:"+strconv.Itoa(days), func() (any, error) {
+		daily, err := s.stats.GetDailyAggregates(req.Context(), days)
+		if err != nil {
+			return nil, err
+		}
+
+		stats := make([]publicDailyStats, 0, len(daily))
+		for _, day := range daily {
+			stats = append(stats, publicDailyStats{
+				Date:         day.Date.Format(time.DateOnly),
+				publicCounts: dailyCounts(day),
+			})
+		}
+		return stats, nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to get daily stats", zap.Error(err), zap.Int("days", days))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return nil
+	}
+
+	s.writeJSON(w, body)
+	return nil
+}
+
+// cached returns the encoded response for the key, loading and encoding it again
+// once the cached copy is older than the cache duration.
+func (s *PublicServer) cached(key string, load func() (any, error)) ([]byte, error) {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+
+	now := time.Now()
+	if entry, ok := s.cache[key]; ok && now.Before(entry.expiresAt) {
+		return entry.body, nil
+	}
+
+	stats, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := sonic.Marshal(publicStatsResponse{
+		GeneratedAt: now.UTC().Format(time.RFC3339),
+		Stats:       stats,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public stats: %w", err)
+	}
+
+	s.cache[key] = cachedResponse{body: body, expiresAt: now.Add(PublicStatsCacheDuration)}
+	return body, nil
+}
+
+// writeJSON writes an encoded response that clients may cache for as long as the server does.
+func (s *PublicServer) writeJSON(w http.ResponseWriter, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(PublicStatsCacheDuration.Seconds())))
+	if _, err := w.Write(body); err != nil {
+		s.logger.Debug("Failed to write public stats response", zap.Error(err))
+	}
+}
+
+// hourlyCounts returns the published counts of an hour.
+func hourlyCounts(stats *types.HourlyStats) publicCounts {
+	return publicCounts{
+		UsersFlagged:    stats.UsersFlagged,
+		UsersConfirmed:  stats.UsersConfirmed,
+		UsersCleared:    stats.UsersCleared,
+		UsersBanned:     stats.UsersBanned,
+		GroupsFlagged:   stats.GroupsFlagged,
+		GroupsConfirmed: stats.GroupsConfirmed,
+		GroupsCleared:   stats.GroupsCleared,
+		GroupsLocked:    stats.GroupsLocked,
+	}
+}
+
+// dailyCounts returns the published counts of a day.
+func dailyCounts(stats *types.DailyStats) publicCounts {
+	return publicCounts{
+		UsersFlagged:    stats.UsersFlagged,
+		UsersConfirmed:  stats.UsersConfirmed,
+		UsersCleared:    stats.UsersCleared,
+		UsersBanned:     stats.UsersBanned,
+		GroupsFlagged:   stats.GroupsFlagged,
+		GroupsConfirmed: stats.GroupsConfirmed,
+		GroupsCleared:   stats.GroupsCleared,
+		GroupsLocked:    stats.GroupsLocked,
+	}
+}
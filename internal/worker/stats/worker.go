@@ -4,8 +4,13 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
 	"github.com/redis/rueidis"
 	"github.com/robalyx/rotector/internal/common/client/ai"
 	"github.com/robalyx/rotector/internal/common/progress"
@@ -13,7 +18,9 @@ import (
 	"github.com/robalyx/rotector/internal/common/storage/database"
 	"github.com/robalyx/rotector/internal/common/storage/database/types"
 	"github.com/robalyx/rotector/internal/common/storage/redis"
+	"github.com/robalyx/rotector/internal/common/trends"
 	"github.com/robalyx/rotector/internal/worker/core"
+	"github.com/robalyx/rotector/internal/worker/retention"
 	"go.uber.org/zap"
 )
 
@@ -23,14 +30,46 @@ const (
 	GroupStatsChartKey = "stats:chart:groups"
 )
 
+const (
+	// ExpiryDigestKey marks that the cleared user expiry digest was sent recently.
+	ExpiryDigestKey = "stats:digest:cleared_expiry"
+	// ExpiryDigestInterval is how often the cleared user expiry digest is sent.
+	ExpiryDigestInterval = 7 * 24 * time.Hour
+	// ExpiryDigestSampleSize is the number of expiring users listed in the digest.
+	ExpiryDigestSampleSize = 10
+)
+
+const (
+	// DefaultTrendPeriod is used when no trend window is configured.
+	DefaultTrendPeriod = 7 * 24 * time.Hour
+	// MaxTrendingTerms is the number of rising terms stored for analysts.
+	MaxTrendingTerms = 100
+)
+
+const (
+	// DefaultInterval is used when no snapshot interval is configured.
+	DefaultInterval = time.Hour
+	// DefaultMaxBackfillGap is used when no maximum backfill gap is configured.
+	DefaultMaxBackfillGap = 48 * time.Hour
+)
+
 // Worker handles hourly statistics snapshots.
 type Worker struct {
-	db          *database.Client
-	bar         *progress.Bar
-	reporter    *core.StatusReporter
-	analyzer    *ai.StatsAnalyzer
-	redisClient rueidis.Client
-	logger      *zap.Logger
+	db               *database.Client
+	bar              *progress.Bar
+	reporter         *core.StatusReporter
+	analyzer         *ai.StatsAnalyzer
+	redisClient      rueidis.Client
+	discordRest      rest.Rest
+	logger           *zap.Logger
+	retention        *retention.Runner
+	server           *PublicServer
+	interval         time.Duration
+	maxBackfillGap   time.Duration
+	backfillWindow   time.Duration
+	trendPeriod      time.Duration
+	clearedRetention time.Duration
+	decayRefreshedAt time.Time
 }
 
 // New creates a new stats worker.
@@ -41,36 +80,95 @@ func New(app *setup.App, bar *progress.Bar, logger *zap.Logger) *Worker {
 		logger.Fatal("Failed to get Redis client for stats", zap.Error(err))
 	}
 
+	cfg := app.Config.Worker.Stats
+	interval := time.Duration(cfg.Interval) * time.Minute
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	maxBackfillGap := time.Duration(cfg.MaxBackfillHours) * time.Hour
+	if maxBackfillGap <= 0 {
+		maxBackfillGap = DefaultMaxBackfillGap
+	}
+	trendPeriod := time.Duration(cfg.TrendDays) * 24 * time.Hour
+	if trendPeriod <= 0 {
+		trendPeriod = DefaultTrendPeriod
+	}
+
+	// Refuse to start rather than purge with a missing retention period
+	retentionCfg := app.Config.Worker.Retention
+	if err := retention.Validate(retentionCfg); err != nil {
+		logger.Fatal("Invalid retention policy", zap.Error(err))
+	}
+
+	// Hours older than the hourly stats retention would be purged right away
+	backfillWindow := time.Duration(retentionCfg.HourlyStats) * 24 * time.Hour
+
+	// Serve public statistics if enabled
+	var server *PublicServer
+	if cfg.Server.Enabled {
+		server, err = NewPublicServer(app.DB.Stats(), cfg.Server, logger)
+		if err != nil {
+			logger.Fatal("Failed to create public stats server", zap.Error(err))
+		}
+	}
+
 	return &Worker{
-		db:          app.DB,
-		bar:         bar,
-		reporter:    core.NewStatusReporter(app.StatusClient, "stats", "", logger),
-		analyzer:    ai.NewStatsAnalyzer(app, logger),
-		redisClient: statsClient,
-		logger:      logger,
+		db:               app.DB,
+		bar:              bar,
+		reporter:         core.NewStatusReporter(app.StatusClient, "stats", "", logger),
+		analyzer:         ai.NewStatsAnalyzer(app, logger),
+		redisClient:      statsClient,
+		discordRest:      rest.New(rest.NewClient(app.Config.Bot.Discord.Token)),
+		logger:           logger,
+		retention:        retention.NewRunner(app.DB, retentionCfg, logger),
+		server:           server,
+		interval:         interval,
+		maxBackfillGap:   maxBackfillGap,
+		backfillWindow:   backfillWindow,
+		trendPeriod:      trendPeriod,
+		clearedRetention: time.Duration(retentionCfg.ClearedUsers) * 24 * time.Hour,
 	}
 }
 
-// Start begins the statistics worker's main loop.
-func (w *Worker) Start() { //nolint:funlen
+// Start begins the statistics worker's main loop, which runs until the context
+// is cancelled and always finishes the current snapshot first.
+func (w *Worker) Start(ctx context.Context) { //nolint:funlen
 	w.logger.Info("Statistics Worker started", zap.String("workerID", w.reporter.GetWorkerID()))
 	w.reporter.Start()
 	defer w.reporter.Stop()
 
 	w.bar.SetTotal(100)
 
-	for {
+	// Serve public statistics for as long as the worker runs
+	if w.server != nil {
+		if err := w.server.Start(); err != nil {
+			w.logger.Error("Failed to start public stats server", zap.Error(err))
+		} else {
+			defer w.server.Shutdown()
+		}
+	}
+
+	// Fill hours missed while the worker was down
+	w.bar.SetStepMessage("Backfilling missing hours", 0)
+	w.reporter.UpdateStatus("Backfilling missing hours", 0)
+	since := time.Now().UTC().Add(-w.backfillWindow)
+	if _, err := w.db.Stats().BackfillMissingHours(context.Background(), since, w.maxBackfillGap); err != nil {
+		w.logger.Error("Failed to backfill missing hours", zap.Error(err))
+	}
+
+	for ctx.Err() == nil {
 		w.bar.Reset()
 		w.reporter.SetHealthy(true)
 
-		ctx := context.Background()
+		// Let the current snapshot finish even if shutdown is requested
+		batchCtx := context.Background()
 		currentHour := time.Now().UTC().Truncate(time.Hour)
 
 		// Step 1: Check if stats exist for current hour (0%)
 		w.bar.SetStepMessage("Checking current hour stats", 0)
 		w.reporter.UpdateStatus("Checking current hour stats", 0)
 
-		exists, err := w.db.Stats().HasStatsForHour(ctx, currentHour)
+		exists, err := w.db.Stats().HasStatsForHour(batchCtx, currentHour)
 		if err != nil {
 			w.logger.Error("Failed to check current hour stats", zap.Error(err))
 			w.reporter.SetHealthy(false)
@@ -81,7 +179,7 @@ func (w *Worker) Start() { //nolint:funlen
 			// Step 2: Get current stats (20%)
 			w.bar.SetStepMessage("Collecting statistics", 20)
 			w.reporter.UpdateStatus("Collecting statistics", 20)
-			stats, err := w.db.Stats().GetCurrentStats(ctx)
+			stats, err := w.db.Stats().GetCurrentStats(batchCtx)
 			if err != nil {
 				w.logger.Error("Failed to get current stats", zap.Error(err))
 				w.reporter.SetHealthy(false)
@@ -91,15 +189,18 @@ func (w *Worker) Start() { //nolint:funlen
 			// Step 3: Save current stats (40%)
 			w.bar.SetStepMessage("Saving statistics", 40)
 			w.reporter.UpdateStatus("Saving statistics", 40)
-			if err := w.db.Stats().SaveHourlyStats(ctx, stats); err != nil {
+			if err := w.db.Stats().SaveHourlyStats(batchCtx, stats); err != nil {
 				w.logger.Error("Failed to save hourly stats", zap.Error(err))
 				w.reporter.SetHealthy(false)
 				continue
 			}
+
+			// Record how far friend flags have cascaded
+			w.logFlagGenerations(batchCtx)
 		}
 
 		// Get hourly stats
-		hourlyStats, err := w.db.Stats().GetHourlyStats(ctx)
+		hourlyStats, err := w.db.Stats().GetHourlyStats(batchCtx)
 		if err != nil {
 			w.logger.Error("Failed to get hourly stats", zap.Error(err))
 			w.reporter.SetHealthy(false)
@@ -109,7 +210,7 @@ func (w *Worker) Start() { //nolint:funlen
 		// Step 4: Generate and cache charts (50%)
 		w.bar.SetStepMessage("Generating charts", 50)
 		w.reporter.UpdateStatus("Generating charts", 50)
-		if err := w.generateAndCacheCharts(ctx, hourlyStats); err != nil {
+		if err := w.generateAndCacheCharts(batchCtx, hourlyStats); err != nil {
 			w.logger.Error("Failed to generate and cache charts", zap.Error(err))
 			w.reporter.SetHealthy(false)
 			continue
@@ -118,30 +219,72 @@ func (w *Worker) Start() { //nolint:funlen
 		// Step 5: Update welcome message (60%)
 		w.bar.SetStepMessage("Updating welcome message", 60)
 		w.reporter.UpdateStatus("Updating welcome message", 60)
-		if err := w.updateWelcomeMessage(ctx, hourlyStats); err != nil {
+		if err := w.updateWelcomeMessage(batchCtx, hourlyStats); err != nil {
 			w.logger.Error("Failed to update welcome message", zap.Error(err))
 			w.reporter.SetHealthy(false)
 			continue
 		}
 
-		// Step 6: Clean up old stats (80%)
-		w.bar.SetStepMessage("Cleaning up old stats", 80)
-		w.reporter.UpdateStatus("Cleaning up old stats", 80)
-		cutoffDate := time.Now().UTC().AddDate(0, 0, -30) // 30 days ago
-		if err := w.db.Stats().PurgeOldStats(ctx, cutoffDate); err != nil {
-			w.logger.Error("Failed to purge old stats", zap.Error(err))
+		// Step 6: Apply retention policies (80%)
+		w.bar.SetStepMessage("Applying retention policies", 80)
+		w.reporter.UpdateStatus("Applying retention policies", 80)
+		// A failed purge is retried next hour without holding back the remaining steps
+		if _, err := w.retention.Run(batchCtx); err != nil {
+			w.logger.Error("Failed to apply retention policies", zap.Error(err))
+			w.reporter.SetHealthy(false)
+		}
+
+		// Step 7: Release stale appeal claims (90%)
+		w.bar.SetStepMessage("Releasing stale appeal claims", 90)
+		w.reporter.UpdateStatus("Releasing stale appeal claims", 90)
+		if err := w.releaseStaleAppealClaims(batchCtx); err != nil {
+			w.logger.Error("Failed to release stale appeal claims", zap.Error(err))
+			w.reporter.SetHealthy(false)
+			continue
+		}
+
+		// Step 8: Refresh decayed reputation scores (93%)
+		w.bar.SetStepMessage("Refreshing decayed reputation", 93)
+		w.reporter.UpdateStatus("Refreshing decayed reputation", 93)
+		refreshedAt := time.Now()
+		if _, err := w.db.Reputation().RefreshDecayedScores(batchCtx, w.decayRefreshedAt, refreshedAt); err != nil {
+			w.logger.Error("Failed to refresh decayed reputation scores", zap.Error(err))
 			w.reporter.SetHealthy(false)
 			continue
 		}
+		w.decayRefreshedAt = refreshedAt
 
-		// Step 7: Completed (100%)
-		w.bar.SetStepMessage("Waiting for next hour", 100)
-		w.reporter.UpdateStatus("Waiting for next hour", 100)
-		nextHour := currentHour.Add(time.Hour)
-		time.Sleep(time.Until(nextHour))
+		// Step 9: Extract trending terms (95%)
+		w.bar.SetStepMessage("Extracting trending terms", 95)
+		w.reporter.UpdateStatus("Extracting trending terms", 95)
+		if err := w.extractTrendingTerms(batchCtx); err != nil {
+			w.logger.Error("Failed to extract trending terms", zap.Error(err))
+			w.reporter.SetHealthy(false)
+			continue
+		}
 
-		w.logger.Info("Hourly statistics processing completed")
+		// Step 10: Send cleared user expiry digest (98%)
+		w.bar.SetStepMessage("Sending expiry digest", 98)
+		w.reporter.UpdateStatus("Sending expiry digest", 98)
+		if err := w.sendExpiryDigest(batchCtx); err != nil {
+			w.logger.Error("Failed to send expiry digest", zap.Error(err))
+			w.reporter.SetHealthy(false)
+			continue
+		}
+
+		// Step 11: Completed (100%)
+		w.bar.SetStepMessage("Waiting for next snapshot", 100)
+		w.reporter.UpdateStatus("Waiting for next snapshot", 100)
+		nextRun := time.Now().UTC().Truncate(w.interval).Add(w.interval)
+		core.SleepContext(ctx, time.Until(nextRun))
+
+		w.logger.Info("Statistics processing completed")
 	}
+
+	// Report that the worker stopped after finishing its last batch
+	w.bar.SetStepMessage("Stopped", 100)
+	w.reporter.UpdateStatus("Stopped", 100)
+	w.logger.Info("Statistics Worker stopped")
 }
 
 // generateAndCacheCharts generates statistics charts and caches them in Redis.
@@ -199,3 +342,171 @@ func (w *Worker) updateWelcomeMessage(ctx context.Context, hourlyStats []*types.
 	w.logger.Info("Updated welcome message", zap.String("message", message))
 	return nil
 }
+
+// logFlagGenerations logs the number of flagged users at each flag generation so
+// that friend flags cascading through a community can be spotted.
+func (w *Worker) logFlagGenerations(ctx context.Context) {
+	counts, err := w.db.Stats().GetFlaggedUserCountsByGeneration(ctx)
+	if err != nil {
+		w.logger.Error("Failed to get flag generation counts", zap.Error(err))
+		return
+	}
+
+	fields := make([]zap.Field, 0, len(counts))
+	for _, count := range counts {
+		fields = append(fields, zap.Int("generation"+strconv.Itoa(count.FlagGeneration), count.Count))
+	}
+	w.logger.Info("Flagged users by generation", fields...)
+}
+
+// releaseStaleAppealClaims unclaims appeals whose claimer has gone quiet and notifies the claimer.
+func (w *Worker) releaseStaleAppealClaims(ctx context.Context) error {
+	botSettings, err := w.db.Settings().GetBotSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get bot settings: %w", err)
+	}
+
+	// Skip if stale claim release is disabled
+	if botSettings.AppealStaleDays == 0 {
+		return nil
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -int(botSettings.AppealStaleDays))
+	appeals, err := w.db.Appeals().ReleaseStaleClaims(ctx, cutoff)
+	if err != nil {
+		return fmt.Errorf("failed to release stale claims: %w", err)
+	}
+
+	for _, appeal := range appeals {
+		w.logger.Info("Released stale appeal claim",
+			zap.Int64("appealID", appeal.ID),
+			zap.Uint64("claimedBy", appeal.ClaimedBy),
+			zap.Time("claimedAt", appeal.ClaimedAt))
+
+		// Notification failures shouldn't stop the sweep
+		if err := w.notifyReleasedClaim(appeal); err != nil {
+			w.logger.Warn("Failed to notify claimer of released appeal",
+				zap.Int64("appealID", appeal.ID),
+				zap.Uint64("claimedBy", appeal.ClaimedBy),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// extractTrendingTerms counts terms in content flagged during the current trend period,
+// compares them with the period immediately before it and stores the fastest rising terms.
+func (w *Worker) extractTrendingTerms(ctx context.Context) error {
+	now := time.Now()
+	currentStart := now.Add(-w.trendPeriod)
+	previousStart := currentStart.Add(-w.trendPeriod)
+
+	current, err := w.db.Trends().GetFlaggedContent(ctx, currentStart, now)
+	if err != nil {
+		return fmt.Errorf("failed to get current flagged content: %w", err)
+	}
+
+	previous, err := w.db.Trends().GetFlaggedContent(ctx, previousStart, currentStart)
+	if err != nil {
+		return fmt.Errorf("failed to get previous flagged content: %w", err)
+	}
+
+	terms := trends.RisingTerms(trends.CountTerms(current), trends.CountTerms(previous), MaxTrendingTerms, now)
+	if err := w.db.Trends().SaveTrendingTerms(ctx, terms); err != nil {
+		return fmt.Errorf("failed to save trending terms: %w", err)
+	}
+
+	w.logger.Debug("Extracted trending terms",
+		zap.Int("currentContent", len(current)),
+		zap.Int("previousContent", len(previous)),
+		zap.Int("terms", len(terms)))
+	return nil
+}
+
+// sendExpiryDigest posts a weekly summary of cleared users that will be purged
+// within the warning window to the notification channel, so reviewers can pin
+// any they want to keep as reference cases.
+func (w *Worker) sendExpiryDigest(ctx context.Context) error {
+	botSettings, err := w.db.Settings().GetBotSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get bot settings: %w", err)
+	}
+
+	// Skip if no notification channel is configured or cleared users are never purged
+	if botSettings.NotificationChannelID == 0 || w.clearedRetention <= 0 {
+		return nil
+	}
+
+	// Skip if the digest was already sent this week
+	exists, err := w.redisClient.Do(ctx, w.redisClient.B().Exists().Key(ExpiryDigestKey).Build()).AsInt64()
+	if err != nil {
+		return fmt.Errorf("failed to check digest status: %w", err)
+	}
+	if exists > 0 {
+		return nil
+	}
+
+	// Users cleared before the purge cutoff are already gone, so the window
+	// covers those that will pass the cutoff within the warning period
+	now := time.Now()
+	purgeCutoff := now.Add(-w.clearedRetention)
+	warnCutoff := purgeCutoff.Add(types.ClearedUserExpiryWarning)
+
+	count, sample, err := w.db.Users().GetExpiringClearedUsers(ctx, purgeCutoff, warnCutoff, ExpiryDigestSampleSize)
+	if err != nil {
+		return fmt.Errorf("failed to get expiring cleared users: %w", err)
+	}
+
+	if count > 0 {
+		var content strings.Builder
+		content.WriteString(fmt.Sprintf("**%d cleared users** will be purged within the next %d days. "+
+			"Pin any you want to keep as reference cases.\n",
+			count, int(types.ClearedUserExpiryWarning.Hours()/24)))
+		for _, user := range sample {
+			purgeAt := user.ClearedAt.Add(w.clearedRetention)
+			content.WriteString(fmt.Sprintf("- [%s](https://www.roblox.com/users/%d/profile) (`%d`) - purged <t:%d:R>\n",
+				user.Name, user.ID, user.ID, purgeAt.Unix()))
+		}
+		if count > len(sample) {
+			content.WriteString(fmt.Sprintf("... and %d more", count-len(sample)))
+		}
+
+		_, err = w.discordRest.CreateMessage(snowflake.ID(botSettings.NotificationChannelID),
+			discord.NewMessageCreateBuilder().
+				SetContent(content.String()).
+				SetAllowedMentions(&discord.AllowedMentions{}).
+				Build())
+		if err != nil {
+			return fmt.Errorf("failed to send expiry digest: %w", err)
+		}
+	}
+
+	// Mark the digest as sent until the next interval
+	if err := w.redisClient.Do(ctx,
+		w.redisClient.B().Set().Key(ExpiryDigestKey).Value(now.Format(time.RFC3339)).Ex(ExpiryDigestInterval).Build(),
+	).Error(); err != nil {
+		return fmt.Errorf("failed to mark digest as sent: %w", err)
+	}
+
+	w.logger.Info("Sent cleared user expiry digest", zap.Int("count", count))
+	return nil
+}
+
+// notifyReleasedClaim sends a direct message to the reviewer whose claim was released.
+func (w *Worker) notifyReleasedClaim(appeal *types.Appeal) error {
+	channel, err := w.discordRest.CreateDMChannel(snowflake.ID(appeal.ClaimedBy))
+	if err != nil {
+		return fmt.Errorf("failed to create DM channel: %w", err)
+	}
+
+	_, err = w.discordRest.CreateMessage(channel.ID(), discord.NewMessageCreateBuilder().
+		SetContentf("Your claim on appeal `#%d` was released after no replies for a while. "+
+			"It is now available to other reviewers.", appeal.ID).
+		Build())
+	if err != nil {
+		return fmt.Errorf("failed to send DM: %w", err)
+	}
+
+	return nil
+}